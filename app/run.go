@@ -5,7 +5,7 @@ import (
 	"time"
 
 	"github.com/sadlil/go-trigger"
-	"github.com/supudo/Kuplung-Go/engine"
+	"github.com/supudo/Kuplung-Go/engine/platform"
 	"github.com/supudo/Kuplung-Go/interfaces"
 	"github.com/supudo/Kuplung-Go/settings"
 	"github.com/supudo/Kuplung-Go/types"
@@ -17,8 +17,8 @@ func Run(initializer func(interfaces.Window), title string, deferrer <-chan func
 
 	trigger.On(types.ActionLog, addToLog)
 
-	var window *engine.KuplungWindow
-	window = engine.NewKuplungWindow(title)
+	var window *platform.KuplungWindow
+	window = platform.NewKuplungWindow(title)
 	defer window.Close()
 
 	initializer(window)
@@ -0,0 +1,232 @@
+// Command kuplung-scene inspects, validates and converts Kuplung scene files without opening a window.
+//
+//	kuplung-scene info [-json] scene.kuplung
+//	kuplung-scene validate scene.kuplung
+//	kuplung-scene export [-format obj|gltf|glb|stl] -o output scene.kuplung
+//	kuplung-scene render [-width 1280] [-height 720] [-samples 64] [-env environment.hdr] -o output.png scene.kuplung
+//
+// It doesn't link SDL2, go-gl or imgui and builds without cgo, for CI and servers with no display.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/engine"
+	"github.com/supudo/Kuplung-Go/engine/export"
+	"github.com/supudo/Kuplung-Go/rendering"
+	"github.com/supudo/Kuplung-Go/rendering/pathtracer"
+	"github.com/supudo/Kuplung-Go/saveopen"
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+const usage = `usage:
+  kuplung-scene info [-json] <scene>
+  kuplung-scene validate <scene>
+  kuplung-scene export [-format obj|gltf|glb|stl] -o <output> <scene>
+  kuplung-scene render [-width 1280] [-height 720] [-samples 64] [-bounces 6] [-exposure 1]
+                       [-env <environment.hdr>] [-env-rotation 0] -o <output.png> <scene>
+
+The scene is a .kuplung archive or a .json scene with its .bin buffer.
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "info":
+		err = runInfo(os.Args[2:])
+	case "validate":
+		err = runValidate(os.Args[2:])
+	case "export":
+		err = runExport(os.Args[2:])
+	case "render":
+		err = runRender(os.Args[2:])
+	case "-h", "-help", "--help", "help":
+		fmt.Print(usage)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%v", os.Args[1], usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "kuplung-scene %v: %v\n", os.Args[1], err)
+		os.Exit(1)
+	}
+}
+
+func runInfo(args []string) error {
+	fs := flag.NewFlagSet("info", flag.ExitOnError)
+	asJSON := fs.Bool("json", false, "print the summary as JSON")
+	_ = fs.Parse(args)
+	scene, archive, err := readScene(fs)
+	if err != nil {
+		return err
+	}
+	summary := summarize(scene, archive)
+	if *asJSON {
+		return summary.writeJSON(os.Stdout)
+	}
+	summary.writeText(os.Stdout)
+	return nil
+}
+
+func runValidate(args []string) error {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+	_ = fs.Parse(args)
+	scene, archive, err := readScene(fs)
+	if err != nil {
+		return err
+	}
+	problems := archive.Validate()
+	for _, p := range problems {
+		fmt.Println(p)
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%v has %v problems", scene, len(problems))
+	}
+	fmt.Printf("%v is valid, format version %v\n", scene, archive.Version)
+	return nil
+}
+
+func runExport(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	format := fs.String("format", "", "obj, gltf, glb or stl, taken from the output extension if empty")
+	output := fs.String("o", "", "the exported file")
+	_ = fs.Parse(args)
+	if *output == "" {
+		return fmt.Errorf("the output file is missing")
+	}
+	if *format == "" {
+		*format = strings.TrimPrefix(strings.ToLower(filepath.Ext(*output)), ".")
+	}
+
+	var itype types.ImportExportFormat
+	switch *format {
+	case "obj":
+		itype = types.ImportExportFormatOBJ
+	case "gltf", "glb":
+		itype = types.ImportExportFormatGLTF
+	case "stl":
+		itype = types.ImportExportFormatSTL
+	default:
+		return fmt.Errorf("unsupported export format %q", *format)
+	}
+	outputPath := *output
+	if filepath.Ext(outputPath) == "" {
+		outputPath += "." + *format
+	}
+
+	_, archive, err := readScene(fs)
+	if err != nil {
+		return err
+	}
+	noProgress := func(float32) {}
+	faces, warnings := archive.Models(noProgress)
+	for _, w := range warnings {
+		fmt.Fprintln(os.Stderr, w)
+	}
+	if len(faces) == 0 {
+		return fmt.Errorf("the scene has no models to export")
+	}
+
+	file := types.FBEntity{
+		IsFile:    true,
+		Path:      outputPath,
+		Title:     filepath.Base(outputPath),
+		Extension: filepath.Ext(outputPath),
+	}
+	return export.NewExportManager(noProgress).Export(faces, nil, nil, file, nil, itype)
+}
+
+func runRender(args []string) error {
+	fs := flag.NewFlagSet("render", flag.ExitOnError)
+	width := fs.Int("width", 1280, "the image width")
+	height := fs.Int("height", 720, "the image height")
+	samples := fs.Int("samples", 64, "the samples per pixel")
+	bounces := fs.Int("bounces", 6, "the maximum number of bounces along a path")
+	exposure := fs.Float64("exposure", 1.0, "the exposure of the tone mapping")
+	env := fs.String("env", "", "an equirectangular .hdr environment map lighting the scene")
+	envRotation := fs.Float64("env-rotation", 0.0, "the rotation of the environment map around Y in degrees")
+	output := fs.String("o", "", "the rendered .png image")
+	_ = fs.Parse(args)
+	if *output == "" {
+		return fmt.Errorf("the output file is missing")
+	}
+	if *width <= 0 || *height <= 0 || *samples <= 0 {
+		return fmt.Errorf("the size and the samples should be positive")
+	}
+
+	_, archive, err := readScene(fs)
+	if err != nil {
+		return err
+	}
+	// the textures are read from the packed resources first
+	engine.SetPackedResources(archive.Resources)
+	noProgress := func(float32) {}
+	faces, warnings := archive.Models(noProgress)
+	for _, w := range warnings {
+		fmt.Fprintln(os.Stderr, w)
+	}
+
+	scene := pathtracer.NewScene()
+	textures := make(rendering.PathTracingTextures)
+	for _, face := range faces {
+		rendering.AddPathTracingModel(scene, face, mgl32.Ident4(), textures)
+	}
+	for _, light := range archive.Lights() {
+		scene.AddLight(pathtracer.LightFromScene(light))
+	}
+	if *env != "" {
+		img, err := engine.LoadHDRImage(*env)
+		if err != nil {
+			return fmt.Errorf("%v: %v", *env, err)
+		}
+		scene.Environment = rendering.NewPathTracingEnvironment(img, mgl32.Rotate3DY(mgl32.DegToRad(-float32(*envRotation))), mgl32.Vec3{})
+	}
+	scene.Build()
+
+	cam, fov := archive.Camera()
+	options := pathtracer.DefaultOptions()
+	options.Bounces = *bounces
+	options.Exposure = float32(*exposure)
+	renderer := pathtracer.NewRenderer(scene, pathtracer.NewCamera(cam.MatrixCamera, fov, *width, *height), *width, *height, options)
+	fmt.Printf("rendering %v triangles at %vx%v, %v samples per pixel\n", scene.Triangles(), *width, *height, *samples)
+	img := renderer.Render(*samples, noProgress)
+
+	f, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func readScene(fs *flag.FlagSet) (string, *saveopen.SceneArchive, error) {
+	if fs.NArg() != 1 {
+		return "", nil, fmt.Errorf("expected one scene file")
+	}
+	scene := fs.Arg(0)
+	var archive *saveopen.SceneArchive
+	var err error
+	if strings.EqualFold(filepath.Ext(scene), ".json") {
+		archive, err = saveopen.ReadSceneJSON(scene)
+	} else {
+		archive, err = saveopen.ReadScene(scene)
+	}
+	if err != nil {
+		return "", nil, fmt.Errorf("%v: %v", scene, err)
+	}
+	return scene, archive, nil
+}
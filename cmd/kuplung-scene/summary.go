@@ -0,0 +1,176 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/supudo/Kuplung-Go/saveopen"
+)
+
+// sceneSummary is what info prints about a scene
+type sceneSummary struct {
+	File      string                  `json:"file"`
+	Version   uint32                  `json:"version"`
+	Metadata  *saveopen.SceneMetadata `json:"metadata,omitempty"`
+	Thumbnail bool                    `json:"thumbnail"`
+	Models    []modelSummary          `json:"models"`
+	Materials []string                `json:"materials"`
+	Lights    []lightSummary          `json:"lights"`
+	Camera    cameraSummary           `json:"camera"`
+	Resources []string                `json:"packedResources,omitempty"`
+	Totals    summaryTotals           `json:"totals"`
+}
+
+type modelSummary struct {
+	ID        int32    `json:"id"`
+	Title     string   `json:"title"`
+	Material  string   `json:"material"`
+	Vertices  int      `json:"vertices"`
+	Indices   int      `json:"indices"`
+	Triangles int      `json:"triangles"`
+	Textures  []string `json:"textures,omitempty"`
+	Source    string   `json:"source,omitempty"`
+	Linked    bool     `json:"linked,omitempty"`
+}
+
+type lightSummary struct {
+	Title    string     `json:"title"`
+	Type     string     `json:"type"`
+	Position [3]float32 `json:"position"`
+}
+
+type cameraSummary struct {
+	Position [3]float32 `json:"position"`
+	Eye      [3]float32 `json:"eye"`
+	Center   [3]float32 `json:"center"`
+	Up       [3]float32 `json:"up"`
+	Fov      float32    `json:"fov"`
+}
+
+type summaryTotals struct {
+	Models    int `json:"models"`
+	Vertices  int `json:"vertices"`
+	Triangles int `json:"triangles"`
+	Lights    int `json:"lights"`
+}
+
+func summarize(file string, archive *saveopen.SceneArchive) *sceneSummary {
+	summary := &sceneSummary{File: file, Version: archive.Version, Metadata: archive.Metadata, Thumbnail: len(archive.Thumbnail) > 0}
+
+	materials := make(map[string]bool)
+	for _, gm := range archive.Scene.GetModels() {
+		mesh := gm.GetMeshObject()
+		mat := mesh.GetModelMaterial()
+		ms := modelSummary{
+			ID:        gm.GetModelID(),
+			Title:     mesh.GetModelTitle(),
+			Material:  mesh.GetMaterialTitle(),
+			Vertices:  len(mesh.GetVertices()),
+			Indices:   len(mesh.GetIndices()),
+			Triangles: len(mesh.GetIndices()) / 3,
+			Source:    gm.GetSource().GetSourcePath(),
+			Linked:    gm.GetSource().GetLinked(),
+		}
+		for _, image := range []string{
+			mat.GetTextureAmbient().GetImage(),
+			mat.GetTextureDiffuse().GetImage(),
+			mat.GetTextureSpecular().GetImage(),
+			mat.GetTextureSpecularExp().GetImage(),
+			mat.GetTextureDissolve().GetImage(),
+			mat.GetTextureBump().GetImage(),
+			mat.GetTextureDisplacement().GetImage(),
+		} {
+			if image != "" {
+				ms.Textures = append(ms.Textures, image)
+			}
+		}
+		if ms.Material != "" && !materials[ms.Material] {
+			materials[ms.Material] = true
+			summary.Materials = append(summary.Materials, ms.Material)
+		}
+		summary.Models = append(summary.Models, ms)
+		summary.Totals.Vertices += ms.Vertices
+		summary.Totals.Triangles += ms.Triangles
+	}
+	summary.Totals.Models = len(summary.Models)
+
+	for _, l := range archive.Settings.GetLights() {
+		lightType := "directional"
+		switch l.GetType() {
+		case 1:
+			lightType = "point"
+		case 2:
+			lightType = "spot"
+		}
+		summary.Lights = append(summary.Lights, lightSummary{
+			Title:    l.GetTitle(),
+			Type:     lightType,
+			Position: [3]float32{l.GetPositionX().GetPoint(), l.GetPositionY().GetPoint(), l.GetPositionZ().GetPoint()},
+		})
+	}
+	summary.Totals.Lights = len(summary.Lights)
+
+	c := archive.Settings.GetCamera()
+	summary.Camera = cameraSummary{
+		Position: [3]float32{c.GetCameraPosition().GetX(), c.GetCameraPosition().GetY(), c.GetCameraPosition().GetZ()},
+		Eye:      [3]float32{c.GetView_Eye().GetX(), c.GetView_Eye().GetY(), c.GetView_Eye().GetZ()},
+		Center:   [3]float32{c.GetView_Center().GetX(), c.GetView_Center().GetY(), c.GetView_Center().GetZ()},
+		Up:       [3]float32{c.GetView_Up().GetX(), c.GetView_Up().GetY(), c.GetView_Up().GetZ()},
+		Fov:      archive.Settings.GetFov(),
+	}
+
+	for path := range archive.Resources {
+		summary.Resources = append(summary.Resources, path)
+	}
+	sort.Strings(summary.Resources)
+	return summary
+}
+
+func (summary *sceneSummary) writeJSON(w io.Writer) error {
+	data, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+func (summary *sceneSummary) writeText(w io.Writer) {
+	fmt.Fprintf(w, "%v - format version %v\n", summary.File, summary.Version)
+	if md := summary.Metadata; md != nil {
+		fmt.Fprintf(w, "Saved with %v by %v, created %v, modified %v\n", md.Application, md.Author, md.Created.Local().Format("2006-01-02 15:04:05"), md.Modified.Local().Format("2006-01-02 15:04:05"))
+	}
+	fmt.Fprintf(w, "%v models, %v vertices, %v triangles, %v lights\n\n", summary.Totals.Models, summary.Totals.Vertices, summary.Totals.Triangles, summary.Totals.Lights)
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tModel\tMaterial\tVertices\tTriangles\tSource")
+	for _, m := range summary.Models {
+		source := m.Source
+		if m.Linked {
+			source += " (linked)"
+		}
+		fmt.Fprintf(tw, "%v\t%v\t%v\t%v\t%v\t%v\n", m.ID, m.Title, m.Material, m.Vertices, m.Triangles, source)
+	}
+	_ = tw.Flush()
+
+	if len(summary.Lights) > 0 {
+		fmt.Fprintln(w)
+		tw = tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "Light\tType\tPosition")
+		for _, l := range summary.Lights {
+			fmt.Fprintf(tw, "%v\t%v\t%v\n", l.Title, l.Type, l.Position)
+		}
+		_ = tw.Flush()
+	}
+
+	fmt.Fprintf(w, "\nCamera: position %v, eye %v, center %v, up %v, fov %v\n", summary.Camera.Position, summary.Camera.Eye, summary.Camera.Center, summary.Camera.Up, summary.Camera.Fov)
+	if len(summary.Resources) > 0 {
+		fmt.Fprintf(w, "\nPacked resources:\n")
+		for _, r := range summary.Resources {
+			fmt.Fprintf(w, "  %v\n", r)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package engine
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// CubeLUT is a 3D color lookup table, the red index changes fastest as in a 3D texture
+type CubeLUT struct {
+	Title     string
+	Size      int
+	DomainMin [3]float32
+	DomainMax [3]float32
+	Pix       []float32
+}
+
+// LoadCubeLUT reads an Adobe/Resolve .cube 3D lookup table
+func LoadCubeLUT(file string) (*CubeLUT, error) {
+	f, err := OpenResource(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return DecodeCubeLUT(f)
+}
+
+// DecodeCubeLUT decodes a .cube 3D lookup table
+func DecodeCubeLUT(r io.Reader) (*CubeLUT, error) {
+	lut := &CubeLUT{DomainMax: [3]float32{1, 1, 1}}
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		switch fields[0] {
+		case "TITLE":
+			lut.Title = strings.Trim(strings.TrimSpace(strings.TrimPrefix(text, "TITLE")), "\"")
+		case "LUT_1D_SIZE":
+			return nil, fmt.Errorf("1D lookup tables are not supported")
+		case "LUT_3D_SIZE":
+			if len(fields) != 2 {
+				return nil, fmt.Errorf("line %v: invalid LUT_3D_SIZE", line)
+			}
+			size, err := strconv.Atoi(fields[1])
+			if err != nil || size < 2 || size > 256 {
+				return nil, fmt.Errorf("line %v: invalid LUT_3D_SIZE %v", line, fields[1])
+			}
+			lut.Size = size
+			lut.Pix = make([]float32, 0, size*size*size*3)
+		case "DOMAIN_MIN", "DOMAIN_MAX":
+			v, err := parseCubeTriple(fields[1:])
+			if err != nil {
+				return nil, fmt.Errorf("line %v: %v", line, err)
+			}
+			if fields[0] == "DOMAIN_MIN" {
+				lut.DomainMin = v
+			} else {
+				lut.DomainMax = v
+			}
+		default:
+			if lut.Size == 0 {
+				return nil, fmt.Errorf("line %v: table data before LUT_3D_SIZE", line)
+			}
+			v, err := parseCubeTriple(fields)
+			if err != nil {
+				return nil, fmt.Errorf("line %v: %v", line, err)
+			}
+			if len(lut.Pix) == cap(lut.Pix) {
+				return nil, fmt.Errorf("line %v: more than %v entries", line, lut.Size*lut.Size*lut.Size)
+			}
+			lut.Pix = append(lut.Pix, v[0], v[1], v[2])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if lut.Size == 0 {
+		return nil, fmt.Errorf("missing LUT_3D_SIZE")
+	}
+	if len(lut.Pix) != cap(lut.Pix) {
+		return nil, fmt.Errorf("expected %v entries, got %v", lut.Size*lut.Size*lut.Size, len(lut.Pix)/3)
+	}
+	return lut, nil
+}
+
+func parseCubeTriple(fields []string) ([3]float32, error) {
+	var v [3]float32
+	if len(fields) != 3 {
+		return v, fmt.Errorf("expected three values, got %v", len(fields))
+	}
+	for i := 0; i < 3; i++ {
+		f, err := strconv.ParseFloat(fields[i], 32)
+		if err != nil {
+			return v, fmt.Errorf("invalid value %v", fields[i])
+		}
+		v[i] = float32(f)
+	}
+	return v, nil
+}
@@ -0,0 +1,216 @@
+package export
+
+import (
+	"math"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/meshes"
+	"github.com/supudo/Kuplung-Go/utilities"
+)
+
+// crossSectionPlane is a single cutting plane, dot(normal, p) = distance
+type crossSectionPlane struct {
+	normal   mgl32.Vec3
+	distance float32
+}
+
+// crossSectionSlice holds the chained contours of one plane, in plane coordinates
+type crossSectionSlice struct {
+	plane     crossSectionPlane
+	polylines []crossSectionPolyline
+}
+
+type crossSectionPolyline struct {
+	points []mgl32.Vec2
+	closed bool
+}
+
+// crossSectionSegment is a triangle/plane intersection, its ends are identified by the mesh edge they lie on
+type crossSectionSegment struct {
+	keys   [2][2]int
+	points [2]mgl32.Vec3
+	used   bool
+}
+
+// crossSectionPlanes returns count parallel planes, starting at offset along the normal
+func crossSectionPlanes(normal mgl32.Vec3, offset, spacing float32, count int32) []crossSectionPlane {
+	if normal.Len() == 0 {
+		return nil
+	}
+	normal = normal.Normalize()
+	if count < 1 {
+		count = 1
+	}
+	planes := make([]crossSectionPlane, count)
+	for i := int32(0); i < count; i++ {
+		planes[i] = crossSectionPlane{normal: normal, distance: offset + float32(i)*spacing}
+	}
+	return planes
+}
+
+// crossSection intersects the faces, after their model transforms, with the planes
+func crossSection(faces []*meshes.ModelFace, planes []crossSectionPlane, doProgress func(float32)) []crossSectionSlice {
+	type faceGeometry struct {
+		vertices []mgl32.Vec3
+		weldID   []int
+		indices  []uint32
+	}
+
+	geometry := make([]faceGeometry, len(faces))
+	weldBase := 0
+	for i, face := range faces {
+		matrixModel := face.ModelMatrix(mgl32.Ident4())
+		fg := faceGeometry{
+			vertices: make([]mgl32.Vec3, len(face.MeshModel.Vertices)),
+			weldID:   make([]int, len(face.MeshModel.Vertices)),
+			indices:  face.MeshModel.Indices,
+		}
+		welded := make(map[[3]int32]int)
+		for j, v := range face.MeshModel.Vertices {
+			fg.vertices[j] = matrixModel.Mul4x1(v.Vec4(1.0)).Vec3()
+			key := [3]int32{int32(math.Round(float64(fg.vertices[j].X()) * 1e4)), int32(math.Round(float64(fg.vertices[j].Y()) * 1e4)), int32(math.Round(float64(fg.vertices[j].Z()) * 1e4))}
+			if id, ok := welded[key]; ok {
+				fg.weldID[j] = id
+			} else {
+				welded[key] = weldBase + j
+				fg.weldID[j] = weldBase + j
+			}
+		}
+		weldBase += len(face.MeshModel.Vertices)
+		geometry[i] = fg
+	}
+
+	slices := make([]crossSectionSlice, len(planes))
+	for p, plane := range planes {
+		var segments []*crossSectionSegment
+		for _, fg := range geometry {
+			distances := make([]float32, len(fg.vertices))
+			for j, v := range fg.vertices {
+				distances[j] = plane.normal.Dot(v) - plane.distance
+			}
+			for t := 0; t+2 < len(fg.indices); t += 3 {
+				if s := intersectTriangle(fg.indices[t:t+3], fg.vertices, fg.weldID, distances); s != nil {
+					segments = append(segments, s)
+				}
+			}
+		}
+		u, v := utilities.PlaneBasis(plane.normal)
+		slices[p] = crossSectionSlice{plane: plane, polylines: chainSegments(segments, u, v)}
+		doProgress(float32(p+1) / float32(len(planes)) * 100.0)
+	}
+	return slices
+}
+
+// intersectTriangle returns the segment where the triangle crosses the plane, vertices on the plane count as above it
+func intersectTriangle(idx []uint32, vertices []mgl32.Vec3, weldID []int, distances []float32) *crossSectionSegment {
+	s := &crossSectionSegment{}
+	found := 0
+	for k := 0; k < 3; k++ {
+		i0, i1 := idx[k], idx[(k+1)%3]
+		d0, d1 := distances[i0], distances[i1]
+		if (d0 >= 0) == (d1 >= 0) {
+			continue
+		}
+		if found == 2 {
+			return nil
+		}
+		key := [2]int{weldID[i0], weldID[i1]}
+		if key[0] > key[1] {
+			key[0], key[1] = key[1], key[0]
+		}
+		t := d0 / (d0 - d1)
+		s.keys[found] = key
+		s.points[found] = vertices[i0].Add(vertices[i1].Sub(vertices[i0]).Mul(t))
+		found++
+	}
+	if found != 2 || s.keys[0] == s.keys[1] {
+		return nil
+	}
+	return s
+}
+
+// chainSegments joins segments sharing a mesh edge into polylines and projects them onto the plane basis
+func chainSegments(segments []*crossSectionSegment, u, v mgl32.Vec3) []crossSectionPolyline {
+	byKey := make(map[[2]int][]*crossSectionSegment)
+	for _, s := range segments {
+		byKey[s.keys[0]] = append(byKey[s.keys[0]], s)
+		byKey[s.keys[1]] = append(byKey[s.keys[1]], s)
+	}
+
+	project := func(p mgl32.Vec3) mgl32.Vec2 {
+		return mgl32.Vec2{p.Dot(u), p.Dot(v)}
+	}
+
+	// walk from the given end of the segment, marking the segments as used
+	walk := func(start *crossSectionSegment, end int) ([]mgl32.Vec3, [2]int) {
+		var points []mgl32.Vec3
+		key := start.keys[end]
+		for {
+			var next *crossSectionSegment
+			for _, s := range byKey[key] {
+				if !s.used {
+					next = s
+					break
+				}
+			}
+			if next == nil {
+				return points, key
+			}
+			next.used = true
+			k := 0
+			if next.keys[0] != key {
+				k = 1
+			}
+			points = append(points, next.points[1-k])
+			key = next.keys[1-k]
+		}
+	}
+
+	var polylines []crossSectionPolyline
+	for _, s := range segments {
+		if s.used {
+			continue
+		}
+		s.used = true
+		forward, lastKey := walk(s, 1)
+		closed := lastKey == s.keys[0]
+		points := append([]mgl32.Vec3{s.points[0], s.points[1]}, forward...)
+		if closed {
+			points = points[:len(points)-1]
+		} else {
+			backward, _ := walk(s, 0)
+			for i, j := 0, len(backward)-1; i < j; i, j = i+1, j-1 {
+				backward[i], backward[j] = backward[j], backward[i]
+			}
+			points = append(backward, points...)
+		}
+		pl := crossSectionPolyline{closed: closed}
+		for _, p := range points {
+			pl.points = append(pl.points, project(p))
+		}
+		pl.points = removeCollinear(pl.points, pl.closed)
+		polylines = append(polylines, pl)
+	}
+	return polylines
+}
+
+// removeCollinear drops the points lying on the line between their neighbours, left by triangle diagonals
+func removeCollinear(points []mgl32.Vec2, closed bool) []mgl32.Vec2 {
+	if len(points) < 3 {
+		return points
+	}
+	var out []mgl32.Vec2
+	for i := range points {
+		if !closed && (i == 0 || i == len(points)-1) {
+			out = append(out, points[i])
+			continue
+		}
+		prev, next := points[(i+len(points)-1)%len(points)], points[(i+1)%len(points)]
+		a, b := points[i].Sub(prev), next.Sub(points[i])
+		cross := a.X()*b.Y() - a.Y()*b.X()
+		if float64(cross*cross) > 1e-12*float64(a.Dot(a)*b.Dot(b)) || a.Dot(b) < 0 {
+			out = append(out, points[i])
+		}
+	}
+	return out
+}
@@ -0,0 +1,172 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/meshes"
+	"github.com/supudo/Kuplung-Go/settings"
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+// ExporterCrossSection slices the scene with the cross-section planes and writes the contours as SVG or DXF
+type ExporterCrossSection struct {
+	funcProgress func(float32)
+}
+
+// NewExporterCrossSection ...
+func NewExporterCrossSection(doProgress func(float32)) *ExporterCrossSection {
+	return &ExporterCrossSection{funcProgress: doProgress}
+}
+
+// Export writes one SVG file per slice, or a single DXF file with one layer per slice
+func (ecs *ExporterCrossSection) Export(faces []*meshes.ModelFace, file types.FBEntity, psettings []string, asDXF bool) error {
+	rsett := settings.GetRenderingSettings()
+	normal := mgl32.Vec3{rsett.CrossSection.NormalX, rsett.CrossSection.NormalY, rsett.CrossSection.NormalZ}
+	planes := crossSectionPlanes(normal, rsett.CrossSection.Offset, rsett.CrossSection.Spacing, rsett.CrossSection.Count)
+	if len(planes) == 0 {
+		return errors.New("no cross-section planes are defined")
+	}
+
+	ecs.funcProgress(0.0)
+	slices := crossSection(faces, planes, ecs.funcProgress)
+
+	folder := filepath.Dir(file.Path)
+	title := strings.TrimSuffix(file.Title, filepath.Ext(file.Title))
+	if asDXF {
+		if err := ioutil.WriteFile(filepath.Join(folder, title+".dxf"), []byte(ecs.buildDXF(slices)), 0644); err != nil {
+			return err
+		}
+	} else {
+		minB, maxB := crossSectionBounds(slices)
+		for i := 0; i < len(slices); i++ {
+			fileName := title + ".svg"
+			if len(slices) > 1 {
+				fileName = fmt.Sprintf("%s_%03d.svg", title, i+1)
+			}
+			if err := ioutil.WriteFile(filepath.Join(folder, fileName), []byte(ecs.buildSVG(slices[i], minB, maxB)), 0644); err != nil {
+				return err
+			}
+		}
+	}
+	ecs.funcProgress(100.0)
+	return nil
+}
+
+// crossSectionBounds returns the 2D bounds of all slices, so that every SVG shares the same frame
+func crossSectionBounds(slices []crossSectionSlice) (mgl32.Vec2, mgl32.Vec2) {
+	minB := mgl32.Vec2{float32(math.Inf(1)), float32(math.Inf(1))}
+	maxB := mgl32.Vec2{float32(math.Inf(-1)), float32(math.Inf(-1))}
+	for _, slice := range slices {
+		for _, pl := range slice.polylines {
+			for _, p := range pl.points {
+				minB = mgl32.Vec2{float32(math.Min(float64(minB.X()), float64(p.X()))), float32(math.Min(float64(minB.Y()), float64(p.Y())))}
+				maxB = mgl32.Vec2{float32(math.Max(float64(maxB.X()), float64(p.X()))), float32(math.Max(float64(maxB.Y()), float64(p.Y())))}
+			}
+		}
+	}
+	if minB.X() > maxB.X() {
+		return mgl32.Vec2{-1, -1}, mgl32.Vec2{1, 1}
+	}
+	return minB, maxB
+}
+
+func (ecs *ExporterCrossSection) buildSVG(slice crossSectionSlice, minB, maxB mgl32.Vec2) string {
+	size := maxB.Sub(minB)
+	margin := float32(math.Max(float64(size.X()), float64(size.Y()))) * 0.05
+	if margin == 0 {
+		margin = 1
+	}
+
+	var sb strings.Builder
+	sb.WriteString("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n")
+	sb.WriteString(fmt.Sprintf("<svg xmlns=\"http://www.w3.org/2000/svg\" viewBox=\"%g %g %g %g\">\n", minB.X()-margin, -maxB.Y()-margin, size.X()+2*margin, size.Y()+2*margin))
+	sb.WriteString("<!-- Kuplung cross-section export - http://www.github.com/supudo/kuplung/ -->\n")
+	sb.WriteString(fmt.Sprintf("<!-- plane normal [%g, %g, %g], distance %g -->\n", slice.plane.normal.X(), slice.plane.normal.Y(), slice.plane.normal.Z(), slice.plane.distance))
+	sb.WriteString("<g fill=\"none\" stroke=\"#000000\" stroke-width=\"1\">\n")
+	for _, pl := range slice.polylines {
+		var d strings.Builder
+		for i, p := range pl.points {
+			cmd := "L"
+			if i == 0 {
+				cmd = "M"
+			}
+			// svg y goes down
+			d.WriteString(fmt.Sprintf("%s%.4f %.4f", cmd, p.X(), -p.Y()))
+		}
+		if pl.closed {
+			d.WriteString("Z")
+		}
+		sb.WriteString(fmt.Sprintf("<path vector-effect=\"non-scaling-stroke\" d=\"%s\"/>\n", d.String()))
+	}
+	sb.WriteString("</g>\n</svg>\n")
+	return sb.String()
+}
+
+// buildDXF writes an AutoCAD R12 DXF, each slice is a SLICE_nnn layer with 2D polylines
+func (ecs *ExporterCrossSection) buildDXF(slices []crossSectionSlice) string {
+	var sb strings.Builder
+	group := func(code int, value interface{}) {
+		sb.WriteString(fmt.Sprintf("%d\n%v\n", code, value))
+	}
+	layerName := func(i int) string {
+		return fmt.Sprintf("SLICE_%03d", i+1)
+	}
+
+	group(999, "Kuplung cross-section export")
+	group(0, "SECTION")
+	group(2, "HEADER")
+	group(9, "$ACADVER")
+	group(1, "AC1009")
+	group(0, "ENDSEC")
+
+	group(0, "SECTION")
+	group(2, "TABLES")
+	group(0, "TABLE")
+	group(2, "LAYER")
+	group(70, len(slices))
+	for i := range slices {
+		group(0, "LAYER")
+		group(2, layerName(i))
+		group(70, 0)
+		group(62, i%255+1)
+		group(6, "CONTINUOUS")
+	}
+	group(0, "ENDTAB")
+	group(0, "ENDSEC")
+
+	group(0, "SECTION")
+	group(2, "ENTITIES")
+	for i, slice := range slices {
+		for _, pl := range slice.polylines {
+			group(0, "POLYLINE")
+			group(8, layerName(i))
+			group(66, 1)
+			group(10, 0.0)
+			group(20, 0.0)
+			group(30, fmt.Sprintf("%.6f", slice.plane.distance))
+			if pl.closed {
+				group(70, 1)
+			} else {
+				group(70, 0)
+			}
+			for _, p := range pl.points {
+				group(0, "VERTEX")
+				group(8, layerName(i))
+				group(10, fmt.Sprintf("%.6f", p.X()))
+				group(20, fmt.Sprintf("%.6f", p.Y()))
+				group(30, fmt.Sprintf("%.6f", slice.plane.distance))
+			}
+			group(0, "SEQEND")
+			group(8, layerName(i))
+		}
+	}
+	group(0, "ENDSEC")
+	group(0, "EOF")
+	return sb.String()
+}
@@ -0,0 +1,102 @@
+package export
+
+import (
+	"math"
+	"testing"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/meshes"
+)
+
+// TestCrossSection slices a cube and checks the planes and the contours, every contour is a closed polygon
+func TestCrossSection(t *testing.T) {
+	// a regular hexagon with sides of sqrt(2)/2
+	hexagon := float32(3 * math.Sqrt(3) / 4)
+	tests := []struct {
+		name            string
+		position, scale mgl32.Vec3
+		normal          mgl32.Vec3
+		offset, spacing float32
+		count           int32
+		// the expected normal, the distance of every plane and the corners and the area of its contour, nothing when it misses the cube
+		expectedNormal    mgl32.Vec3
+		expectedDistances []float32
+		expectedCorners   []int
+		expectedAreas     []float32
+	}{
+		{"middle", mgl32.Vec3{}, mgl32.Vec3{1, 1, 1}, mgl32.Vec3{0, 2, 0}, 0, 0.5, 1,
+			mgl32.Vec3{0, 1, 0}, []float32{0}, []int{4}, []float32{1}},
+		{"three planes", mgl32.Vec3{}, mgl32.Vec3{1, 1, 1}, mgl32.Vec3{1, 0, 0}, -0.25, 0.25, 3,
+			mgl32.Vec3{1, 0, 0}, []float32{-0.25, 0, 0.25}, []int{4, 4, 4}, []float32{1, 1, 1}},
+		{"past the cube", mgl32.Vec3{}, mgl32.Vec3{1, 1, 1}, mgl32.Vec3{0, 0, 1}, 0.25, 0.5, 3,
+			mgl32.Vec3{0, 0, 1}, []float32{0.25, 0.75, 1.25}, []int{4, 0, 0}, []float32{1, 0, 0}},
+		{"at least one plane", mgl32.Vec3{}, mgl32.Vec3{1, 1, 1}, mgl32.Vec3{0, 0, -1}, 0, 1, 0,
+			mgl32.Vec3{0, 0, -1}, []float32{0}, []int{4}, []float32{1}},
+		{"diagonal", mgl32.Vec3{}, mgl32.Vec3{1, 1, 1}, mgl32.Vec3{1, 1, 1}, 0, 1, 1,
+			mgl32.Vec3{1, 1, 1}.Normalize(), []float32{0}, []int{6}, []float32{hexagon}},
+		// the cube is scaled before it's moved, its center lands at (2, 4, 6) and its sides are 2 long
+		{"scaled and moved", mgl32.Vec3{1, 2, 3}, mgl32.Vec3{2, 2, 2}, mgl32.Vec3{0, 1, 0}, 3.25, 0.75, 4,
+			mgl32.Vec3{0, 1, 0}, []float32{3.25, 4, 4.75, 5.5}, []int{4, 4, 4, 0}, []float32{4, 4, 4, 0}},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			planes := crossSectionPlanes(tc.normal, tc.offset, tc.spacing, tc.count)
+			if len(planes) != len(tc.expectedDistances) {
+				t.Fatalf("planes = %v, expected %v", len(planes), len(tc.expectedDistances))
+			}
+			for i, plane := range planes {
+				if !plane.normal.ApproxEqualThreshold(tc.expectedNormal, 1e-5) {
+					t.Errorf("plane %v normal = %v, expected %v", i, plane.normal, tc.expectedNormal)
+				}
+				if !approxEqual(plane.distance, tc.expectedDistances[i]) {
+					t.Errorf("plane %v distance = %v, expected %v", i, plane.distance, tc.expectedDistances[i])
+				}
+			}
+
+			face := testCube(tc.position, tc.scale, mgl32.Vec3{})
+			slices := crossSection([]*meshes.ModelFace{face}, planes, noProgress)
+			if len(slices) != len(planes) {
+				t.Fatalf("slices = %v, expected one for each of the %v planes", len(slices), len(planes))
+			}
+			for i, slice := range slices {
+				if tc.expectedCorners[i] == 0 {
+					if len(slice.polylines) != 0 {
+						t.Errorf("slice %v has %v contours, the plane misses the cube", i, len(slice.polylines))
+					}
+					continue
+				}
+				if len(slice.polylines) != 1 {
+					t.Fatalf("slice %v has %v contours, expected 1", i, len(slice.polylines))
+				}
+				contour := slice.polylines[0]
+				if !contour.closed {
+					t.Errorf("slice %v contour isn't closed", i)
+				}
+				if len(contour.points) != tc.expectedCorners[i] {
+					t.Errorf("slice %v contour has %v corners, expected %v", i, len(contour.points), tc.expectedCorners[i])
+				}
+				if area := polygonArea(contour.points); !approxEqual(area, tc.expectedAreas[i]) {
+					t.Errorf("slice %v contour area = %v, expected %v", i, area, tc.expectedAreas[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCrossSectionPlanesWithoutNormal(t *testing.T) {
+	if planes := crossSectionPlanes(mgl32.Vec3{}, 0, 1, 3); planes != nil {
+		t.Errorf("planes = %v, expected none without a normal", planes)
+	}
+}
+
+// polygonArea is the unsigned area of the closed polygon
+func polygonArea(points []mgl32.Vec2) float32 {
+	area := float32(0)
+	for i := range points {
+		a, b := points[i], points[(i+1)%len(points)]
+		area += a.X()*b.Y() - b.X()*a.Y()
+	}
+	return float32(math.Abs(float64(area))) / 2
+}
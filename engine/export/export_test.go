@@ -0,0 +1,53 @@
+package export
+
+import (
+	"math"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/meshes"
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+// testCube returns a unit cube centered at the origin, every side has its own four vertices with the side normal and texture coordinates
+func testCube(position, scale, rotate mgl32.Vec3) *meshes.ModelFace {
+	sides := []struct{ normal, u, v mgl32.Vec3 }{
+		{mgl32.Vec3{1, 0, 0}, mgl32.Vec3{0, 0, -1}, mgl32.Vec3{0, 1, 0}},
+		{mgl32.Vec3{-1, 0, 0}, mgl32.Vec3{0, 0, 1}, mgl32.Vec3{0, 1, 0}},
+		{mgl32.Vec3{0, 1, 0}, mgl32.Vec3{1, 0, 0}, mgl32.Vec3{0, 0, -1}},
+		{mgl32.Vec3{0, -1, 0}, mgl32.Vec3{1, 0, 0}, mgl32.Vec3{0, 0, 1}},
+		{mgl32.Vec3{0, 0, 1}, mgl32.Vec3{1, 0, 0}, mgl32.Vec3{0, 1, 0}},
+		{mgl32.Vec3{0, 0, -1}, mgl32.Vec3{-1, 0, 0}, mgl32.Vec3{0, 1, 0}},
+	}
+	corners := []mgl32.Vec2{{0, 0}, {1, 0}, {1, 1}, {0, 1}}
+
+	model := types.MeshModel{ModelTitle: "Cube"}
+	model.ModelMaterial.MaterialTitle = "CubeMaterial"
+	model.ModelMaterial.DiffuseColor = mgl32.Vec3{0.8, 0.2, 0.1}
+	model.ModelMaterial.Transparency = 1
+	for _, side := range sides {
+		base := uint32(len(model.Vertices))
+		for _, c := range corners {
+			p := side.normal.Add(side.u.Mul(c.X()*2 - 1)).Add(side.v.Mul(c.Y()*2 - 1)).Mul(0.5)
+			model.Vertices = append(model.Vertices, p)
+			model.Normals = append(model.Normals, side.normal)
+			model.TextureCoordinates = append(model.TextureCoordinates, c)
+		}
+		model.Indices = append(model.Indices, base, base+1, base+2, base, base+2, base+3)
+	}
+	model.CountVertices = int32(len(model.Vertices))
+	model.CountNormals = int32(len(model.Normals))
+	model.CountTextureCoordinates = int32(len(model.TextureCoordinates))
+	model.CountIndices = int32(len(model.Indices))
+
+	face := &meshes.ModelFace{MeshModel: model}
+	face.PositionX.Point, face.PositionY.Point, face.PositionZ.Point = position.X(), position.Y(), position.Z()
+	face.ScaleX.Point, face.ScaleY.Point, face.ScaleZ.Point = scale.X(), scale.Y(), scale.Z()
+	face.RotateX.Point, face.RotateY.Point, face.RotateZ.Point = rotate.X(), rotate.Y(), rotate.Z()
+	return face
+}
+
+func noProgress(float32) {}
+
+func approxEqual(a, b float32) bool {
+	return math.Abs(float64(a-b)) < 1e-4
+}
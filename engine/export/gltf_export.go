@@ -0,0 +1,407 @@
+package export
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/meshes"
+	"github.com/supudo/Kuplung-Go/settings"
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+// glTF constants
+const (
+	gltfComponentFloat       = 5126
+	gltfComponentUInt        = 5125
+	gltfTargetArrayBuffer    = 34962
+	gltfTargetElementBuffer  = 34963
+	gltfModeTriangles        = 4
+	glbMagic                 = 0x46546C67
+	glbVersion               = 2
+	glbChunkJSON             = 0x4E4F534A
+	glbChunkBIN              = 0x004E4942
+	gltfSamplerLinear        = 9729
+	gltfSamplerLinearMipmaps = 9987
+	gltfSamplerRepeat        = 10497
+)
+
+// ExporterGLTF ...
+type ExporterGLTF struct {
+	funcProgress func(float32)
+
+	exportFile      types.FBEntity
+	binary          bool
+	collectTextures bool
+
+	doc    *gltfDocument
+	buffer bytes.Buffer
+	tc     *textureCollector
+
+	images    map[string]int
+	materials map[string]int
+}
+
+type gltfDocument struct {
+	Asset       gltfAsset        `json:"asset"`
+	Scene       int              `json:"scene"`
+	Scenes      []gltfScene      `json:"scenes"`
+	Nodes       []gltfNode       `json:"nodes,omitempty"`
+	Meshes      []gltfMesh       `json:"meshes,omitempty"`
+	Materials   []gltfMaterial   `json:"materials,omitempty"`
+	Textures    []gltfTexture    `json:"textures,omitempty"`
+	Images      []gltfImage      `json:"images,omitempty"`
+	Samplers    []gltfSampler    `json:"samplers,omitempty"`
+	Accessors   []gltfAccessor   `json:"accessors,omitempty"`
+	BufferViews []gltfBufferView `json:"bufferViews,omitempty"`
+	Buffers     []gltfBuffer     `json:"buffers,omitempty"`
+}
+
+type gltfAsset struct {
+	Version   string `json:"version"`
+	Generator string `json:"generator"`
+}
+
+type gltfScene struct {
+	Nodes []int `json:"nodes"`
+}
+
+// gltfNode places the mesh with a column-major matrix, glTF composes translation, rotation and scale
+// as T*R*S and can't express the scale-first order Kuplung places the models in
+type gltfNode struct {
+	Name   string      `json:"name,omitempty"`
+	Mesh   int         `json:"mesh"`
+	Matrix [16]float32 `json:"matrix"`
+}
+
+type gltfMesh struct {
+	Name       string          `json:"name,omitempty"`
+	Primitives []gltfPrimitive `json:"primitives"`
+}
+
+type gltfPrimitive struct {
+	Attributes map[string]int `json:"attributes"`
+	Indices    int            `json:"indices"`
+	Material   *int           `json:"material,omitempty"`
+	Mode       int            `json:"mode"`
+}
+
+type gltfMaterial struct {
+	Name                 string           `json:"name,omitempty"`
+	PbrMetallicRoughness gltfPBR          `json:"pbrMetallicRoughness"`
+	NormalTexture        *gltfTextureInfo `json:"normalTexture,omitempty"`
+	EmissiveFactor       [3]float32       `json:"emissiveFactor"`
+	AlphaMode            string           `json:"alphaMode"`
+}
+
+type gltfPBR struct {
+	BaseColorFactor  [4]float32       `json:"baseColorFactor"`
+	BaseColorTexture *gltfTextureInfo `json:"baseColorTexture,omitempty"`
+	MetallicFactor   float32          `json:"metallicFactor"`
+	RoughnessFactor  float32          `json:"roughnessFactor"`
+}
+
+type gltfTextureInfo struct {
+	Index int `json:"index"`
+}
+
+type gltfTexture struct {
+	Sampler int `json:"sampler"`
+	Source  int `json:"source"`
+}
+
+type gltfImage struct {
+	Name       string `json:"name,omitempty"`
+	URI        string `json:"uri,omitempty"`
+	MimeType   string `json:"mimeType,omitempty"`
+	BufferView *int   `json:"bufferView,omitempty"`
+}
+
+type gltfSampler struct {
+	MagFilter int `json:"magFilter"`
+	MinFilter int `json:"minFilter"`
+	WrapS     int `json:"wrapS"`
+	WrapT     int `json:"wrapT"`
+}
+
+type gltfAccessor struct {
+	BufferView    int       `json:"bufferView"`
+	ComponentType int       `json:"componentType"`
+	Count         int       `json:"count"`
+	Type          string    `json:"type"`
+	Min           []float32 `json:"min,omitempty"`
+	Max           []float32 `json:"max,omitempty"`
+}
+
+type gltfBufferView struct {
+	Buffer     int  `json:"buffer"`
+	ByteOffset int  `json:"byteOffset"`
+	ByteLength int  `json:"byteLength"`
+	Target     *int `json:"target,omitempty"`
+}
+
+type gltfBuffer struct {
+	ByteLength int    `json:"byteLength"`
+	URI        string `json:"uri,omitempty"`
+}
+
+// NewExporterGLTF ...
+func NewExporterGLTF(doProgress func(float32)) *ExporterGLTF {
+	return &ExporterGLTF{funcProgress: doProgress}
+}
+
+// Export writes the faces as .gltf (with external .bin) or as binary .glb, depending on the file extension.
+// When texture collection is on, images are embedded in the .glb or copied into textures/ for .gltf.
+func (egltf *ExporterGLTF) Export(faces []*meshes.ModelFace, file types.FBEntity, psettings []string) error {
+	egltf.exportFile = file
+	egltf.binary = strings.ToLower(filepath.Ext(file.Path)) == ".glb"
+	egltf.collectTextures = collectTexturesSetting(psettings)
+	egltf.tc = newTextureCollector(filepath.Dir(file.Path))
+	egltf.images = make(map[string]int)
+	egltf.materials = make(map[string]int)
+	egltf.buffer.Reset()
+	egltf.doc = &gltfDocument{
+		Asset:  gltfAsset{Version: "2.0", Generator: "Kuplung"},
+		Scenes: []gltfScene{{Nodes: []int{}}},
+	}
+
+	egltf.funcProgress(0.0)
+	for i := 0; i < len(faces); i++ {
+		egltf.exportMesh(faces[i])
+		egltf.funcProgress((float32(i+1) / float32(len(faces))) * 100.0)
+	}
+
+	return egltf.save()
+}
+
+func (egltf *ExporterGLTF) exportMesh(face *meshes.ModelFace) {
+	model := face.MeshModel
+
+	positions := make([]float32, 0, len(model.Vertices)*3)
+	pmin := []float32{math.MaxFloat32, math.MaxFloat32, math.MaxFloat32}
+	pmax := []float32{-math.MaxFloat32, -math.MaxFloat32, -math.MaxFloat32}
+	for _, v := range model.Vertices {
+		positions = append(positions, v.X(), v.Y(), v.Z())
+		for k := 0; k < 3; k++ {
+			pmin[k] = float32(math.Min(float64(pmin[k]), float64(v[k])))
+			pmax[k] = float32(math.Max(float64(pmax[k]), float64(v[k])))
+		}
+	}
+	if len(model.Vertices) == 0 {
+		return
+	}
+
+	attributes := make(map[string]int)
+	attributes["POSITION"] = egltf.addAccessor(positions, len(model.Vertices), "VEC3", pmin, pmax)
+
+	if len(model.Normals) == len(model.Vertices) {
+		normals := make([]float32, 0, len(model.Normals)*3)
+		for _, n := range model.Normals {
+			normals = append(normals, n.X(), n.Y(), n.Z())
+		}
+		attributes["NORMAL"] = egltf.addAccessor(normals, len(model.Normals), "VEC3", nil, nil)
+	}
+
+	if len(model.TextureCoordinates) == len(model.Vertices) {
+		uvs := make([]float32, 0, len(model.TextureCoordinates)*2)
+		for _, uv := range model.TextureCoordinates {
+			uvs = append(uvs, uv.X(), 1.0-uv.Y())
+		}
+		attributes["TEXCOORD_0"] = egltf.addAccessor(uvs, len(model.TextureCoordinates), "VEC2", nil, nil)
+	}
+
+	indices := egltf.addIndices(model.Indices)
+	material := egltf.addMaterial(&model.ModelMaterial)
+
+	egltf.doc.Meshes = append(egltf.doc.Meshes, gltfMesh{
+		Name: model.ModelTitle,
+		Primitives: []gltfPrimitive{{
+			Attributes: attributes,
+			Indices:    indices,
+			Material:   &material,
+			Mode:       gltfModeTriangles,
+		}},
+	})
+
+	egltf.doc.Nodes = append(egltf.doc.Nodes, gltfNode{
+		Name:   model.ModelTitle,
+		Mesh:   len(egltf.doc.Meshes) - 1,
+		Matrix: face.ModelMatrix(mgl32.Ident4()),
+	})
+	egltf.doc.Scenes[0].Nodes = append(egltf.doc.Scenes[0].Nodes, len(egltf.doc.Nodes)-1)
+}
+
+func (egltf *ExporterGLTF) addMaterial(mat *types.MeshModelMaterial) int {
+	if idx, ok := egltf.materials[mat.MaterialTitle]; ok {
+		return idx
+	}
+
+	alpha := mat.Transparency
+	if alpha <= 0.0 {
+		alpha = 1.0
+	}
+	gmat := gltfMaterial{
+		Name: mat.MaterialTitle,
+		PbrMetallicRoughness: gltfPBR{
+			BaseColorFactor: [4]float32{mat.DiffuseColor.X(), mat.DiffuseColor.Y(), mat.DiffuseColor.Z(), alpha},
+			MetallicFactor:  0.0,
+			RoughnessFactor: 1.0 - float32(math.Min(float64(mat.SpecularExp)/1000.0, 1.0)),
+		},
+		EmissiveFactor: [3]float32{mat.EmissionColor.X(), mat.EmissionColor.Y(), mat.EmissionColor.Z()},
+		AlphaMode:      "OPAQUE",
+	}
+	if alpha < 1.0 {
+		gmat.AlphaMode = "BLEND"
+	}
+	if tex := egltf.addTexture(mat.TextureDiffuse.Image); tex >= 0 {
+		gmat.PbrMetallicRoughness.BaseColorTexture = &gltfTextureInfo{Index: tex}
+	}
+	if tex := egltf.addTexture(mat.TextureBump.Image); tex >= 0 {
+		gmat.NormalTexture = &gltfTextureInfo{Index: tex}
+	}
+
+	egltf.doc.Materials = append(egltf.doc.Materials, gmat)
+	egltf.materials[mat.MaterialTitle] = len(egltf.doc.Materials) - 1
+	return len(egltf.doc.Materials) - 1
+}
+
+func (egltf *ExporterGLTF) addTexture(image string) int {
+	if len(image) == 0 {
+		return -1
+	}
+	if idx, ok := egltf.images[image]; ok {
+		return idx
+	}
+
+	mimeType := ""
+	switch strings.ToLower(filepath.Ext(image)) {
+	case ".png":
+		mimeType = "image/png"
+	case ".jpg", ".jpeg":
+		mimeType = "image/jpeg"
+	default:
+		settings.LogWarn("[ExporterGLTF] Texture %v is not PNG or JPEG, skipping.", image)
+		return -1
+	}
+
+	gimage := gltfImage{Name: filepath.Base(image)}
+	switch {
+	case egltf.collectTextures && egltf.binary:
+		data, err := ioutil.ReadFile(image)
+		if err != nil {
+			settings.LogWarn("[ExporterGLTF] Can't embed texture %v : %v", image, err)
+			return -1
+		}
+		bv := egltf.addBufferView(data, nil)
+		gimage.BufferView = &bv
+		gimage.MimeType = mimeType
+	case egltf.collectTextures:
+		gimage.URI = egltf.tc.collect(image)
+	default:
+		gimage.URI = filepath.ToSlash(image)
+	}
+
+	if len(egltf.doc.Samplers) == 0 {
+		egltf.doc.Samplers = append(egltf.doc.Samplers, gltfSampler{
+			MagFilter: gltfSamplerLinear,
+			MinFilter: gltfSamplerLinearMipmaps,
+			WrapS:     gltfSamplerRepeat,
+			WrapT:     gltfSamplerRepeat,
+		})
+	}
+	egltf.doc.Images = append(egltf.doc.Images, gimage)
+	egltf.doc.Textures = append(egltf.doc.Textures, gltfTexture{Sampler: 0, Source: len(egltf.doc.Images) - 1})
+	egltf.images[image] = len(egltf.doc.Textures) - 1
+	return len(egltf.doc.Textures) - 1
+}
+
+func (egltf *ExporterGLTF) addAccessor(data []float32, count int, atype string, min, max []float32) int {
+	buf := new(bytes.Buffer)
+	_ = binary.Write(buf, binary.LittleEndian, data)
+	target := gltfTargetArrayBuffer
+	bv := egltf.addBufferView(buf.Bytes(), &target)
+	egltf.doc.Accessors = append(egltf.doc.Accessors, gltfAccessor{
+		BufferView:    bv,
+		ComponentType: gltfComponentFloat,
+		Count:         count,
+		Type:          atype,
+		Min:           min,
+		Max:           max,
+	})
+	return len(egltf.doc.Accessors) - 1
+}
+
+func (egltf *ExporterGLTF) addIndices(indices []uint32) int {
+	buf := new(bytes.Buffer)
+	_ = binary.Write(buf, binary.LittleEndian, indices)
+	target := gltfTargetElementBuffer
+	bv := egltf.addBufferView(buf.Bytes(), &target)
+	egltf.doc.Accessors = append(egltf.doc.Accessors, gltfAccessor{
+		BufferView:    bv,
+		ComponentType: gltfComponentUInt,
+		Count:         len(indices),
+		Type:          "SCALAR",
+	})
+	return len(egltf.doc.Accessors) - 1
+}
+
+func (egltf *ExporterGLTF) addBufferView(data []byte, target *int) int {
+	for egltf.buffer.Len()%4 != 0 {
+		egltf.buffer.WriteByte(0)
+	}
+	egltf.doc.BufferViews = append(egltf.doc.BufferViews, gltfBufferView{
+		Buffer:     0,
+		ByteOffset: egltf.buffer.Len(),
+		ByteLength: len(data),
+		Target:     target,
+	})
+	egltf.buffer.Write(data)
+	return len(egltf.doc.BufferViews) - 1
+}
+
+func (egltf *ExporterGLTF) save() error {
+	filePath := filepath.Dir(egltf.exportFile.Path)
+	fileName := egltf.exportFile.Title
+	fileName = strings.TrimSuffix(fileName, filepath.Ext(fileName))
+
+	for egltf.buffer.Len()%4 != 0 {
+		egltf.buffer.WriteByte(0)
+	}
+
+	if !egltf.binary {
+		binName := fileName + ".bin"
+		egltf.doc.Buffers = []gltfBuffer{{ByteLength: egltf.buffer.Len(), URI: binName}}
+		if err := ioutil.WriteFile(filepath.Join(filePath, binName), egltf.buffer.Bytes(), 0644); err != nil {
+			return err
+		}
+		jsonData, err := json.MarshalIndent(egltf.doc, "", "  ")
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(filepath.Join(filePath, fileName+".gltf"), jsonData, 0644)
+	}
+
+	egltf.doc.Buffers = []gltfBuffer{{ByteLength: egltf.buffer.Len()}}
+	jsonData, err := json.Marshal(egltf.doc)
+	if err != nil {
+		return err
+	}
+	for len(jsonData)%4 != 0 {
+		jsonData = append(jsonData, ' ')
+	}
+
+	totalLength := 12 + 8 + len(jsonData) + 8 + egltf.buffer.Len()
+	glb := new(bytes.Buffer)
+	_ = binary.Write(glb, binary.LittleEndian, []uint32{glbMagic, glbVersion, uint32(totalLength)})
+	_ = binary.Write(glb, binary.LittleEndian, []uint32{uint32(len(jsonData)), glbChunkJSON})
+	glb.Write(jsonData)
+	_ = binary.Write(glb, binary.LittleEndian, []uint32{uint32(egltf.buffer.Len()), glbChunkBIN})
+	glb.Write(egltf.buffer.Bytes())
+
+	return ioutil.WriteFile(filepath.Join(filePath, fileName+".glb"), glb.Bytes(), 0644)
+}
@@ -0,0 +1,64 @@
+package export
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/meshes"
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+// TestExportGLTFNodeMatrix checks that a scaled, moved and rotated cube is placed as the renderers place it
+func TestExportGLTFNodeMatrix(t *testing.T) {
+	folder, err := ioutil.TempDir("", "kuplung-gltf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(folder)
+
+	face := testCube(mgl32.Vec3{1, 2, 3}, mgl32.Vec3{2, 0.5, 3}, mgl32.Vec3{30, 45, 60})
+	file := types.FBEntity{Path: filepath.Join(folder, "cube.gltf"), Title: "cube.gltf"}
+	if err := NewExporterGLTF(noProgress).Export([]*meshes.ModelFace{face}, file, nil); err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+
+	data, err := ioutil.ReadFile(file.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc struct {
+		Nodes []map[string]json.RawMessage `json:"nodes"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if len(doc.Nodes) != 1 {
+		t.Fatalf("nodes = %v, expected 1", len(doc.Nodes))
+	}
+	node := doc.Nodes[0]
+	for _, trs := range []string{"translation", "rotation", "scale"} {
+		if _, ok := node[trs]; ok {
+			t.Errorf("the node has %q, glTF would apply it after the matrix", trs)
+		}
+	}
+	var matrix [16]float32
+	if err := json.Unmarshal(node["matrix"], &matrix); err != nil {
+		t.Fatalf("matrix: %v", err)
+	}
+
+	expected := face.ModelMatrix(mgl32.Ident4())
+	for i := range matrix {
+		if !approxEqual(matrix[i], expected[i]) {
+			t.Fatalf("matrix = %v, expected %v", matrix, expected)
+		}
+	}
+	// the scale applies to the position too, the center of the cube lands at (1*2, 2*0.5, 3*3)
+	center := mgl32.Mat4(matrix).Mul4x1(mgl32.Vec4{0, 0, 0, 1})
+	if want := (mgl32.Vec3{2, 1, 9}); !approxEqual(center.X(), want.X()) || !approxEqual(center.Y(), want.Y()) || !approxEqual(center.Z(), want.Z()) {
+		t.Errorf("center of the cube = %v, expected %v", center.Vec3(), want)
+	}
+}
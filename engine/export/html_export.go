@@ -0,0 +1,237 @@
+package export
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"html"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/meshes"
+	"github.com/supudo/Kuplung-Go/settings"
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+// ExporterHTML writes a single .html file with the scene and a WebGL2 viewer
+type ExporterHTML struct {
+	funcProgress func(float32)
+
+	exportFile types.FBEntity
+	buffer     bytes.Buffer
+	textures   map[string]int
+	scene      htmlScene
+}
+
+type htmlScene struct {
+	Camera   htmlCamera  `json:"camera"`
+	Lights   []htmlLight `json:"lights"`
+	Meshes   []htmlMesh  `json:"meshes"`
+	Textures []string    `json:"textures"`
+}
+
+type htmlCamera struct {
+	Eye [3]float32 `json:"eye"`
+	Fov float32    `json:"fov"`
+}
+
+type htmlLight struct {
+	Type     int        `json:"type"`
+	Position [3]float32 `json:"position"`
+	Ambient  [3]float32 `json:"ambient"`
+	Diffuse  [3]float32 `json:"diffuse"`
+	Specular [3]float32 `json:"specular"`
+	Att      [3]float32 `json:"att"`
+	Cone     [2]float32 `json:"cone"`
+}
+
+type htmlMesh struct {
+	Name     string       `json:"name"`
+	Model    [16]float32  `json:"model"`
+	Material htmlMaterial `json:"material"`
+	Count    int          `json:"count"`
+	Vertices int          `json:"vertices"`
+	Position int          `json:"position"`
+	Normal   int          `json:"normal"`
+	UV       int          `json:"uv"`
+	Index    int          `json:"index"`
+}
+
+type htmlMaterial struct {
+	Ambient   [3]float32 `json:"ambient"`
+	Diffuse   [3]float32 `json:"diffuse"`
+	Specular  [3]float32 `json:"specular"`
+	Emission  [3]float32 `json:"emission"`
+	Shininess float32    `json:"shininess"`
+	Alpha     float32    `json:"alpha"`
+	Texture   int        `json:"texture"`
+}
+
+// NewExporterHTML ...
+func NewExporterHTML(doProgress func(float32)) *ExporterHTML {
+	return &ExporterHTML{funcProgress: doProgress}
+}
+
+// Export ...
+func (ehtml *ExporterHTML) Export(faces []*meshes.ModelFace, lights []types.SceneLight, camera *types.SceneCamera, file types.FBEntity, psettings []string) error {
+	ehtml.exportFile = file
+	ehtml.buffer.Reset()
+	ehtml.textures = make(map[string]int)
+	ehtml.scene = htmlScene{Lights: []htmlLight{}, Meshes: []htmlMesh{}, Textures: []string{}}
+
+	ehtml.funcProgress(0.0)
+
+	ehtml.scene.Camera = htmlCamera{Eye: [3]float32{0, 0, 10}, Fov: 45.0}
+	if camera != nil {
+		eye := camera.View.Inv().Col(3)
+		if eye.Vec3().Len() > 0.001 {
+			ehtml.scene.Camera.Eye = [3]float32{eye.X(), eye.Y(), eye.Z()}
+		}
+		if camera.Fov > 0.0 {
+			ehtml.scene.Camera.Fov = camera.Fov
+		}
+	}
+
+	for i := 0; i < len(lights); i++ {
+		ehtml.scene.Lights = append(ehtml.scene.Lights, ehtml.exportLight(&lights[i]))
+	}
+
+	for i := 0; i < len(faces); i++ {
+		ehtml.exportMesh(faces[i])
+		ehtml.funcProgress((float32(i+1) / float32(len(faces))) * 100.0)
+	}
+
+	return ehtml.save()
+}
+
+func (ehtml *ExporterHTML) exportLight(light *types.SceneLight) htmlLight {
+	hl := htmlLight{
+		Type:     int(light.LightType),
+		Position: [3]float32{light.Position.X(), light.Position.Y(), light.Position.Z()},
+		Ambient:  htmlVec3(light.Ambient.Mul(light.StrengthAmbient)),
+		Diffuse:  htmlVec3(light.Diffuse.Mul(light.StrengthDiffuse)),
+		Specular: htmlVec3(light.Specular.Mul(light.StrengthSpecular)),
+		Att:      [3]float32{light.Constant, light.Linear, light.Quadratic},
+	}
+	if light.LightType == types.LightSourceTypeSpot {
+		hl.Cone = [2]float32{light.CutOff, light.OuterCutOff}
+	}
+	return hl
+}
+
+func (ehtml *ExporterHTML) exportMesh(face *meshes.ModelFace) {
+	model := face.MeshModel
+	if len(model.Vertices) == 0 || len(model.Indices) == 0 {
+		return
+	}
+
+	matrixModel := face.ModelMatrix(mgl32.Ident4())
+
+	mat := model.ModelMaterial
+	alpha := mat.Transparency
+	if alpha <= 0.0 {
+		alpha = 1.0
+	}
+	mesh := htmlMesh{
+		Name:  model.ModelTitle,
+		Model: matrixModel,
+		Material: htmlMaterial{
+			Ambient:   htmlVec3(mat.AmbientColor),
+			Diffuse:   htmlVec3(mat.DiffuseColor),
+			Specular:  htmlVec3(mat.SpecularColor),
+			Emission:  htmlVec3(mat.EmissionColor),
+			Shininess: mat.SpecularExp,
+			Alpha:     alpha,
+			Texture:   ehtml.addTexture(mat.TextureDiffuse.Image),
+		},
+		Count:    len(model.Indices),
+		Vertices: len(model.Vertices),
+		Normal:   -1,
+		UV:       -1,
+	}
+
+	mesh.Position = ehtml.write(model.Vertices)
+	if len(model.Normals) == len(model.Vertices) {
+		mesh.Normal = ehtml.write(model.Normals)
+	}
+	if len(model.TextureCoordinates) == len(model.Vertices) {
+		mesh.UV = ehtml.write(model.TextureCoordinates)
+	}
+	mesh.Index = ehtml.write(model.Indices)
+
+	ehtml.scene.Meshes = append(ehtml.scene.Meshes, mesh)
+}
+
+// addTexture embeds the image as a data URI and returns its index, or -1
+func (ehtml *ExporterHTML) addTexture(image string) int {
+	if len(image) == 0 {
+		return -1
+	}
+	if idx, ok := ehtml.textures[image]; ok {
+		return idx
+	}
+
+	mimeType := ""
+	switch strings.ToLower(filepath.Ext(image)) {
+	case ".png":
+		mimeType = "image/png"
+	case ".jpg", ".jpeg":
+		mimeType = "image/jpeg"
+	case ".gif":
+		mimeType = "image/gif"
+	case ".bmp":
+		mimeType = "image/bmp"
+	default:
+		settings.LogWarn("[ExporterHTML] Texture %v can't be shown in a browser, skipping.", image)
+		return -1
+	}
+	data, err := ioutil.ReadFile(image)
+	if err != nil {
+		settings.LogWarn("[ExporterHTML] Can't embed texture %v : %v", image, err)
+		return -1
+	}
+
+	ehtml.scene.Textures = append(ehtml.scene.Textures, "data:"+mimeType+";base64,"+base64.StdEncoding.EncodeToString(data))
+	ehtml.textures[image] = len(ehtml.scene.Textures) - 1
+	return len(ehtml.scene.Textures) - 1
+}
+
+// write appends the data to the geometry buffer and returns its byte offset
+func (ehtml *ExporterHTML) write(data interface{}) int {
+	offset := ehtml.buffer.Len()
+	_ = binary.Write(&ehtml.buffer, binary.LittleEndian, data)
+	return offset
+}
+
+func (ehtml *ExporterHTML) save() error {
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	if _, err := zw.Write(ehtml.buffer.Bytes()); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	sceneJSON, err := json.Marshal(ehtml.scene)
+	if err != nil {
+		return err
+	}
+
+	title := strings.TrimSuffix(ehtml.exportFile.Title, filepath.Ext(ehtml.exportFile.Title))
+	contents := strings.NewReplacer(
+		"{{TITLE}}", html.EscapeString(title),
+		"{{SCENE}}", string(sceneJSON),
+		"{{GEOMETRY}}", base64.StdEncoding.EncodeToString(compressed.Bytes()),
+	).Replace(htmlViewerTemplate)
+
+	return ioutil.WriteFile(filepath.Join(filepath.Dir(ehtml.exportFile.Path), title+".html"), []byte(contents), 0644)
+}
+
+func htmlVec3(v mgl32.Vec3) [3]float32 {
+	return [3]float32{v.X(), v.Y(), v.Z()}
+}
@@ -0,0 +1,352 @@
+package export
+
+// htmlViewerTemplate is the WebGL2 viewer used by the HTML exporter.
+// {{TITLE}}, {{SCENE}} and {{GEOMETRY}} are replaced with the scene title, the scene description (JSON)
+// and the gzip compressed, base64 encoded geometry buffer.
+const htmlViewerTemplate = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="generator" content="Kuplung">
+<title>{{TITLE}}</title>
+<style>
+html, body { margin: 0; height: 100%; overflow: hidden; background: #464646; font-family: sans-serif; }
+canvas { display: block; width: 100%; height: 100%; touch-action: none; }
+#ui { position: absolute; top: 10px; left: 10px; color: #ddd; font-size: 12px; user-select: none; }
+#ui button { margin-right: 4px; }
+#error { position: absolute; top: 40%; width: 100%; text-align: center; color: #f88; }
+</style>
+</head>
+<body>
+<canvas id="viewer"></canvas>
+<div id="ui">
+<strong>{{TITLE}}</strong><br>
+<button id="shading">Shading: Textured</button><button id="reset">Reset Camera</button><br>
+Drag to orbit, right drag or shift+drag to pan, wheel to zoom.
+</div>
+<div id="error"></div>
+<script type="application/json" id="kuplung-scene">{{SCENE}}</script>
+<script type="text/plain" id="kuplung-geometry">{{GEOMETRY}}</script>
+<script>
+(function () {
+"use strict";
+
+var MAX_LIGHTS = 8;
+
+var vsSource = [
+  "#version 300 es",
+  "in vec3 aPosition;",
+  "in vec3 aNormal;",
+  "in vec2 aUV;",
+  "uniform mat4 uModel, uView, uProjection;",
+  "out vec3 vPosition;",
+  "out vec3 vNormal;",
+  "out vec2 vUV;",
+  "void main() {",
+  "  vec4 world = uModel * vec4(aPosition, 1.0);",
+  "  vPosition = world.xyz;",
+  "  vNormal = transpose(inverse(mat3(uModel))) * aNormal;",
+  "  vUV = aUV;",
+  "  gl_Position = uProjection * uView * world;",
+  "}"
+].join("\n");
+
+var fsSource = [
+  "#version 300 es",
+  "precision highp float;",
+  "#define MAX_LIGHTS " + MAX_LIGHTS,
+  "in vec3 vPosition;",
+  "in vec3 vNormal;",
+  "in vec2 vUV;",
+  "uniform vec3 uEye;",
+  "uniform vec3 uAmbient, uDiffuse, uSpecular, uEmission;",
+  "uniform float uShininess, uAlpha;",
+  "uniform bool uUseTexture;",
+  "uniform sampler2D uTexture;",
+  "uniform int uLightCount;",
+  "uniform int uLightType[MAX_LIGHTS];",
+  "uniform vec3 uLightPosition[MAX_LIGHTS];",
+  "uniform vec3 uLightAmbient[MAX_LIGHTS];",
+  "uniform vec3 uLightDiffuse[MAX_LIGHTS];",
+  "uniform vec3 uLightSpecular[MAX_LIGHTS];",
+  "uniform vec3 uLightAtt[MAX_LIGHTS];",
+  "uniform vec2 uLightCone[MAX_LIGHTS];",
+  "out vec4 outColor;",
+  "void main() {",
+  "  vec3 n = normalize(vNormal);",
+  "  vec3 v = normalize(uEye - vPosition);",
+  "  if (!gl_FrontFacing) n = -n;",
+  "  vec3 base = uDiffuse;",
+  "  float alpha = uAlpha;",
+  "  if (uUseTexture) { vec4 t = texture(uTexture, vUV); base = t.rgb; alpha *= t.a; }",
+  "  vec3 color = uEmission;",
+  "  if (uLightCount == 0) {",
+  "    float d = max(dot(n, v), 0.0);",
+  "    color += 0.2 * base + 0.8 * d * base;",
+  "  }",
+  "  for (int i = 0; i < MAX_LIGHTS; i++) {",
+  "    if (i >= uLightCount) break;",
+  "    vec3 l;",
+  "    float att = 1.0;",
+  "    if (uLightType[i] == 0) {",
+  "      l = normalize(uLightPosition[i]);",
+  "    } else {",
+  "      vec3 toLight = uLightPosition[i] - vPosition;",
+  "      float dist = length(toLight);",
+  "      l = toLight / max(dist, 0.0001);",
+  "      vec3 a = uLightAtt[i];",
+  "      att = 1.0 / max(a.x + a.y * dist + a.z * dist * dist, 1.0);",
+  "      if (uLightType[i] == 2) {",
+  "        float theta = dot(l, normalize(uLightPosition[i]));",
+  "        float inner = cos(radians(uLightCone[i].x));",
+  "        float outer = cos(radians(uLightCone[i].y));",
+  "        att *= clamp((theta - outer) / max(inner - outer, 0.0001), 0.0, 1.0);",
+  "      }",
+  "    }",
+  "    float diff = max(dot(n, l), 0.0);",
+  "    vec3 h = normalize(l + v);",
+  "    float spec = diff > 0.0 ? pow(max(dot(n, h), 0.0), max(uShininess, 1.0)) : 0.0;",
+  "    color += uLightAmbient[i] * base;",
+  "    color += att * (uLightDiffuse[i] * diff * base + uLightSpecular[i] * spec * uSpecular);",
+  "  }",
+  "  outColor = vec4(color, alpha);",
+  "}"
+].join("\n");
+
+function fail(message) {
+  document.getElementById("error").textContent = message;
+}
+
+function base64ToBytes(text) {
+  var raw = atob(text.trim());
+  var bytes = new Uint8Array(raw.length);
+  for (var i = 0; i < raw.length; i++) bytes[i] = raw.charCodeAt(i);
+  return bytes;
+}
+
+function inflate(bytes) {
+  var stream = new Blob([bytes]).stream().pipeThrough(new DecompressionStream("gzip"));
+  return new Response(stream).arrayBuffer();
+}
+
+// column-major 4x4 matrix helpers
+function perspective(fovy, aspect, near, far) {
+  var f = 1.0 / Math.tan(fovy / 2), nf = 1 / (near - far);
+  return [f / aspect, 0, 0, 0, 0, f, 0, 0, 0, 0, (far + near) * nf, -1, 0, 0, 2 * far * near * nf, 0];
+}
+
+function sub(a, b) { return [a[0] - b[0], a[1] - b[1], a[2] - b[2]]; }
+function cross(a, b) { return [a[1] * b[2] - a[2] * b[1], a[2] * b[0] - a[0] * b[2], a[0] * b[1] - a[1] * b[0]]; }
+function dot(a, b) { return a[0] * b[0] + a[1] * b[1] + a[2] * b[2]; }
+function normalize(a) { var l = Math.sqrt(dot(a, a)) || 1; return [a[0] / l, a[1] / l, a[2] / l]; }
+
+function lookAt(eye, center, up) {
+  var z = normalize(sub(eye, center));
+  var x = normalize(cross(up, z));
+  var y = cross(z, x);
+  return [x[0], y[0], z[0], 0, x[1], y[1], z[1], 0, x[2], y[2], z[2], 0, -dot(x, eye), -dot(y, eye), -dot(z, eye), 1];
+}
+
+function compile(gl, type, source) {
+  var shader = gl.createShader(type);
+  gl.shaderSource(shader, source);
+  gl.compileShader(shader);
+  if (!gl.getShaderParameter(shader, gl.COMPILE_STATUS)) throw new Error(gl.getShaderInfoLog(shader));
+  return shader;
+}
+
+function start(scene, buffer) {
+  var canvas = document.getElementById("viewer");
+  var gl = canvas.getContext("webgl2", { antialias: true });
+  if (!gl) { fail("WebGL2 is not supported by this browser."); return; }
+
+  var program = gl.createProgram();
+  gl.attachShader(program, compile(gl, gl.VERTEX_SHADER, vsSource));
+  gl.attachShader(program, compile(gl, gl.FRAGMENT_SHADER, fsSource));
+  gl.bindAttribLocation(program, 0, "aPosition");
+  gl.bindAttribLocation(program, 1, "aNormal");
+  gl.bindAttribLocation(program, 2, "aUV");
+  gl.linkProgram(program);
+  if (!gl.getProgramParameter(program, gl.LINK_STATUS)) throw new Error(gl.getProgramInfoLog(program));
+
+  var u = {};
+  ["uModel", "uView", "uProjection", "uEye", "uAmbient", "uDiffuse", "uSpecular", "uEmission", "uShininess", "uAlpha",
+   "uUseTexture", "uTexture", "uLightCount", "uLightType", "uLightPosition", "uLightAmbient", "uLightDiffuse",
+   "uLightSpecular", "uLightAtt", "uLightCone"].forEach(function (name) {
+    u[name] = gl.getUniformLocation(program, name) || gl.getUniformLocation(program, name + "[0]");
+  });
+
+  var textures = scene.textures.map(function (uri) {
+    var texture = gl.createTexture();
+    gl.bindTexture(gl.TEXTURE_2D, texture);
+    gl.texImage2D(gl.TEXTURE_2D, 0, gl.RGBA, 1, 1, 0, gl.RGBA, gl.UNSIGNED_BYTE, new Uint8Array([255, 255, 255, 255]));
+    var image = new Image();
+    image.onload = function () {
+      gl.bindTexture(gl.TEXTURE_2D, texture);
+      gl.pixelStorei(gl.UNPACK_FLIP_Y_WEBGL, true);
+      gl.texImage2D(gl.TEXTURE_2D, 0, gl.RGBA, gl.RGBA, gl.UNSIGNED_BYTE, image);
+      gl.generateMipmap(gl.TEXTURE_2D);
+      gl.texParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.LINEAR_MIPMAP_LINEAR);
+      draw();
+    };
+    image.src = uri;
+    return texture;
+  });
+
+  var meshes = scene.meshes.map(function (m) {
+    var vao = gl.createVertexArray();
+    gl.bindVertexArray(vao);
+    function attribute(location, offset, size) {
+      if (offset < 0) { gl.disableVertexAttribArray(location); return; }
+      var vbo = gl.createBuffer();
+      gl.bindBuffer(gl.ARRAY_BUFFER, vbo);
+      gl.bufferData(gl.ARRAY_BUFFER, new Float32Array(buffer, offset, m.vertices * size), gl.STATIC_DRAW);
+      gl.enableVertexAttribArray(location);
+      gl.vertexAttribPointer(location, size, gl.FLOAT, false, 0, 0);
+    }
+    attribute(0, m.position, 3);
+    attribute(1, m.normal, 3);
+    attribute(2, m.uv, 2);
+    var ibo = gl.createBuffer();
+    gl.bindBuffer(gl.ELEMENT_ARRAY_BUFFER, ibo);
+    gl.bufferData(gl.ELEMENT_ARRAY_BUFFER, new Uint32Array(buffer, m.index, m.count), gl.STATIC_DRAW);
+    gl.bindVertexArray(null);
+    return { vao: vao, data: m };
+  });
+
+  // orbit camera around the origin, starting at the exported camera position
+  var orbit = {};
+  function resetCamera() {
+    var eye = scene.camera.eye;
+    orbit.target = [0, 0, 0];
+    orbit.distance = Math.max(Math.sqrt(dot(eye, eye)), 0.1);
+    orbit.yaw = Math.atan2(eye[0], eye[2]);
+    orbit.pitch = Math.asin(Math.max(-1, Math.min(1, eye[1] / orbit.distance)));
+  }
+  resetCamera();
+
+  function eyePosition() {
+    var cp = Math.cos(orbit.pitch);
+    return [orbit.target[0] + orbit.distance * cp * Math.sin(orbit.yaw),
+            orbit.target[1] + orbit.distance * Math.sin(orbit.pitch),
+            orbit.target[2] + orbit.distance * cp * Math.cos(orbit.yaw)];
+  }
+
+  var textured = true;
+  var pending = false;
+
+  function draw() {
+    if (pending) return;
+    pending = true;
+    requestAnimationFrame(render);
+  }
+
+  function render() {
+    pending = false;
+    var dpr = window.devicePixelRatio || 1;
+    var w = Math.floor(canvas.clientWidth * dpr), h = Math.floor(canvas.clientHeight * dpr);
+    if (canvas.width !== w || canvas.height !== h) { canvas.width = w; canvas.height = h; }
+    gl.viewport(0, 0, w, h);
+    gl.clearColor(0.275, 0.275, 0.275, 1.0);
+    gl.clear(gl.COLOR_BUFFER_BIT | gl.DEPTH_BUFFER_BIT);
+    gl.enable(gl.DEPTH_TEST);
+    gl.enable(gl.BLEND);
+    gl.blendFunc(gl.SRC_ALPHA, gl.ONE_MINUS_SRC_ALPHA);
+
+    var eye = eyePosition();
+    gl.useProgram(program);
+    gl.uniformMatrix4fv(u.uProjection, false, perspective(scene.camera.fov * Math.PI / 180, w / Math.max(h, 1), 0.1, 10000));
+    gl.uniformMatrix4fv(u.uView, false, lookAt(eye, orbit.target, [0, 1, 0]));
+    gl.uniform3fv(u.uEye, eye);
+
+    var lights = scene.lights.slice(0, MAX_LIGHTS);
+    var flat = function (key, size) {
+      var out = new Float32Array(MAX_LIGHTS * size);
+      lights.forEach(function (l, i) { out.set(l[key], i * size); });
+      return out;
+    };
+    gl.uniform1i(u.uLightCount, lights.length);
+    gl.uniform1iv(u.uLightType, new Int32Array(MAX_LIGHTS).map(function (_, i) { return i < lights.length ? lights[i].type : 0; }));
+    gl.uniform3fv(u.uLightPosition, flat("position", 3));
+    gl.uniform3fv(u.uLightAmbient, flat("ambient", 3));
+    gl.uniform3fv(u.uLightDiffuse, flat("diffuse", 3));
+    gl.uniform3fv(u.uLightSpecular, flat("specular", 3));
+    gl.uniform3fv(u.uLightAtt, flat("att", 3));
+    gl.uniform2fv(u.uLightCone, flat("cone", 2));
+
+    meshes.forEach(function (mesh) {
+      var m = mesh.data, mat = m.material;
+      gl.uniformMatrix4fv(u.uModel, false, m.model);
+      gl.uniform3fv(u.uAmbient, mat.ambient);
+      gl.uniform3fv(u.uDiffuse, mat.diffuse);
+      gl.uniform3fv(u.uSpecular, mat.specular);
+      gl.uniform3fv(u.uEmission, mat.emission);
+      gl.uniform1f(u.uShininess, mat.shininess);
+      gl.uniform1f(u.uAlpha, mat.alpha);
+      var useTexture = textured && mat.texture >= 0 && m.uv >= 0;
+      gl.uniform1i(u.uUseTexture, useTexture ? 1 : 0);
+      if (useTexture) {
+        gl.activeTexture(gl.TEXTURE0);
+        gl.bindTexture(gl.TEXTURE_2D, textures[mat.texture]);
+        gl.uniform1i(u.uTexture, 0);
+      }
+      gl.bindVertexArray(mesh.vao);
+      gl.drawElements(gl.TRIANGLES, m.count, gl.UNSIGNED_INT, 0);
+    });
+    gl.bindVertexArray(null);
+  }
+
+  // controls
+  var drag = null;
+  canvas.addEventListener("contextmenu", function (e) { e.preventDefault(); });
+  canvas.addEventListener("pointerdown", function (e) {
+    drag = { x: e.clientX, y: e.clientY, pan: e.button === 2 || e.shiftKey };
+    canvas.setPointerCapture(e.pointerId);
+  });
+  canvas.addEventListener("pointerup", function (e) { drag = null; canvas.releasePointerCapture(e.pointerId); });
+  canvas.addEventListener("pointermove", function (e) {
+    if (!drag) return;
+    var dx = e.clientX - drag.x, dy = e.clientY - drag.y;
+    drag.x = e.clientX; drag.y = e.clientY;
+    if (drag.pan) {
+      var eye = eyePosition();
+      var forward = normalize(sub(orbit.target, eye));
+      var right = normalize(cross(forward, [0, 1, 0]));
+      var up = cross(right, forward);
+      var s = orbit.distance * 0.002;
+      for (var i = 0; i < 3; i++) orbit.target[i] += (-dx * right[i] + dy * up[i]) * s;
+    } else {
+      orbit.yaw -= dx * 0.01;
+      orbit.pitch = Math.max(-1.55, Math.min(1.55, orbit.pitch + dy * 0.01));
+    }
+    draw();
+  });
+  canvas.addEventListener("wheel", function (e) {
+    e.preventDefault();
+    orbit.distance = Math.max(0.1, orbit.distance * Math.exp(e.deltaY * 0.001));
+    draw();
+  }, { passive: false });
+  document.getElementById("shading").addEventListener("click", function () {
+    textured = !textured;
+    this.textContent = "Shading: " + (textured ? "Textured" : "Solid");
+    draw();
+  });
+  document.getElementById("reset").addEventListener("click", function () { resetCamera(); draw(); });
+  window.addEventListener("resize", draw);
+  draw();
+}
+
+try {
+  var scene = JSON.parse(document.getElementById("kuplung-scene").textContent);
+  inflate(base64ToBytes(document.getElementById("kuplung-geometry").textContent)).then(function (buffer) {
+    start(scene, buffer);
+  }).catch(function (e) { fail("Can't load the scene: " + e); });
+} catch (e) {
+  fail("Can't load the scene: " + e);
+}
+})();
+</script>
+</body>
+</html>
+`
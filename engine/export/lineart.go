@@ -0,0 +1,306 @@
+package export
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/meshes"
+)
+
+// lineEdgeType is the classification of a line-art edge
+type lineEdgeType int
+
+// Line-art edge types
+const (
+	lineEdgeSilhouette lineEdgeType = iota
+	lineEdgeCrease
+	lineEdgeBoundary
+	lineEdgeTypesCount
+)
+
+// lineArtSettings are the line-art export options, from psettings[3:]
+type lineArtSettings struct {
+	strokeWidths [lineEdgeTypesCount]float32
+	creaseAngle  float32
+}
+
+// lineSegment is a visible 2D segment in viewport coordinates
+type lineSegment struct {
+	a, b mgl32.Vec2
+	kind lineEdgeType
+}
+
+// lineArtVertex is a vertex projected to the viewport
+type lineArtVertex struct {
+	clip  mgl32.Vec4
+	world mgl32.Vec3
+}
+
+type lineArtEdge struct {
+	a, b      lineArtVertex
+	triangles []int
+}
+
+type lineArtTriangle struct {
+	normal mgl32.Vec3
+	front  bool
+}
+
+// lineArtDepthBias is the NDC depth tolerance used when testing edges against the depth buffer
+const lineArtDepthBias = 0.0005
+
+func parseLineArtSettings(psettings []string) lineArtSettings {
+	ls := lineArtSettings{
+		strokeWidths: [lineEdgeTypesCount]float32{2.0, 1.0, 1.5},
+		creaseAngle:  30.0,
+	}
+	parse := func(idx int, value *float32) {
+		if len(psettings) > idx {
+			if f, err := strconv.ParseFloat(psettings[idx], 32); err == nil && f >= 0.0 {
+				*value = float32(f)
+			}
+		}
+	}
+	parse(3, &ls.strokeWidths[lineEdgeSilhouette])
+	parse(4, &ls.strokeWidths[lineEdgeCrease])
+	parse(5, &ls.strokeWidths[lineEdgeBoundary])
+	parse(6, &ls.creaseAngle)
+	return ls
+}
+
+// lineArt computes the visible silhouette, crease and boundary edges of the faces
+type lineArt struct {
+	view, projection mgl32.Mat4
+	eye              mgl32.Vec3
+	width, height    int
+	creaseCos        float32
+
+	depth     []float32
+	edges     []*lineArtEdge
+	triangles []lineArtTriangle
+}
+
+func newLineArt(view, projection mgl32.Mat4, width, height int, creaseAngle float32) *lineArt {
+	la := &lineArt{
+		view:       view,
+		projection: projection,
+		eye:        view.Inv().Col(3).Vec3(),
+		width:      width,
+		height:     height,
+		creaseCos:  float32(math.Cos(float64(mgl32.DegToRad(creaseAngle)))),
+		depth:      make([]float32, width*height),
+	}
+	for i := range la.depth {
+		la.depth[i] = float32(math.Inf(1))
+	}
+	return la
+}
+
+// addFace rasterizes the face into the depth buffer and collects its edges
+func (la *lineArt) addFace(face *meshes.ModelFace) {
+	model := face.MeshModel
+	mvp := la.projection.Mul4(la.view)
+	matrixModel := face.ModelMatrix(mgl32.Ident4())
+
+	vertices := make([]lineArtVertex, len(model.Vertices))
+	for i, v := range model.Vertices {
+		w := matrixModel.Mul4x1(v.Vec4(1.0))
+		vertices[i] = lineArtVertex{world: w.Vec3(), clip: mvp.Mul4x1(w)}
+	}
+
+	// weld vertices by position, so that uv and normal seams don't become boundaries
+	welded := make(map[[3]int32]int)
+	weldID := make([]int, len(vertices))
+	for i, v := range vertices {
+		key := [3]int32{int32(math.Round(float64(v.world.X()) * 1e4)), int32(math.Round(float64(v.world.Y()) * 1e4)), int32(math.Round(float64(v.world.Z()) * 1e4))}
+		if id, ok := welded[key]; ok {
+			weldID[i] = id
+		} else {
+			welded[key] = i
+			weldID[i] = i
+		}
+	}
+
+	edges := make(map[[2]int]*lineArtEdge)
+	for t := 0; t+2 < len(model.Indices); t += 3 {
+		idx := [3]uint32{model.Indices[t], model.Indices[t+1], model.Indices[t+2]}
+		a, b, c := vertices[idx[0]], vertices[idx[1]], vertices[idx[2]]
+		normal := b.world.Sub(a.world).Cross(c.world.Sub(a.world))
+		if normal.Len() < 1e-12 {
+			continue
+		}
+		normal = normal.Normalize()
+		la.triangles = append(la.triangles, lineArtTriangle{normal: normal, front: normal.Dot(la.eye.Sub(a.world)) > 0})
+		triangle := len(la.triangles) - 1
+
+		la.rasterize(a.clip, b.clip, c.clip)
+
+		for k := 0; k < 3; k++ {
+			i0, i1 := idx[k], idx[(k+1)%3]
+			key := [2]int{weldID[i0], weldID[i1]}
+			if key[0] > key[1] {
+				key[0], key[1] = key[1], key[0]
+			}
+			if key[0] == key[1] {
+				continue
+			}
+			e, ok := edges[key]
+			if !ok {
+				e = &lineArtEdge{a: vertices[i0], b: vertices[i1]}
+				edges[key] = e
+				la.edges = append(la.edges, e)
+			}
+			e.triangles = append(e.triangles, triangle)
+		}
+	}
+}
+
+// classify returns the edge type, or false if the edge is not a feature edge
+func (la *lineArt) classify(e *lineArtEdge) (lineEdgeType, bool) {
+	if len(e.triangles) != 2 {
+		return lineEdgeBoundary, true
+	}
+	t0, t1 := la.triangles[e.triangles[0]], la.triangles[e.triangles[1]]
+	if t0.front != t1.front {
+		return lineEdgeSilhouette, true
+	}
+	if t0.front && t0.normal.Dot(t1.normal) < la.creaseCos {
+		return lineEdgeCrease, true
+	}
+	return lineEdgeSilhouette, false
+}
+
+// visibleSegments returns the feature edges, split at the points where they become hidden
+func (la *lineArt) visibleSegments(doProgress func(float32)) []lineSegment {
+	var segments []lineSegment
+	for i, e := range la.edges {
+		kind, ok := la.classify(e)
+		if !ok {
+			continue
+		}
+		a, b, ok := clipSegmentNear(e.a.clip, e.b.clip)
+		if !ok {
+			continue
+		}
+		sa, sb := la.toScreen(a), la.toScreen(b)
+		steps := int(math.Ceil(math.Max(math.Abs(float64(sb.X()-sa.X())), math.Abs(float64(sb.Y()-sa.Y())))))
+		if steps < 1 {
+			steps = 1
+		}
+		runStart := -1.0
+		for k := 0; k <= steps; k++ {
+			t := float64(k) / float64(steps)
+			p := sa.Add(sb.Sub(sa).Mul(float32(t)))
+			visible := la.isVisible(p)
+			if visible && runStart < 0 {
+				runStart = t
+			}
+			if (!visible || k == steps) && runStart >= 0 {
+				end := t
+				if !visible {
+					end = float64(k-1) / float64(steps)
+				}
+				if end > runStart {
+					pa := sa.Add(sb.Sub(sa).Mul(float32(runStart)))
+					pb := sa.Add(sb.Sub(sa).Mul(float32(end)))
+					segments = append(segments, lineSegment{a: pa.Vec2(), b: pb.Vec2(), kind: kind})
+				}
+				runStart = -1
+			}
+		}
+		if i%1000 == 0 {
+			doProgress(50.0 + float32(i)/float32(len(la.edges))*50.0)
+		}
+	}
+	return segments
+}
+
+// toScreen converts a clip-space position to viewport x, y and NDC depth
+func (la *lineArt) toScreen(clip mgl32.Vec4) mgl32.Vec3 {
+	ndc := clip.Vec3().Mul(1.0 / clip.W())
+	return mgl32.Vec3{(ndc.X() + 1.0) * 0.5 * float32(la.width), (1.0 - ndc.Y()) * 0.5 * float32(la.height), ndc.Z()}
+}
+
+func (la *lineArt) isVisible(p mgl32.Vec3) bool {
+	x, y := int(p.X()), int(p.Y())
+	if x < 0 || y < 0 || x >= la.width || y >= la.height {
+		return false
+	}
+	return p.Z() <= la.depth[y*la.width+x]+lineArtDepthBias
+}
+
+// rasterize writes the triangle depth into the depth buffer, after clipping it against the near plane
+func (la *lineArt) rasterize(a, b, c mgl32.Vec4) {
+	polygon := clipPolygonNear([]mgl32.Vec4{a, b, c})
+	for i := 1; i+1 < len(polygon); i++ {
+		la.rasterizeTriangle(la.toScreen(polygon[0]), la.toScreen(polygon[i]), la.toScreen(polygon[i+1]))
+	}
+}
+
+func (la *lineArt) rasterizeTriangle(a, b, c mgl32.Vec3) {
+	area := (b.X()-a.X())*(c.Y()-a.Y()) - (b.Y()-a.Y())*(c.X()-a.X())
+	if area == 0 {
+		return
+	}
+	minX := int(math.Max(0, math.Floor(float64(min3(a.X(), b.X(), c.X())))))
+	maxX := int(math.Min(float64(la.width-1), math.Ceil(float64(max3(a.X(), b.X(), c.X())))))
+	minY := int(math.Max(0, math.Floor(float64(min3(a.Y(), b.Y(), c.Y())))))
+	maxY := int(math.Min(float64(la.height-1), math.Ceil(float64(max3(a.Y(), b.Y(), c.Y())))))
+	for y := minY; y <= maxY; y++ {
+		for x := minX; x <= maxX; x++ {
+			px, py := float32(x)+0.5, float32(y)+0.5
+			w0 := ((b.X()-px)*(c.Y()-py) - (b.Y()-py)*(c.X()-px)) / area
+			w1 := ((c.X()-px)*(a.Y()-py) - (c.Y()-py)*(a.X()-px)) / area
+			w2 := 1.0 - w0 - w1
+			if w0 < 0 || w1 < 0 || w2 < 0 {
+				continue
+			}
+			z := w0*a.Z() + w1*b.Z() + w2*c.Z()
+			if z < la.depth[y*la.width+x] {
+				la.depth[y*la.width+x] = z
+			}
+		}
+	}
+}
+
+// nearDistance is the signed distance to the near clip plane (z > -w)
+func nearDistance(v mgl32.Vec4) float32 {
+	return v.Z() + v.W()
+}
+
+func clipSegmentNear(a, b mgl32.Vec4) (mgl32.Vec4, mgl32.Vec4, bool) {
+	da, db := nearDistance(a), nearDistance(b)
+	if da < 0 && db < 0 {
+		return a, b, false
+	}
+	if da < 0 {
+		a = a.Add(b.Sub(a).Mul(da / (da - db)))
+	} else if db < 0 {
+		b = b.Add(a.Sub(b).Mul(db / (db - da)))
+	}
+	return a, b, true
+}
+
+func clipPolygonNear(polygon []mgl32.Vec4) []mgl32.Vec4 {
+	var out []mgl32.Vec4
+	for i := range polygon {
+		cur, next := polygon[i], polygon[(i+1)%len(polygon)]
+		dc, dn := nearDistance(cur), nearDistance(next)
+		if dc >= 0 {
+			out = append(out, cur)
+		}
+		if (dc >= 0) != (dn >= 0) {
+			out = append(out, cur.Add(next.Sub(cur).Mul(dc/(dc-dn))))
+		}
+	}
+	return out
+}
+
+func min3(a, b, c float32) float32 {
+	return float32(math.Min(float64(a), math.Min(float64(b), float64(c))))
+}
+
+func max3(a, b, c float32) float32 {
+	return float32(math.Max(float64(a), math.Max(float64(b), float64(c))))
+}
@@ -0,0 +1,140 @@
+package export
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/meshes"
+	"github.com/supudo/Kuplung-Go/settings"
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+// ExporterLineArt writes silhouette, crease and boundary edges of the current view as SVG or PDF
+type ExporterLineArt struct {
+	funcProgress func(float32)
+}
+
+var lineEdgeNames = [lineEdgeTypesCount]string{"silhouette", "crease", "boundary"}
+
+// NewExporterLineArt ...
+func NewExporterLineArt(doProgress func(float32)) *ExporterLineArt {
+	return &ExporterLineArt{funcProgress: doProgress}
+}
+
+// Export projects the faces through the camera and the rendering projection matrix and writes the visible edges
+func (ela *ExporterLineArt) Export(faces []*meshes.ModelFace, camera *types.SceneCamera, file types.FBEntity, psettings []string, asPDF bool) error {
+	sett := settings.GetSettings()
+	rsett := settings.GetRenderingSettings()
+	lsett := parseLineArtSettings(psettings)
+
+	width := int(sett.AppWindow.SDLWindowWidth)
+	if width <= 0 {
+		width = 1280
+	}
+	height := width * 3 / 4
+	if rsett.General.RatioWidth > 0 && rsett.General.RatioHeight > 0 {
+		height = int(float32(width) * rsett.General.RatioHeight / rsett.General.RatioWidth)
+	}
+
+	view := mgl32.Ident4()
+	if camera != nil {
+		view = camera.View
+	}
+
+	ela.funcProgress(0.0)
+	la := newLineArt(view, rsett.MatrixProjection, width, height, lsett.creaseAngle)
+	for i := 0; i < len(faces); i++ {
+		la.addFace(faces[i])
+		ela.funcProgress(float32(i+1) / float32(len(faces)) * 50.0)
+	}
+	segments := la.visibleSegments(ela.funcProgress)
+
+	var contents []byte
+	ext := ".svg"
+	if asPDF {
+		contents = ela.buildPDF(segments, lsett, width, height)
+		ext = ".pdf"
+	} else {
+		contents = []byte(ela.buildSVG(segments, lsett, width, height))
+	}
+
+	fileName := strings.TrimSuffix(file.Title, filepath.Ext(file.Title)) + ext
+	if err := ioutil.WriteFile(filepath.Join(filepath.Dir(file.Path), fileName), contents, 0644); err != nil {
+		return err
+	}
+	ela.funcProgress(100.0)
+	return nil
+}
+
+func (ela *ExporterLineArt) buildSVG(segments []lineSegment, lsett lineArtSettings, width, height int) string {
+	var sb strings.Builder
+	sb.WriteString("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n")
+	sb.WriteString(fmt.Sprintf("<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\" viewBox=\"0 0 %d %d\">\n", width, height, width, height))
+	sb.WriteString("<!-- Kuplung line-art export - http://www.github.com/supudo/kuplung/ -->\n")
+	for kind := lineEdgeType(0); kind < lineEdgeTypesCount; kind++ {
+		if lsett.strokeWidths[kind] <= 0 {
+			continue
+		}
+		var d strings.Builder
+		for _, s := range segments {
+			if s.kind == kind {
+				d.WriteString(fmt.Sprintf("M%.2f %.2fL%.2f %.2f", s.a.X(), s.a.Y(), s.b.X(), s.b.Y()))
+			}
+		}
+		if d.Len() == 0 {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("<path id=\"%s\" fill=\"none\" stroke=\"#000000\" stroke-width=\"%g\" stroke-linecap=\"round\" stroke-linejoin=\"round\" d=\"%s\"/>\n", lineEdgeNames[kind], lsett.strokeWidths[kind], d.String()))
+	}
+	sb.WriteString("</svg>\n")
+	return sb.String()
+}
+
+func (ela *ExporterLineArt) buildPDF(segments []lineSegment, lsett lineArtSettings, width, height int) []byte {
+	var content bytes.Buffer
+	content.WriteString("1 J 1 j 0 0 0 RG\n")
+	for kind := lineEdgeType(0); kind < lineEdgeTypesCount; kind++ {
+		if lsett.strokeWidths[kind] <= 0 {
+			continue
+		}
+		content.WriteString(fmt.Sprintf("%g w\n", lsett.strokeWidths[kind]))
+		for _, s := range segments {
+			if s.kind == kind {
+				content.WriteString(fmt.Sprintf("%.2f %.2f m %.2f %.2f l S\n", s.a.X(), float32(height)-s.a.Y(), s.b.X(), float32(height)-s.b.Y()))
+			}
+		}
+	}
+
+	var stream bytes.Buffer
+	zw := zlib.NewWriter(&stream)
+	_, _ = zw.Write(content.Bytes())
+	_ = zw.Close()
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Contents 4 0 R /Resources << >> >>", width, height),
+		fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", stream.Len(), stream.String()),
+		"<< /Producer (Kuplung) >>",
+	}
+
+	var pdf bytes.Buffer
+	pdf.WriteString("%PDF-1.4\n%\xE2\xE3\xCF\xD3\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = pdf.Len()
+		pdf.WriteString(fmt.Sprintf("%d 0 obj\n%s\nendobj\n", i+1, obj))
+	}
+	xref := pdf.Len()
+	pdf.WriteString(fmt.Sprintf("xref\n0 %d\n0000000000 65535 f \n", len(objects)+1))
+	for _, off := range offsets {
+		pdf.WriteString(fmt.Sprintf("%010d 00000 n \n", off))
+	}
+	pdf.WriteString(fmt.Sprintf("trailer\n<< /Size %d /Root 1 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, len(objects), xref))
+	return pdf.Bytes()
+}
@@ -1,13 +1,21 @@
 package export
 
 import (
+	"fmt"
+
 	"github.com/supudo/Kuplung-Go/meshes"
 	"github.com/supudo/Kuplung-Go/types"
 )
 
 // ExporterManager ...
 type ExporterManager struct {
-	exporterObj *ExporterObj
+	exporterObj     *ExporterObj
+	exporterGLTF    *ExporterGLTF
+	exporterSTL     *ExporterSTL
+	exporterUSD     *ExporterUSD
+	exporterHTML    *ExporterHTML
+	exporterLineArt *ExporterLineArt
+	exporterSection *ExporterCrossSection
 
 	doProgress func(float32)
 }
@@ -17,17 +25,64 @@ func NewExportManager(doProgress func(float32)) *ExporterManager {
 	pm := &ExporterManager{}
 	pm.doProgress = doProgress
 	pm.initExporterObj()
+	pm.initExporterGLTF()
+	pm.initExporterSTL()
+	pm.initExporterUSD()
+	pm.initExporterHTML()
+	pm.initExporterLineArt()
+	pm.initExporterCrossSection()
 	return pm
 }
 
-// Export ...
-func (pm *ExporterManager) Export(mmodels []*meshes.ModelFace, file types.FBEntity, psettings []string, itype types.ImportExportFormat) {
+// Export writes the models in the format, it returns the error of the exporter
+func (pm *ExporterManager) Export(mmodels []*meshes.ModelFace, lights []types.SceneLight, camera *types.SceneCamera, file types.FBEntity, psettings []string, itype types.ImportExportFormat) error {
 	switch itype {
 	case types.ImportExportFormatOBJ:
-		pm.exporterObj.Export(mmodels, file, psettings)
+		return pm.exporterObj.Export(mmodels, file, psettings)
+	case types.ImportExportFormatGLTF:
+		return pm.exporterGLTF.Export(mmodels, file, psettings)
+	case types.ImportExportFormatSTL:
+		return pm.exporterSTL.Export(mmodels, file, psettings)
+	case types.ImportExportFormatUSD:
+		return pm.exporterUSD.Export(mmodels, lights, camera, file, psettings)
+	case types.ImportExportFormatHTML:
+		return pm.exporterHTML.Export(mmodels, lights, camera, file, psettings)
+	case types.ImportExportFormatSVG:
+		return pm.exporterLineArt.Export(mmodels, camera, file, psettings, false)
+	case types.ImportExportFormatPDF:
+		return pm.exporterLineArt.Export(mmodels, camera, file, psettings, true)
+	case types.ImportExportFormatSectionSVG:
+		return pm.exporterSection.Export(mmodels, file, psettings, false)
+	case types.ImportExportFormatSectionDXF:
+		return pm.exporterSection.Export(mmodels, file, psettings, true)
 	}
+	return fmt.Errorf("unsupported export format %v", itype)
 }
 
 func (pm *ExporterManager) initExporterObj() {
 	pm.exporterObj = NewExporterObj(pm.doProgress)
 }
+
+func (pm *ExporterManager) initExporterGLTF() {
+	pm.exporterGLTF = NewExporterGLTF(pm.doProgress)
+}
+
+func (pm *ExporterManager) initExporterSTL() {
+	pm.exporterSTL = NewExporterSTL(pm.doProgress)
+}
+
+func (pm *ExporterManager) initExporterUSD() {
+	pm.exporterUSD = NewExporterUSD(pm.doProgress)
+}
+
+func (pm *ExporterManager) initExporterHTML() {
+	pm.exporterHTML = NewExporterHTML(pm.doProgress)
+}
+
+func (pm *ExporterManager) initExporterLineArt() {
+	pm.exporterLineArt = NewExporterLineArt(pm.doProgress)
+}
+
+func (pm *ExporterManager) initExporterCrossSection() {
+	pm.exporterSection = NewExporterCrossSection(pm.doProgress)
+}
@@ -2,6 +2,7 @@ package export
 
 import (
 	"fmt"
+	"io/ioutil"
 	"path/filepath"
 	"runtime"
 	"strconv"
@@ -10,7 +11,6 @@ import (
 
 	"github.com/go-gl/mathgl/mgl32"
 	"github.com/supudo/Kuplung-Go/meshes"
-	"github.com/supudo/Kuplung-Go/settings"
 	"github.com/supudo/Kuplung-Go/types"
 )
 
@@ -25,10 +25,11 @@ type ExporterObj struct {
 	vtCounter                int32
 	vnCounter                int32
 
-	addSuffix   bool
-	objSettings []string
-	exportFile  types.FBEntity
-	nlDelimiter string
+	addSuffix       bool
+	collectTextures bool
+	objSettings     []string
+	exportFile      types.FBEntity
+	nlDelimiter     string
 }
 
 // NewExporterObj ...
@@ -39,23 +40,27 @@ func NewExporterObj(doProgress func(float32)) *ExporterObj {
 		vnCounter:    1,
 		addSuffix:    false,
 		funcProgress: doProgress,
+		nlDelimiter:  "\n",
 	}
-	if runtime.GOOS == "darwin" {
-		eobj.nlDelimiter = "\n"
+	if runtime.GOOS == "windows" {
+		eobj.nlDelimiter = "\r\n"
 	}
 	return eobj
 }
 
 // Export ...
-func (eobj *ExporterObj) Export(faces []*meshes.ModelFace, file types.FBEntity, psettings []string) {
+func (eobj *ExporterObj) Export(faces []*meshes.ModelFace, file types.FBEntity, psettings []string) error {
 	eobj.objSettings = psettings
 	eobj.addSuffix = false
+	eobj.collectTextures = collectTexturesSetting(psettings)
 	eobj.exportFile = file
-	eobj.exportGeometry(faces)
-	eobj.exportMaterials(faces)
+	if err := eobj.exportGeometry(faces); err != nil {
+		return err
+	}
+	return eobj.exportMaterials(faces)
 }
 
-func (eobj *ExporterObj) exportGeometry(faces []*meshes.ModelFace) {
+func (eobj *ExporterObj) exportGeometry(faces []*meshes.ModelFace) error {
 	fileContents := "# Kuplung v1.0 OBJ File Export" + eobj.nlDelimiter
 	fileContents += "# http://www.github.com/supudo/kuplung/" + eobj.nlDelimiter
 	fn := eobj.exportFile.Title
@@ -82,11 +87,20 @@ func (eobj *ExporterObj) exportGeometry(faces []*meshes.ModelFace) {
 		filePath := filepath.Dir(eobj.exportFile.Path)
 		fileName := eobj.exportFile.Title
 		fileName = strings.TrimSuffix(fileName, ".obj")
-		settings.SaveStringToFile(fileContents, filePath+"/"+fileName+fileSuffix+".obj", "ExporterOBJ")
+		return ioutil.WriteFile(filePath+"/"+fileName+fileSuffix+".obj", []byte(fileContents), 0644)
 	}
+	return nil
 }
 
-func (eobj *ExporterObj) exportMaterials(faces []*meshes.ModelFace) {
+func (eobj *ExporterObj) exportMaterials(faces []*meshes.ModelFace) error {
+	tc := newTextureCollector(filepath.Dir(eobj.exportFile.Path))
+	texturePath := func(image string) string {
+		if eobj.collectTextures {
+			return tc.collect(image)
+		}
+		return image
+	}
+
 	materials := make(map[string]string)
 	for i := 0; i < len(faces); i++ {
 		mat := faces[i].MeshModel.ModelMaterial
@@ -105,25 +119,25 @@ func (eobj *ExporterObj) exportMaterials(faces []*meshes.ModelFace) {
 			materials[mat.MaterialTitle] += fmt.Sprintf("illum %d", mat.IlluminationMode) + eobj.nlDelimiter
 
 			if len(mat.TextureAmbient.Image) > 0 {
-				materials[mat.MaterialTitle] += "map_Ka " + mat.TextureAmbient.Image + eobj.nlDelimiter
+				materials[mat.MaterialTitle] += "map_Ka " + texturePath(mat.TextureAmbient.Image) + eobj.nlDelimiter
 			}
 			if len(mat.TextureDiffuse.Image) > 0 {
-				materials[mat.MaterialTitle] += "map_Kd " + mat.TextureDiffuse.Image + eobj.nlDelimiter
+				materials[mat.MaterialTitle] += "map_Kd " + texturePath(mat.TextureDiffuse.Image) + eobj.nlDelimiter
 			}
 			if len(mat.TextureDissolve.Image) > 0 {
-				materials[mat.MaterialTitle] += "map_d " + mat.TextureDissolve.Image + eobj.nlDelimiter
+				materials[mat.MaterialTitle] += "map_d " + texturePath(mat.TextureDissolve.Image) + eobj.nlDelimiter
 			}
 			if len(mat.TextureBump.Image) > 0 {
-				materials[mat.MaterialTitle] += "map_Bump " + mat.TextureBump.Image + eobj.nlDelimiter
+				materials[mat.MaterialTitle] += "map_Bump " + texturePath(mat.TextureBump.Image) + eobj.nlDelimiter
 			}
 			if len(mat.TextureDisplacement.Image) > 0 {
-				materials[mat.MaterialTitle] += "disp " + mat.TextureDisplacement.Image + eobj.nlDelimiter
+				materials[mat.MaterialTitle] += "disp " + texturePath(mat.TextureDisplacement.Image) + eobj.nlDelimiter
 			}
 			if len(mat.TextureSpecular.Image) > 0 {
-				materials[mat.MaterialTitle] += "map_Ks " + mat.TextureSpecular.Image + eobj.nlDelimiter
+				materials[mat.MaterialTitle] += "map_Ks " + texturePath(mat.TextureSpecular.Image) + eobj.nlDelimiter
 			}
 			if len(mat.TextureSpecularExp.Image) > 0 {
-				materials[mat.MaterialTitle] += "map_Ns " + mat.TextureSpecularExp.Image + eobj.nlDelimiter
+				materials[mat.MaterialTitle] += "map_Ns " + texturePath(mat.TextureSpecularExp.Image) + eobj.nlDelimiter
 			}
 		}
 	}
@@ -146,8 +160,9 @@ func (eobj *ExporterObj) exportMaterials(faces []*meshes.ModelFace) {
 		filePath := filepath.Dir(eobj.exportFile.Path)
 		fileName := eobj.exportFile.Title
 		fileName = strings.TrimSuffix(fileName, ".obj")
-		settings.SaveStringToFile(fileContents, filePath+"/"+fileName+fileSuffix+".mtl", "ExporterOBJ")
+		return ioutil.WriteFile(filePath+"/"+fileName+fileSuffix+".mtl", []byte(fileContents), 0644)
 	}
+	return nil
 }
 
 func (eobj *ExporterObj) exportMesh(face meshes.ModelFace) string {
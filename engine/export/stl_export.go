@@ -0,0 +1,62 @@
+package export
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/meshes"
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+// ExporterSTL writes the triangles of all models, in world space, as a binary STL file
+type ExporterSTL struct {
+	funcProgress func(float32)
+}
+
+// NewExporterSTL ...
+func NewExporterSTL(doProgress func(float32)) *ExporterSTL {
+	return &ExporterSTL{funcProgress: doProgress}
+}
+
+// Export ...
+func (estl *ExporterSTL) Export(faces []*meshes.ModelFace, file types.FBEntity, psettings []string) error {
+	var triangles bytes.Buffer
+	count := uint32(0)
+
+	estl.funcProgress(0.0)
+	for i := 0; i < len(faces); i++ {
+		model := faces[i].MeshModel
+		matrix := faces[i].ModelMatrix(mgl32.Ident4())
+		for j := 0; j+2 < len(model.Indices); j += 3 {
+			a := matrix.Mul4x1(model.Vertices[model.Indices[j]].Vec4(1)).Vec3()
+			b := matrix.Mul4x1(model.Vertices[model.Indices[j+1]].Vec4(1)).Vec3()
+			c := matrix.Mul4x1(model.Vertices[model.Indices[j+2]].Vec4(1)).Vec3()
+			normal := b.Sub(a).Cross(c.Sub(a))
+			if normal.Len() > 0 {
+				normal = normal.Normalize()
+			}
+			_ = binary.Write(&triangles, binary.LittleEndian, [12]float32{
+				normal.X(), normal.Y(), normal.Z(),
+				a.X(), a.Y(), a.Z(),
+				b.X(), b.Y(), b.Z(),
+				c.X(), c.Y(), c.Z()})
+			_ = binary.Write(&triangles, binary.LittleEndian, uint16(0))
+			count++
+		}
+		estl.funcProgress((float32(i+1) / float32(len(faces))) * 100.0)
+	}
+
+	header := make([]byte, 80)
+	copy(header, "Kuplung STL Export - http://www.github.com/supudo/kuplung/")
+	var data bytes.Buffer
+	data.Write(header)
+	_ = binary.Write(&data, binary.LittleEndian, count)
+	data.Write(triangles.Bytes())
+
+	fileName := strings.TrimSuffix(file.Title, filepath.Ext(file.Title)) + ".stl"
+	return ioutil.WriteFile(filepath.Join(filepath.Dir(file.Path), fileName), data.Bytes(), 0644)
+}
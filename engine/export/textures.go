@@ -0,0 +1,88 @@
+package export
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/supudo/Kuplung-Go/settings"
+)
+
+// texturesFolder is the subfolder, beside the exported file, where collected textures are copied
+const texturesFolder = "textures"
+
+// textureCollector copies the textures referenced by the exported materials beside the export
+type textureCollector struct {
+	exportFolder string
+	collected    map[string]string
+	usedNames    map[string]bool
+}
+
+func newTextureCollector(exportFolder string) *textureCollector {
+	return &textureCollector{
+		exportFolder: exportFolder,
+		collected:    make(map[string]string),
+		usedNames:    make(map[string]bool),
+	}
+}
+
+// collectTexturesSetting returns true when the export settings ask for texture collection
+func collectTexturesSetting(psettings []string) bool {
+	return len(psettings) > 2 && psettings[2] == "1"
+}
+
+// collect copies the image into the textures folder and returns the path relative to the export folder.
+// If the image can't be copied, the original path is returned.
+func (tc *textureCollector) collect(image string) string {
+	if len(image) == 0 {
+		return image
+	}
+	if rel, ok := tc.collected[image]; ok {
+		return rel
+	}
+
+	rel := filepath.ToSlash(filepath.Join(texturesFolder, tc.uniqueName(filepath.Base(image))))
+	if err := copyFile(image, filepath.Join(tc.exportFolder, rel)); err != nil {
+		settings.LogWarn("[Exporter] Can't collect texture %v : %v", image, err)
+		return image
+	}
+
+	tc.collected[image] = rel
+	return rel
+}
+
+// uniqueName makes sure two different textures with the same file name don't overwrite each other
+func (tc *textureCollector) uniqueName(name string) string {
+	ext := filepath.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	candidate := name
+	for i := 1; tc.usedNames[strings.ToLower(candidate)]; i++ {
+		candidate = base + "_" + strconv.Itoa(i) + ext
+	}
+	tc.usedNames[strings.ToLower(candidate)] = true
+	return candidate
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,330 @@
+package export
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/meshes"
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+// ExporterUSD writes the scene as USD ASCII (.usda)
+type ExporterUSD struct {
+	funcProgress func(float32)
+
+	exportFile      types.FBEntity
+	collectTextures bool
+	tc              *textureCollector
+
+	sb        strings.Builder
+	usedNames map[string]bool
+	materials map[string]string
+}
+
+// NewExporterUSD ...
+func NewExporterUSD(doProgress func(float32)) *ExporterUSD {
+	return &ExporterUSD{funcProgress: doProgress}
+}
+
+// Export ...
+func (eusd *ExporterUSD) Export(faces []*meshes.ModelFace, lights []types.SceneLight, camera *types.SceneCamera, file types.FBEntity, psettings []string) error {
+	eusd.exportFile = file
+	eusd.collectTextures = collectTexturesSetting(psettings)
+	eusd.tc = newTextureCollector(filepath.Dir(file.Path))
+	eusd.usedNames = make(map[string]bool)
+	eusd.materials = make(map[string]string)
+	eusd.sb.Reset()
+
+	eusd.funcProgress(0.0)
+
+	eusd.line(0, "#usda 1.0")
+	eusd.line(0, "(")
+	eusd.line(1, "defaultPrim = \"Kuplung\"")
+	eusd.line(1, "doc = \"Kuplung USD Export - http://www.github.com/supudo/kuplung/\"")
+	eusd.line(1, "metersPerUnit = 1")
+	eusd.line(1, "upAxis = \"Y\"")
+	eusd.line(0, ")")
+	eusd.line(0, "")
+	eusd.line(0, "def Xform \"Kuplung\"")
+	eusd.line(0, "{")
+
+	eusd.line(1, "def Scope \"Materials\"")
+	eusd.line(1, "{")
+	for i := 0; i < len(faces); i++ {
+		eusd.writeMaterial(&faces[i].MeshModel.ModelMaterial)
+	}
+	eusd.line(1, "}")
+
+	for i := 0; i < len(faces); i++ {
+		eusd.writeModel(faces[i])
+		eusd.funcProgress((float32(i+1) / float32(len(faces))) * 100.0)
+	}
+
+	for i := 0; i < len(lights); i++ {
+		eusd.writeLight(&lights[i])
+	}
+
+	if camera != nil {
+		eusd.writeCamera(camera)
+	}
+
+	eusd.line(0, "}")
+
+	fileName := strings.TrimSuffix(file.Title, filepath.Ext(file.Title)) + ".usda"
+	return ioutil.WriteFile(filepath.Join(filepath.Dir(file.Path), fileName), []byte(eusd.sb.String()), 0644)
+}
+
+func (eusd *ExporterUSD) writeMaterial(mat *types.MeshModelMaterial) {
+	if _, ok := eusd.materials[mat.MaterialTitle]; ok {
+		return
+	}
+	name := eusd.primName(mat.MaterialTitle, "Material")
+	matPath := "/Kuplung/Materials/" + name
+	eusd.materials[mat.MaterialTitle] = matPath
+
+	opacity := mat.Transparency
+	if opacity <= 0.0 {
+		opacity = 1.0
+	}
+
+	eusd.line(2, "def Material \"%s\"", name)
+	eusd.line(2, "{")
+	eusd.line(3, "token outputs:surface.connect = <%s/PreviewSurface.outputs:surface>", matPath)
+	eusd.line(0, "")
+	eusd.line(3, "def Shader \"PreviewSurface\"")
+	eusd.line(3, "{")
+	eusd.line(4, "uniform token info:id = \"UsdPreviewSurface\"")
+	eusd.line(4, "color3f inputs:diffuseColor = %s", usdVec3(mat.DiffuseColor))
+	eusd.line(4, "color3f inputs:emissiveColor = %s", usdVec3(mat.EmissionColor))
+	eusd.line(4, "color3f inputs:specularColor = %s", usdVec3(mat.SpecularColor))
+	eusd.line(4, "int inputs:useSpecularWorkflow = 1")
+	eusd.line(4, "float inputs:roughness = %s", usdFloat(specularExpToRoughness(mat.SpecularExp)))
+	eusd.line(4, "float inputs:opacity = %s", usdFloat(opacity))
+	if mat.OpticalDensity > 0.0 {
+		eusd.line(4, "float inputs:ior = %s", usdFloat(mat.OpticalDensity))
+	}
+	textures := []struct {
+		image  *types.MeshMaterialTextureImage
+		input  string
+		itype  string
+		output string
+	}{
+		{&mat.TextureDiffuse, "diffuseColor", "color3f", "rgb"},
+		{&mat.TextureSpecular, "specularColor", "color3f", "rgb"},
+		{&mat.TextureSpecularExp, "roughness", "float", "r"},
+		{&mat.TextureDissolve, "opacity", "float", "r"},
+		{&mat.TextureBump, "normal", "normal3f", "rgb"},
+		{&mat.TextureDisplacement, "displacement", "float", "r"},
+		{&mat.TextureAmbient, "occlusion", "float", "r"},
+	}
+	hasTextures := false
+	for _, t := range textures {
+		if len(t.image.Image) > 0 {
+			hasTextures = true
+			eusd.line(4, "%s inputs:%s.connect = <%s/Texture_%s.outputs:%s>", t.itype, t.input, matPath, t.input, t.output)
+		}
+	}
+	eusd.line(4, "token outputs:surface")
+	eusd.line(3, "}")
+
+	if hasTextures {
+		eusd.line(0, "")
+		eusd.line(3, "def Shader \"PrimvarST\"")
+		eusd.line(3, "{")
+		eusd.line(4, "uniform token info:id = \"UsdPrimvarReader_float2\"")
+		eusd.line(4, "string inputs:varname = \"st\"")
+		eusd.line(4, "float2 outputs:result")
+		eusd.line(3, "}")
+	}
+	for _, t := range textures {
+		if len(t.image.Image) == 0 {
+			continue
+		}
+		image := t.image.Image
+		if eusd.collectTextures {
+			image = eusd.tc.collect(image)
+		}
+		eusd.line(0, "")
+		eusd.line(3, "def Shader \"Texture_%s\"", t.input)
+		eusd.line(3, "{")
+		eusd.line(4, "uniform token info:id = \"UsdUVTexture\"")
+		eusd.line(4, "asset inputs:file = @%s@", filepath.ToSlash(image))
+		eusd.line(4, "float2 inputs:st.connect = <%s/PrimvarST.outputs:result>", matPath)
+		eusd.line(4, "float3 outputs:rgb")
+		eusd.line(4, "float outputs:r")
+		eusd.line(3, "}")
+	}
+	eusd.line(2, "}")
+}
+
+func (eusd *ExporterUSD) writeModel(face *meshes.ModelFace) {
+	model := face.MeshModel
+	name := eusd.primName(model.ModelTitle, "Model")
+
+	eusd.line(0, "")
+	eusd.line(1, "def Xform \"%s\"", name)
+	eusd.line(1, "{")
+	eusd.writeXformOps(2,
+		mgl32.Vec3{face.PositionX.Point, face.PositionY.Point, face.PositionZ.Point},
+		mgl32.Vec3{face.RotateX.Point, face.RotateY.Point, face.RotateZ.Point},
+		mgl32.Vec3{face.ScaleX.Point, face.ScaleY.Point, face.ScaleZ.Point})
+	eusd.line(0, "")
+	eusd.line(2, "def Mesh \"Mesh\"")
+	eusd.line(2, "{")
+	eusd.line(3, "uniform bool doubleSided = 0")
+	eusd.line(3, "uniform token subdivisionScheme = \"none\"")
+
+	counts := make([]string, len(model.Indices)/3)
+	for i := range counts {
+		counts[i] = "3"
+	}
+	eusd.line(3, "int[] faceVertexCounts = [%s]", strings.Join(counts, ", "))
+
+	indices := make([]string, len(model.Indices))
+	for i, idx := range model.Indices {
+		indices[i] = fmt.Sprint(idx)
+	}
+	eusd.line(3, "int[] faceVertexIndices = [%s]", strings.Join(indices, ", "))
+
+	points := make([]string, len(model.Vertices))
+	for i, v := range model.Vertices {
+		points[i] = usdVec3(v)
+	}
+	eusd.line(3, "point3f[] points = [%s]", strings.Join(points, ", "))
+
+	if len(model.Normals) == len(model.Vertices) {
+		normals := make([]string, len(model.Normals))
+		for i, n := range model.Normals {
+			normals[i] = usdVec3(n)
+		}
+		eusd.line(3, "normal3f[] normals = [%s] (", strings.Join(normals, ", "))
+		eusd.line(4, "interpolation = \"vertex\"")
+		eusd.line(3, ")")
+	}
+
+	if len(model.TextureCoordinates) == len(model.Vertices) {
+		uvs := make([]string, len(model.TextureCoordinates))
+		for i, uv := range model.TextureCoordinates {
+			uvs[i] = fmt.Sprintf("(%s, %s)", usdFloat(uv.X()), usdFloat(uv.Y()))
+		}
+		eusd.line(3, "texCoord2f[] primvars:st = [%s] (", strings.Join(uvs, ", "))
+		eusd.line(4, "interpolation = \"vertex\"")
+		eusd.line(3, ")")
+	}
+
+	if matPath, ok := eusd.materials[model.ModelMaterial.MaterialTitle]; ok {
+		eusd.line(3, "rel material:binding = <%s>", matPath)
+	}
+	eusd.line(2, "}")
+	eusd.line(1, "}")
+}
+
+func (eusd *ExporterUSD) writeLight(light *types.SceneLight) {
+	name := eusd.primName(light.Title, "Light")
+
+	eusd.line(0, "")
+	switch light.LightType {
+	case types.LightSourceTypeDirectional:
+		eusd.line(1, "def DistantLight \"%s\"", name)
+	default:
+		eusd.line(1, "def SphereLight \"%s\"", name)
+	}
+	eusd.line(1, "{")
+	eusd.line(2, "color3f inputs:color = %s", usdVec3(light.Diffuse))
+	eusd.line(2, "float inputs:intensity = %s", usdFloat(light.StrengthDiffuse))
+	switch light.LightType {
+	case types.LightSourceTypePoint:
+		eusd.line(2, "bool treatAsPoint = 1")
+	case types.LightSourceTypeSpot:
+		eusd.line(2, "bool treatAsPoint = 1")
+		softness := float32(0.0)
+		if light.OuterCutOff > 0.0 {
+			softness = (light.OuterCutOff - light.CutOff) / light.OuterCutOff
+		}
+		eusd.line(2, "float inputs:shaping:cone:angle = %s", usdFloat(light.OuterCutOff))
+		eusd.line(2, "float inputs:shaping:cone:softness = %s", usdFloat(softness))
+	}
+	eusd.line(2, "custom float3 kuplung:direction = %s", usdVec3(light.Direction))
+	eusd.line(2, "custom color3f kuplung:ambient = %s", usdVec3(light.Ambient))
+	eusd.line(2, "custom color3f kuplung:specular = %s", usdVec3(light.Specular))
+	eusd.line(2, "custom float kuplung:strengthAmbient = %s", usdFloat(light.StrengthAmbient))
+	eusd.line(2, "custom float kuplung:strengthSpecular = %s", usdFloat(light.StrengthSpecular))
+	eusd.line(2, "custom float kuplung:cutOff = %s", usdFloat(light.CutOff))
+	eusd.line(2, "custom float kuplung:constant = %s", usdFloat(light.Constant))
+	eusd.line(2, "custom float kuplung:linear = %s", usdFloat(light.Linear))
+	eusd.line(2, "custom float kuplung:quadratic = %s", usdFloat(light.Quadratic))
+	eusd.writeXformOps(2, light.Position, light.Rotate, light.Scale)
+	eusd.line(1, "}")
+}
+
+func (eusd *ExporterUSD) writeCamera(camera *types.SceneCamera) {
+	name := eusd.primName(camera.Title, "Camera")
+
+	eusd.line(0, "")
+	eusd.line(1, "def Camera \"%s\"", name)
+	eusd.line(1, "{")
+	eusd.line(2, "token projection = \"perspective\"")
+	eusd.line(2, "float focalLength = %s", usdFloat(camera.FocalLength))
+	eusd.writeXformOps(2, camera.Position, camera.Rotate, mgl32.Vec3{1, 1, 1})
+	eusd.line(1, "}")
+}
+
+// writeXformOps writes the ops in the order Kuplung composes them - scale is the outermost, then translate,
+// then the rotations, Z is applied first, so the rotation is rotateZYX
+func (eusd *ExporterUSD) writeXformOps(indent int, translate, rotate, scale mgl32.Vec3) {
+	eusd.line(indent, "float3 xformOp:scale = %s", usdVec3(scale))
+	eusd.line(indent, "double3 xformOp:translate = %s", usdVec3(translate))
+	eusd.line(indent, "float3 xformOp:rotateZYX = %s", usdVec3(rotate))
+	eusd.line(indent, "uniform token[] xformOpOrder = [\"xformOp:scale\", \"xformOp:translate\", \"xformOp:rotateZYX\"]")
+}
+
+// primName turns a title into a valid and unique USD prim name
+func (eusd *ExporterUSD) primName(title, fallback string) string {
+	var sb strings.Builder
+	for _, r := range title {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			sb.WriteRune(r)
+		} else {
+			sb.WriteRune('_')
+		}
+	}
+	name := sb.String()
+	if len(name) == 0 {
+		name = fallback
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+	candidate := name
+	for i := 1; eusd.usedNames[candidate]; i++ {
+		candidate = fmt.Sprintf("%s_%d", name, i)
+	}
+	eusd.usedNames[candidate] = true
+	return candidate
+}
+
+func (eusd *ExporterUSD) line(indent int, format string, args ...interface{}) {
+	eusd.sb.WriteString(strings.Repeat("    ", indent))
+	eusd.sb.WriteString(fmt.Sprintf(format, args...))
+	eusd.sb.WriteString("\n")
+}
+
+// specularExpToRoughness maps a Blinn-Phong exponent to a UsdPreviewSurface roughness
+func specularExpToRoughness(specularExp float32) float32 {
+	if specularExp <= 0.0 {
+		return 1.0
+	}
+	return float32(math.Min(math.Sqrt(2.0/(float64(specularExp)+2.0)), 1.0))
+}
+
+func usdFloat(f float32) string {
+	return fmt.Sprintf("%g", f)
+}
+
+func usdVec3(v mgl32.Vec3) string {
+	return fmt.Sprintf("(%s, %s, %s)", usdFloat(v.X()), usdFloat(v.Y()), usdFloat(v.Z()))
+}
@@ -0,0 +1,75 @@
+package export
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/engine/parsers"
+	"github.com/supudo/Kuplung-Go/meshes"
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+// TestExportUSDRoundTrip writes a scaled, moved and rotated cube and reads it back with the USD parser,
+// the parser bakes the transform into the points and the normals
+func TestExportUSDRoundTrip(t *testing.T) {
+	folder, err := ioutil.TempDir("", "kuplung-usd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(folder)
+
+	face := testCube(mgl32.Vec3{1, 2, 3}, mgl32.Vec3{2, 0.5, 3}, mgl32.Vec3{30, 45, 60})
+	file := types.FBEntity{Path: filepath.Join(folder, "cube.usda"), Title: "cube.usda"}
+	if err := NewExporterUSD(noProgress).Export([]*meshes.ModelFace{face}, nil, nil, file, nil); err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+
+	data, err := ioutil.ReadFile(file.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order := `uniform token[] xformOpOrder = ["xformOp:scale", "xformOp:translate", "xformOp:rotateZYX"]`; !strings.Contains(string(data), order) {
+		t.Errorf("the model has no %v", order)
+	}
+
+	models := parsers.NewUsdParser(noProgress).Parse(file.Path, nil)
+	if len(models) != 1 {
+		t.Fatalf("models = %v, expected 1", len(models))
+	}
+	model := models[0]
+	expected := face.MeshModel
+	if model.ModelTitle != expected.ModelTitle {
+		t.Errorf("title = %q, expected %q", model.ModelTitle, expected.ModelTitle)
+	}
+	if model.ModelMaterial.MaterialTitle != expected.ModelMaterial.MaterialTitle || !model.ModelMaterial.DiffuseColor.ApproxEqualThreshold(expected.ModelMaterial.DiffuseColor, 1e-5) {
+		t.Errorf("material = %q %v, expected %q %v", model.ModelMaterial.MaterialTitle, model.ModelMaterial.DiffuseColor, expected.ModelMaterial.MaterialTitle, expected.ModelMaterial.DiffuseColor)
+	}
+	if !reflect.DeepEqual(model.Indices, expected.Indices) {
+		t.Errorf("faceVertexIndices = %v, expected %v", model.Indices, expected.Indices)
+	}
+	if len(model.Vertices) != len(expected.Vertices) || len(model.Normals) != len(expected.Normals) || len(model.TextureCoordinates) != len(expected.TextureCoordinates) {
+		t.Fatalf("points, normals, st = %v, %v, %v, expected %v, %v, %v",
+			len(model.Vertices), len(model.Normals), len(model.TextureCoordinates),
+			len(expected.Vertices), len(expected.Normals), len(expected.TextureCoordinates))
+	}
+
+	// the xform ops have to compose to the matrix the renderers place the model with
+	matrixModel := face.ModelMatrix(mgl32.Ident4())
+	matrixNormal := matrixModel.Mat3().Inv().Transpose()
+	for i := range expected.Vertices {
+		if point := matrixModel.Mul4x1(expected.Vertices[i].Vec4(1)).Vec3(); !model.Vertices[i].ApproxEqualThreshold(point, 1e-4) {
+			t.Errorf("point %v = %v, expected %v", i, model.Vertices[i], point)
+		}
+		if normal := matrixNormal.Mul3x1(expected.Normals[i]).Normalize(); !model.Normals[i].ApproxEqualThreshold(normal, 1e-4) {
+			t.Errorf("normal %v = %v, expected %v", i, model.Normals[i], normal)
+		}
+		if !model.TextureCoordinates[i].ApproxEqualThreshold(expected.TextureCoordinates[i], 1e-5) {
+			t.Errorf("st %v = %v, expected %v", i, model.TextureCoordinates[i], expected.TextureCoordinates[i])
+		}
+	}
+}
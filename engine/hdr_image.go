@@ -0,0 +1,134 @@
+package engine
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+// HDRImage is a decoded Radiance RGBE image with linear RGB floats, the rows go from the bottom to the top as OpenGL expects them
+type HDRImage struct {
+	Width, Height int
+	Pix           []float32
+}
+
+// LoadHDRImage reads a Radiance .hdr (RGBE) file, flat or run-length encoded
+func LoadHDRImage(file string) (*HDRImage, error) {
+	f, err := OpenResource(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return DecodeHDR(f)
+}
+
+// DecodeHDR decodes a Radiance RGBE image
+func DecodeHDR(r io.Reader) (*HDRImage, error) {
+	br := bufio.NewReader(r)
+
+	magic, err := br.ReadString('\n')
+	if err != nil || !(strings.HasPrefix(magic, "#?RADIANCE") || strings.HasPrefix(magic, "#?RGBE")) {
+		return nil, fmt.Errorf("not a Radiance HDR file")
+	}
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			return nil, fmt.Errorf("truncated header: %v", err)
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			break
+		}
+		if strings.HasPrefix(line, "FORMAT=") && line != "FORMAT=32-bit_rle_rgbe" {
+			return nil, fmt.Errorf("unsupported format %v", strings.TrimPrefix(line, "FORMAT="))
+		}
+	}
+
+	resolution, err := br.ReadString('\n')
+	if err != nil {
+		return nil, fmt.Errorf("missing resolution: %v", err)
+	}
+	var width, height int
+	if _, err := fmt.Sscanf(strings.TrimSpace(resolution), "-Y %d +X %d", &height, &width); err != nil {
+		return nil, fmt.Errorf("unsupported orientation %q", strings.TrimSpace(resolution))
+	}
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("invalid size %vx%v", width, height)
+	}
+
+	img := &HDRImage{Width: width, Height: height, Pix: make([]float32, width*height*3)}
+	scanline := make([]byte, width*4)
+	for y := 0; y < height; y++ {
+		if err := readHDRScanline(br, scanline, width); err != nil {
+			return nil, fmt.Errorf("scanline %v: %v", y, err)
+		}
+		row := img.Pix[(height-1-y)*width*3:]
+		for x := 0; x < width; x++ {
+			e := scanline[x*4+3]
+			if e == 0 {
+				row[x*3+0], row[x*3+1], row[x*3+2] = 0, 0, 0
+				continue
+			}
+			scale := float32(math.Ldexp(1.0, int(e)-136))
+			row[x*3+0] = float32(scanline[x*4+0]) * scale
+			row[x*3+1] = float32(scanline[x*4+1]) * scale
+			row[x*3+2] = float32(scanline[x*4+2]) * scale
+		}
+	}
+	return img, nil
+}
+
+// readHDRScanline reads one scanline of RGBE pixels, the new run-length encoding keeps the four channels separately
+func readHDRScanline(br *bufio.Reader, scanline []byte, width int) error {
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return err
+	}
+	if width < 8 || width > 0x7fff || header[0] != 2 || header[1] != 2 || header[2]&0x80 != 0 {
+		// flat pixels
+		copy(scanline, header)
+		_, err := io.ReadFull(br, scanline[4:])
+		return err
+	}
+	if int(header[2])<<8|int(header[3]) != width {
+		return fmt.Errorf("wrong scanline width")
+	}
+
+	for c := 0; c < 4; c++ {
+		for x := 0; x < width; {
+			count, err := br.ReadByte()
+			if err != nil {
+				return err
+			}
+			if count > 128 {
+				run := int(count) - 128
+				if x+run > width {
+					return fmt.Errorf("run overflows the scanline")
+				}
+				value, err := br.ReadByte()
+				if err != nil {
+					return err
+				}
+				for ; run > 0; run-- {
+					scanline[x*4+c] = value
+					x++
+				}
+			} else {
+				if count == 0 || x+int(count) > width {
+					return fmt.Errorf("bad run length")
+				}
+				for n := 0; n < int(count); n++ {
+					value, err := br.ReadByte()
+					if err != nil {
+						return err
+					}
+					scanline[x*4+c] = value
+					x++
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -36,6 +36,7 @@ const (
 	COLOR_ATTACHMENT15          = 0x8CEF
 	DEPTH_ATTACHMENT            = 0x8D00
 	FRAMEBUFFER_COMPLETE        = 0x8CD5
+	FRAMEBUFFER_BINDING         = 0x8CA6
 )
 
 // Draw Types
@@ -87,6 +88,8 @@ const (
 const (
 	ARRAY_BUFFER         uint32 = 0x8892
 	ELEMENT_ARRAY_BUFFER        = 0x8893
+	UNIFORM_BUFFER              = 0x8A11
+	INVALID_INDEX               = 0xFFFFFFFF
 )
 
 // Draw Types
@@ -119,6 +122,8 @@ const (
 	POLYGON_MODE                 = 0x0B40
 	VIEWPORT                     = 0x0BA2
 	SCISSOR_BOX                  = 0x0C10
+	MAX_TEXTURE_SIZE             = 0x0D33
+	MAX_RENDERBUFFER_SIZE        = 0x84E8
 	BLEND_SRC_RGB                = 0x80C9
 	BLEND_DST_RGB                = 0x80C8
 	BLEND_SRC_ALPHA              = 0x80CB
@@ -126,13 +131,20 @@ const (
 	BLEND_EQUATION_RGB           = 0x8009
 	BLEND_EQUATION_ALPHA         = 0x883D
 	FRONT_AND_BACK               = 0x0408
+	FRONT                        = 0x0404
+	BACK                         = 0x0405
+	NONE                         = 0
 	FILL                         = 0x1B02
+	POLYGON_OFFSET_FILL          = 0x8037
+	TEXTURE_CUBE_MAP_SEAMLESS    = 0x884F
 )
 
 // Alpha constants
 // nolint: golint,megacheck
 const (
-	SRC_ALPHA           uint32 = 0x0302
+	ZERO                uint32 = 0
+	ONE                        = 1
+	SRC_ALPHA                  = 0x0302
 	ONE_MINUS_SRC_ALPHA        = 0x0303
 	ONE_MINUS_SRC_COLOR        = 0x0301
 
@@ -155,6 +167,7 @@ const (
 // nolint: golint,megacheck
 const (
 	TEXTURE_2D uint32 = 0x0DE1
+	TEXTURE_3D        = 0x806F
 
 	TEXTURE0 = 0x84C0
 	TEXTURE1 = 0x84C1
@@ -182,8 +195,11 @@ const (
 	TEXTURE_WRAP_T     = 0x2803
 	TEXTURE_WRAP_R     = 0x8072
 	CLAMP_TO_EDGE      = 0x812F
+	CLAMP_TO_BORDER    = 0x812D
 	REPEAT             = 0x2901
 
+	TEXTURE_BORDER_COLOR = 0x1004
+
 	UNPACK_ROW_LENGTH = 0x0CF2
 
 	LINEAR                = 0x2601
@@ -195,9 +211,15 @@ const (
 // Texture Floats Constants
 // nolint: golint,megacheck
 const (
-	RGB16F_ARB uint32 = 0x881B
-	RGB16F            = 0x881B
-	RGBA8             = 0x8058
+	RGB16F_ARB        uint32 = 0x881B
+	RGB16F                   = 0x881B
+	RG16F                    = 0x822F
+	RGBA16F                  = 0x881A
+	RGBA32F                  = 0x8814
+	R11F_G11F_B10F           = 0x8C3A
+	RGBA8                    = 0x8058
+	RGBA16                   = 0x805B
+	DEPTH_COMPONENT24        = 0x81A6
 )
 
 // Errors
@@ -221,4 +243,5 @@ const (
 	RGBA         = 0x1908
 	RED          = 0x1903
 	R8           = 0x8229
+	RG           = 0x8227
 )
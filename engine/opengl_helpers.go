@@ -69,6 +69,24 @@ func LinkNewStandardProgram(gl interfaces.OpenGL, vertexShaderSource, fragmentSh
 	return
 }
 
+// LinkNewGeometryProgram creates a new shader based on vertex, geometry and fragment shader sources.
+func LinkNewGeometryProgram(gl interfaces.OpenGL, vertexShaderSource, geomShaderSource, fragmentShaderSource string) (program uint32, err error) {
+	vertexShader, vertexErr := CompileNewShader(gl, oglconsts.VERTEX_SHADER, vertexShaderSource)
+	defer gl.DeleteShader(vertexShader)
+	geomShader, geomErr := CompileNewShader(gl, oglconsts.GEOMETRY_SHADER, geomShaderSource)
+	defer gl.DeleteShader(geomShader)
+	fragmentShader, fragmentErr := CompileNewShader(gl, oglconsts.FRAGMENT_SHADER, fragmentShaderSource)
+	defer gl.DeleteShader(fragmentShader)
+
+	if (vertexErr == nil) && (geomErr == nil) && (fragmentErr == nil) {
+		program, err = LinkNewProgram(gl, vertexShader, geomShader, fragmentShader)
+	} else {
+		err = fmt.Errorf("[OpenGL Utils] Error compiling shaders:\nVertex = %v\nGEOM = %v\nFragment = %v", vertexErr, geomErr, fragmentErr)
+	}
+
+	return
+}
+
 // CompileNewShader creates a shader of given type and compiles the provided source.
 func CompileNewShader(gl interfaces.OpenGL, shaderType uint32, source string) (shader uint32, err error) {
 	shader = gl.CreateShader(shaderType)
@@ -108,13 +126,16 @@ func GetShaderSourcePartial(filename string) string {
 
 // LoadTexture ...
 func LoadTexture(gl interfaces.OpenGL, file string) uint32 {
-	imgFile, err := os.Open(file)
+	imgFile, err := OpenResource(file)
 	if err != nil {
-		settings.LogError("[OpenGL Utils] Texture file not found: %v", err)
+		settings.LogWarn("[OpenGL Utils] Texture file not found: %v", err)
+		return 0
 	}
+	defer imgFile.Close()
 	img, _, err := image.Decode(imgFile)
 	if err != nil {
-		settings.LogError("[OpenGL Utils] Can't decode texture: %v", err)
+		settings.LogWarn("[OpenGL Utils] Can't decode texture: %v", err)
+		return 0
 	}
 
 	rgba := image.NewRGBA(img.Bounds())
@@ -146,13 +167,16 @@ func LoadTexture(gl interfaces.OpenGL, file string) uint32 {
 
 // LoadTextureRepeat ...
 func LoadTextureRepeat(gl interfaces.OpenGL, file string) uint32 {
-	imgFile, err := os.Open(file)
+	imgFile, err := OpenResource(file)
 	if err != nil {
-		settings.LogError("[OpenGL Utils] Texture file (%v) not found: %v", file, err)
+		settings.LogWarn("[OpenGL Utils] Texture file (%v) not found: %v", file, err)
+		return 0
 	}
+	defer imgFile.Close()
 	img, _, err := image.Decode(imgFile)
 	if err != nil {
-		settings.LogError("[OpenGL Utils] Can't decode texture (%v): %v", file, err)
+		settings.LogWarn("[OpenGL Utils] Can't decode texture (%v): %v", file, err)
+		return 0
 	}
 
 	rgba := image.NewRGBA(img.Bounds())
@@ -5,6 +5,7 @@ import "github.com/supudo/Kuplung-Go/types"
 // ParserManager ...
 type ParserManager struct {
 	objParser *ObjParser
+	usdParser *UsdParser
 
 	doProgress func(float32)
 }
@@ -14,6 +15,7 @@ func NewParserManager(doProgress func(float32)) *ParserManager {
 	pm := &ParserManager{}
 	pm.doProgress = doProgress
 	pm.initObjParser()
+	pm.initUsdParser()
 	return pm
 }
 
@@ -22,10 +24,25 @@ func (pm *ParserManager) Parse(filename string, psettings []string, itype types.
 	switch itype {
 	case types.ImportExportFormatOBJ:
 		return pm.objParser.Parse(filename, psettings)
+	case types.ImportExportFormatUSD:
+		return pm.usdParser.Parse(filename, psettings)
 	}
 	return nil
 }
 
+// SceneObjects returns the lights and cameras found by the last Parse of a scene format
+func (pm *ParserManager) SceneObjects(itype types.ImportExportFormat) ([]types.SceneLight, []types.SceneCamera) {
+	switch itype {
+	case types.ImportExportFormatUSD:
+		return pm.usdParser.Lights(), pm.usdParser.Cameras()
+	}
+	return nil, nil
+}
+
 func (pm *ParserManager) initObjParser() {
 	pm.objParser = NewObjParser(pm.doProgress)
 }
+
+func (pm *ParserManager) initUsdParser() {
+	pm.usdParser = NewUsdParser(pm.doProgress)
+}
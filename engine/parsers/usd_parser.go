@@ -0,0 +1,740 @@
+package parsers
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/settings"
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+// UsdParser reads the USD ASCII (.usda) subset written by the Kuplung USD exporter
+type UsdParser struct {
+	filename   string
+	doProgress func(float32)
+
+	models  []types.MeshModel
+	lights  []types.SceneLight
+	cameras []types.SceneCamera
+
+	materials map[string]*types.MeshModelMaterial
+}
+
+// usdPrim is a parsed "def" block
+type usdPrim struct {
+	primType   string
+	name       string
+	path       string
+	attributes map[string]*usdValue
+	children   []*usdPrim
+}
+
+// usdValue is a parsed attribute or relationship value
+type usdValue struct {
+	scalar   string
+	elements []*usdValue
+}
+
+// NewUsdParser ...
+func NewUsdParser(doProgress func(float32)) *UsdParser {
+	return &UsdParser{doProgress: doProgress}
+}
+
+// Parse ...
+func (usdp *UsdParser) Parse(filename string, psettings []string) []types.MeshModel {
+	usdp.filename = filename
+	usdp.models = nil
+	usdp.lights = nil
+	usdp.cameras = nil
+	usdp.materials = make(map[string]*types.MeshModelMaterial)
+
+	source, err := ioutil.ReadFile(filename)
+	if err != nil {
+		settings.LogWarn("[USD Parser] Can't open usda file (%v): %v", filename, err)
+		return nil
+	}
+
+	lexer := &usdLexer{tokens: usdTokenize(string(source))}
+	if lexer.next() != "#usda" {
+		settings.LogWarn("[USD Parser] File %v is not a USD ASCII file!", filename)
+		return nil
+	}
+	root, err := lexer.parseLayer()
+	if err != nil {
+		settings.LogWarn("[USD Parser] Can't parse %v : %v", filename, err)
+		return nil
+	}
+
+	usdp.doProgress(0.0)
+	usdp.collectMaterials(root)
+	usdp.collectObjects(root, mgl32.Ident4())
+	usdp.doProgress(100.0)
+
+	return usdp.models
+}
+
+// Lights returns the lights found during the last Parse
+func (usdp *UsdParser) Lights() []types.SceneLight {
+	return usdp.lights
+}
+
+// Cameras returns the cameras found during the last Parse
+func (usdp *UsdParser) Cameras() []types.SceneCamera {
+	return usdp.cameras
+}
+
+func (usdp *UsdParser) collectMaterials(prim *usdPrim) {
+	for _, child := range prim.children {
+		if child.primType == "Material" {
+			usdp.materials[child.path] = usdp.readMaterial(child)
+		}
+		usdp.collectMaterials(child)
+	}
+}
+
+func (usdp *UsdParser) readMaterial(prim *usdPrim) *types.MeshModelMaterial {
+	mat := &types.MeshModelMaterial{
+		MaterialID:       uint32(len(usdp.materials)),
+		MaterialTitle:    prim.name,
+		DiffuseColor:     mgl32.Vec3{0.8, 0.8, 0.8},
+		Transparency:     1.0,
+		IlluminationMode: 2,
+		OpticalDensity:   1.0,
+		SpecularExp:      1.0,
+	}
+
+	shaders := make(map[string]*usdPrim)
+	for _, child := range prim.children {
+		if child.primType == "Shader" {
+			shaders[child.path] = child
+		}
+	}
+
+	var surface *usdPrim
+	for _, shader := range shaders {
+		if shader.attributes["info:id"].text() == "UsdPreviewSurface" {
+			surface = shader
+		}
+	}
+	if surface == nil {
+		return mat
+	}
+
+	if v, ok := surface.attributes["inputs:diffuseColor"]; ok {
+		mat.DiffuseColor = v.vec3()
+	}
+	if v, ok := surface.attributes["inputs:emissiveColor"]; ok {
+		mat.EmissionColor = v.vec3()
+	}
+	if v, ok := surface.attributes["inputs:specularColor"]; ok {
+		mat.SpecularColor = v.vec3()
+	}
+	if v, ok := surface.attributes["inputs:roughness"]; ok {
+		mat.SpecularExp = roughnessToSpecularExp(v.float())
+	}
+	if v, ok := surface.attributes["inputs:opacity"]; ok {
+		mat.Transparency = v.float()
+	}
+	if v, ok := surface.attributes["inputs:ior"]; ok {
+		mat.OpticalDensity = v.float()
+	}
+
+	textures := map[string]*types.MeshMaterialTextureImage{
+		"diffuseColor":  &mat.TextureDiffuse,
+		"specularColor": &mat.TextureSpecular,
+		"roughness":     &mat.TextureSpecularExp,
+		"opacity":       &mat.TextureDissolve,
+		"normal":        &mat.TextureBump,
+		"displacement":  &mat.TextureDisplacement,
+		"occlusion":     &mat.TextureAmbient,
+	}
+	for input, image := range textures {
+		conn, ok := surface.attributes["inputs:"+input+".connect"]
+		if !ok {
+			continue
+		}
+		shaderPath := strings.SplitN(conn.text(), ".", 2)[0]
+		shader, ok := shaders[shaderPath]
+		if !ok || shader.attributes["info:id"].text() != "UsdUVTexture" {
+			continue
+		}
+		file := shader.attributes["inputs:file"].text()
+		if len(file) == 0 {
+			continue
+		}
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(filepath.Dir(usdp.filename), file)
+		}
+		image.Image = file
+		image.Filename = filepath.Base(file)
+		image.UseTexture = true
+	}
+
+	return mat
+}
+
+func (usdp *UsdParser) collectObjects(prim *usdPrim, parent mgl32.Mat4) {
+	for _, child := range prim.children {
+		local := child.localTransform()
+		world := parent.Mul4(local)
+		switch child.primType {
+		case "Mesh":
+			usdp.readMesh(child, world)
+		case "DistantLight", "SphereLight":
+			usdp.readLight(child)
+		case "Camera":
+			usdp.readCamera(child)
+		}
+		usdp.collectObjects(child, world)
+	}
+}
+
+func (usdp *UsdParser) readMesh(prim *usdPrim, transform mgl32.Mat4) {
+	points := prim.attributes["points"].vec3Array()
+	indices := prim.attributes["faceVertexIndices"].intArray()
+	counts := prim.attributes["faceVertexCounts"].intArray()
+	normals := prim.attributes["normals"].vec3Array()
+	uvs := prim.attributes["primvars:st"].vec2Array()
+	if len(points) == 0 || len(indices) == 0 {
+		return
+	}
+
+	normalMatrix := transform.Mat3().Inv().Transpose()
+	for i := range points {
+		points[i] = transform.Mul4x1(points[i].Vec4(1.0)).Vec3()
+	}
+	for i := range normals {
+		normals[i] = normalMatrix.Mul3x1(normals[i]).Normalize()
+	}
+
+	// triangulate polygons as fans
+	var triangles []uint32
+	offset := 0
+	if len(counts) == 0 {
+		counts = make([]int, len(indices)/3)
+		for i := range counts {
+			counts[i] = 3
+		}
+	}
+	skipped := 0
+	for _, c := range counts {
+		if c < 0 || offset+c > len(indices) {
+			skipped++
+			break
+		}
+		// faces with vertex indices outside of the points are skipped
+		valid := true
+		for k := 0; k < c; k++ {
+			if indices[offset+k] < 0 || indices[offset+k] >= len(points) {
+				valid = false
+				break
+			}
+		}
+		if !valid {
+			skipped++
+			offset += c
+			continue
+		}
+		for k := 1; k+1 < c; k++ {
+			triangles = append(triangles, uint32(indices[offset]), uint32(indices[offset+k]), uint32(indices[offset+k+1]))
+		}
+		offset += c
+	}
+	if skipped > 0 {
+		settings.LogWarn("[USD Parser] Mesh %v in %v has %v faces with invalid vertex indices, skipping them!", prim.path, usdp.filename, skipped)
+	}
+	if len(triangles) == 0 {
+		return
+	}
+
+	if len(normals) != len(points) {
+		normals = usdComputeNormals(points, triangles)
+	}
+	if len(uvs) != len(points) {
+		uvs = nil
+	}
+
+	model := types.MeshModel{
+		ID:                      uint32(len(usdp.models)),
+		File:                    filepath.Base(usdp.filename),
+		FilePath:                usdp.filename,
+		ModelTitle:              usdp.meshTitle(prim),
+		CountVertices:           int32(len(triangles)),
+		CountNormals:            int32(len(triangles)),
+		CountTextureCoordinates: int32(len(uvs)),
+		CountIndices:            int32(len(triangles)),
+		Vertices:                points,
+		Normals:                 normals,
+		TextureCoordinates:      uvs,
+		Indices:                 triangles,
+	}
+
+	if binding, ok := prim.attributes["material:binding"]; ok {
+		if mat, ok := usdp.materials[binding.text()]; ok {
+			model.ModelMaterial = *mat
+		}
+	}
+	if len(model.ModelMaterial.MaterialTitle) == 0 {
+		model.ModelMaterial = types.MeshModelMaterial{
+			MaterialTitle:    "Default",
+			DiffuseColor:     mgl32.Vec3{0.8, 0.8, 0.8},
+			Transparency:     1.0,
+			IlluminationMode: 2,
+			OpticalDensity:   1.0,
+			SpecularExp:      1.0,
+		}
+	}
+	model.MaterialTitle = model.ModelMaterial.MaterialTitle
+
+	usdp.models = append(usdp.models, model)
+}
+
+// meshTitle uses the parent Xform name for the "Mesh" prims written by Kuplung
+func (usdp *UsdParser) meshTitle(prim *usdPrim) string {
+	if prim.name == "Mesh" {
+		elements := strings.Split(prim.path, "/")
+		if len(elements) > 2 {
+			return elements[len(elements)-2]
+		}
+	}
+	return prim.name
+}
+
+func (usdp *UsdParser) readLight(prim *usdPrim) {
+	light := types.SceneLight{
+		Title:           prim.name,
+		LightType:       types.LightSourceTypeDirectional,
+		Diffuse:         mgl32.Vec3{1, 1, 1},
+		Ambient:         mgl32.Vec3{1, 1, 1},
+		Specular:        mgl32.Vec3{1, 1, 1},
+		StrengthDiffuse: 1.0,
+		Scale:           mgl32.Vec3{1, 1, 1},
+		Direction:       mgl32.Vec3{0, 1, 0},
+	}
+	if prim.primType == "SphereLight" {
+		light.LightType = types.LightSourceTypePoint
+		if _, ok := prim.attributes["inputs:shaping:cone:angle"]; ok {
+			light.LightType = types.LightSourceTypeSpot
+		}
+	}
+
+	light.Position, light.Rotate, light.Scale = prim.xformOps()
+	if v, ok := prim.attributes["inputs:color"]; ok {
+		light.Diffuse = v.vec3()
+	}
+	if v, ok := prim.attributes["inputs:intensity"]; ok {
+		light.StrengthDiffuse = v.float()
+	}
+	if v, ok := prim.attributes["inputs:shaping:cone:angle"]; ok {
+		light.OuterCutOff = v.float()
+		light.CutOff = light.OuterCutOff
+		if s, ok := prim.attributes["inputs:shaping:cone:softness"]; ok {
+			light.CutOff = light.OuterCutOff * (1.0 - s.float())
+		}
+	}
+	if v, ok := prim.attributes["kuplung:direction"]; ok {
+		light.Direction = v.vec3()
+	}
+	if v, ok := prim.attributes["kuplung:ambient"]; ok {
+		light.Ambient = v.vec3()
+	}
+	if v, ok := prim.attributes["kuplung:specular"]; ok {
+		light.Specular = v.vec3()
+	}
+	if v, ok := prim.attributes["kuplung:strengthAmbient"]; ok {
+		light.StrengthAmbient = v.float()
+	}
+	if v, ok := prim.attributes["kuplung:strengthSpecular"]; ok {
+		light.StrengthSpecular = v.float()
+	}
+	if v, ok := prim.attributes["kuplung:cutOff"]; ok {
+		light.CutOff = v.float()
+	}
+	if v, ok := prim.attributes["kuplung:constant"]; ok {
+		light.Constant = v.float()
+	}
+	if v, ok := prim.attributes["kuplung:linear"]; ok {
+		light.Linear = v.float()
+	}
+	if v, ok := prim.attributes["kuplung:quadratic"]; ok {
+		light.Quadratic = v.float()
+	}
+
+	usdp.lights = append(usdp.lights, light)
+}
+
+func (usdp *UsdParser) readCamera(prim *usdPrim) {
+	camera := types.SceneCamera{Title: prim.name, FocalLength: 50.0}
+	camera.Position, camera.Rotate, _ = prim.xformOps()
+	if v, ok := prim.attributes["focalLength"]; ok {
+		camera.FocalLength = v.float()
+	}
+	usdp.cameras = append(usdp.cameras, camera)
+}
+
+// xformOps returns the translate, rotateZYX or rotateXYZ (degrees) and scale ops of the prim
+func (prim *usdPrim) xformOps() (translate, rotate, scale mgl32.Vec3) {
+	scale = mgl32.Vec3{1, 1, 1}
+	if v, ok := prim.attributes["xformOp:translate"]; ok {
+		translate = v.vec3()
+	}
+	if v, ok := prim.attributes["xformOp:rotateZYX"]; ok {
+		rotate = v.vec3()
+	} else if v, ok := prim.attributes["xformOp:rotateXYZ"]; ok {
+		rotate = v.vec3()
+	}
+	if v, ok := prim.attributes["xformOp:scale"]; ok {
+		scale = v.vec3()
+	}
+	return translate, rotate, scale
+}
+
+// localTransform builds the matrix from the xformOpOrder of the prim
+func (prim *usdPrim) localTransform() mgl32.Mat4 {
+	m := mgl32.Ident4()
+	order, ok := prim.attributes["xformOpOrder"]
+	if !ok {
+		return m
+	}
+	for _, op := range order.elements {
+		v, ok := prim.attributes[op.scalar]
+		if !ok {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(op.scalar, "xformOp:translate"):
+			t := v.vec3()
+			m = m.Mul4(mgl32.Translate3D(t.X(), t.Y(), t.Z()))
+		case strings.HasPrefix(op.scalar, "xformOp:rotateXYZ"):
+			r := v.vec3()
+			// rotateXYZ applies X first, so Z is the outermost rotation
+			m = m.Mul4(mgl32.HomogRotate3DZ(mgl32.DegToRad(r.Z())))
+			m = m.Mul4(mgl32.HomogRotate3DY(mgl32.DegToRad(r.Y())))
+			m = m.Mul4(mgl32.HomogRotate3DX(mgl32.DegToRad(r.X())))
+		case strings.HasPrefix(op.scalar, "xformOp:rotateZYX"):
+			r := v.vec3()
+			// rotateZYX applies Z first, so X is the outermost rotation, as Kuplung rotates the models
+			m = m.Mul4(mgl32.HomogRotate3DX(mgl32.DegToRad(r.X())))
+			m = m.Mul4(mgl32.HomogRotate3DY(mgl32.DegToRad(r.Y())))
+			m = m.Mul4(mgl32.HomogRotate3DZ(mgl32.DegToRad(r.Z())))
+		case strings.HasPrefix(op.scalar, "xformOp:scale"):
+			s := v.vec3()
+			m = m.Mul4(mgl32.Scale3D(s.X(), s.Y(), s.Z()))
+		}
+	}
+	return m
+}
+
+func (v *usdValue) text() string {
+	if v == nil {
+		return ""
+	}
+	return v.scalar
+}
+
+func (v *usdValue) float() float32 {
+	if v == nil {
+		return 0.0
+	}
+	f, _ := strconv.ParseFloat(v.scalar, 32)
+	return float32(f)
+}
+
+func (v *usdValue) vec2() mgl32.Vec2 {
+	if v == nil || len(v.elements) < 2 {
+		return mgl32.Vec2{}
+	}
+	return mgl32.Vec2{v.elements[0].float(), v.elements[1].float()}
+}
+
+func (v *usdValue) vec3() mgl32.Vec3 {
+	if v == nil || len(v.elements) < 3 {
+		return mgl32.Vec3{}
+	}
+	return mgl32.Vec3{v.elements[0].float(), v.elements[1].float(), v.elements[2].float()}
+}
+
+func (v *usdValue) intArray() []int {
+	if v == nil {
+		return nil
+	}
+	result := make([]int, 0, len(v.elements))
+	for _, e := range v.elements {
+		i, _ := strconv.Atoi(e.scalar)
+		result = append(result, i)
+	}
+	return result
+}
+
+func (v *usdValue) vec2Array() []mgl32.Vec2 {
+	if v == nil {
+		return nil
+	}
+	result := make([]mgl32.Vec2, 0, len(v.elements))
+	for _, e := range v.elements {
+		result = append(result, e.vec2())
+	}
+	return result
+}
+
+func (v *usdValue) vec3Array() []mgl32.Vec3 {
+	if v == nil {
+		return nil
+	}
+	result := make([]mgl32.Vec3, 0, len(v.elements))
+	for _, e := range v.elements {
+		result = append(result, e.vec3())
+	}
+	return result
+}
+
+// roughnessToSpecularExp is the inverse of the exporter's Blinn-Phong exponent mapping
+func roughnessToSpecularExp(roughness float32) float32 {
+	if roughness <= 0.001 {
+		return 1000.0
+	}
+	return float32(math.Min(2.0/float64(roughness*roughness)-2.0, 1000.0))
+}
+
+func usdComputeNormals(points []mgl32.Vec3, indices []uint32) []mgl32.Vec3 {
+	normals := make([]mgl32.Vec3, len(points))
+	for i := 0; i+2 < len(indices); i += 3 {
+		a, b, c := points[indices[i]], points[indices[i+1]], points[indices[i+2]]
+		n := b.Sub(a).Cross(c.Sub(a))
+		normals[indices[i]] = normals[indices[i]].Add(n)
+		normals[indices[i+1]] = normals[indices[i+1]].Add(n)
+		normals[indices[i+2]] = normals[indices[i+2]].Add(n)
+	}
+	for i := range normals {
+		if normals[i].Len() > 0 {
+			normals[i] = normals[i].Normalize()
+		}
+	}
+	return normals
+}
+
+// usdLexer walks the tokens of a .usda file
+type usdLexer struct {
+	tokens []string
+	pos    int
+}
+
+func usdTokenize(source string) []string {
+	var tokens []string
+	runes := []rune(source)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '#' && len(tokens) > 0:
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case r == '"':
+			j := i + 1
+			var sb strings.Builder
+			for j < len(runes) && runes[j] != '"' {
+				if runes[j] == '\\' && j+1 < len(runes) {
+					j++
+				}
+				sb.WriteRune(runes[j])
+				j++
+			}
+			tokens = append(tokens, "\""+sb.String())
+			i = j + 1
+		case r == '<':
+			j := i + 1
+			for j < len(runes) && runes[j] != '>' {
+				j++
+			}
+			tokens = append(tokens, string(runes[i:j]))
+			i = j + 1
+		case r == '@':
+			j := i + 1
+			for j < len(runes) && runes[j] != '@' {
+				j++
+			}
+			tokens = append(tokens, string(runes[i:j]))
+			i = j + 1
+		case strings.ContainsRune("(){}[]=,", r):
+			tokens = append(tokens, string(r))
+			i++
+		default:
+			j := i
+			for j < len(runes) && !unicode.IsSpace(runes[j]) && !strings.ContainsRune("(){}[]=,\"<@", runes[j]) {
+				j++
+			}
+			tokens = append(tokens, string(runes[i:j]))
+			i = j
+		}
+	}
+	return tokens
+}
+
+func (lx *usdLexer) peek() string {
+	if lx.pos < len(lx.tokens) {
+		return lx.tokens[lx.pos]
+	}
+	return ""
+}
+
+func (lx *usdLexer) next() string {
+	t := lx.peek()
+	lx.pos++
+	return t
+}
+
+func (lx *usdLexer) expect(t string) error {
+	if got := lx.next(); got != t {
+		return fmt.Errorf("expected %q, got %q", t, got)
+	}
+	return nil
+}
+
+func (lx *usdLexer) parseLayer() (*usdPrim, error) {
+	// version number
+	lx.next()
+	if lx.peek() == "(" {
+		lx.skipGroup()
+	}
+	root := &usdPrim{attributes: make(map[string]*usdValue)}
+	for lx.pos < len(lx.tokens) {
+		if err := lx.parseStatement(root); err != nil {
+			return nil, err
+		}
+	}
+	return root, nil
+}
+
+// skipGroup skips a balanced (...) metadata block
+func (lx *usdLexer) skipGroup() {
+	depth := 0
+	for lx.pos < len(lx.tokens) {
+		switch lx.next() {
+		case "(":
+			depth++
+		case ")":
+			depth--
+			if depth == 0 {
+				return
+			}
+		}
+	}
+}
+
+func (lx *usdLexer) parseStatement(parent *usdPrim) error {
+	switch lx.peek() {
+	case "def", "over", "class":
+		return lx.parsePrim(parent)
+	case "":
+		lx.next()
+		return nil
+	}
+	return lx.parseProperty(parent)
+}
+
+func (lx *usdLexer) parsePrim(parent *usdPrim) error {
+	lx.next()
+	prim := &usdPrim{attributes: make(map[string]*usdValue)}
+	if !strings.HasPrefix(lx.peek(), "\"") {
+		prim.primType = lx.next()
+	}
+	name := lx.next()
+	if !strings.HasPrefix(name, "\"") {
+		return fmt.Errorf("invalid prim name %q", name)
+	}
+	prim.name = name[1:]
+	prim.path = parent.path + "/" + prim.name
+	if lx.peek() == "(" {
+		lx.skipGroup()
+	}
+	if err := lx.expect("{"); err != nil {
+		return err
+	}
+	for lx.peek() != "}" {
+		if lx.pos >= len(lx.tokens) {
+			return fmt.Errorf("unexpected end of file in prim %v", prim.path)
+		}
+		if err := lx.parseStatement(prim); err != nil {
+			return err
+		}
+	}
+	lx.next()
+	parent.children = append(parent.children, prim)
+	return nil
+}
+
+func (lx *usdLexer) parseProperty(prim *usdPrim) error {
+	for {
+		switch lx.peek() {
+		case "custom", "uniform", "varying", "prepend", "append", "add", "delete":
+			lx.next()
+			continue
+		}
+		break
+	}
+
+	// "rel name" or "type name", where array types are tokenized as "type [ ]"
+	typeName := lx.next()
+	if typeName != "rel" && lx.peek() == "[" {
+		lx.next()
+		if err := lx.expect("]"); err != nil {
+			return err
+		}
+	}
+	name := lx.next()
+	if len(name) == 0 || strings.ContainsAny(name[:1], "(){}[]=,\"<@") {
+		return fmt.Errorf("unexpected token %q in prim %v", name, prim.path)
+	}
+
+	if lx.peek() == "=" {
+		lx.next()
+		value, err := lx.parseValue(prim)
+		if err != nil {
+			return err
+		}
+		prim.attributes[name] = value
+	}
+	if lx.peek() == "(" {
+		lx.skipGroup()
+	}
+	return nil
+}
+
+func (lx *usdLexer) parseValue(prim *usdPrim) (*usdValue, error) {
+	t := lx.next()
+	switch {
+	case t == "(" || t == "[":
+		closing := ")"
+		if t == "[" {
+			closing = "]"
+		}
+		value := &usdValue{}
+		for lx.peek() != closing {
+			if lx.pos >= len(lx.tokens) {
+				return nil, fmt.Errorf("unexpected end of file in %v", prim.path)
+			}
+			if lx.peek() == "," {
+				lx.next()
+				continue
+			}
+			e, err := lx.parseValue(prim)
+			if err != nil {
+				return nil, err
+			}
+			value.elements = append(value.elements, e)
+		}
+		lx.next()
+		return value, nil
+	case strings.HasPrefix(t, "\""), strings.HasPrefix(t, "@"), strings.HasPrefix(t, "<"):
+		return &usdValue{scalar: t[1:]}, nil
+	}
+	return &usdValue{scalar: t}, nil
+}
@@ -1,4 +1,4 @@
-package engine
+package platform
 
 import (
 	"github.com/supudo/Kuplung-Go/engine/input"
@@ -1,4 +1,4 @@
-package engine
+package platform
 
 import (
 	"fmt"
@@ -64,6 +64,11 @@ func (native *OpenGL) BindBuffer(target uint32, buffer uint32) {
 	gl.BindBuffer(target, buffer)
 }
 
+// BindBufferBase implements the interfaces.OpenGL interface.
+func (native *OpenGL) BindBufferBase(target uint32, index uint32, buffer uint32) {
+	gl.BindBufferBase(target, index, buffer)
+}
+
 // BindSampler implements the interfaces.OpenGL interface.
 func (native *OpenGL) BindSampler(unit uint32, sampler uint32) {
 	gl.BindSampler(unit, sampler)
@@ -326,6 +331,11 @@ func (native *OpenGL) GetUniformLocation(program uint32, name string) int32 {
 	return gl.GetUniformLocation(program, gl.Str(name+"\x00"))
 }
 
+// GetUniformBlockIndex implements the interfaces.OpenGL interface.
+func (native *OpenGL) GetUniformBlockIndex(program uint32, name string) uint32 {
+	return gl.GetUniformBlockIndex(program, gl.Str(name+"\x00"))
+}
+
 // IsEnabled implements the OpenGL interface.
 func (native *OpenGL) IsEnabled(capability uint32) bool {
 	return gl.IsEnabled(capability)
@@ -375,7 +385,17 @@ func (native *OpenGL) TexImage2D(target uint32, level int32, internalFormat uint
 	}
 }
 
-// TexParameteri implements the interfaces.OpenGL interface.
+// TexImage3D implements the interfaces.OpenGL interface.
+func (native *OpenGL) TexImage3D(target uint32, level int32, internalFormat uint32, width int32, height int32, depth int32,
+	border int32, format uint32, xtype uint32, pixels interface{}) {
+	ptr, isPointer := pixels.(unsafe.Pointer)
+	if isPointer {
+		gl.TexImage3D(target, level, int32(internalFormat), width, height, depth, border, format, xtype, ptr)
+	} else {
+		gl.TexImage3D(target, level, int32(internalFormat), width, height, depth, border, format, xtype, gl.Ptr(pixels))
+	}
+}
+
 func (native *OpenGL) TexParameteri(target uint32, pname uint32, param int32) {
 	gl.TexParameteri(target, pname, param)
 }
@@ -436,6 +456,11 @@ func (native *OpenGL) UniformMatrix4fv(location int32, transpose bool, value *[1
 	gl.UniformMatrix4fv(location, count, transpose, &value[0])
 }
 
+// UniformBlockBinding implements the interfaces.OpenGL interface.
+func (native *OpenGL) UniformBlockBinding(program uint32, blockIndex uint32, blockBinding uint32) {
+	gl.UniformBlockBinding(program, blockIndex, blockBinding)
+}
+
 // UniformMatrix3fv implements the interfaces.OpenGL interface.
 func (native *OpenGL) UniformMatrix3fv(location int32, count int32, transpose bool, value *float32) {
 	gl.UniformMatrix3fv(location, count, transpose, value)
@@ -625,3 +650,43 @@ func (native *OpenGL) FramebufferRenderbuffer(target uint32, attachment uint32,
 func (native *OpenGL) CheckFramebufferStatus(target uint32) uint32 {
 	return gl.CheckFramebufferStatus(target)
 }
+
+// DeleteFramebuffers implements the interfaces.OpenGL interface.
+func (native *OpenGL) DeleteFramebuffers(framebuffers []uint32) {
+	gl.DeleteFramebuffers(int32(len(framebuffers)), &framebuffers[0])
+}
+
+// DrawBuffer implements the interfaces.OpenGL interface.
+func (native *OpenGL) DrawBuffer(buf uint32) {
+	gl.DrawBuffer(buf)
+}
+
+// ReadBuffer implements the interfaces.OpenGL interface.
+func (native *OpenGL) ReadBuffer(src uint32) {
+	gl.ReadBuffer(src)
+}
+
+// TexParameterfv implements the interfaces.OpenGL interface.
+func (native *OpenGL) TexParameterfv(target uint32, pname uint32, params *float32) {
+	gl.TexParameterfv(target, pname, params)
+}
+
+// CullFace implements the interfaces.OpenGL interface.
+func (native *OpenGL) CullFace(mode uint32) {
+	gl.CullFace(mode)
+}
+
+// PolygonOffset implements the interfaces.OpenGL interface.
+func (native *OpenGL) PolygonOffset(factor float32, units float32) {
+	gl.PolygonOffset(factor, units)
+}
+
+// FramebufferTexture implements the interfaces.OpenGL interface.
+func (native *OpenGL) FramebufferTexture(target uint32, attachment uint32, texture uint32, level int32) {
+	gl.FramebufferTexture(target, attachment, texture, level)
+}
+
+// DeleteRenderbuffers implements the interfaces.OpenGL interface.
+func (native *OpenGL) DeleteRenderbuffers(renderbuffers []uint32) {
+	gl.DeleteRenderbuffers(int32(len(renderbuffers)), &renderbuffers[0])
+}
@@ -1,4 +1,5 @@
-package engine
+// Package platform is the SDL2 window and the go-gl bindings, the only packages besides gui that need cgo.
+package platform
 
 import (
 	"time"
@@ -0,0 +1,73 @@
+package engine
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"sort"
+	"sync"
+)
+
+// packedResources holds the images packed inside the opened scene, keyed by the path they were saved with
+var packedResources = make(map[string][]byte)
+var packedResourcesMutex sync.RWMutex
+
+// SetPackedResources replaces the packed resources of the current scene
+func SetPackedResources(resources map[string][]byte) {
+	packedResourcesMutex.Lock()
+	defer packedResourcesMutex.Unlock()
+	packedResources = make(map[string][]byte, len(resources))
+	for path, data := range resources {
+		packedResources[path] = data
+	}
+}
+
+// AddPackedResources adds the packed resources of an appended scene, the current scene keeps its contents for the same path
+func AddPackedResources(resources map[string][]byte) {
+	packedResourcesMutex.Lock()
+	defer packedResourcesMutex.Unlock()
+	for path, data := range resources {
+		if _, ok := packedResources[path]; !ok {
+			packedResources[path] = data
+		}
+	}
+}
+
+// ClearPackedResources ...
+func ClearPackedResources() {
+	SetPackedResources(nil)
+}
+
+// PackedResource returns the packed contents for the path
+func PackedResource(path string) ([]byte, bool) {
+	packedResourcesMutex.RLock()
+	defer packedResourcesMutex.RUnlock()
+	data, ok := packedResources[path]
+	return data, ok
+}
+
+// PackedResourcePaths returns the sorted paths of all packed resources
+func PackedResourcePaths() []string {
+	packedResourcesMutex.RLock()
+	defer packedResourcesMutex.RUnlock()
+	paths := make([]string, 0, len(packedResources))
+	for path := range packedResources {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// OpenResource opens a scene resource, preferring the packed contents so that a packed scene renders the same as when it was saved
+func OpenResource(path string) (io.ReadCloser, error) {
+	if data, ok := PackedResource(path); ok {
+		return nopCloser{bytes.NewReader(data)}, nil
+	}
+	return os.Open(path)
+}
+
+type nopCloser struct {
+	io.Reader
+}
+
+func (nopCloser) Close() error { return nil }
@@ -22,6 +22,10 @@ type ComponentExport struct {
 	panelWidthOptionsMin float32
 
 	SettingForward, SettingUp int32
+	SettingCollectTextures    bool
+
+	SettingStrokeSilhouette, SettingStrokeCrease, SettingStrokeBoundary float32
+	SettingCreaseAngle                                                  float32
 
 	currentFolder string
 
@@ -45,12 +49,22 @@ func NewComponentExport() *ComponentExport {
 	comp.panelWidthOptionsMin = 200.0
 	comp.SettingForward = 2
 	comp.SettingUp = 4
+	comp.SettingStrokeSilhouette = 2.0
+	comp.SettingStrokeCrease = 1.0
+	comp.SettingStrokeBoundary = 1.5
+	comp.SettingCreaseAngle = 30.0
 	comp.currentFolder = sett.App.CurrentFolder
 	comp.formats = []string{
 		"Wavefront OBJ",
 		"glTF",
 		"STereoLithography STL",
-		"Stanford PLY"}
+		"Stanford PLY",
+		"Universal Scene Description USDA",
+		"HTML Viewer",
+		"Line Art SVG",
+		"Line Art PDF",
+		"Cross Section SVG",
+		"Cross Section DXF"}
 	comp.forwards = []string{
 		"-X Forward",
 		"-Y Forward",
@@ -91,6 +105,18 @@ func (comp *ComponentExport) Render(open *bool, dialogExportType *types.ImportEx
 		windowTitle += "Stanford PLY file"
 	case types.ImportExportFormatGLTF:
 		windowTitle += "glTF file"
+	case types.ImportExportFormatUSD:
+		windowTitle += "USD ASCII file"
+	case types.ImportExportFormatHTML:
+		windowTitle += "HTML viewer"
+	case types.ImportExportFormatSVG:
+		windowTitle += "line-art SVG file"
+	case types.ImportExportFormatPDF:
+		windowTitle += "line-art PDF file"
+	case types.ImportExportFormatSectionSVG:
+		windowTitle += "cross-section SVG files"
+	case types.ImportExportFormatSectionDXF:
+		windowTitle += "cross-section DXF file"
 	}
 
 	if imgui.BeginV(windowTitle, open, 0) {
@@ -105,11 +131,15 @@ func (comp *ComponentExport) Render(open *bool, dialogExportType *types.ImportEx
 		imgui.Separator()
 		imgui.PushItemWidth(-1.0)
 		imgui.Text("Kuplung File Format")
-		if imgui.BeginCombo("##982", comp.formats[int32(*dialogExportType)]) {
+		formatTitle := ""
+		if *dialogExportType != types.ImportExportFormatUNDEFINED {
+			formatTitle = comp.formats[int32(*dialogExportType)-1]
+		}
+		if imgui.BeginCombo("##982", formatTitle) {
 			var i int32
 			for i = 0; i < int32(len(comp.formats)); i++ {
-				if imgui.SelectableV(comp.formats[i], (types.ImportExportFormat(i) == *dialogExportType), 0, imgui.Vec2{X: 0, Y: 0}) {
-					*dialogExportType = types.ImportExportFormat(i)
+				if imgui.SelectableV(comp.formats[i], (types.ImportExportFormat(i+1) == *dialogExportType), 0, imgui.Vec2{X: 0, Y: 0}) {
+					*dialogExportType = types.ImportExportFormat(i + 1)
 				}
 			}
 			imgui.EndCombo()
@@ -152,6 +182,25 @@ func (comp *ComponentExport) Render(open *bool, dialogExportType *types.ImportEx
 			comp.SettingUp = 4
 		}
 		imgui.Separator()
+		imgui.Checkbox("Collect Textures", &comp.SettingCollectTextures)
+		if imgui.IsItemHovered() {
+			imgui.SetTooltip("Copy textures into a textures/ folder beside the export (embedded in .glb)")
+		}
+		if *dialogExportType == types.ImportExportFormatSVG || *dialogExportType == types.ImportExportFormatPDF {
+			imgui.Separator()
+			imgui.Text("Line Art")
+			imgui.PushItemWidth(-1.0)
+			imgui.SliderFloatV("##990", &comp.SettingStrokeSilhouette, 0.0, 10.0, "Silhouette %.1f", 1.0)
+			imgui.SliderFloatV("##991", &comp.SettingStrokeCrease, 0.0, 10.0, "Crease %.1f", 1.0)
+			imgui.SliderFloatV("##992", &comp.SettingStrokeBoundary, 0.0, 10.0, "Boundary %.1f", 1.0)
+			imgui.SliderFloatV("##993", &comp.SettingCreaseAngle, 0.0, 180.0, "Crease Angle %.0f", 1.0)
+			imgui.PopItemWidth()
+		}
+		if *dialogExportType == types.ImportExportFormatSectionSVG || *dialogExportType == types.ImportExportFormatSectionDXF {
+			imgui.Separator()
+			imgui.Text("Planes are set in\nGUI Controls > Cross Section")
+		}
+		imgui.Separator()
 		imgui.Text("Parser:")
 		// TODO: cuda parsers
 		if imgui.BeginCombo("##989", comp.parsers[sett.MemSettings.ModelFileParser]) {
@@ -210,6 +259,15 @@ func (comp *ComponentExport) Render(open *bool, dialogExportType *types.ImportEx
 			var setts []string
 			setts = append(setts, fmt.Sprintf("%v", comp.SettingForward))
 			setts = append(setts, fmt.Sprintf("%v", comp.SettingUp))
+			if comp.SettingCollectTextures {
+				setts = append(setts, "1")
+			} else {
+				setts = append(setts, "0")
+			}
+			setts = append(setts, fmt.Sprintf("%v", comp.SettingStrokeSilhouette))
+			setts = append(setts, fmt.Sprintf("%v", comp.SettingStrokeCrease))
+			setts = append(setts, fmt.Sprintf("%v", comp.SettingStrokeBoundary))
+			setts = append(setts, fmt.Sprintf("%v", comp.SettingCreaseAngle))
 			_, _ = trigger.Fire(types.ActionFileExport, file, setts, *dialogExportType)
 			*open = false
 		}
@@ -354,11 +412,23 @@ func (comp *ComponentExport) getFolderContents(dialogExportType *types.ImportExp
 					case types.ImportExportFormatOBJ:
 						isAllowedFileExtension = fext == ".obj"
 					case types.ImportExportFormatGLTF:
-						isAllowedFileExtension = fext == ".gltf"
+						isAllowedFileExtension = fext == ".gltf" || fext == ".glb"
 					case types.ImportExportFormatPLY:
 						isAllowedFileExtension = fext == ".ply"
 					case types.ImportExportFormatSTL:
 						isAllowedFileExtension = fext == ".stl"
+					case types.ImportExportFormatUSD:
+						isAllowedFileExtension = fext == ".usda"
+					case types.ImportExportFormatHTML:
+						isAllowedFileExtension = fext == ".html"
+					case types.ImportExportFormatSVG:
+						isAllowedFileExtension = fext == ".svg"
+					case types.ImportExportFormatPDF:
+						isAllowedFileExtension = fext == ".pdf"
+					case types.ImportExportFormatSectionSVG:
+						isAllowedFileExtension = fext == ".svg"
+					case types.ImportExportFormatSectionDXF:
+						isAllowedFileExtension = fext == ".dxf"
 					}
 				} else {
 					isAllowedFileExtension = true
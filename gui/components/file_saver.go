@@ -1,21 +1,33 @@
 package components
 
 import (
+	"bytes"
 	"fmt"
+	"image"
+	"image/draw"
+	"image/png"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"runtime"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/inkyblackness/imgui-go"
 	"github.com/sadlil/go-trigger"
+	"github.com/supudo/Kuplung-Go/engine/oglconsts"
+	"github.com/supudo/Kuplung-Go/interfaces"
+	"github.com/supudo/Kuplung-Go/rendering"
+	"github.com/supudo/Kuplung-Go/saveopen"
 	"github.com/supudo/Kuplung-Go/settings"
 	"github.com/supudo/Kuplung-Go/types"
 )
 
 // ComponentFileSaver ...
 type ComponentFileSaver struct {
+	window interfaces.Window
+
 	showNewFolderModel    bool
 	panelWidthFileOptions float32
 
@@ -27,12 +39,21 @@ type ComponentFileSaver struct {
 	positionY float32
 	width     float32
 	height    float32
+
+	// the preview of the selected scene, reloaded when the file changes
+	previewPath    string
+	previewModTime time.Time
+	preview        *saveopen.ScenePreview
+	previewTexture uint32
+	previewWidth   float32
+	previewHeight  float32
 }
 
 // NewComponentFileSaver ...
-func NewComponentFileSaver() *ComponentFileSaver {
+func NewComponentFileSaver(window interfaces.Window) *ComponentFileSaver {
 	sett := settings.GetSettings()
 	return &ComponentFileSaver{
+		window:                window,
 		positionX:             50,
 		positionY:             50,
 		width:                 sett.AppWindow.FileBrowserWidth,
@@ -60,15 +81,63 @@ func (comp *ComponentFileSaver) Render(operation types.FileSaverOperation, open
 		windowTitle = "Open Scene"
 		btnLabel = "Open"
 	case types.FileSaverOperationRenderer:
-		windowTitle = "Render Scene"
+		windowTitle = "Render Image"
+		btnLabel = "Render"
+	case types.FileSaverOperationUnpackResources:
+		windowTitle = "Unpack Resources"
+		btnLabel = "Unpack"
+	case types.FileSaverOperationAppendScene:
+		windowTitle = "Append Scene"
+		btnLabel = "Append"
+	case types.FileSaverOperationOpenEnvironmentMap:
+		windowTitle = "Open HDR Environment"
+		btnLabel = "Open"
+	case types.FileSaverOperationOpenColorGradingLUT:
+		windowTitle = "Open Color Grading LUT"
+		btnLabel = "Open"
 	}
 
 	if imgui.BeginV(windowTitle, open, 0) {
 		imgui.Text(fmt.Sprintf("%s", filepath.Clean(comp.currentFolder)))
 		imgui.Separator()
 
-		// TODO: options
-		// imgui.SameLine()
+		if operation == types.FileSaverOperationSaveScene {
+			imgui.Checkbox("Pack Resources", &sett.App.PackResources)
+			if imgui.IsItemHovered() {
+				imgui.SetTooltip("Store the textures inside the scene file")
+			}
+			imgui.SameLineV(0, 20)
+			imgui.Checkbox("Link Imported Models", &sett.App.LinkModels)
+			if imgui.IsItemHovered() {
+				imgui.SetTooltip("Store the source file of the imported models instead of their geometry, they are re-imported on open")
+			}
+			imgui.Text("Use a .json extension to save a JSON scene with a .bin buffer")
+			imgui.Separator()
+		} else if operation == types.FileSaverOperationRenderer {
+			comp.drawRenderImageOptions()
+			imgui.Separator()
+		} else if operation == types.FileSaverOperationUnpackResources {
+			imgui.Text("Folder name, the resources are written to the current folder if empty")
+			imgui.Separator()
+		} else if operation == types.FileSaverOperationOpenEnvironmentMap {
+			imgui.Text("Equirectangular Radiance .hdr image, used as the skybox and for the PBR lighting")
+			imgui.Separator()
+		} else if operation == types.FileSaverOperationOpenColorGradingLUT {
+			imgui.Text("3D lookup table in a .cube file, used by the post-processing color grading")
+			imgui.Separator()
+		} else if operation == types.FileSaverOperationAppendScene {
+			imgui.Text("The models and lights are added to the current scene, also take from the appended scene:")
+			imgui.Checkbox("Camera", &sett.App.AppendCamera)
+			imgui.SameLineV(0, 20)
+			imgui.Checkbox("Grid", &sett.App.AppendGrid)
+			imgui.SameLineV(0, 20)
+			imgui.Checkbox("Render Settings", &sett.App.AppendRendering)
+			imgui.Separator()
+		}
+
+		if operation == types.FileSaverOperationOpenScene || operation == types.FileSaverOperationAppendScene {
+			comp.drawPreview()
+		}
 
 		imgui.BeginChild("scrolling")
 		imgui.PushStyleVarVec2(imgui.StyleVarItemSpacing, imgui.Vec2{X: 0, Y: 1})
@@ -96,6 +165,15 @@ func (comp *ComponentFileSaver) Render(operation types.FileSaverOperation, open
 				_, _ = trigger.Fire(types.ActionFileSaverOpenScene, file)
 			case types.FileSaverOperationRenderer:
 				_, _ = trigger.Fire(types.ActionFileSaverRenderer, file)
+			case types.FileSaverOperationUnpackResources:
+				file.IsFile = false
+				_, _ = trigger.Fire(types.ActionFileSaverUnpack, file)
+			case types.FileSaverOperationAppendScene:
+				_, _ = trigger.Fire(types.ActionFileSaverAppend, file)
+			case types.FileSaverOperationOpenEnvironmentMap:
+				_, _ = trigger.Fire(types.ActionFileSaverEnvironmentMap, file)
+			case types.FileSaverOperationOpenColorGradingLUT:
+				_, _ = trigger.Fire(types.ActionFileSaverColorGradingLUT, file)
 			}
 			*open = false
 		}
@@ -135,6 +213,146 @@ func (comp *ComponentFileSaver) Render(operation types.FileSaverOperation, open
 	}
 }
 
+// drawRenderImageOptions shows the size, the format and the file name pattern of the Render Image
+func (comp *ComponentFileSaver) drawRenderImageOptions() {
+	sett := settings.GetSettings()
+	opts := &sett.RenderImage
+
+	imgui.PushItemWidth(120)
+	imgui.DragIntV("Width##994", &opts.Width, 1.0, 1, 32768, "%d")
+	imgui.SameLineV(0, 20)
+	imgui.DragIntV("Height##995", &opts.Height, 1.0, 1, 32768, "%d")
+	imgui.SameLineV(0, 20)
+	if imgui.Button("Window Size") {
+		w, h := comp.window.Size()
+		opts.Width, opts.Height = int32(w), int32(h)
+	}
+
+	formats := []string{"PNG", "JPEG", "PNG 16-bit"}
+	if int(opts.Format) >= len(formats) {
+		opts.Format = uint32(types.RenderImageFormatPNG)
+	}
+	if imgui.BeginCombo("Format##996", formats[opts.Format]) {
+		for i := 0; i < len(formats); i++ {
+			if imgui.SelectableV(formats[i], opts.Format == uint32(i), 0, imgui.Vec2{X: 0, Y: 0}) {
+				opts.Format = uint32(i)
+			}
+		}
+		imgui.EndCombo()
+	}
+	imgui.SameLineV(0, 20)
+	samples := []string{"Off", "2x2", "3x3", "4x4"}
+	if opts.Supersampling < 1 || int(opts.Supersampling) > len(samples) {
+		opts.Supersampling = 1
+	}
+	if imgui.BeginCombo("Supersampling##997", samples[opts.Supersampling-1]) {
+		for i := 0; i < len(samples); i++ {
+			if imgui.SelectableV(samples[i], opts.Supersampling == int32(i+1), 0, imgui.Vec2{X: 0, Y: 0}) {
+				opts.Supersampling = int32(i + 1)
+			}
+		}
+		imgui.EndCombo()
+	}
+	imgui.PopItemWidth()
+
+	if types.RenderImageFormat(opts.Format) == types.RenderImageFormatJPEG {
+		imgui.Text("Transparent background isn't supported in JPEG")
+	} else {
+		imgui.Checkbox("Transparent Background##998", &opts.Transparent)
+		if imgui.IsItemHovered() {
+			imgui.SetTooltip("The skybox isn't drawn and the background is saved with zero alpha")
+		}
+	}
+
+	imgui.PushItemWidth(300)
+	imgui.InputText("Name Pattern##999", &opts.NamePattern)
+	imgui.PopItemWidth()
+	if imgui.IsItemHovered() {
+		imgui.SetTooltip("{name} - the file name below, {width}, {height}, {renderer}, {date}, {time}\n{n} - the first number that doesn't overwrite an existing file")
+	}
+	imgui.Text(fmt.Sprintf("Output: %v", filepath.Base(rendering.RenderImageFileName(comp.currentFolder, comp.fileName))))
+	imgui.Text("Larger than 4096 pixels images are rendered in tiles, the bloom and the FXAA are computed per tile")
+}
+
+// drawPreview shows the thumbnail and the metadata of the selected scene
+func (comp *ComponentFileSaver) drawPreview() {
+	comp.loadPreview(comp.currentFolder + "/" + comp.fileName)
+	if comp.preview == nil {
+		return
+	}
+
+	if comp.previewTexture > 0 {
+		imgui.Image(imgui.TextureID(comp.previewTexture), imgui.Vec2{X: comp.previewWidth, Y: comp.previewHeight})
+		imgui.SameLineV(0, 10)
+	}
+	imgui.BeginGroup()
+	md := comp.preview.Metadata
+	if md == nil {
+		imgui.Text(fmt.Sprintf("Format version %v, saved without metadata", comp.preview.Version))
+	} else {
+		imgui.Text(fmt.Sprintf("Author: %v", md.Author))
+		imgui.Text(fmt.Sprintf("Created: %v", md.Created.Local().Format("02-Jan-2006 15:04:05")))
+		imgui.Text(fmt.Sprintf("Modified: %v", md.Modified.Local().Format("02-Jan-2006 15:04:05")))
+		imgui.Text(fmt.Sprintf("Saved with: %v, format version %v", md.Application, comp.preview.Version))
+		imgui.Text(fmt.Sprintf("Objects: %v, Lights: %v", md.Objects, md.Lights))
+		imgui.Text(fmt.Sprintf("Vertices: %v, Triangles: %v", md.Vertices, md.Triangles))
+	}
+	imgui.EndGroup()
+	imgui.Separator()
+}
+
+// loadPreview reads the preview of the scene file, unless it's already loaded
+func (comp *ComponentFileSaver) loadPreview(path string) {
+	var modTime time.Time
+	fi, err := os.Stat(path)
+	if err == nil {
+		modTime = fi.ModTime()
+	}
+	if path == comp.previewPath && modTime.Equal(comp.previewModTime) {
+		return
+	}
+	comp.previewPath, comp.previewModTime = path, modTime
+	comp.preview = nil
+	if comp.previewTexture > 0 {
+		comp.window.OpenGL().DeleteTextures([]uint32{comp.previewTexture})
+		comp.previewTexture = 0
+	}
+	if err != nil || fi.IsDir() || !strings.EqualFold(filepath.Ext(path), ".kuplung") {
+		return
+	}
+
+	preview, err := saveopen.ReadScenePreview(path)
+	if err != nil {
+		settings.LogWarn("[FileSaver] Can't read the scene preview %v : %v", path, err)
+		return
+	}
+	comp.preview = preview
+	if len(preview.Thumbnail) > 0 {
+		comp.createPreviewTexture(preview.Thumbnail)
+	}
+}
+
+func (comp *ComponentFileSaver) createPreviewTexture(data []byte) {
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		settings.LogWarn("[FileSaver] Can't decode the scene thumbnail : %v", err)
+		return
+	}
+	rgba := image.NewRGBA(img.Bounds())
+	draw.Draw(rgba, rgba.Bounds(), img, img.Bounds().Min, draw.Src)
+
+	gl := comp.window.OpenGL()
+	comp.previewTexture = gl.GenTextures(1)[0]
+	gl.BindTexture(oglconsts.TEXTURE_2D, comp.previewTexture)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_MIN_FILTER, oglconsts.LINEAR)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_MAG_FILTER, oglconsts.LINEAR)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_WRAP_S, oglconsts.CLAMP_TO_EDGE)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_WRAP_T, oglconsts.CLAMP_TO_EDGE)
+	gl.TexImage2D(oglconsts.TEXTURE_2D, 0, oglconsts.RGBA, int32(rgba.Rect.Dx()), int32(rgba.Rect.Dy()), 0, oglconsts.RGBA, oglconsts.UNSIGNED_BYTE, gl.Ptr(rgba.Pix))
+	gl.BindTexture(oglconsts.TEXTURE_2D, 0)
+	comp.previewWidth, comp.previewHeight = float32(rgba.Rect.Dx()), float32(rgba.Rect.Dy())
+}
+
 func (comp *ComponentFileSaver) modalNewFolder(ww float32) {
 	imgui.OpenPopup("New Folder")
 	sett := settings.GetSettings()
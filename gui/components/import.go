@@ -45,7 +45,8 @@ func NewComponentImport() *ComponentImport {
 		"Wavefront OBJ",
 		"glTF",
 		"STereoLithography STL",
-		"Stanford PLY"}
+		"Stanford PLY",
+		"Universal Scene Description USDA"}
 	comp.forwards = []string{
 		"-X Forward",
 		"-Y Forward",
@@ -83,6 +84,8 @@ func (comp *ComponentImport) Render(open *bool, dialogImportType *types.ImportEx
 		windowTitle += "Stanford PLY file"
 	case types.ImportExportFormatGLTF:
 		windowTitle += "glTF file"
+	case types.ImportExportFormatUSD:
+		windowTitle += "USD ASCII file"
 	}
 
 	if imgui.BeginV(windowTitle, open, 0) {
@@ -97,11 +100,15 @@ func (comp *ComponentImport) Render(open *bool, dialogImportType *types.ImportEx
 		imgui.Separator()
 		imgui.PushItemWidth(-1.0)
 		imgui.Text("Kuplung File Format")
-		if imgui.BeginCombo("##982", comp.formats[int32(*dialogImportType)]) {
+		formatTitle := ""
+		if *dialogImportType != types.ImportExportFormatUNDEFINED && int(*dialogImportType) <= len(comp.formats) {
+			formatTitle = comp.formats[int32(*dialogImportType)-1]
+		}
+		if imgui.BeginCombo("##982", formatTitle) {
 			var i int32
 			for i = 0; i < int32(len(comp.formats)); i++ {
-				if imgui.SelectableV(comp.formats[i], (types.ImportExportFormat(i) == *dialogImportType), 0, imgui.Vec2{X: 0, Y: 0}) {
-					*dialogImportType = types.ImportExportFormat(i)
+				if imgui.SelectableV(comp.formats[i], (types.ImportExportFormat(i+1) == *dialogImportType), 0, imgui.Vec2{X: 0, Y: 0}) {
+					*dialogImportType = types.ImportExportFormat(i + 1)
 				}
 			}
 			imgui.EndCombo()
@@ -269,7 +276,7 @@ func (comp *ComponentImport) getFolderContents(dialogImportType *types.ImportExp
 			isAllowedFileExtension := false
 			for _, f := range files {
 				fext := filepath.Ext(f.Name())
-				if *dialogImportType != types.ImportExportFormatUNDEFINED {
+				if *dialogImportType != types.ImportExportFormatUNDEFINED && int(*dialogImportType) <= len(comp.formats) {
 					switch *dialogImportType {
 					case types.ImportExportFormatOBJ:
 						isAllowedFileExtension = fext == ".obj"
@@ -279,6 +286,8 @@ func (comp *ComponentImport) getFolderContents(dialogImportType *types.ImportExp
 						isAllowedFileExtension = fext == ".ply"
 					case types.ImportExportFormatSTL:
 						isAllowedFileExtension = fext == ".stl"
+					case types.ImportExportFormatUSD:
+						isAllowedFileExtension = fext == ".usda"
 					}
 				} else {
 					isAllowedFileExtension = true
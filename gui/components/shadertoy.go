@@ -98,7 +98,7 @@ func (comp *ComponentShadertoy) Render(open *bool, deltaTime float32) {
 		if int32(comp.heightTopPanel) < comp.engineShadertoy.TextureHeight || int32(comp.heightTopPanel) > comp.engineShadertoy.TextureHeight {
 			comp.engineShadertoy.InitFBO(int32(comp.windowWidth), int32(comp.heightTopPanel), &comp.vboTexture)
 		}
-		comp.engineShadertoy.RenderToTexture(deltaTime, mousex, mousey, float32(sdl.GetTicks()/1000.0), &comp.vboTexture)
+		comp.engineShadertoy.RenderToTexture(deltaTime, imgui.CurrentIO().Framerate(), mousex, mousey, float32(sdl.GetTicks()/1000.0), &comp.vboTexture)
 
 		imgui.BeginChildV("Preview", imgui.Vec2{X: 0, Y: comp.heightTopPanel}, true, 0)
 
@@ -1,6 +1,7 @@
 package gui
 
 import (
+	"path/filepath"
 	"time"
 
 	"github.com/inkyblackness/imgui-go"
@@ -85,8 +86,13 @@ type WindowVariables struct {
 	showImageSave  bool
 	showRendererUI bool
 
-	showOpenDialog bool
-	showSaveDialog bool
+	showOpenDialog   bool
+	showSaveDialog   bool
+	showUnpackDialog bool
+	showAppendDialog bool
+
+	showEnvironmentMapDialog  bool
+	showColorGradingLUTDialog bool
 
 	showImporterFile bool
 	showExporterFile bool
@@ -101,10 +107,16 @@ type WindowVariables struct {
 
 	ParsingPercentage float32
 
-	recentFiles         []*types.FBEntity
-	recentFilesImported []*types.FBEntity
+	recentFiles         *settings.RecentFiles
+	recentFilesImported *settings.RecentFiles
+
+	showRecentFileDoesntExists bool
+
+	showError                bool
+	errorTitle, errorMessage string
 
-	showRecentFileImportedDoesntExists bool
+	showRecovery bool
+	recoveryFile *types.FBEntity
 }
 
 // NewContext initializes a new UI context based on the provided OpenGL window.
@@ -125,7 +137,7 @@ func NewContext(window interfaces.Window) *Context {
 		componentIDE:       components.NewComponentIDE(),
 		componentImport:    components.NewComponentImport(),
 		componentExport:    components.NewComponentExport(),
-		componentFileSaver: components.NewComponentFileSaver(),
+		componentFileSaver: components.NewComponentFileSaver(window),
 		componentShadertoy: components.NewComponentShadertoy(window),
 	}
 
@@ -137,6 +149,9 @@ func NewContext(window interfaces.Window) *Context {
 
 	context.GuiVars.showOpenDialog = false
 	context.GuiVars.showSaveDialog = false
+	context.GuiVars.showAppendDialog = false
+	context.GuiVars.showEnvironmentMapDialog = false
+	context.GuiVars.showColorGradingLUTDialog = false
 
 	context.GuiVars.showDemoWindow = false
 	context.GuiVars.showAboutImGui = false
@@ -161,9 +176,9 @@ func NewContext(window interfaces.Window) *Context {
 	context.GuiVars.showSVS = false
 	context.GuiVars.showShadertoy = false
 
-	context.GuiVars.recentFiles = nil
-	context.GuiVars.recentFilesImported = nil
-	context.GuiVars.showRecentFileImportedDoesntExists = false
+	context.GuiVars.recentFiles = settings.LoadRecentFiles(settings.RecentScenesFile)
+	context.GuiVars.recentFilesImported = settings.LoadRecentFiles(settings.RecentImportsFile)
+	context.GuiVars.showRecentFileDoesntExists = false
 
 	err := context.createDeviceObjects()
 	if err != nil {
@@ -181,7 +196,17 @@ func NewContext(window interfaces.Window) *Context {
 		context.GuiVars.showParsing = false
 	})
 
+	trigger.On(types.ActionFileSaverAddToRecentFiles, context.recentFilesAdd)
 	trigger.On(types.ActionFileImportAddToRecentFiles, context.recentFilesAddImported)
+	trigger.On(types.ActionGuiShowRecovery, func(file *types.FBEntity) {
+		context.GuiVars.recoveryFile = file
+		context.GuiVars.showRecovery = true
+	})
+	trigger.On(types.ActionGuiShowError, func(title, message string) {
+		context.GuiVars.errorTitle = title
+		context.GuiVars.errorMessage = message
+		context.GuiVars.showError = true
+	})
 
 	return context
 }
@@ -304,8 +329,16 @@ func (context *Context) DrawGUI(isFrame bool, rm *rendering.RenderManager) {
 		context.componentExport.Render(&context.GuiVars.showExporterFile, &context.GuiVars.dialogExportType)
 	}
 
-	if context.GuiVars.showRecentFileImportedDoesntExists {
-		context.popupRecentFileImportedDoesntExists(&context.GuiVars.showRecentFileImportedDoesntExists)
+	if context.GuiVars.showRecentFileDoesntExists {
+		context.popupRecentFileDoesntExists(&context.GuiVars.showRecentFileDoesntExists)
+	}
+
+	if context.GuiVars.showRecovery {
+		context.popupRecovery(&context.GuiVars.showRecovery)
+	}
+
+	if context.GuiVars.showError {
+		context.popupError(&context.GuiVars.showError)
 	}
 
 	if context.GuiVars.showSaveDialog {
@@ -316,26 +349,45 @@ func (context *Context) DrawGUI(isFrame bool, rm *rendering.RenderManager) {
 		context.componentFileSaver.Render(types.FileSaverOperationOpenScene, &context.GuiVars.showOpenDialog)
 	}
 
+	if context.GuiVars.showUnpackDialog {
+		context.componentFileSaver.Render(types.FileSaverOperationUnpackResources, &context.GuiVars.showUnpackDialog)
+	}
+
+	if context.GuiVars.showAppendDialog {
+		context.componentFileSaver.Render(types.FileSaverOperationAppendScene, &context.GuiVars.showAppendDialog)
+	}
+
+	if context.GuiVars.showEnvironmentMapDialog {
+		context.componentFileSaver.Render(types.FileSaverOperationOpenEnvironmentMap, &context.GuiVars.showEnvironmentMapDialog)
+	}
+	if context.GuiVars.showColorGradingLUTDialog {
+		context.componentFileSaver.Render(types.FileSaverOperationOpenColorGradingLUT, &context.GuiVars.showColorGradingLUTDialog)
+	}
+
+	if context.GuiVars.showImageSave {
+		context.componentFileSaver.Render(types.FileSaverOperationRenderer, &context.GuiVars.showImageSave)
+	}
+
 	if context.GuiVars.showShadertoy {
 		context.componentShadertoy.Render(&context.GuiVars.showShadertoy, context.DeltaTime)
 	}
 }
 
-func (context *Context) recentFilesClearImported() {
-	context.GuiVars.recentFilesImported = nil
+func recentFileEntity(file *settings.RecentFile) *types.FBEntity {
+	return &types.FBEntity{
+		IsFile:    true,
+		Path:      file.Path,
+		Title:     file.Title,
+		Extension: filepath.Ext(file.Path),
+	}
 }
 
-func (context *Context) recentFilesAddImported(file *types.FBEntity) {
-	exists := false
-	for i := 0; i < len(context.GuiVars.recentFilesImported); i++ {
-		if context.GuiVars.recentFilesImported[i].Path == file.Path {
-			exists = true
-		}
-	}
-	if !exists {
-		context.GuiVars.recentFilesImported = append(context.GuiVars.recentFilesImported, file)
-		settings.SaveRecentFilesImported(context.GuiVars.recentFilesImported)
-	}
+func (context *Context) recentFilesAdd(file *types.FBEntity) {
+	context.GuiVars.recentFiles.Add(settings.RecentFile{Title: file.Title, Path: file.Path})
+}
+
+func (context *Context) recentFilesAddImported(file *types.FBEntity, setts []string, itype types.ImportExportFormat) {
+	context.GuiVars.recentFilesImported.Add(settings.RecentFile{Title: file.Title, Path: file.Path, Format: itype, Settings: setts})
 }
 
 // IMGUI IMPLEMENTATION FOLLOWS BELLOW ...
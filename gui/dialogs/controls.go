@@ -2,6 +2,7 @@ package dialogs
 
 import (
 	"fmt"
+	"path/filepath"
 
 	"github.com/go-gl/mathgl/mgl32"
 	"github.com/inkyblackness/imgui-go"
@@ -219,6 +220,18 @@ func (view *ViewControls) Render(open, isFrame *bool, rm *rendering.RenderManage
 			}
 			imgui.TreePop()
 		}
+		if imgui.TreeNodeV("Cross Section", imgui.TreeNodeFlagsCollapsingHeader) {
+			imgui.Checkbox("Show Cut Plane", &rsett.CrossSection.ShowPlane)
+			imgui.Text("Plane Normal")
+			imgui.SliderFloat("X##9940", &rsett.CrossSection.NormalX, -1.0, 1.0)
+			imgui.SliderFloat("Y##9941", &rsett.CrossSection.NormalY, -1.0, 1.0)
+			imgui.SliderFloat("Z##9942", &rsett.CrossSection.NormalZ, -1.0, 1.0)
+			helpers.AddControlsSlider("Offset", 9943, 0.01, -100.0, 100.0, false, nil, &rsett.CrossSection.Offset, true, isFrame)
+			helpers.AddControlsIntegerSlider("Slices", 9944, 1, 100, &rsett.CrossSection.Count)
+			helpers.AddControlsSlider("Spacing", 9945, 0.01, 0.0, 10.0, false, nil, &rsett.CrossSection.Spacing, false, isFrame)
+			helpers.AddControlsSlider("Preview Size", 9946, 0.1, 0.0, 100.0, false, nil, &rsett.CrossSection.PlaneSize, false, isFrame)
+			imgui.TreePop()
+		}
 		if imgui.TreeNodeV("Bounding Box", imgui.TreeNodeFlagsCollapsingHeader) {
 			if imgui.Checkbox("Bounding Box", &rsett.General.ShowBoundingBox) {
 				settings.SaveRenderingSettings()
@@ -253,8 +266,159 @@ func (view *ViewControls) Render(open, isFrame *bool, rm *rendering.RenderManage
 			if imgui.ButtonV("Shadow Texture", imgui.Vec2{X: -1, Y: 0}) {
 				rsett.General.DebugShadowTexture = !rsett.General.DebugShadowTexture
 			}
+			if imgui.ButtonV("Light Clusters", imgui.Vec2{X: -1, Y: 0}) {
+				rsett.General.DebugLightClusters = !rsett.General.DebugLightClusters
+			}
 			imgui.TreePop()
 		}
+		if imgui.TreeNodeV("Post-Processing", imgui.TreeNodeFlagsCollapsingHeader) {
+			imgui.Checkbox("Enabled##235", &rsett.PostProcessing.Enabled)
+			if rsett.PostProcessing.Enabled {
+				imgui.Separator()
+				imgui.Checkbox("Bloom", &rsett.PostProcessing.Bloom)
+				if rsett.PostProcessing.Bloom {
+					imgui.Text("Strength")
+					imgui.SliderFloatV("##236", &rsett.PostProcessing.BloomStrength, 0.0, 0.5, "%.3f", 1.0)
+					imgui.Text("Filter Radius")
+					imgui.SliderFloatV("##237", &rsett.PostProcessing.BloomFilterRadius, 0.001, 0.02, "%.4f", 1.0)
+				}
+				imgui.Separator()
+
+				imgui.Checkbox("Tone Mapping", &rsett.PostProcessing.ToneMapping)
+				imgui.Text("Exposure")
+				imgui.SliderFloat("##238", &rsett.PostProcessing.Exposure, 0.0, 8.0)
+				if rsett.PostProcessing.ToneMapping {
+					operators := []string{"Reinhard", "ACES Filmic", "Uncharted 2"}
+					imgui.Text("Operator")
+					if imgui.BeginCombo("##239", operators[rsett.PostProcessing.ToneMappingOperator]) {
+						for i, title := range operators {
+							if imgui.SelectableV(title, rsett.PostProcessing.ToneMappingOperator == int32(i), 0, imgui.Vec2{X: 0, Y: 0}) {
+								rsett.PostProcessing.ToneMappingOperator = int32(i)
+							}
+						}
+						imgui.EndCombo()
+					}
+				}
+				imgui.Separator()
+
+				imgui.Checkbox("Color Grading", &rsett.PostProcessing.ColorGrading)
+				if rsett.PostProcessing.ColorGrading {
+					if rsett.PostProcessing.ColorGradingLUT == "" {
+						imgui.Text("No lookup table, open a .cube file from Scene > Color Grading LUT ...")
+					} else {
+						imgui.Text(fmt.Sprintf("LUT: %v", filepath.Base(rsett.PostProcessing.ColorGradingLUT)))
+						if imgui.IsItemHovered() {
+							imgui.SetTooltip(rsett.PostProcessing.ColorGradingLUT)
+						}
+					}
+					imgui.Text("Intensity")
+					imgui.SliderFloat("##240", &rsett.PostProcessing.ColorGradingIntensity, 0.0, 1.0)
+				}
+				imgui.Separator()
+
+				imgui.Checkbox("Vignette", &rsett.PostProcessing.Vignette)
+				if rsett.PostProcessing.Vignette {
+					imgui.Text("Intensity")
+					imgui.SliderFloat("##241", &rsett.PostProcessing.VignetteIntensity, 0.0, 1.0)
+					imgui.Text("Smoothness")
+					imgui.SliderFloat("##242", &rsett.PostProcessing.VignetteSmoothness, 0.01, 1.0)
+				}
+				imgui.Separator()
+
+				imgui.Checkbox("FXAA", &rsett.PostProcessing.FXAA)
+			}
+			imgui.TreePop()
+		}
+		if imgui.TreeNodeV("Path Tracing", imgui.TreeNodeFlagsCollapsingHeader) {
+			imgui.Text("Used by the Rendered skin of Scene Rendering")
+			imgui.Text("Samples")
+			imgui.SliderInt("##243", &rsett.PathTracing.Samples, 1, 4096)
+			imgui.Text("Bounces")
+			imgui.SliderInt("##244", &rsett.PathTracing.Bounces, 1, 32)
+			imgui.Text("Exposure")
+			imgui.SliderFloat("##245", &rsett.PathTracing.Exposure, 0.0, 8.0)
+			imgui.Text("Resolution Scale")
+			imgui.SliderFloat("##246", &rsett.PathTracing.ResolutionScale, 0.1, 1.0)
+			passes, samples := rm.PathTracingProgress()
+			imgui.Text(fmt.Sprintf("%v / %v samples", passes, samples))
+			imgui.TreePop()
+		}
+
+		atlasShadows := sett.App.RendererType == types.InAppRendererTypeShadowMapping || sett.App.RendererType == types.InAppRendererTypeForwardShadowMapping
+		if atlasShadows || sett.App.RendererType == types.InAppRendererTypeDeferred {
+			if imgui.TreeNodeV("Shadows", imgui.TreeNodeFlagsCollapsingHeader) {
+				if atlasShadows {
+					imgui.Text("Atlas Size")
+					if imgui.BeginCombo("##220", fmt.Sprint(rsett.Shadows.AtlasSize)) {
+						for _, size := range []int32{1024, 2048, 4096, 8192} {
+							if imgui.SelectableV(fmt.Sprint(size), rsett.Shadows.AtlasSize == size, 0, imgui.Vec2{X: 0, Y: 0}) {
+								rsett.Shadows.AtlasSize = size
+								settings.SaveRenderingSettings()
+							}
+						}
+						imgui.EndCombo()
+					}
+
+					imgui.Text("Max Lights")
+					if imgui.SliderInt("##221", &rsett.Shadows.MaxLights, 1, 8) {
+						settings.SaveRenderingSettings()
+					}
+					imgui.Text("PCF Radius")
+					if imgui.SliderInt("##222", &rsett.Shadows.PCFRadius, 0, 4) {
+						settings.SaveRenderingSettings()
+					}
+					imgui.Text("Constant Bias")
+					if imgui.SliderFloatV("##223", &rsett.Shadows.BiasConstant, 0.0, 0.01, "%.5f", 1.0) {
+						settings.SaveRenderingSettings()
+					}
+					imgui.Text("Slope Bias")
+					if imgui.SliderFloatV("##224", &rsett.Shadows.BiasSlope, 0.0, 0.05, "%.4f", 1.0) {
+						settings.SaveRenderingSettings()
+					}
+					if imgui.Checkbox("Show Shadow Texture", &rsett.General.DebugShadowTexture) {
+						settings.SaveRenderingSettings()
+					}
+					imgui.Separator()
+
+					imgui.Text("Directional Light Cascades")
+					if imgui.SliderInt("##225", &rsett.Shadows.CascadeCount, 1, 4) {
+						settings.SaveRenderingSettings()
+					}
+					imgui.Text("Cascade Split Lambda")
+					if imgui.SliderFloat("##226", &rsett.Shadows.CascadeSplitLambda, 0.0, 1.0) {
+						settings.SaveRenderingSettings()
+					}
+					imgui.Text("Cascade Blending")
+					if imgui.SliderFloat("##227", &rsett.Shadows.CascadeBlend, 0.0, 0.5) {
+						settings.SaveRenderingSettings()
+					}
+					if imgui.Checkbox("Show Cascades", &rsett.Shadows.DebugCascades) {
+						settings.SaveRenderingSettings()
+					}
+					imgui.Separator()
+				}
+
+				imgui.Text("Point Light Cube Map Size")
+				if imgui.BeginCombo("##228", fmt.Sprint(rsett.Shadows.PointMapSize)) {
+					for _, size := range []int32{256, 512, 1024, 2048} {
+						if imgui.SelectableV(fmt.Sprint(size), rsett.Shadows.PointMapSize == size, 0, imgui.Vec2{X: 0, Y: 0}) {
+							rsett.Shadows.PointMapSize = size
+							settings.SaveRenderingSettings()
+						}
+					}
+					imgui.EndCombo()
+				}
+				imgui.Text("Point Light Bias")
+				if imgui.SliderFloatV("##229", &rsett.Shadows.PointBias, 0.0, 0.5, "%.3f", 1.0) {
+					settings.SaveRenderingSettings()
+				}
+				imgui.Text("Point Light Softness")
+				if imgui.SliderFloatV("##230", &rsett.Shadows.PointSoftness, 0.0, 0.2, "%.3f", 1.0) {
+					settings.SaveRenderingSettings()
+				}
+				imgui.TreePop()
+			}
+		}
 
 		if sett.App.RendererType == types.InAppRendererTypeDeferred {
 			if imgui.TreeNodeV("Deferred Rendering", imgui.TreeNodeFlagsCollapsingHeader) {
@@ -271,6 +435,8 @@ func (view *ViewControls) Render(open, isFrame *bool, rm *rendering.RenderManage
 					gbtitle = "Diffuse"
 				case 4:
 					gbtitle = "Specular"
+				case 5:
+					gbtitle = "AO Only"
 				}
 				if imgui.BeginCombo("##110", gbtitle) {
 					if imgui.SelectableV("Lighting", rsett.Defered.LightingPassDrawMode == 0, 0, imgui.Vec2{X: 0, Y: 0}) {
@@ -288,11 +454,28 @@ func (view *ViewControls) Render(open, isFrame *bool, rm *rendering.RenderManage
 					if imgui.SelectableV("Specular", rsett.Defered.LightingPassDrawMode == 4, 0, imgui.Vec2{X: 0, Y: 0}) {
 						rsett.Defered.LightingPassDrawMode = 4
 					}
+					if imgui.SelectableV("AO Only", rsett.Defered.LightingPassDrawMode == 5, 0, imgui.Vec2{X: 0, Y: 0}) {
+						rsett.Defered.LightingPassDrawMode = 5
+					}
 					imgui.EndCombo()
 				}
 
 				imgui.Text("Ambient Strength")
 				imgui.SliderFloat("##210", &rsett.Defered.DeferredAmbientStrength, 0.0, 1.0)
+				imgui.Separator()
+
+				imgui.Checkbox("Ambient Occlusion (SSAO)", &rsett.Defered.SSAOEnabled)
+				if rsett.Defered.SSAOEnabled {
+					imgui.Text("Radius")
+					imgui.SliderFloat("##231", &rsett.Defered.SSAORadius, 0.01, 5.0)
+					imgui.Text("Bias")
+					imgui.SliderFloat("##232", &rsett.Defered.SSAOBias, 0.0, 0.5)
+					imgui.Text("Samples")
+					imgui.SliderInt("##233", &rsett.Defered.SSAOSamples, 1, 64)
+					imgui.Text("Strength")
+					imgui.SliderFloat("##234", &rsett.Defered.SSAOStrength, 0.0, 8.0)
+				}
+				imgui.Separator()
 
 				imgui.Checkbox("Test Mode", &rsett.Defered.DeferredTestMode)
 				imgui.Checkbox("Test Lights", &rsett.Defered.DeferredTestLights)
@@ -470,6 +653,17 @@ func (view *ViewControls) Render(open, isFrame *bool, rm *rendering.RenderManage
 			}
 			imgui.EndCombo()
 		}
+		if rsett.SkyBox.SkyboxSelectedItem > 0 {
+			imgui.Text("Rotation")
+			imgui.SliderFloat("##skyboxRotation", &rsett.SkyBox.SkyboxRotation, -180.0, 180.0)
+		}
+		if rm.SkyBox.SkyboxItems[rsett.SkyBox.SkyboxSelectedItem].HDR != "" {
+			imgui.Text("Exposure")
+			imgui.SliderFloatV("##skyboxExposure", &rsett.SkyBox.SkyboxExposure, 0.0, 8.0, "%.2f", 1.0)
+			if rm.SkyBox.Environment() != nil {
+				imgui.Text("The environment lights the PBR materials")
+			}
+		}
 	case 6:
 		if imgui.BeginTabBarV("sceneLightsTab", imgui.TabBarFlagsNoCloseWithMiddleMouseButton|imgui.TabBarFlagsNoTooltip) {
 			if view.selectedObjectLight > -1 {
@@ -481,6 +675,7 @@ func (view *ViewControls) Render(open, isFrame *bool, rm *rendering.RenderManage
 					imgui.Checkbox("Lamp", &rm.LightSources[view.selectedObjectLight].ShowLampObject)
 					imgui.Checkbox("Direction", &rm.LightSources[view.selectedObjectLight].ShowLampDirection)
 					imgui.Checkbox("Wire", &rm.LightSources[view.selectedObjectLight].ShowInWire)
+					imgui.Checkbox("Casts Shadows", &rm.LightSources[view.selectedObjectLight].CastShadows)
 					imgui.Checkbox("Lock with Camera", &view.lockCameraWithLight)
 					if imgui.ButtonV("View from Here", imgui.Vec2{X: -1, Y: 0}) {
 						view.lockCameraOnce(rm)
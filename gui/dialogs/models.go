@@ -4,11 +4,11 @@ import (
 	"fmt"
 	"image"
 	"image/draw"
-	"os"
 	"path/filepath"
 
 	"github.com/inkyblackness/imgui-go"
 	"github.com/sadlil/go-trigger"
+	"github.com/supudo/Kuplung-Go/engine"
 	"github.com/supudo/Kuplung-Go/engine/oglconsts"
 	"github.com/supudo/Kuplung-Go/gui/helpers"
 	"github.com/supudo/Kuplung-Go/meshes"
@@ -582,13 +582,16 @@ func (view *ViewModels) showTextureImage(mmf *meshes.ModelFace, textype uint16,
 func (view *ViewModels) createTextureBuffer(imageFile string, width, height *int32, rm *rendering.RenderManager) uint32 {
 	gl := rm.Window.OpenGL()
 
-	imgFile, err := os.Open(imageFile)
+	imgFile, err := engine.OpenResource(imageFile)
 	if err != nil {
-		settings.LogError("[DialogModels] Texture file (%v) not found: %v", imageFile, err)
+		settings.LogWarn("[DialogModels] Texture file (%v) not found: %v", imageFile, err)
+		return 0
 	}
+	defer imgFile.Close()
 	img, _, err := image.Decode(imgFile)
 	if err != nil {
-		settings.LogError("[DialogModels] Can't decode texture (%v): %v", imageFile, err)
+		settings.LogWarn("[DialogModels] Can't decode texture (%v): %v", imageFile, err)
+		return 0
 	}
 
 	rgba := image.NewRGBA(img.Bounds())
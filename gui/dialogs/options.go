@@ -2,6 +2,7 @@ package dialogs
 
 import (
 	"github.com/inkyblackness/imgui-go"
+	"github.com/supudo/Kuplung-Go/rendering/renderers"
 	"github.com/supudo/Kuplung-Go/settings"
 )
 
@@ -24,6 +25,23 @@ func (view *ViewOptions) Render(open, isFrame *bool) {
 
 	if imgui.BeginV("Options", open, imgui.WindowFlagsResizeFromAnySide) {
 		if imgui.TreeNodeV("General", imgui.TreeNodeFlagsCollapsingHeader) {
+			if imgui.InputText("Author", &sett.App.Author) {
+				settings.SaveSettings()
+			}
+			if imgui.IsItemHovered() {
+				imgui.SetTooltip("Stored in the saved scenes, the user name is used if empty")
+			}
+			if imgui.Checkbox("Autosave", &sett.Autosave.Enabled) {
+				settings.SaveSettings()
+			}
+			interval := int32(sett.Autosave.Interval / 60)
+			if imgui.SliderIntV("Interval", &interval, 1, 60, "%d min") {
+				sett.Autosave.Interval = int64(interval) * 60
+				settings.SaveSettings()
+			}
+			if imgui.SliderInt("Kept Versions", &sett.Autosave.KeepVersions, 1, 50) {
+				settings.SaveSettings()
+			}
 			imgui.TreePop()
 		}
 		if imgui.TreeNodeV("Rendering", imgui.TreeNodeFlagsCollapsingHeader) {
@@ -33,13 +51,15 @@ func (view *ViewOptions) Render(open, isFrame *bool) {
 			if imgui.Checkbox("Occlusion Culling", &rsett.General.OcclusionCulling) {
 				settings.SaveRenderingSettings()
 			}
-			availableRenderers := []string{"Simple", "Forward", "Forward with Shadow Mapping", "Shadow Mapping", "Deferred"}
-			if imgui.BeginCombo("Renderer", availableRenderers[sett.App.RendererType]) {
-				var i uint32
-				for i = 0; i < uint32(len(availableRenderers)); i++ {
-					rsel := (i == sett.App.RendererType)
-					if imgui.SelectableV(availableRenderers[i], rsel, 0, imgui.Vec2{0, 0}) {
-						sett.App.RendererType = i
+			current := "Unknown"
+			if reg, ok := renderers.Lookup(sett.App.RendererType); ok {
+				current = reg.Title
+			}
+			if imgui.BeginCombo("Renderer", current) {
+				for _, reg := range renderers.Registered() {
+					rsel := (reg.ID == sett.App.RendererType)
+					if imgui.SelectableV(reg.Title, rsel, 0, imgui.Vec2{0, 0}) {
+						sett.App.RendererType = reg.ID
 						settings.SaveSettings()
 					}
 					if rsel {
@@ -2,9 +2,9 @@ package gui
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/inkyblackness/imgui-go"
+	"github.com/sadlil/go-trigger"
 	"github.com/supudo/Kuplung-Go/settings"
 	"github.com/supudo/Kuplung-Go/types"
 )
@@ -24,7 +24,7 @@ func (context *Context) dialogSceneStats(open *bool) {
 	imgui.Text(fmt.Sprintf("%d vertices, %d indices (%d triangles)", imgui.CurrentIO().MetricsRenderVertices(), imgui.CurrentIO().MetricsRenderIndices(), imgui.CurrentIO().MetricsRenderIndices()/3))
 }
 
-func (context *Context) popupRecentFileImportedDoesntExists(open *bool) {
+func (context *Context) popupRecentFileDoesntExists(open *bool) {
 	if *open {
 		imgui.OpenPopup("Warning")
 	}
@@ -34,14 +34,52 @@ func (context *Context) popupRecentFileImportedDoesntExists(open *bool) {
 	if imgui.BeginPopupModalV("Warning", open, imgui.WindowFlagsAlwaysAutoResize|imgui.WindowFlagsNoResize|imgui.WindowFlagsNoTitleBar) {
 		imgui.Text("This file no longer exists!")
 		if imgui.ButtonV("OK", imgui.Vec2{X: 140, Y: 0}) {
-			var recents []*types.FBEntity
-			for i := 0; i < len(context.GuiVars.recentFilesImported); i++ {
-				if _, err := os.Stat(context.GuiVars.recentFilesImported[i].Path); !os.IsNotExist(err) {
-					recents = append(recents, context.GuiVars.recentFilesImported[i])
-				}
-			}
-			context.GuiVars.recentFilesImported = recents
-			settings.SaveRecentFilesImported(context.GuiVars.recentFilesImported)
+			context.GuiVars.recentFiles.PruneAndSave()
+			context.GuiVars.recentFilesImported.PruneAndSave()
+			*open = false
+			imgui.CloseCurrentPopup()
+		}
+		imgui.EndPopup()
+	}
+}
+
+func (context *Context) popupError(open *bool) {
+	if *open {
+		imgui.OpenPopup("Error")
+	}
+	sett := settings.GetSettings()
+	imgui.SetNextWindowPosV(imgui.Vec2{X: float32(sett.AppWindow.SDLWindowWidth)/2 - 200, Y: float32(sett.AppWindow.SDLWindowHeight)/2 - 100}, imgui.ConditionAlways, imgui.Vec2{X: 0.5, Y: 0.5})
+	imgui.SetNextWindowFocus()
+	if imgui.BeginPopupModalV("Error", open, imgui.WindowFlagsAlwaysAutoResize|imgui.WindowFlagsNoResize|imgui.WindowFlagsNoTitleBar) {
+		imgui.Text(context.GuiVars.errorTitle)
+		imgui.Separator()
+		imgui.Text(context.GuiVars.errorMessage)
+		if imgui.ButtonV("OK", imgui.Vec2{X: 140, Y: 0}) {
+			*open = false
+			imgui.CloseCurrentPopup()
+		}
+		imgui.EndPopup()
+	}
+}
+
+func (context *Context) popupRecovery(open *bool) {
+	if *open {
+		imgui.OpenPopup("Recover Scene")
+	}
+	sett := settings.GetSettings()
+	imgui.SetNextWindowPosV(imgui.Vec2{X: float32(sett.AppWindow.SDLWindowWidth)/2 - 200, Y: float32(sett.AppWindow.SDLWindowHeight)/2 - 100}, imgui.ConditionAlways, imgui.Vec2{X: 0.5, Y: 0.5})
+	imgui.SetNextWindowFocus()
+	if imgui.BeginPopupModalV("Recover Scene", open, imgui.WindowFlagsAlwaysAutoResize|imgui.WindowFlagsNoResize) {
+		imgui.Text("Kuplung didn't shut down cleanly last time.")
+		imgui.Text(fmt.Sprintf("Restore the autosave from %v?", context.GuiVars.recoveryFile.ModifiedDate))
+		imgui.Separator()
+		if imgui.ButtonV("Restore", imgui.Vec2{X: 140, Y: 0}) {
+			_, _ = trigger.Fire(types.ActionFileSaverOpenScene, context.GuiVars.recoveryFile)
+			*open = false
+			imgui.CloseCurrentPopup()
+		}
+		imgui.SameLineV(0, 20)
+		if imgui.ButtonV("Discard", imgui.Vec2{X: 140, Y: 0}) {
 			*open = false
 			imgui.CloseCurrentPopup()
 		}
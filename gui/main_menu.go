@@ -7,6 +7,7 @@ import (
 
 	"github.com/inkyblackness/imgui-go"
 	"github.com/sadlil/go-trigger"
+	"github.com/supudo/Kuplung-Go/engine"
 	"github.com/supudo/Kuplung-Go/gui/fonts"
 	"github.com/supudo/Kuplung-Go/settings"
 	"github.com/supudo/Kuplung-Go/types"
@@ -28,29 +29,39 @@ func (context *Context) DrawMainMenu() {
 		if imgui.MenuItem(fmt.Sprintf("%c Open ...", fonts.FA_ICON_FOLDER_OPEN_O)) {
 			context.GuiVars.showOpenDialog = true
 		}
+		if imgui.MenuItem(fmt.Sprintf("%c Append Scene ...", fonts.FA_ICON_PLUS_SQUARE_O)) {
+			context.GuiVars.showAppendDialog = true
+		}
 		if imgui.BeginMenu(fmt.Sprintf("%c Open Recent", fonts.FA_ICON_FILES_O)) {
-			// if (this->recentFiles.size() == 0)
-			// 	imgui.MenuItem("No recent files", nil, false, false);
-			// else {
-			// 	for (size_t i = 0; i < this->recentFiles.size(); i++) {
-			// 		FBEntity file = this->recentFiles[i];
-			// 		if (imgui.MenuItem(file.title.c_str(), nil, false, true)) {
-			// 			if (boost::filesystem::exists(file.path))
-			// 				this->funcOpenScene(file);
-			// 			else
-			// 				this->showRecentFileDoesntExists = true;
-			// 		}
-			// 	}
-			// 	imgui.Separator();
-			// 	if (imgui.MenuItem("Clear recent files", nil, false))
-			// 		this->recentFilesClear();
-			// }
+			if len(context.GuiVars.recentFiles.Files) == 0 {
+				imgui.MenuItemV("No recent files", "", false, false)
+			} else {
+				for _, file := range context.GuiVars.recentFiles.Files {
+					if imgui.MenuItem(file.Title) {
+						if _, err := os.Stat(file.Path); err == nil {
+							_, _ = trigger.Fire(types.ActionFileSaverOpenScene, recentFileEntity(file))
+						} else {
+							context.GuiVars.showRecentFileDoesntExists = true
+						}
+					}
+					if imgui.IsItemHovered() {
+						imgui.SetTooltip(file.Path)
+					}
+				}
+				imgui.Separator()
+				if imgui.MenuItem("Clear recent files") {
+					context.GuiVars.recentFiles.Clear()
+				}
+			}
 			imgui.EndMenu()
 		}
 
 		if imgui.MenuItem(fmt.Sprintf("%c Save ...", fonts.FA_ICON_FLOPPY_O)) {
 			context.GuiVars.showSaveDialog = true
 		}
+		if imgui.MenuItemV("   Unpack Resources ...", "", false, len(engine.PackedResourcePaths()) > 0) {
+			context.GuiVars.showUnpackDialog = true
+		}
 
 		imgui.Separator()
 
@@ -71,6 +82,10 @@ func (context *Context) DrawMainMenu() {
 				context.GuiVars.showImporterFile = true
 				context.GuiVars.dialogImportType = types.ImportExportFormatPLY
 			}
+			if imgui.MenuItemV("USD ASCII (.usda)", "", context.GuiVars.showImporterFile, true) {
+				context.GuiVars.showImporterFile = true
+				context.GuiVars.dialogImportType = types.ImportExportFormatUSD
+			}
 			if imgui.BeginMenu("Assimp...") {
 				// for (size_t a = 0; a < Settings::Instance()->AssimpSupportedFormats_Import.size(); a++) {
 				// 	SupportedAssimpFormat format = Settings::Instance()->AssimpSupportedFormats_Import[a];
@@ -84,25 +99,24 @@ func (context *Context) DrawMainMenu() {
 		}
 
 		if imgui.BeginMenu(fmt.Sprintf("%c Import Recent", fonts.FA_ICON_FILES_O)) {
-			if len(context.GuiVars.recentFilesImported) == 0 {
-				imgui.MenuItem("No recent files")
+			if len(context.GuiVars.recentFilesImported.Files) == 0 {
+				imgui.MenuItemV("No recent files", "", false, false)
 			} else {
-				for i := 0; i < len(context.GuiVars.recentFilesImported); i++ {
-					file := context.GuiVars.recentFilesImported[i]
+				for _, file := range context.GuiVars.recentFilesImported.Files {
 					if imgui.MenuItem(file.Title) {
-						if _, err := os.Stat(file.Path); !os.IsNotExist(err) {
-							var setts []string
-							setts = append(setts, "2")
-							setts = append(setts, "4")
-							_, _ = trigger.Fire(types.ActionFileImport, file, setts, context.GuiVars.dialogImportType)
+						if _, err := os.Stat(file.Path); err == nil {
+							_, _ = trigger.Fire(types.ActionFileImport, recentFileEntity(file), file.Settings, file.Format)
 						} else {
-							context.GuiVars.showRecentFileImportedDoesntExists = true
+							context.GuiVars.showRecentFileDoesntExists = true
 						}
 					}
+					if imgui.IsItemHovered() {
+						imgui.SetTooltip(file.Path)
+					}
 				}
 				imgui.Separator()
 				if imgui.MenuItem("Clear recent files") {
-					context.recentFilesClearImported()
+					context.GuiVars.recentFilesImported.Clear()
 				}
 			}
 			imgui.EndMenu()
@@ -125,6 +139,30 @@ func (context *Context) DrawMainMenu() {
 				context.GuiVars.showExporterFile = true
 				context.GuiVars.dialogExportType = types.ImportExportFormatPLY
 			}
+			if imgui.MenuItemV("USD ASCII (.usda)", "", context.GuiVars.showExporterFile, true) {
+				context.GuiVars.showExporterFile = true
+				context.GuiVars.dialogExportType = types.ImportExportFormatUSD
+			}
+			if imgui.MenuItemV("HTML Viewer (.html)", "", context.GuiVars.showExporterFile, true) {
+				context.GuiVars.showExporterFile = true
+				context.GuiVars.dialogExportType = types.ImportExportFormatHTML
+			}
+			if imgui.MenuItemV("Line Art (.svg)", "", context.GuiVars.showExporterFile, true) {
+				context.GuiVars.showExporterFile = true
+				context.GuiVars.dialogExportType = types.ImportExportFormatSVG
+			}
+			if imgui.MenuItemV("Line Art (.pdf)", "", context.GuiVars.showExporterFile, true) {
+				context.GuiVars.showExporterFile = true
+				context.GuiVars.dialogExportType = types.ImportExportFormatPDF
+			}
+			if imgui.MenuItemV("Cross Section (.svg)", "", context.GuiVars.showExporterFile, true) {
+				context.GuiVars.showExporterFile = true
+				context.GuiVars.dialogExportType = types.ImportExportFormatSectionSVG
+			}
+			if imgui.MenuItemV("Cross Section (.dxf)", "", context.GuiVars.showExporterFile, true) {
+				context.GuiVars.showExporterFile = true
+				context.GuiVars.dialogExportType = types.ImportExportFormatSectionDXF
+			}
 			if imgui.BeginMenu("Assimp...") {
 				// for (size_t a = 0; a < Settings::Instance()->AssimpSupportedFormats_Export.size(); a++) {
 				// 	SupportedAssimpFormat format = Settings::Instance()->AssimpSupportedFormats_Export[a];
@@ -146,6 +184,7 @@ func (context *Context) DrawMainMenu() {
 			quitShortcut = "Alt+F4"
 		}
 		if imgui.MenuItemV(fmt.Sprintf("%c Quit", fonts.FA_ICON_POWER_OFF), quitShortcut, false, true) {
+			_, _ = trigger.Fire(types.ActionGuiActionExit)
 			os.Exit(3)
 		}
 		imgui.EndMenu()
@@ -164,6 +203,12 @@ func (context *Context) DrawMainMenu() {
 			}
 			imgui.EndMenu()
 		}
+		if imgui.MenuItem(fmt.Sprintf("%c HDR Environment ...", fonts.FA_ICON_PICTURE_O)) {
+			context.GuiVars.showEnvironmentMapDialog = true
+		}
+		if imgui.MenuItem(fmt.Sprintf("%c Color Grading LUT ...", fonts.FA_ICON_ADJUST)) {
+			context.GuiVars.showColorGradingLUTDialog = true
+		}
 		imgui.Separator()
 		if imgui.BeginMenu(fmt.Sprintf("%c Scene Rendering", fonts.FA_ICON_CERTIFICATE)) {
 			if imgui.MenuItemV("Solid", "", rsett.General.SelectedViewModelSkin == types.ViewModelSkinSolid, true) {
@@ -186,7 +231,9 @@ func (context *Context) DrawMainMenu() {
 			imgui.EndMenu()
 		}
 		imgui.Separator()
-		imgui.MenuItemV(fmt.Sprintf("%c Render Image", fonts.FA_ICON_FILE_IMAGE_O), "", context.GuiVars.showImageSave, true)
+		if imgui.MenuItemV(fmt.Sprintf("%c Render Image", fonts.FA_ICON_FILE_IMAGE_O), "", context.GuiVars.showImageSave, true) {
+			context.GuiVars.showImageSave = !context.GuiVars.showImageSave
+		}
 		imgui.MenuItemV(fmt.Sprintf("%c Renderer UI", fonts.FA_ICON_CUBES), "", context.GuiVars.showRendererUI, true)
 
 		imgui.EndMenu()
@@ -11,6 +11,7 @@ type OpenGL interface {
 
 	BindAttribLocation(program uint32, index uint32, name string)
 	BindBuffer(target uint32, buffer uint32)
+	BindBufferBase(target uint32, index uint32, buffer uint32)
 	BindSampler(unit uint32, sampler uint32)
 	BindTexture(target uint32, texture uint32)
 	BindVertexArray(array uint32)
@@ -66,6 +67,7 @@ type OpenGL interface {
 	GetProgramInfoLog(program uint32) string
 	GetProgramParameter(program uint32, param uint32) int32
 	GetUniformLocation(program uint32, name string) int32
+	GetUniformBlockIndex(program uint32, name string) uint32
 
 	IsEnabled(cap uint32) bool
 
@@ -80,6 +82,7 @@ type OpenGL interface {
 	ShaderSource(shader uint32, source string)
 
 	TexImage2D(target uint32, level int32, internalFormat uint32, width int32, height int32, border int32, format uint32, xtype uint32, pixels interface{})
+	TexImage3D(target uint32, level int32, internalFormat uint32, width int32, height int32, depth int32, border int32, format uint32, xtype uint32, pixels interface{})
 	TexParameteri(target uint32, pname uint32, param int32)
 	TexParameterf(target uint32, pname uint32, param float32)
 	FramebufferTexture2D(target uint32, attachment uint32, textarget uint32, texture uint32, level int32)
@@ -93,6 +96,7 @@ type OpenGL interface {
 	Uniform4f(location int32, v0 float32, v1 float32, v2 float32, v3 float32)
 	Uniform4fv(location int32, value *[4]float32)
 	UniformMatrix4fv(location int32, transpose bool, value *[16]float32)
+	UniformBlockBinding(program uint32, blockIndex uint32, blockBinding uint32)
 	UniformMatrix3fv(location int32, count int32, transpose bool, value *float32)
 	GLUniformMatrix4fv(location int32, count int32, transpose bool, value *float32)
 	UseProgram(program uint32)
@@ -138,4 +142,12 @@ type OpenGL interface {
 	RenderbufferStorage(target uint32, internalformat uint32, width int32, height int32)
 	FramebufferRenderbuffer(target uint32, attachment uint32, renderbuffertarget uint32, renderbuffer uint32)
 	CheckFramebufferStatus(target uint32) uint32
+	DeleteFramebuffers(framebuffers []uint32)
+	DrawBuffer(buf uint32)
+	ReadBuffer(src uint32)
+	TexParameterfv(target uint32, pname uint32, params *float32)
+	CullFace(mode uint32)
+	PolygonOffset(factor float32, units float32)
+	FramebufferTexture(target uint32, attachment uint32, texture uint32, level int32)
+	DeleteRenderbuffers(renderbuffers []uint32)
 }
@@ -45,6 +45,10 @@ type ModelFace struct {
 	MeshModel   types.MeshModel
 	MatrixModel mgl32.Mat4
 
+	// SourceMissing is set when the linked source file of the model couldn't be re-imported,
+	// the model has no geometry and is saved with its source as it was
+	SourceMissing bool
+
 	DeferredRender     bool
 	CelShading         bool
 	Wireframe          bool
@@ -237,13 +241,17 @@ func (mesh *ModelFace) InitBuffers() {
 
 	vboVertices := gl.GenBuffers(1)[0]
 	gl.BindBuffer(oglconsts.ARRAY_BUFFER, vboVertices)
-	gl.BufferData(oglconsts.ARRAY_BUFFER, len(mesh.MeshModel.Vertices)*3*4, gl.Ptr(mesh.MeshModel.Vertices), oglconsts.STATIC_DRAW)
+	if len(mesh.MeshModel.Vertices) > 0 {
+		gl.BufferData(oglconsts.ARRAY_BUFFER, len(mesh.MeshModel.Vertices)*3*4, gl.Ptr(mesh.MeshModel.Vertices), oglconsts.STATIC_DRAW)
+	}
 	gl.EnableVertexAttribArray(0)
 	gl.VertexAttribPointer(0, 3, oglconsts.FLOAT, false, 3*4, gl.PtrOffset(0))
 
 	vboNormals := gl.GenBuffers(1)[0]
 	gl.BindBuffer(oglconsts.ARRAY_BUFFER, vboNormals)
-	gl.BufferData(oglconsts.ARRAY_BUFFER, len(mesh.MeshModel.Normals)*3*4, gl.Ptr(mesh.MeshModel.Normals), oglconsts.STATIC_DRAW)
+	if len(mesh.MeshModel.Normals) > 0 {
+		gl.BufferData(oglconsts.ARRAY_BUFFER, len(mesh.MeshModel.Normals)*3*4, gl.Ptr(mesh.MeshModel.Normals), oglconsts.STATIC_DRAW)
+	}
 	gl.EnableVertexAttribArray(1)
 	gl.VertexAttribPointer(1, 3, oglconsts.FLOAT, false, 3*4, gl.PtrOffset(0))
 
@@ -286,7 +294,9 @@ func (mesh *ModelFace) InitBuffers() {
 
 	vboIndices := gl.GenBuffers(1)[0]
 	gl.BindBuffer(oglconsts.ELEMENT_ARRAY_BUFFER, vboIndices)
-	gl.BufferData(oglconsts.ELEMENT_ARRAY_BUFFER, int(mesh.MeshModel.CountIndices)*4, gl.Ptr(mesh.MeshModel.Indices), oglconsts.STATIC_DRAW)
+	if len(mesh.MeshModel.Indices) > 0 {
+		gl.BufferData(oglconsts.ELEMENT_ARRAY_BUFFER, int(mesh.MeshModel.CountIndices)*4, gl.Ptr(mesh.MeshModel.Indices), oglconsts.STATIC_DRAW)
+	}
 
 	if len(mesh.MeshModel.ModelMaterial.TextureBump.Image) > 0 && len(mesh.MeshModel.Vertices) > 0 && len(mesh.MeshModel.TextureCoordinates) > 0 && len(mesh.MeshModel.Normals) > 0 {
 		tangents, bitangents := utilities.ComputeTangentBasis(mesh.MeshModel.TextureCoordinates, mesh.MeshModel.Vertices, mesh.MeshModel.Normals)
@@ -315,6 +325,21 @@ func (mesh *ModelFace) InitBuffers() {
 	gl.CheckForOpenGLErrors("ModelFace")
 }
 
+// ModelMatrix places the model on the grid - scale, then translate, then rotate around X, Y and Z
+func (mesh *ModelFace) ModelMatrix(matrixGrid mgl32.Mat4) mgl32.Mat4 {
+	matrixModel := mgl32.Ident4()
+	matrixModel = matrixModel.Mul4(matrixGrid)
+	// scale
+	matrixModel = matrixModel.Mul4(mgl32.Scale3D(mesh.ScaleX.Point, mesh.ScaleY.Point, mesh.ScaleZ.Point))
+	// translate
+	matrixModel = matrixModel.Mul4(mgl32.Translate3D(mesh.PositionX.Point, mesh.PositionY.Point, mesh.PositionZ.Point))
+	// rotate
+	matrixModel = matrixModel.Mul4(mgl32.HomogRotate3D(mgl32.DegToRad(mesh.RotateX.Point), mgl32.Vec3{1, 0, 0}))
+	matrixModel = matrixModel.Mul4(mgl32.HomogRotate3D(mgl32.DegToRad(mesh.RotateY.Point), mgl32.Vec3{0, 1, 0}))
+	matrixModel = matrixModel.Mul4(mgl32.HomogRotate3D(mgl32.DegToRad(mesh.RotateZ.Point), mgl32.Vec3{0, 0, 1}))
+	return matrixModel
+}
+
 // Render ...
 func (mesh *ModelFace) Render(useTessellation bool) {
 	gl := mesh.window.OpenGL()
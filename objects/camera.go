@@ -1,6 +1,8 @@
 package objects
 
 import (
+	"math"
+
 	"github.com/go-gl/mathgl/mgl32"
 	"github.com/supudo/Kuplung-Go/interfaces"
 	"github.com/supudo/Kuplung-Go/types"
@@ -71,3 +73,32 @@ func (camera *Camera) Render() {
 
 	camera.CameraPosition = mgl32.Vec3{camera.MatrixCamera[4*3+0], camera.MatrixCamera[4*3+1], camera.MatrixCamera[4*3+2]}
 }
+
+// cameraVerticalAperture is the USD default vertical film aperture in millimeters
+const cameraVerticalAperture = 15.2908
+
+// SceneCamera returns the camera as an interchange scene camera for the given vertical field of view
+func (camera *Camera) SceneCamera(fov float32) types.SceneCamera {
+	return types.SceneCamera{
+		Title:       "Camera",
+		Position:    mgl32.Vec3{camera.PositionX.Point, camera.PositionY.Point, camera.PositionZ.Point},
+		Rotate:      mgl32.Vec3{mgl32.RadToDeg(camera.RotateX.Point), mgl32.RadToDeg(camera.RotateY.Point), mgl32.RadToDeg(camera.RotateZ.Point)},
+		FocalLength: float32(cameraVerticalAperture / 2.0 / math.Tan(float64(mgl32.DegToRad(fov))/2.0)),
+		Fov:         fov,
+		View:        camera.MatrixCamera,
+	}
+}
+
+// ApplySceneCamera sets the camera from an interchange scene camera and returns its vertical field of view
+func (camera *Camera) ApplySceneCamera(sc types.SceneCamera) float32 {
+	camera.PositionX.Point = sc.Position.X()
+	camera.PositionY.Point = sc.Position.Y()
+	camera.PositionZ.Point = sc.Position.Z()
+	camera.RotateX.Point = mgl32.DegToRad(sc.Rotate.X())
+	camera.RotateY.Point = mgl32.DegToRad(sc.Rotate.Y())
+	camera.RotateZ.Point = mgl32.DegToRad(sc.Rotate.Z())
+	if sc.FocalLength <= 0.0 {
+		return 45.0
+	}
+	return mgl32.RadToDeg(float32(2.0 * math.Atan(cameraVerticalAperture/2.0/float64(sc.FocalLength))))
+}
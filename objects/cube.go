@@ -8,7 +8,6 @@ import (
 	"github.com/supudo/Kuplung-Go/engine/oglconsts"
 	"github.com/supudo/Kuplung-Go/interfaces"
 	"github.com/supudo/Kuplung-Go/settings"
-	"github.com/veandco/go-sdl2/sdl"
 )
 
 // Cube ...
@@ -156,7 +155,7 @@ void main() {
 	gl.VertexAttribPointer(texCoordAttrib, 2, oglconsts.FLOAT, false, 5*4, gl.PtrOffset(3*4))
 
 	cube.angle = 0.0
-	cube.previousTime = float32(sdl.GetTicks())
+	cube.previousTime = float32(window.GetTicks())
 
 	return cube
 }
@@ -173,7 +172,7 @@ func (cube *Cube) Render() {
 	gl.PolygonMode(oglconsts.FRONT_AND_BACK, oglconsts.FILL)
 
 	// Update
-	sdlTime := float32(sdl.GetTicks())
+	sdlTime := float32(cube.window.GetTicks())
 	elapsed := (sdlTime - cube.previousTime) / 1000
 	cube.previousTime = sdlTime
 
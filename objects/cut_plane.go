@@ -0,0 +1,144 @@
+package objects
+
+import (
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/engine"
+	"github.com/supudo/Kuplung-Go/engine/oglconsts"
+	"github.com/supudo/Kuplung-Go/interfaces"
+	"github.com/supudo/Kuplung-Go/settings"
+	"github.com/supudo/Kuplung-Go/utilities"
+)
+
+// CutPlane previews the cross-section planes in the viewport
+type CutPlane struct {
+	window interfaces.Window
+
+	shaderProgram      uint32
+	glVAO              uint32
+	glVBO              uint32
+	glUniformMVPMatrix int32
+	glUniformColor     int32
+
+	drawCount   int32
+	planesCount int32
+	normal      mgl32.Vec3
+	offset      float32
+	spacing     float32
+	size        float32
+}
+
+// InitCutPlane ...
+func InitCutPlane(window interfaces.Window) *CutPlane {
+	sett := settings.GetSettings()
+	gl := window.OpenGL()
+
+	cp := &CutPlane{}
+	cp.window = window
+
+	vertexShader := engine.GetShaderSource(sett.App.AppFolder + "shaders/cut_plane.vert")
+	fragmentShader := engine.GetShaderSource(sett.App.AppFolder + "shaders/cut_plane.frag")
+
+	var err error
+	cp.shaderProgram, err = engine.LinkNewStandardProgram(gl, vertexShader, fragmentShader)
+	if err != nil {
+		settings.LogWarn("[CutPlane] Can't load the cut plane shaders: %v", err)
+	}
+
+	cp.glUniformMVPMatrix = gl.GLGetUniformLocation(cp.shaderProgram, gl.Str("u_MVPMatrix\x00"))
+	cp.glUniformColor = gl.GLGetUniformLocation(cp.shaderProgram, gl.Str("fs_color\x00"))
+
+	cp.glVAO = gl.GenVertexArrays(1)[0]
+	cp.glVBO = gl.GenBuffers(1)[0]
+
+	gl.CheckForOpenGLErrors("CutPlane")
+
+	return cp
+}
+
+// InitBuffers rebuilds the plane quads when the cross-section settings have changed
+func (cp *CutPlane) InitBuffers() {
+	rsett := settings.GetRenderingSettings()
+	normal := mgl32.Vec3{rsett.CrossSection.NormalX, rsett.CrossSection.NormalY, rsett.CrossSection.NormalZ}
+	if cp.planesCount == rsett.CrossSection.Count && cp.normal == normal && cp.offset == rsett.CrossSection.Offset && cp.spacing == rsett.CrossSection.Spacing && cp.size == rsett.CrossSection.PlaneSize {
+		return
+	}
+	cp.planesCount = rsett.CrossSection.Count
+	cp.normal = normal
+	cp.offset = rsett.CrossSection.Offset
+	cp.spacing = rsett.CrossSection.Spacing
+	cp.size = rsett.CrossSection.PlaneSize
+
+	var dataVertices []float32
+	if normal.Len() > 0 {
+		n := normal.Normalize()
+		u, v := utilities.PlaneBasis(n)
+		u, v = u.Mul(cp.size*0.5), v.Mul(cp.size*0.5)
+		for i := int32(0); i < cp.planesCount; i++ {
+			center := n.Mul(cp.offset + float32(i)*cp.spacing)
+			corners := []mgl32.Vec3{center.Sub(u).Sub(v), center.Add(u).Sub(v), center.Add(u).Add(v), center.Sub(u).Add(v)}
+			for _, c := range corners {
+				dataVertices = append(dataVertices, c.X(), c.Y(), c.Z())
+			}
+		}
+	}
+
+	gl := cp.window.OpenGL()
+	gl.BindVertexArray(cp.glVAO)
+	gl.BindBuffer(oglconsts.ARRAY_BUFFER, cp.glVBO)
+	if len(dataVertices) > 0 {
+		gl.BufferData(oglconsts.ARRAY_BUFFER, len(dataVertices)*4, gl.Ptr(dataVertices), oglconsts.STATIC_DRAW)
+	}
+	gl.EnableVertexAttribArray(0)
+	gl.VertexAttribPointer(0, 3, oglconsts.FLOAT, false, 3*4, gl.PtrOffset(0))
+	gl.BindVertexArray(0)
+	cp.drawCount = int32(len(dataVertices) / 12)
+}
+
+// Render ...
+func (cp *CutPlane) Render(matrixGrid mgl32.Mat4) {
+	cp.InitBuffers()
+	if cp.drawCount <= 0 {
+		return
+	}
+
+	gl := cp.window.OpenGL()
+	rsett := settings.GetRenderingSettings()
+
+	gl.UseProgram(cp.shaderProgram)
+	gl.BindVertexArray(cp.glVAO)
+
+	mvpMatrix := rsett.MatrixProjection.Mul4(rsett.MatrixCamera.Mul4(matrixGrid))
+	gl.GLUniformMatrix4fv(cp.glUniformMVPMatrix, 1, false, &mvpMatrix[0])
+
+	gl.Enable(oglconsts.DEPTH_TEST)
+	gl.DepthFunc(oglconsts.LESS)
+	gl.DepthMask(false)
+	gl.Enable(oglconsts.BLEND)
+	gl.BlendFunc(oglconsts.SRC_ALPHA, oglconsts.ONE_MINUS_SRC_ALPHA)
+
+	gl.Uniform4f(cp.glUniformColor, 1.0, 0.55, 0.0, 0.2)
+	for i := int32(0); i < cp.drawCount; i++ {
+		gl.DrawArrays(oglconsts.TRIANGLE_FAN, i*4, 4)
+	}
+
+	gl.LineWidth(1.5)
+	gl.Uniform4f(cp.glUniformColor, 1.0, 0.55, 0.0, 0.9)
+	for i := int32(0); i < cp.drawCount; i++ {
+		gl.DrawArrays(oglconsts.LINE_LOOP, i*4, 4)
+	}
+
+	gl.DepthMask(true)
+	gl.Disable(oglconsts.BLEND)
+
+	gl.BindVertexArray(0)
+	gl.UseProgram(0)
+}
+
+// Dispose will cleanup everything
+func (cp *CutPlane) Dispose() {
+	gl := cp.window.OpenGL()
+
+	gl.DeleteBuffers([]uint32{cp.glVBO})
+	gl.DeleteVertexArrays([]uint32{cp.glVAO})
+	gl.DeleteProgram(cp.shaderProgram)
+}
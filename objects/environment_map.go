@@ -0,0 +1,310 @@
+package objects
+
+import (
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/engine"
+	"github.com/supudo/Kuplung-Go/engine/oglconsts"
+	"github.com/supudo/Kuplung-Go/interfaces"
+	"github.com/supudo/Kuplung-Go/settings"
+)
+
+const (
+	environmentCubemapSize     = 512
+	environmentIrradianceSize  = 32
+	environmentPrefilterSize   = 128
+	environmentBRDFLUTSize     = 512
+	environmentPrefilterLevels = 5
+)
+
+// environmentFaces are the directions and the up vectors of the cube faces, in the order of TEXTURE_CUBE_MAP_POSITIVE_X and the following faces
+var environmentFaces = [6][2]mgl32.Vec3{
+	{{1, 0, 0}, {0, -1, 0}},
+	{{-1, 0, 0}, {0, -1, 0}},
+	{{0, 1, 0}, {0, 0, 1}},
+	{{0, -1, 0}, {0, 0, -1}},
+	{{0, 0, 1}, {0, -1, 0}},
+	{{0, 0, -1}, {0, -1, 0}},
+}
+
+// EnvironmentMap is an equirectangular HDR image converted to a cube map and precomputed for image based lighting -
+// the diffuse irradiance, the prefiltered specular mip chain and the BRDF lookup table
+type EnvironmentMap struct {
+	window interfaces.Window
+
+	File string
+
+	EnvironmentCubemap uint32
+	IrradianceMap      uint32
+	PrefilterMap       uint32
+	BRDFLUT            uint32
+	PrefilterLevels    int32
+
+	captureFBO, captureRBO uint32
+	cubeVAO, cubeVBO       uint32
+	quadVAO, quadVBO       uint32
+	captureViews           [6]mgl32.Mat4
+	captureProjection      mgl32.Mat4
+}
+
+// LoadEnvironmentMap reads the .hdr file and precomputes the image based lighting maps
+func LoadEnvironmentMap(window interfaces.Window, file string) (*EnvironmentMap, error) {
+	img, err := engine.LoadHDRImage(file)
+	if err != nil {
+		return nil, err
+	}
+
+	gl := window.OpenGL()
+	env := &EnvironmentMap{}
+	env.window = window
+	env.File = file
+	env.PrefilterLevels = environmentPrefilterLevels
+
+	var viewport [4]int32
+	var depthFunc, framebuffer int32
+	gl.GetIntegerv(oglconsts.VIEWPORT, &viewport[0])
+	gl.GetIntegerv(oglconsts.FRAMEBUFFER_BINDING, &framebuffer)
+	gl.GetIntegerv(oglconsts.DEPTH_FUNC, &depthFunc)
+	cullFace := gl.IsEnabled(oglconsts.CULL_FACE)
+
+	gl.Enable(oglconsts.TEXTURE_CUBE_MAP_SEAMLESS)
+	gl.Disable(oglconsts.CULL_FACE)
+	gl.Enable(oglconsts.DEPTH_TEST)
+	gl.DepthFunc(oglconsts.LEQUAL)
+
+	env.initCapture()
+
+	hdrTexture := gl.GenTextures(1)[0]
+	gl.ActiveTexture(oglconsts.TEXTURE0)
+	gl.BindTexture(oglconsts.TEXTURE_2D, hdrTexture)
+	gl.TexImage2D(oglconsts.TEXTURE_2D, 0, oglconsts.RGB16F, int32(img.Width), int32(img.Height), 0, oglconsts.RGB, oglconsts.FLOAT, gl.Ptr(img.Pix))
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_WRAP_S, oglconsts.REPEAT)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_WRAP_T, oglconsts.CLAMP_TO_EDGE)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_MIN_FILTER, oglconsts.LINEAR)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_MAG_FILTER, oglconsts.LINEAR)
+
+	env.EnvironmentCubemap = env.newCubemap(environmentCubemapSize, true)
+	env.renderEquirectangular(hdrTexture)
+	gl.DeleteTextures([]uint32{hdrTexture})
+	gl.BindTexture(oglconsts.TEXTURE_CUBE_MAP, env.EnvironmentCubemap)
+	gl.GenerateMipmap(oglconsts.TEXTURE_CUBE_MAP)
+
+	env.IrradianceMap = env.newCubemap(environmentIrradianceSize, false)
+	env.renderIrradiance()
+
+	env.PrefilterMap = env.newCubemap(environmentPrefilterSize, true)
+	gl.BindTexture(oglconsts.TEXTURE_CUBE_MAP, env.PrefilterMap)
+	gl.GenerateMipmap(oglconsts.TEXTURE_CUBE_MAP)
+	env.renderPrefilter()
+
+	env.renderBRDFLUT()
+
+	gl.BindTexture(oglconsts.TEXTURE_CUBE_MAP, 0)
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, uint32(framebuffer))
+	gl.Viewport(viewport[0], viewport[1], viewport[2], viewport[3])
+	gl.DepthFunc(uint32(depthFunc))
+	if cullFace {
+		gl.Enable(oglconsts.CULL_FACE)
+	}
+	env.disposeCapture()
+
+	gl.CheckForOpenGLErrors("EnvironmentMap - LoadEnvironmentMap")
+	settings.LogInfo("[EnvironmentMap] Loaded %v (%vx%v)", file, img.Width, img.Height)
+	return env, nil
+}
+
+func (env *EnvironmentMap) initCapture() {
+	gl := env.window.OpenGL()
+
+	env.captureFBO = gl.GenFramebuffers(1)[0]
+	env.captureRBO = gl.GenRenderbuffers(1)[0]
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, env.captureFBO)
+	gl.BindRenderbuffer(oglconsts.RENDERBUFFER, env.captureRBO)
+	gl.RenderbufferStorage(oglconsts.RENDERBUFFER, oglconsts.DEPTH_COMPONENT24, environmentCubemapSize, environmentCubemapSize)
+	gl.FramebufferRenderbuffer(oglconsts.FRAMEBUFFER, oglconsts.DEPTH_ATTACHMENT, oglconsts.RENDERBUFFER, env.captureRBO)
+
+	env.captureProjection = mgl32.Perspective(mgl32.DegToRad(90.0), 1.0, 0.1, 10.0)
+	for face, dir := range environmentFaces {
+		env.captureViews[face] = mgl32.LookAtV(mgl32.Vec3{0, 0, 0}, dir[0], dir[1])
+	}
+
+	cubeVertices := []float32{
+		-1.0, 1.0, -1.0, -1.0, -1.0, -1.0, 1.0, -1.0, -1.0, 1.0, -1.0, -1.0, 1.0, 1.0, -1.0, -1.0, 1.0, -1.0,
+		-1.0, -1.0, 1.0, -1.0, -1.0, -1.0, -1.0, 1.0, -1.0, -1.0, 1.0, -1.0, -1.0, 1.0, 1.0, -1.0, -1.0, 1.0,
+		1.0, -1.0, -1.0, 1.0, -1.0, 1.0, 1.0, 1.0, 1.0, 1.0, 1.0, 1.0, 1.0, 1.0, -1.0, 1.0, -1.0, -1.0,
+		-1.0, -1.0, 1.0, -1.0, 1.0, 1.0, 1.0, 1.0, 1.0, 1.0, 1.0, 1.0, 1.0, -1.0, 1.0, -1.0, -1.0, 1.0,
+		-1.0, 1.0, -1.0, 1.0, 1.0, -1.0, 1.0, 1.0, 1.0, 1.0, 1.0, 1.0, -1.0, 1.0, 1.0, -1.0, 1.0, -1.0,
+		-1.0, -1.0, -1.0, -1.0, -1.0, 1.0, 1.0, -1.0, -1.0, 1.0, -1.0, -1.0, -1.0, -1.0, 1.0, 1.0, -1.0, 1.0}
+	env.cubeVAO = gl.GenVertexArrays(1)[0]
+	env.cubeVBO = gl.GenBuffers(1)[0]
+	gl.BindVertexArray(env.cubeVAO)
+	gl.BindBuffer(oglconsts.ARRAY_BUFFER, env.cubeVBO)
+	gl.BufferData(oglconsts.ARRAY_BUFFER, len(cubeVertices)*4, gl.Ptr(cubeVertices), oglconsts.STATIC_DRAW)
+	gl.EnableVertexAttribArray(0)
+	gl.VertexAttribPointer(0, 3, oglconsts.FLOAT, false, 3*4, gl.PtrOffset(0))
+
+	quadVertices := []float32{0.0, 0.0, 1.0, 0.0, 0.0, 1.0, 1.0, 1.0}
+	env.quadVAO = gl.GenVertexArrays(1)[0]
+	env.quadVBO = gl.GenBuffers(1)[0]
+	gl.BindVertexArray(env.quadVAO)
+	gl.BindBuffer(oglconsts.ARRAY_BUFFER, env.quadVBO)
+	gl.BufferData(oglconsts.ARRAY_BUFFER, len(quadVertices)*4, gl.Ptr(quadVertices), oglconsts.STATIC_DRAW)
+	gl.EnableVertexAttribArray(0)
+	gl.VertexAttribPointer(0, 2, oglconsts.FLOAT, false, 2*4, gl.PtrOffset(0))
+
+	gl.BindVertexArray(0)
+}
+
+func (env *EnvironmentMap) disposeCapture() {
+	gl := env.window.OpenGL()
+
+	gl.DeleteFramebuffers([]uint32{env.captureFBO})
+	gl.DeleteRenderbuffers([]uint32{env.captureRBO})
+	gl.DeleteVertexArrays([]uint32{env.cubeVAO, env.quadVAO})
+	gl.DeleteBuffers([]uint32{env.cubeVBO, env.quadVBO})
+}
+
+// newCubemap allocates an RGB16F cube map, with trilinear filtering when it has mip levels
+func (env *EnvironmentMap) newCubemap(size int32, mipmapped bool) uint32 {
+	gl := env.window.OpenGL()
+
+	cube := gl.GenTextures(1)[0]
+	gl.BindTexture(oglconsts.TEXTURE_CUBE_MAP, cube)
+	for face := uint32(0); face < 6; face++ {
+		gl.TexImage2D(oglconsts.TEXTURE_CUBE_MAP_POSITIVE_X+face, 0, oglconsts.RGB16F, size, size, 0, oglconsts.RGB, oglconsts.FLOAT, nil)
+	}
+	gl.TexParameteri(oglconsts.TEXTURE_CUBE_MAP, oglconsts.TEXTURE_WRAP_S, oglconsts.CLAMP_TO_EDGE)
+	gl.TexParameteri(oglconsts.TEXTURE_CUBE_MAP, oglconsts.TEXTURE_WRAP_T, oglconsts.CLAMP_TO_EDGE)
+	gl.TexParameteri(oglconsts.TEXTURE_CUBE_MAP, oglconsts.TEXTURE_WRAP_R, oglconsts.CLAMP_TO_EDGE)
+	if mipmapped {
+		gl.TexParameteri(oglconsts.TEXTURE_CUBE_MAP, oglconsts.TEXTURE_MIN_FILTER, oglconsts.LINEAR_MIPMAP_LINEAR)
+	} else {
+		gl.TexParameteri(oglconsts.TEXTURE_CUBE_MAP, oglconsts.TEXTURE_MIN_FILTER, oglconsts.LINEAR)
+	}
+	gl.TexParameteri(oglconsts.TEXTURE_CUBE_MAP, oglconsts.TEXTURE_MAG_FILTER, oglconsts.LINEAR)
+	return cube
+}
+
+// newCaptureProgram links a program that renders the cube faces with ibl_cubemap.vert
+func (env *EnvironmentMap) newCaptureProgram(sFragment string) uint32 {
+	sett := settings.GetSettings()
+	gl := env.window.OpenGL()
+
+	sVertex := engine.GetShaderSource(sett.App.AppFolder + "shaders/ibl_cubemap.vert")
+	program, err := engine.LinkNewStandardProgram(gl, sVertex, sFragment)
+	if err != nil {
+		settings.LogWarn("[EnvironmentMap] Can't load the image based lighting shaders: %v", err)
+	}
+	return program
+}
+
+// renderFaces draws the cube into every face of the cube map at the mip level
+func (env *EnvironmentMap) renderFaces(program, cube uint32, size int32, level int32) {
+	gl := env.window.OpenGL()
+
+	glView := gl.GLGetUniformLocation(program, gl.Str("vs_matrixView\x00"))
+	glProjection := gl.GLGetUniformLocation(program, gl.Str("vs_matrixProjection\x00"))
+	gl.GLUniformMatrix4fv(glProjection, 1, false, &env.captureProjection[0])
+
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, env.captureFBO)
+	gl.BindRenderbuffer(oglconsts.RENDERBUFFER, env.captureRBO)
+	gl.RenderbufferStorage(oglconsts.RENDERBUFFER, oglconsts.DEPTH_COMPONENT24, size, size)
+	gl.Viewport(0, 0, size, size)
+	gl.BindVertexArray(env.cubeVAO)
+	for face := uint32(0); face < 6; face++ {
+		gl.GLUniformMatrix4fv(glView, 1, false, &env.captureViews[face][0])
+		gl.FramebufferTexture2D(oglconsts.FRAMEBUFFER, oglconsts.COLOR_ATTACHMENT0, oglconsts.TEXTURE_CUBE_MAP_POSITIVE_X+face, cube, level)
+		gl.Clear(oglconsts.COLOR_BUFFER_BIT | oglconsts.DEPTH_BUFFER_BIT)
+		gl.DrawArrays(oglconsts.TRIANGLES, 0, 36)
+	}
+	gl.BindVertexArray(0)
+}
+
+func (env *EnvironmentMap) renderEquirectangular(hdrTexture uint32) {
+	sett := settings.GetSettings()
+	gl := env.window.OpenGL()
+
+	program := env.newCaptureProgram(engine.GetShaderSource(sett.App.AppFolder + "shaders/ibl_equirectangular.frag"))
+	gl.UseProgram(program)
+	gl.Uniform1i(gl.GLGetUniformLocation(program, gl.Str("sampler_equirectangular\x00")), 0)
+	gl.ActiveTexture(oglconsts.TEXTURE0)
+	gl.BindTexture(oglconsts.TEXTURE_2D, hdrTexture)
+	env.renderFaces(program, env.EnvironmentCubemap, environmentCubemapSize, 0)
+	gl.UseProgram(0)
+	gl.DeleteProgram(program)
+}
+
+func (env *EnvironmentMap) renderIrradiance() {
+	sett := settings.GetSettings()
+	gl := env.window.OpenGL()
+
+	program := env.newCaptureProgram(engine.GetShaderSource(sett.App.AppFolder + "shaders/ibl_irradiance.frag"))
+	gl.UseProgram(program)
+	gl.Uniform1i(gl.GLGetUniformLocation(program, gl.Str("sampler_environment\x00")), 0)
+	gl.ActiveTexture(oglconsts.TEXTURE0)
+	gl.BindTexture(oglconsts.TEXTURE_CUBE_MAP, env.EnvironmentCubemap)
+	env.renderFaces(program, env.IrradianceMap, environmentIrradianceSize, 0)
+	gl.UseProgram(0)
+	gl.DeleteProgram(program)
+}
+
+// renderPrefilter convolves the environment for increasing roughness, one mip level for each step
+func (env *EnvironmentMap) renderPrefilter() {
+	sett := settings.GetSettings()
+	gl := env.window.OpenGL()
+
+	program := env.newCaptureProgram(engine.GetShaderSourcePartial(sett.App.AppFolder+"shaders/ibl_prefilter.frag") + engine.GetShaderSource(sett.App.AppFolder+"shaders/ibl_common.frag"))
+	gl.UseProgram(program)
+	gl.Uniform1i(gl.GLGetUniformLocation(program, gl.Str("sampler_environment\x00")), 0)
+	gl.Uniform1f(gl.GLGetUniformLocation(program, gl.Str("fs_resolution\x00")), environmentCubemapSize)
+	glRoughness := gl.GLGetUniformLocation(program, gl.Str("fs_roughness\x00"))
+	gl.ActiveTexture(oglconsts.TEXTURE0)
+	gl.BindTexture(oglconsts.TEXTURE_CUBE_MAP, env.EnvironmentCubemap)
+	for level := int32(0); level < env.PrefilterLevels; level++ {
+		gl.Uniform1f(glRoughness, float32(level)/float32(env.PrefilterLevels-1))
+		env.renderFaces(program, env.PrefilterMap, environmentPrefilterSize>>uint(level), level)
+	}
+	gl.UseProgram(0)
+	gl.DeleteProgram(program)
+}
+
+func (env *EnvironmentMap) renderBRDFLUT() {
+	sett := settings.GetSettings()
+	gl := env.window.OpenGL()
+
+	env.BRDFLUT = gl.GenTextures(1)[0]
+	gl.BindTexture(oglconsts.TEXTURE_2D, env.BRDFLUT)
+	gl.TexImage2D(oglconsts.TEXTURE_2D, 0, oglconsts.RG16F, environmentBRDFLUTSize, environmentBRDFLUTSize, 0, oglconsts.RG, oglconsts.FLOAT, nil)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_WRAP_S, oglconsts.CLAMP_TO_EDGE)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_WRAP_T, oglconsts.CLAMP_TO_EDGE)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_MIN_FILTER, oglconsts.LINEAR)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_MAG_FILTER, oglconsts.LINEAR)
+	gl.BindTexture(oglconsts.TEXTURE_2D, 0)
+
+	sVertex := engine.GetShaderSource(sett.App.AppFolder + "shaders/ibl_brdf.vert")
+	sFragment := engine.GetShaderSourcePartial(sett.App.AppFolder+"shaders/ibl_brdf.frag") + engine.GetShaderSource(sett.App.AppFolder+"shaders/ibl_common.frag")
+	program, err := engine.LinkNewStandardProgram(gl, sVertex, sFragment)
+	if err != nil {
+		settings.LogWarn("[EnvironmentMap] Can't load the BRDF shaders: %v", err)
+	}
+
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, env.captureFBO)
+	gl.BindRenderbuffer(oglconsts.RENDERBUFFER, env.captureRBO)
+	gl.RenderbufferStorage(oglconsts.RENDERBUFFER, oglconsts.DEPTH_COMPONENT24, environmentBRDFLUTSize, environmentBRDFLUTSize)
+	gl.FramebufferTexture2D(oglconsts.FRAMEBUFFER, oglconsts.COLOR_ATTACHMENT0, oglconsts.TEXTURE_2D, env.BRDFLUT, 0)
+	gl.Viewport(0, 0, environmentBRDFLUTSize, environmentBRDFLUTSize)
+	gl.Clear(oglconsts.COLOR_BUFFER_BIT | oglconsts.DEPTH_BUFFER_BIT)
+
+	gl.UseProgram(program)
+	gl.BindVertexArray(env.quadVAO)
+	gl.DrawArrays(oglconsts.TRIANGLE_STRIP, 0, 4)
+	gl.BindVertexArray(0)
+	gl.UseProgram(0)
+	gl.DeleteProgram(program)
+}
+
+// Dispose will cleanup everything
+func (env *EnvironmentMap) Dispose() {
+	gl := env.window.OpenGL()
+
+	gl.DeleteTextures([]uint32{env.EnvironmentCubemap, env.IrradianceMap, env.PrefilterMap, env.BRDFLUT})
+}
@@ -37,6 +37,7 @@ type Light struct {
 	LightType                         types.LightSourceType
 	ShowLampObject, ShowLampDirection bool
 	TurnOffPosition, ShowInWire       bool
+	CastShadows                       bool
 
 	PositionX, PositionY, PositionZ             types.ObjectCoordinate
 	DirectionX, DirectionY, DirectionZ          types.ObjectCoordinate
@@ -78,6 +79,7 @@ func (l *Light) InitProperties(shape types.LightSourceType) {
 	l.ShowLampObject = true
 	l.ShowLampDirection = true
 	l.ShowInWire = false
+	l.CastShadows = true
 	l.LightType = shape
 
 	l.PositionX = types.ObjectCoordinate{Animate: false, Point: 0.0}
@@ -297,3 +299,43 @@ func (l *Light) Dispose() {
 	gl.DeleteVertexArrays([]uint32{l.glVAO})
 	gl.DeleteProgram(l.shaderProgram)
 }
+
+// SceneLight returns the light as an interchange scene light
+func (l *Light) SceneLight() types.SceneLight {
+	return types.SceneLight{
+		Title:            l.Title,
+		LightType:        l.LightType,
+		Position:         mgl32.Vec3{l.PositionX.Point, l.PositionY.Point, l.PositionZ.Point},
+		Direction:        mgl32.Vec3{l.DirectionX.Point, l.DirectionY.Point, l.DirectionZ.Point},
+		Rotate:           mgl32.Vec3{l.RotateX.Point, l.RotateY.Point, l.RotateZ.Point},
+		Scale:            mgl32.Vec3{l.ScaleX.Point, l.ScaleY.Point, l.ScaleZ.Point},
+		Ambient:          l.Ambient.Color,
+		Diffuse:          l.Diffuse.Color,
+		Specular:         l.Specular.Color,
+		StrengthAmbient:  l.Ambient.Strength,
+		StrengthDiffuse:  l.Diffuse.Strength,
+		StrengthSpecular: l.Specular.Strength,
+		CutOff:           l.LCutOff.Point,
+		OuterCutOff:      l.LOuterCutOff.Point,
+		Constant:         l.LConstant.Point,
+		Linear:           l.LLinear.Point,
+		Quadratic:        l.LQuadratic.Point,
+	}
+}
+
+// ApplySceneLight sets the light properties from an interchange scene light
+func (l *Light) ApplySceneLight(sl types.SceneLight) {
+	l.Title = sl.Title
+	l.PositionX.Point, l.PositionY.Point, l.PositionZ.Point = sl.Position.X(), sl.Position.Y(), sl.Position.Z()
+	l.DirectionX.Point, l.DirectionY.Point, l.DirectionZ.Point = sl.Direction.X(), sl.Direction.Y(), sl.Direction.Z()
+	l.RotateX.Point, l.RotateY.Point, l.RotateZ.Point = sl.Rotate.X(), sl.Rotate.Y(), sl.Rotate.Z()
+	l.ScaleX.Point, l.ScaleY.Point, l.ScaleZ.Point = sl.Scale.X(), sl.Scale.Y(), sl.Scale.Z()
+	l.Ambient.Color, l.Ambient.Strength = sl.Ambient, sl.StrengthAmbient
+	l.Diffuse.Color, l.Diffuse.Strength = sl.Diffuse, sl.StrengthDiffuse
+	l.Specular.Color, l.Specular.Strength = sl.Specular, sl.StrengthSpecular
+	l.LCutOff.Point = sl.CutOff
+	l.LOuterCutOff.Point = sl.OuterCutOff
+	l.LConstant.Point = sl.Constant
+	l.LLinear.Point = sl.Linear
+	l.LQuadratic.Point = sl.Quadratic
+}
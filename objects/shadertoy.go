@@ -6,7 +6,6 @@ import (
 	"os"
 	"time"
 
-	"github.com/inkyblackness/imgui-go"
 	"github.com/supudo/Kuplung-Go/engine"
 	"github.com/supudo/Kuplung-Go/engine/oglconsts"
 	"github.com/supudo/Kuplung-Go/interfaces"
@@ -224,11 +223,14 @@ func (st *Shadertoy) addTexture(textureImage string, vboTexture *uint32, texture
 
 	imgFile, err := os.Open(textureImage)
 	if err != nil {
-		settings.LogError("[Shadertoy] Texture file not found: %v", err)
+		settings.LogWarn("[Shadertoy] Texture file not found: %v", err)
+		return
 	}
+	defer imgFile.Close()
 	img, _, err := image.Decode(imgFile)
 	if err != nil {
-		settings.LogError("[Shadertoy] Can't decode texture: %v", err)
+		settings.LogWarn("[Shadertoy] Can't decode texture: %v", err)
+		return
 	}
 
 	rgba := image.NewRGBA(img.Bounds())
@@ -274,7 +276,7 @@ func (st *Shadertoy) addTexture(textureImage string, vboTexture *uint32, texture
 }
 
 // Render ...
-func (st *Shadertoy) Render(deltaTime float32, mouseX, mouseY int32, seconds float32) {
+func (st *Shadertoy) Render(deltaTime, frameRate float32, mouseX, mouseY int32, seconds float32) {
 	gl := st.window.OpenGL()
 	if st.glVAO > 0 {
 		gl.UseProgram(st.shaderProgram)
@@ -325,7 +327,7 @@ func (st *Shadertoy) Render(deltaTime float32, mouseX, mouseY int32, seconds flo
 		timet := time.Now()
 		gl.Uniform4f(st.iDate, float32(timet.Year()), float32(timet.Month()), float32(timet.Day()), float32(timet.Second()))
 
-		gl.Uniform1f(st.iFrameRate, imgui.CurrentIO().Framerate())
+		gl.Uniform1f(st.iFrameRate, frameRate)
 		gl.Uniform1f(st.iFrame, 0.0)
 
 		// draw
@@ -387,8 +389,8 @@ func (st *Shadertoy) unbindFBO(vboTexture *uint32) {
 }
 
 // RenderToTexture ...
-func (st *Shadertoy) RenderToTexture(deltaTime float32, mouseX, mouseY int32, seconds float32, vboTexture *uint32) {
+func (st *Shadertoy) RenderToTexture(deltaTime, frameRate float32, mouseX, mouseY int32, seconds float32, vboTexture *uint32) {
 	st.bindFBO()
-	st.Render(deltaTime, mouseX, mouseY, seconds)
+	st.Render(deltaTime, frameRate, mouseX, mouseY, seconds)
 	st.unbindFBO(vboTexture)
 }
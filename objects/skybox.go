@@ -1,6 +1,10 @@
 package objects
 
 import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
 	"github.com/go-gl/mathgl/mgl32"
 	"github.com/supudo/Kuplung-Go/engine"
 	"github.com/supudo/Kuplung-Go/engine/oglconsts"
@@ -17,6 +21,9 @@ type SkyBox struct {
 
 	glVSMatrixView       int32
 	glVSMatrixProjection int32
+	glVSMatrixRotation   int32
+	glFSIsHDR            int32
+	glFSExposure         int32
 
 	matrixModel mgl32.Mat4
 	vboTexture  uint32
@@ -24,12 +31,15 @@ type SkyBox struct {
 	gridSize           int32
 	SkyboxSelectedItem int32
 	SkyboxItems        []SkyboxItem
+
+	environment *EnvironmentMap
 }
 
-// SkyboxItem ...
+// SkyboxItem is either six cube map images or an equirectangular HDR environment map
 type SkyboxItem struct {
 	Title  string
 	Images []string
+	HDR    string
 }
 
 // InitSkyBox ...
@@ -46,10 +56,41 @@ func InitSkyBox(window interfaces.Window) *SkyBox {
 	skyBox.SkyboxItems = append(skyBox.SkyboxItems, SkyboxItem{Title: "Lake Mountain", Images: []string{"lake_mountain_right.jpg", "lake_mountain_left.jpg", "lake_mountain_top.jpg", "lake_mountain_bottom.jpg", "lake_mountain_back.jpg", "lake_mountain_front.jpg"}})
 	skyBox.SkyboxItems = append(skyBox.SkyboxItems, SkyboxItem{Title: "Fire Planet", Images: []string{"fire_planet_right.jpg", "fire_planet_left.jpg", "fire_planet_top.jpg", "fire_planet_bottom.jpg", "fire_planet_back.jpg", "fire_planet_front.jpg"}})
 	skyBox.SkyboxItems = append(skyBox.SkyboxItems, SkyboxItem{Title: "Stormy Days", Images: []string{"stormydays_right.jpg", "stormydays_left.jpg", "stormydays_top.jpg", "stormydays_bottom.jpg", "stormydays_back.jpg", "stormydays_front.jpg"}})
+	skyBox.addHDRFolder(settings.GetSettings().App.AppFolder + "skybox/")
 
 	return skyBox
 }
 
+// addHDRFolder adds the .hdr environment maps in the folder
+func (sb *SkyBox) addHDRFolder(folder string) {
+	files, err := ioutil.ReadDir(folder)
+	if err != nil {
+		return
+	}
+	for _, f := range files {
+		if !f.IsDir() && strings.EqualFold(filepath.Ext(f.Name()), ".hdr") {
+			sb.AddHDR(folder + f.Name())
+		}
+	}
+}
+
+// AddHDR adds an equirectangular .hdr environment map to the skybox items and returns its index
+func (sb *SkyBox) AddHDR(file string) int32 {
+	for i, item := range sb.SkyboxItems {
+		if item.HDR == file {
+			return int32(i)
+		}
+	}
+	title := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file)) + " (HDR)"
+	sb.SkyboxItems = append(sb.SkyboxItems, SkyboxItem{Title: title, HDR: file})
+	return int32(len(sb.SkyboxItems) - 1)
+}
+
+// Environment returns the image based lighting maps of the selected HDR skybox, nil when a cube map or no box is selected
+func (sb *SkyBox) Environment() *EnvironmentMap {
+	return sb.environment
+}
+
 // InitBuffers ...
 func (sb *SkyBox) InitBuffers() {
 	sett := settings.GetSettings()
@@ -66,6 +107,14 @@ func (sb *SkyBox) InitBuffers() {
 
 	sb.glVSMatrixView = gl.GLGetUniformLocation(sb.shaderProgram, gl.Str("vs_MatrixView\x00"))
 	sb.glVSMatrixProjection = gl.GLGetUniformLocation(sb.shaderProgram, gl.Str("vs_MatrixProjection\x00"))
+	sb.glVSMatrixRotation = gl.GLGetUniformLocation(sb.shaderProgram, gl.Str("vs_MatrixRotation\x00"))
+	sb.glFSIsHDR = gl.GLGetUniformLocation(sb.shaderProgram, gl.Str("fs_isHDR\x00"))
+	sb.glFSExposure = gl.GLGetUniformLocation(sb.shaderProgram, gl.Str("fs_exposure\x00"))
+
+	if sb.environment != nil {
+		sb.environment.Dispose()
+		sb.environment = nil
+	}
 
 	if sb.SkyboxSelectedItem > 0 {
 		sb.glVAO = gl.GenVertexArrays(1)[0]
@@ -90,7 +139,18 @@ func (sb *SkyBox) InitBuffers() {
 		gl.EnableVertexAttribArray(0)
 		gl.VertexAttribPointer(0, 3, oglconsts.FLOAT, false, 3*4, gl.PtrOffset(0))
 
-		sb.vboTexture = engine.LoadCubemapTexture(gl, sb.SkyboxItems[sb.SkyboxSelectedItem].Images)
+		item := sb.SkyboxItems[sb.SkyboxSelectedItem]
+		if item.HDR != "" {
+			env, err := LoadEnvironmentMap(sb.window, item.HDR)
+			if err != nil {
+				settings.LogWarn("[SkyBox] Can't load the environment map %v: %v", item.HDR, err)
+			} else {
+				sb.environment = env
+				sb.vboTexture = env.EnvironmentCubemap
+			}
+		} else {
+			sb.vboTexture = engine.LoadCubemapTexture(gl, item.Images)
+		}
 
 		gl.BindVertexArray(0)
 	}
@@ -107,7 +167,6 @@ func (sb *SkyBox) Render() {
 	}
 	if sb.SkyboxSelectedItem > 0 {
 		gl := sb.window.OpenGL()
-		sett := settings.GetSettings()
 
 		gl.BindVertexArray(sb.glVAO)
 		gl.UseProgram(sb.shaderProgram)
@@ -119,9 +178,18 @@ func (sb *SkyBox) Render() {
 
 		gl.GLUniformMatrix4fv(sb.glVSMatrixView, 1, false, &rsett.MatrixCamera[0])
 
-		matrixProjection := mgl32.Perspective(rsett.General.Fov, sett.AppWindow.SDLWindowWidth/sett.AppWindow.SDLWindowHeight, rsett.General.PlaneClose, rsett.General.PlaneFar)
-		gl.GLUniformMatrix4fv(sb.glVSMatrixProjection, 1, false, &matrixProjection[0])
+		gl.GLUniformMatrix4fv(sb.glVSMatrixProjection, 1, false, &rsett.MatrixProjection[0])
 
+		matrixRotation := EnvironmentRotation()
+		gl.UniformMatrix3fv(sb.glVSMatrixRotation, 1, false, &matrixRotation[0])
+		if sb.environment != nil {
+			gl.Uniform1i(sb.glFSIsHDR, 1)
+		} else {
+			gl.Uniform1i(sb.glFSIsHDR, 0)
+		}
+		gl.Uniform1f(sb.glFSExposure, rsett.SkyBox.SkyboxExposure)
+
+		gl.ActiveTexture(oglconsts.TEXTURE0)
 		gl.BindTexture(oglconsts.TEXTURE_CUBE_MAP, sb.vboTexture)
 
 		gl.DrawArrays(oglconsts.TRIANGLES, 0, 36)
@@ -139,4 +207,13 @@ func (sb *SkyBox) Dispose() {
 
 	gl.DeleteVertexArrays([]uint32{sb.glVAO})
 	gl.DeleteProgram(sb.shaderProgram)
+	if sb.environment != nil {
+		sb.environment.Dispose()
+	}
+}
+
+// EnvironmentRotation turns the world directions into the directions of the skybox, rotated around Y by the skybox rotation
+func EnvironmentRotation() mgl32.Mat3 {
+	rsett := settings.GetRenderingSettings()
+	return mgl32.Rotate3DY(mgl32.DegToRad(-rsett.SkyBox.SkyboxRotation))
 }
@@ -0,0 +1,296 @@
+package rendering
+
+import (
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/engine"
+	"github.com/supudo/Kuplung-Go/engine/oglconsts"
+	"github.com/supudo/Kuplung-Go/interfaces"
+	"github.com/supudo/Kuplung-Go/meshes"
+	"github.com/supudo/Kuplung-Go/objects"
+	"github.com/supudo/Kuplung-Go/rendering/pathtracer"
+	"github.com/supudo/Kuplung-Go/rendering/renderers"
+	"github.com/supudo/Kuplung-Go/settings"
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+// pathTracingModel is what the path traced scene was built from for one model
+type pathTracingModel struct {
+	face                        *meshes.ModelFace
+	vertices, indices           int
+	position, scale, rotate     mgl32.Vec3
+	diffuse, specular, emission types.MaterialColor
+	specularExp, refraction     float32
+	transparency, alpha         float32
+	pbr                         bool
+	metallic, roughness         float32
+	texture                     string
+}
+
+// pathTracingSceneKey is what the path traced scene was built from, the scene is rebuilt when it changes
+type pathTracingSceneKey struct {
+	models      []pathTracingModel
+	lights      []types.SceneLight
+	matrixGrid  mgl32.Mat4
+	environment string
+	rotation    float32
+	background  mgl32.Vec3
+}
+
+func (key *pathTracingSceneKey) equal(other *pathTracingSceneKey) bool {
+	if len(key.models) != len(other.models) || len(key.lights) != len(other.lights) {
+		return false
+	}
+	for i := range key.models {
+		if key.models[i] != other.models[i] {
+			return false
+		}
+	}
+	for i := range key.lights {
+		if key.lights[i] != other.lights[i] {
+			return false
+		}
+	}
+	return key.matrixGrid == other.matrixGrid && key.environment == other.environment &&
+		key.rotation == other.rotation && key.background == other.background
+}
+
+// pathTracingViewKey is what the image was rendered for, the passes start over when it changes
+type pathTracingViewKey struct {
+	matrixCamera    mgl32.Mat4
+	fov             float32
+	width, height   int
+	samples         int32
+	bounces         int32
+	exposure        float32
+	hdr             bool
+	resolutionScale float32
+}
+
+// pathTracing renders the Rendered view skin with the CPU path tracer in the background
+// and shows the progressively refined image in the viewport
+type pathTracing struct {
+	window interfaces.Window
+
+	fbo, texture                uint32
+	textureWidth, textureHeight int
+	uploaded                    int
+
+	sceneKey pathTracingSceneKey
+	viewKey  pathTracingViewKey
+	scene    *pathtracer.Scene
+	renderer *pathtracer.Renderer
+	textures PathTracingTextures
+
+	environmentFile  string
+	environmentImage *engine.HDRImage
+}
+
+func newPathTracing(window interfaces.Window) *pathTracing {
+	pt := &pathTracing{}
+	pt.window = window
+	pt.textures = make(PathTracingTextures)
+	gl := window.OpenGL()
+	pt.fbo = gl.GenFramebuffers(1)[0]
+	pt.texture = gl.GenTextures(1)[0]
+	gl.BindTexture(oglconsts.TEXTURE_2D, pt.texture)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_MIN_FILTER, oglconsts.LINEAR)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_MAG_FILTER, oglconsts.LINEAR)
+	gl.BindTexture(oglconsts.TEXTURE_2D, 0)
+	return pt
+}
+
+// Render restarts the path tracer when the scene or the view changed, uploads the new passes
+// and draws the image into the frame - it returns false while there is no pass to show yet
+func (pt *pathTracing) Render(frame *renderers.FrameContext) bool {
+	rsett := settings.GetRenderingSettings()
+
+	sceneKey := pt.newSceneKey(frame)
+	if pt.scene == nil || !sceneKey.equal(&pt.sceneKey) {
+		pt.Stop()
+		pt.sceneKey = sceneKey
+		pt.scene = pt.newScene(frame)
+	}
+
+	scale := rsett.PathTracing.ResolutionScale
+	if scale <= 0 || scale > 1 {
+		scale = 1
+	}
+	viewKey := pathTracingViewKey{
+		matrixCamera:    frame.MatrixCamera,
+		fov:             rsett.General.Fov,
+		width:           frame.Width,
+		height:          frame.Height,
+		samples:         rsett.PathTracing.Samples,
+		bounces:         rsett.PathTracing.Bounces,
+		exposure:        rsett.PathTracing.Exposure,
+		hdr:             frame.Framebuffer != 0,
+		resolutionScale: scale,
+	}
+	if pt.renderer == nil || viewKey != pt.viewKey {
+		pt.Stop()
+		pt.viewKey = viewKey
+		pt.start()
+	}
+
+	if passes := pt.renderer.Passes(); passes > pt.uploaded {
+		pt.upload()
+		pt.uploaded = passes
+	}
+	if pt.uploaded == 0 {
+		return false
+	}
+
+	// the image rows go from the top to the bottom, the destination is flipped
+	gl := pt.window.OpenGL()
+	gl.BindFramebuffer(oglconsts.READ_FRAMEBUFFER, pt.fbo)
+	gl.BindFramebuffer(oglconsts.DRAW_FRAMEBUFFER, frame.Framebuffer)
+	gl.BlitFramebuffer(0, 0, int32(pt.textureWidth), int32(pt.textureHeight), 0, int32(frame.Height), int32(frame.Width), 0, oglconsts.COLOR_BUFFER_BIT, oglconsts.LINEAR)
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, frame.Framebuffer)
+	return true
+}
+
+// Progress returns the passes shown and the passes the image is refined to
+func (pt *pathTracing) Progress() (int, int) {
+	if pt.renderer == nil {
+		return 0, 0
+	}
+	return pt.uploaded, int(pt.viewKey.samples)
+}
+
+// start renders the passes of the view in the background
+func (pt *pathTracing) start() {
+	key := pt.viewKey
+	width := int(float32(key.width) * key.resolutionScale)
+	height := int(float32(key.height) * key.resolutionScale)
+	if width < 1 {
+		width = 1
+	}
+	if height < 1 {
+		height = 1
+	}
+	options := pathtracer.DefaultOptions()
+	options.Bounces = int(key.bounces)
+	options.Exposure = key.exposure
+	camera := pathtracer.NewCamera(key.matrixCamera, key.fov, width, height)
+	renderer := pathtracer.NewRenderer(pt.scene, camera, width, height, options)
+	pt.renderer = renderer
+	pt.uploaded = 0
+
+	go func() {
+		for renderer.Passes() < int(key.samples) {
+			if !renderer.Pass() {
+				return
+			}
+		}
+	}()
+}
+
+// Stop cancels the passes in the background
+func (pt *pathTracing) Stop() {
+	if pt.renderer != nil {
+		pt.renderer.Cancel()
+		pt.renderer = nil
+	}
+	pt.uploaded = 0
+}
+
+// upload copies the image into the texture, linear for the HDR target of the post-processing, tone mapped for the window
+func (pt *pathTracing) upload() {
+	gl := pt.window.OpenGL()
+	pt.textureWidth, pt.textureHeight = pt.renderer.Size()
+	gl.BindTexture(oglconsts.TEXTURE_2D, pt.texture)
+	if pt.viewKey.hdr {
+		gl.TexImage2D(oglconsts.TEXTURE_2D, 0, oglconsts.RGBA16F, int32(pt.textureWidth), int32(pt.textureHeight), 0, oglconsts.RGBA, oglconsts.FLOAT, gl.Ptr(pt.renderer.Radiance()))
+	} else {
+		gl.TexImage2D(oglconsts.TEXTURE_2D, 0, oglconsts.RGBA8, int32(pt.textureWidth), int32(pt.textureHeight), 0, oglconsts.RGBA, oglconsts.UNSIGNED_BYTE, gl.Ptr(pt.renderer.Image().Pix))
+	}
+	gl.BindTexture(oglconsts.TEXTURE_2D, 0)
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, pt.fbo)
+	gl.FramebufferTexture2D(oglconsts.FRAMEBUFFER, oglconsts.COLOR_ATTACHMENT0, oglconsts.TEXTURE_2D, pt.texture, 0)
+	if gl.CheckFramebufferStatus(oglconsts.FRAMEBUFFER) != oglconsts.FRAMEBUFFER_COMPLETE {
+		settings.LogWarn("[PathTracing] Framebuffer (%vx%v) is not complete!", pt.textureWidth, pt.textureHeight)
+	}
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, 0)
+}
+
+func (pt *pathTracing) newSceneKey(frame *renderers.FrameContext) pathTracingSceneKey {
+	rsett := settings.GetRenderingSettings()
+	key := pathTracingSceneKey{matrixGrid: frame.MatrixGrid, background: pt.background()}
+	for _, mfd := range frame.MeshModelFaces {
+		mm := &mfd.MeshModel.ModelMaterial
+		model := pathTracingModel{
+			face:         mfd,
+			vertices:     len(mfd.MeshModel.Vertices),
+			indices:      len(mfd.MeshModel.Indices),
+			position:     mgl32.Vec3{mfd.PositionX.Point, mfd.PositionY.Point, mfd.PositionZ.Point},
+			scale:        mgl32.Vec3{mfd.ScaleX.Point, mfd.ScaleY.Point, mfd.ScaleZ.Point},
+			rotate:       mgl32.Vec3{mfd.RotateX.Point, mfd.RotateY.Point, mfd.RotateZ.Point},
+			diffuse:      mfd.MaterialDiffuse,
+			specular:     mfd.MaterialSpecular,
+			emission:     mfd.MaterialEmission,
+			specularExp:  mfd.MaterialSpecularExp.Point,
+			refraction:   mfd.MaterialRefraction.Point,
+			transparency: mm.Transparency,
+			alpha:        mfd.Alpha,
+			pbr:          mfd.RenderingPBR,
+			metallic:     mfd.RenderingPBRMetallic,
+			roughness:    mfd.RenderingPBRRoughness,
+		}
+		if mm.TextureDiffuse.UseTexture {
+			model.texture = mm.TextureDiffuse.Image
+		}
+		// the color pickers opening and closing don't change the render
+		model.diffuse.ColorPickerOpen, model.specular.ColorPickerOpen, model.emission.ColorPickerOpen = false, false, false
+		key.models = append(key.models, model)
+	}
+	for _, light := range frame.LightSources {
+		key.lights = append(key.lights, light.SceneLight())
+	}
+	if env := frame.Environment; env != nil {
+		key.environment = env.File
+		key.rotation = rsett.SkyBox.SkyboxRotation
+	}
+	return key
+}
+
+// newScene collects the models, the lights and the environment of the frame, the decoded textures are kept between the scenes
+func (pt *pathTracing) newScene(frame *renderers.FrameContext) *pathtracer.Scene {
+	scene := pathtracer.NewScene()
+	for _, mfd := range frame.MeshModelFaces {
+		AddPathTracingModel(scene, mfd, frame.MatrixGrid, pt.textures)
+	}
+	for _, light := range frame.LightSources {
+		scene.AddLight(pathtracer.LightFromScene(light.SceneLight()))
+	}
+
+	var img *engine.HDRImage
+	if env := frame.Environment; env != nil {
+		if env.File != pt.environmentFile {
+			img, err := engine.LoadHDRImage(env.File)
+			if err != nil {
+				settings.LogWarn("[PathTracing] Can't load the environment map %v: %v", env.File, err)
+			}
+			pt.environmentFile, pt.environmentImage = env.File, img
+		}
+		img = pt.environmentImage
+	}
+	scene.Environment = NewPathTracingEnvironment(img, objects.EnvironmentRotation(), pt.background())
+	return scene
+}
+
+// background is the light of the empty viewport when there is no HDR environment
+func (pt *pathTracing) background() mgl32.Vec3 {
+	sett := settings.GetSettings()
+	if len(sett.AppGui.GUIClearColor) < 3 {
+		return mgl32.Vec3{}
+	}
+	return mgl32.Vec3{sett.AppGui.GUIClearColor[0], sett.AppGui.GUIClearColor[1], sett.AppGui.GUIClearColor[2]}
+}
+
+// Dispose will cleanup everything
+func (pt *pathTracing) Dispose() {
+	pt.Stop()
+	gl := pt.window.OpenGL()
+	gl.DeleteFramebuffers([]uint32{pt.fbo})
+	gl.DeleteTextures([]uint32{pt.texture})
+}
@@ -0,0 +1,81 @@
+package rendering
+
+import (
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/engine"
+	"github.com/supudo/Kuplung-Go/meshes"
+	"github.com/supudo/Kuplung-Go/rendering/pathtracer"
+	"github.com/supudo/Kuplung-Go/settings"
+)
+
+// PathTracingTextures are the decoded diffuse textures of the path traced models, every file is loaded once
+type PathTracingTextures map[string]*pathtracer.Texture
+
+// AddPathTracingModel adds the triangles and the material of the model to the path traced scene, placed as the renderers place it on the grid
+func AddPathTracingModel(scene *pathtracer.Scene, mfd *meshes.ModelFace, matrixGrid mgl32.Mat4, textures PathTracingTextures) {
+	model := &mfd.MeshModel
+	if len(model.Vertices) == 0 || len(model.Indices) < 3 {
+		return
+	}
+	scene.AddMesh(model.Vertices, model.Normals, model.TextureCoordinates, model.Indices, mfd.ModelMatrix(matrixGrid), pathTracingMaterial(mfd, textures))
+}
+
+// NewPathTracingEnvironment returns the environment lit by the HDR image, a nil image leaves only the color
+func NewPathTracingEnvironment(img *engine.HDRImage, rotation mgl32.Mat3, color mgl32.Vec3) *pathtracer.Environment {
+	env := &pathtracer.Environment{Rotation: rotation, Color: color}
+	if img != nil {
+		env.Pix, env.Width, env.Height = img.Pix, img.Width, img.Height
+	}
+	return env
+}
+
+// pathTracingMaterial returns the material of the model with the edits from the material editor.
+// The ambient occlusion of the PBR parameters is left out, the path tracer finds the occlusion itself.
+func pathTracingMaterial(mfd *meshes.ModelFace, textures PathTracingTextures) pathtracer.Material {
+	mm := &mfd.MeshModel.ModelMaterial
+	m := pathtracer.Material{
+		Diffuse:        mfd.MaterialDiffuse.Color.Mul(mfd.MaterialDiffuse.Strength),
+		Specular:       mfd.MaterialSpecular.Color.Mul(mfd.MaterialSpecular.Strength),
+		Emission:       mfd.MaterialEmission.Color.Mul(mfd.MaterialEmission.Strength),
+		SpecularExp:    mfd.MaterialSpecularExp.Point,
+		Transparency:   mm.Transparency * mfd.Alpha,
+		OpticalDensity: mfd.MaterialRefraction.Point,
+		PBR:            mfd.RenderingPBR,
+		Metallic:       mfd.RenderingPBRMetallic,
+		Roughness:      mfd.RenderingPBRRoughness,
+	}
+	if mm.TextureDiffuse.UseTexture && mm.TextureDiffuse.Image != "" {
+		m.DiffuseTexture = textures.texture(mm.TextureDiffuse.Image)
+	}
+	return m
+}
+
+// texture returns the decoded image, packed scene resources included
+func (textures PathTracingTextures) texture(file string) *pathtracer.Texture {
+	if tex, ok := textures[file]; ok {
+		return tex
+	}
+	tex, err := loadPathTracingTexture(file)
+	if err != nil {
+		settings.LogWarn("[PathTracer] Can't load the texture %v: %v", file, err)
+	}
+	textures[file] = tex
+	return tex
+}
+
+func loadPathTracingTexture(file string) (*pathtracer.Texture, error) {
+	f, err := engine.OpenResource(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return nil, err
+	}
+	return pathtracer.NewTexture(img), nil
+}
@@ -0,0 +1,366 @@
+package pathtracer
+
+import (
+	"math"
+	"sort"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+// bvhBins is the number of buckets the surface area heuristic evaluates per split
+const bvhBins = 16
+
+// bvhLeafSize is the number of triangles below which a node is never split
+const bvhLeafSize = 2
+
+// bvhMaxLeafSize is the number of triangles above which a node is always split
+const bvhMaxLeafSize = 16
+
+// bvhMaxDepth limits the depth of the hierarchy, so that the traversal stacks stay on the goroutine stack
+const bvhMaxDepth = 62
+
+// ray is a half line with its reciprocal direction cached for the box tests
+type ray struct {
+	origin, dir, invDir mgl32.Vec3
+}
+
+func newRay(origin, dir mgl32.Vec3) ray {
+	r := ray{origin: origin, dir: dir}
+	for i := 0; i < 3; i++ {
+		if dir[i] == 0 {
+			r.invDir[i] = math.MaxFloat32
+		} else {
+			r.invDir[i] = 1.0 / dir[i]
+		}
+	}
+	return r
+}
+
+// triangle is a world space triangle with the vertex attributes the shading needs
+type triangle struct {
+	v0, e1, e2    mgl32.Vec3
+	n0, n1, n2    mgl32.Vec3
+	uv0, uv1, uv2 mgl32.Vec2
+	normal        mgl32.Vec3
+	material      int32
+}
+
+// intersect returns the distance and the barycentric coordinates of the hit, Möller-Trumbore
+func (tri *triangle) intersect(r *ray, tMax float32) (float32, float32, float32, bool) {
+	p := r.dir.Cross(tri.e2)
+	det := tri.e1.Dot(p)
+	if det > -1e-12 && det < 1e-12 {
+		return 0, 0, 0, false
+	}
+	invDet := 1.0 / det
+	s := r.origin.Sub(tri.v0)
+	u := s.Dot(p) * invDet
+	if u < 0 || u > 1 {
+		return 0, 0, 0, false
+	}
+	q := s.Cross(tri.e1)
+	v := r.dir.Dot(q) * invDet
+	if v < 0 || u+v > 1 {
+		return 0, 0, 0, false
+	}
+	t := tri.e2.Dot(q) * invDet
+	if t <= 0 || t >= tMax {
+		return 0, 0, 0, false
+	}
+	return t, u, v, true
+}
+
+// aabb is an axis aligned bounding box
+type aabb struct {
+	min, max mgl32.Vec3
+}
+
+func emptyAABB() aabb {
+	return aabb{
+		min: mgl32.Vec3{math.MaxFloat32, math.MaxFloat32, math.MaxFloat32},
+		max: mgl32.Vec3{-math.MaxFloat32, -math.MaxFloat32, -math.MaxFloat32},
+	}
+}
+
+func (b *aabb) grow(p mgl32.Vec3) {
+	for i := 0; i < 3; i++ {
+		b.min[i] = float32(math.Min(float64(b.min[i]), float64(p[i])))
+		b.max[i] = float32(math.Max(float64(b.max[i]), float64(p[i])))
+	}
+}
+
+func (b *aabb) merge(o aabb) {
+	b.grow(o.min)
+	b.grow(o.max)
+}
+
+func (b *aabb) area() float32 {
+	d := b.max.Sub(b.min)
+	if d[0] < 0 {
+		return 0
+	}
+	return 2.0 * (d[0]*d[1] + d[1]*d[2] + d[2]*d[0])
+}
+
+// bvhNode is a node of the flattened hierarchy, leaves have a count and their triangles start at first,
+// inner nodes have their first child right after them and the second child at first
+type bvhNode struct {
+	bounds       aabb
+	first, count int32
+}
+
+// hit is the closest intersection found along a ray
+type hit struct {
+	t, u, v  float32
+	triangle int32
+}
+
+// bvh is a bounding volume hierarchy over the scene triangles, built with the binned surface area heuristic
+type bvh struct {
+	nodes     []bvhNode
+	triangles []triangle
+
+	refs      []int32
+	centroids []mgl32.Vec3
+	bounds    []aabb
+}
+
+// buildBVH builds the hierarchy and sorts the triangles so that every leaf is a contiguous range
+func buildBVH(triangles []triangle) *bvh {
+	b := &bvh{}
+	n := len(triangles)
+	b.refs = make([]int32, n)
+	b.centroids = make([]mgl32.Vec3, n)
+	b.bounds = make([]aabb, n)
+	for i := range triangles {
+		tri := &triangles[i]
+		bb := emptyAABB()
+		bb.grow(tri.v0)
+		bb.grow(tri.v0.Add(tri.e1))
+		bb.grow(tri.v0.Add(tri.e2))
+		b.refs[i] = int32(i)
+		b.bounds[i] = bb
+		b.centroids[i] = bb.min.Add(bb.max).Mul(0.5)
+	}
+	b.nodes = make([]bvhNode, 0, 2*n+1)
+	if n > 0 {
+		b.split(0, n, 0)
+	}
+
+	b.triangles = make([]triangle, n)
+	for i, ref := range b.refs {
+		b.triangles[i] = triangles[ref]
+	}
+	b.refs, b.centroids, b.bounds = nil, nil, nil
+	return b
+}
+
+// split adds the node for the triangle references in [start, end) and returns its index
+func (b *bvh) split(start, end, depth int) int32 {
+	index := int32(len(b.nodes))
+	node := bvhNode{bounds: emptyAABB()}
+	centroidBounds := emptyAABB()
+	for _, ref := range b.refs[start:end] {
+		node.bounds.merge(b.bounds[ref])
+		centroidBounds.grow(b.centroids[ref])
+	}
+	b.nodes = append(b.nodes, node)
+
+	count := end - start
+	if count <= bvhLeafSize || depth >= bvhMaxDepth {
+		b.makeLeaf(index, start, count)
+		return index
+	}
+
+	extent := centroidBounds.max.Sub(centroidBounds.min)
+	axis := 0
+	if extent[1] > extent[axis] {
+		axis = 1
+	}
+	if extent[2] > extent[axis] {
+		axis = 2
+	}
+	if extent[axis] <= 1e-12 {
+		b.makeLeaf(index, start, count)
+		return index
+	}
+
+	// bucket the centroids along the longest axis and find the cheapest split between the buckets
+	var binCounts [bvhBins]int
+	var binBounds [bvhBins]aabb
+	for i := range binBounds {
+		binBounds[i] = emptyAABB()
+	}
+	binOf := func(ref int32) int {
+		bin := int(float32(bvhBins) * (b.centroids[ref][axis] - centroidBounds.min[axis]) / extent[axis])
+		if bin >= bvhBins {
+			bin = bvhBins - 1
+		}
+		return bin
+	}
+	for _, ref := range b.refs[start:end] {
+		bin := binOf(ref)
+		binCounts[bin]++
+		binBounds[bin].merge(b.bounds[ref])
+	}
+
+	var rightArea [bvhBins]float32
+	var rightCount [bvhBins]int
+	acc := emptyAABB()
+	accCount := 0
+	for i := bvhBins - 1; i > 0; i-- {
+		acc.merge(binBounds[i])
+		accCount += binCounts[i]
+		rightArea[i] = acc.area()
+		rightCount[i] = accCount
+	}
+	bestBin, bestCost := -1, float32(math.MaxFloat32)
+	acc = emptyAABB()
+	accCount = 0
+	for i := 1; i < bvhBins; i++ {
+		acc.merge(binBounds[i-1])
+		accCount += binCounts[i-1]
+		if accCount == 0 || rightCount[i] == 0 {
+			continue
+		}
+		cost := acc.area()*float32(accCount) + rightArea[i]*float32(rightCount[i])
+		if cost < bestCost {
+			bestBin, bestCost = i, cost
+		}
+	}
+
+	leafCost := node.bounds.area() * float32(count)
+	if bestBin < 0 || (bestCost >= leafCost && count <= bvhMaxLeafSize) {
+		if count <= bvhMaxLeafSize {
+			b.makeLeaf(index, start, count)
+			return index
+		}
+		// no useful bucket split, halve the references along the axis
+		refs := b.refs[start:end]
+		sort.Slice(refs, func(i, j int) bool { return b.centroids[refs[i]][axis] < b.centroids[refs[j]][axis] })
+		b.splitAt(index, start, start+count/2, end, depth)
+		return index
+	}
+
+	mid := start
+	for i := start; i < end; i++ {
+		if binOf(b.refs[i]) < bestBin {
+			b.refs[i], b.refs[mid] = b.refs[mid], b.refs[i]
+			mid++
+		}
+	}
+	b.splitAt(index, start, mid, end, depth)
+	return index
+}
+
+func (b *bvh) splitAt(index int32, start, mid, end, depth int) {
+	b.split(start, mid, depth+1)
+	second := b.split(mid, end, depth+1)
+	b.nodes[index].first = second
+	b.nodes[index].count = 0
+}
+
+func (b *bvh) makeLeaf(index int32, start, count int) {
+	b.nodes[index].first = int32(start)
+	b.nodes[index].count = int32(count)
+}
+
+// intersectBox returns the distance to the box along the ray, or false when the box is missed or further than tMax
+func intersectBox(bb *aabb, r *ray, tMax float32) (float32, bool) {
+	tNear, tFar := float32(0), tMax
+	for i := 0; i < 3; i++ {
+		t0 := (bb.min[i] - r.origin[i]) * r.invDir[i]
+		t1 := (bb.max[i] - r.origin[i]) * r.invDir[i]
+		if t0 > t1 {
+			t0, t1 = t1, t0
+		}
+		if t0 > tNear {
+			tNear = t0
+		}
+		if t1 < tFar {
+			tFar = t1
+		}
+		if tNear > tFar {
+			return 0, false
+		}
+	}
+	return tNear, true
+}
+
+// intersect returns the closest triangle hit along the ray
+func (b *bvh) intersect(r *ray, tMax float32) (hit, bool) {
+	h := hit{t: tMax, triangle: -1}
+	if len(b.nodes) == 0 {
+		return h, false
+	}
+	var stack [bvhMaxDepth + 2]int32
+	var stackNear [bvhMaxDepth + 2]float32
+	sp := 1
+	for sp > 0 {
+		sp--
+		// the box was hit before the closest triangle got closer
+		if stackNear[sp] >= h.t {
+			continue
+		}
+		index := stack[sp]
+		node := &b.nodes[index]
+		if node.count > 0 {
+			for i := node.first; i < node.first+node.count; i++ {
+				if t, u, v, ok := b.triangles[i].intersect(r, h.t); ok {
+					h = hit{t: t, u: u, v: v, triangle: i}
+				}
+			}
+			continue
+		}
+		// push the farther child first, so that the nearer one is visited first and shortens the ray
+		first, second := index+1, node.first
+		t1, ok1 := intersectBox(&b.nodes[first].bounds, r, h.t)
+		t2, ok2 := intersectBox(&b.nodes[second].bounds, r, h.t)
+		if ok1 && ok2 && t2 < t1 {
+			first, second = second, first
+			t1, t2 = t2, t1
+		} else if !ok1 {
+			first, second = second, first
+			t1, t2 = t2, t1
+			ok1, ok2 = ok2, ok1
+		}
+		if ok2 {
+			stack[sp], stackNear[sp] = second, t2
+			sp++
+		}
+		if ok1 {
+			stack[sp], stackNear[sp] = first, t1
+			sp++
+		}
+	}
+	return h, h.triangle >= 0
+}
+
+// occluded returns true when any triangle is between the ray origin and tMax
+func (b *bvh) occluded(r *ray, tMax float32) bool {
+	if len(b.nodes) == 0 {
+		return false
+	}
+	var stack [bvhMaxDepth + 2]int32
+	sp := 1
+	for sp > 0 {
+		sp--
+		index := stack[sp]
+		node := &b.nodes[index]
+		if _, ok := intersectBox(&node.bounds, r, tMax); !ok {
+			continue
+		}
+		if node.count > 0 {
+			for i := node.first; i < node.first+node.count; i++ {
+				if _, _, _, ok := b.triangles[i].intersect(r, tMax); ok {
+					return true
+				}
+			}
+			continue
+		}
+		stack[sp] = node.first
+		stack[sp+1] = index + 1
+		sp += 2
+	}
+	return false
+}
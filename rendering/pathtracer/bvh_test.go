@@ -0,0 +1,118 @@
+package pathtracer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+func newTriangle(p0, p1, p2 mgl32.Vec3) triangle {
+	tri := triangle{v0: p0, e1: p1.Sub(p0), e2: p2.Sub(p0)}
+	tri.normal = tri.e1.Cross(tri.e2).Normalize()
+	return tri
+}
+
+func TestTriangleIntersect(t *testing.T) {
+	tri := newTriangle(mgl32.Vec3{0, 0, 0}, mgl32.Vec3{1, 0, 0}, mgl32.Vec3{0, 1, 0})
+	tests := []struct {
+		name        string
+		origin, dir mgl32.Vec3
+		tMax        float32
+		hit         bool
+		t, u, v     float32
+	}{
+		{"front", mgl32.Vec3{0.25, 0.5, 2}, mgl32.Vec3{0, 0, -1}, math.MaxFloat32, true, 2, 0.25, 0.5},
+		{"back", mgl32.Vec3{0.25, 0.25, -1}, mgl32.Vec3{0, 0, 1}, math.MaxFloat32, true, 1, 0.25, 0.25},
+		{"slanted", mgl32.Vec3{0, 0, 1}, mgl32.Vec3{0.2, 0.2, -1}.Normalize(), math.MaxFloat32, true, mgl32.Vec3{0.2, 0.2, 1}.Len(), 0.2, 0.2},
+		{"outside", mgl32.Vec3{0.75, 0.75, 1}, mgl32.Vec3{0, 0, -1}, math.MaxFloat32, false, 0, 0, 0},
+		{"parallel", mgl32.Vec3{-1, 0.25, 0}, mgl32.Vec3{1, 0, 0}, math.MaxFloat32, false, 0, 0, 0},
+		{"behind the origin", mgl32.Vec3{0.25, 0.25, -1}, mgl32.Vec3{0, 0, -1}, math.MaxFloat32, false, 0, 0, 0},
+		{"past tMax", mgl32.Vec3{0.25, 0.25, 2}, mgl32.Vec3{0, 0, -1}, 1.5, false, 0, 0, 0},
+	}
+	for _, tc := range tests {
+		r := newRay(tc.origin, tc.dir)
+		hitT, u, v, ok := tri.intersect(&r, tc.tMax)
+		if ok != tc.hit {
+			t.Errorf("%v: hit = %v, expected %v", tc.name, ok, tc.hit)
+			continue
+		}
+		if ok && (!approxEqual(hitT, tc.t) || !approxEqual(u, tc.u) || !approxEqual(v, tc.v)) {
+			t.Errorf("%v: t, u, v = %v, %v, %v, expected %v, %v, %v", tc.name, hitT, u, v, tc.t, tc.u, tc.v)
+		}
+	}
+}
+
+// TestBVHIntersect compares the hierarchy with testing every triangle on random triangles and rays
+func TestBVHIntersect(t *testing.T) {
+	rng := newSampler(1, 2)
+	random := func(scale float32) mgl32.Vec3 {
+		return mgl32.Vec3{(rng.next()*2 - 1) * scale, (rng.next()*2 - 1) * scale, (rng.next()*2 - 1) * scale}
+	}
+
+	var triangles []triangle
+	for len(triangles) < 500 {
+		center := random(5)
+		tri := newTriangle(center.Add(random(1)), center.Add(random(1)), center.Add(random(1)))
+		if !math.IsNaN(float64(tri.normal[0])) {
+			triangles = append(triangles, tri)
+		}
+	}
+	b := buildBVH(triangles)
+	if len(b.triangles) != len(triangles) {
+		t.Fatalf("triangles = %v, expected %v", len(b.triangles), len(triangles))
+	}
+
+	hits := 0
+	for i := 0; i < 2000; i++ {
+		// aim near the triangles, so that about half of the rays hit one
+		origin := random(8)
+		r := newRay(origin, random(4).Sub(origin).Normalize())
+
+		expected := hit{t: math.MaxFloat32, triangle: -1}
+		for j := range b.triangles {
+			if tt, u, v, ok := b.triangles[j].intersect(&r, expected.t); ok {
+				expected = hit{t: tt, u: u, v: v, triangle: int32(j)}
+			}
+		}
+
+		h, ok := b.intersect(&r, math.MaxFloat32)
+		if ok != (expected.triangle >= 0) {
+			t.Fatalf("ray %v: hit = %v, expected %v", i, ok, expected.triangle >= 0)
+		}
+		if !ok {
+			if b.occluded(&r, math.MaxFloat32) {
+				t.Errorf("ray %v: occluded without a hit", i)
+			}
+			continue
+		}
+		hits++
+		if h.t != expected.t {
+			t.Errorf("ray %v: t = %v on triangle %v, expected %v on triangle %v", i, h.t, h.triangle, expected.t, expected.triangle)
+		}
+		if !b.occluded(&r, h.t*1.001) {
+			t.Errorf("ray %v: not occluded before the hit at %v", i, h.t)
+		}
+		if b.occluded(&r, h.t*0.999) {
+			t.Errorf("ray %v: occluded before the closest hit at %v", i, h.t)
+		}
+	}
+	if hits < 200 || hits > 1800 {
+		t.Errorf("%v of the rays hit, the test doesn't cover both cases", hits)
+	}
+}
+
+func TestBVHEmpty(t *testing.T) {
+	b := buildBVH(nil)
+	r := newRay(mgl32.Vec3{}, mgl32.Vec3{0, 0, -1})
+	if _, ok := b.intersect(&r, math.MaxFloat32); ok {
+		t.Error("hit in an empty hierarchy")
+	}
+	if b.occluded(&r, math.MaxFloat32) {
+		t.Error("occluded in an empty hierarchy")
+	}
+}
+
+func approxEqual(a, b float32) bool {
+	return math.Abs(float64(a-b)) < 1e-4
+}
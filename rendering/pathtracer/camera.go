@@ -0,0 +1,37 @@
+package pathtracer
+
+import (
+	"math"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+// Camera is a pinhole camera placed with the view matrix of the viewport
+type Camera struct {
+	origin              mgl32.Vec3
+	right, up, forward  mgl32.Vec3
+	tanHalfFov, aspect  float32
+	invWidth, invHeight float32
+}
+
+// NewCamera returns a camera for the view matrix, the vertical field of view in degrees and the image size
+func NewCamera(matrixCamera mgl32.Mat4, fov float32, width, height int) Camera {
+	inv := matrixCamera.Inv()
+	cam := Camera{}
+	cam.origin = inv.Col(3).Vec3()
+	cam.right = inv.Col(0).Vec3().Normalize()
+	cam.up = inv.Col(1).Vec3().Normalize()
+	cam.forward = inv.Col(2).Vec3().Normalize().Mul(-1)
+	cam.tanHalfFov = float32(math.Tan(float64(mgl32.DegToRad(fov)) / 2.0))
+	cam.aspect = float32(width) / float32(height)
+	cam.invWidth, cam.invHeight = 1.0/float32(width), 1.0/float32(height)
+	return cam
+}
+
+// ray returns the primary ray through the image position, x and y are in pixels from the top left corner
+func (cam *Camera) ray(x, y float32) ray {
+	sx := (2.0*x*cam.invWidth - 1.0) * cam.tanHalfFov * cam.aspect
+	sy := (1.0 - 2.0*y*cam.invHeight) * cam.tanHalfFov
+	dir := cam.forward.Add(cam.right.Mul(sx)).Add(cam.up.Mul(sy)).Normalize()
+	return newRay(cam.origin, dir)
+}
@@ -0,0 +1,88 @@
+package pathtracer
+
+import (
+	"image"
+	"image/draw"
+	"math"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+// environmentMaxRadiance keeps the sun and the other very bright texels from turning into fireflies in the indirect bounces
+const environmentMaxRadiance = 64.0
+
+// Environment is the light coming from the directions that don't hit the scene,
+// an equirectangular HDR image rotated as the skybox or a constant color
+type Environment struct {
+	// Pix is the linear RGB of the image, the rows go from the bottom to the top
+	Pix           []float32
+	Width, Height int
+	Rotation      mgl32.Mat3
+	Color         mgl32.Vec3
+}
+
+// radiance returns the environment light along the world direction
+func (env *Environment) radiance(dir mgl32.Vec3) mgl32.Vec3 {
+	if env == nil {
+		return mgl32.Vec3{}
+	}
+	if env.Width == 0 || env.Height == 0 || len(env.Pix) < env.Width*env.Height*3 {
+		return env.Color
+	}
+	// the same mapping as the equirectangular to cube map conversion of the image based lighting
+	d := env.Rotation.Mul3x1(dir).Normalize()
+	u := math.Atan2(float64(d[2]), float64(d[0]))*0.1591 + 0.5
+	v := math.Asin(math.Max(-1, math.Min(1, float64(d[1]))))*0.3183 + 0.5
+	x := int(u * float64(env.Width))
+	y := int(v * float64(env.Height))
+	x = clampInt(x, 0, env.Width-1)
+	y = clampInt(y, 0, env.Height-1)
+	// the rows go from the bottom to the top
+	i := (y*env.Width + x) * 3
+	return mgl32.Vec3{env.Pix[i], env.Pix[i+1], env.Pix[i+2]}
+}
+
+// Texture is a decoded material image with linear colors
+type Texture struct {
+	width, height int
+	pix           []mgl32.Vec3
+}
+
+// NewTexture converts the decoded image to linear colors
+func NewTexture(img image.Image) *Texture {
+	rgba := image.NewRGBA(img.Bounds())
+	draw.Draw(rgba, rgba.Bounds(), img, img.Bounds().Min, draw.Src)
+
+	tex := &Texture{width: rgba.Rect.Dx(), height: rgba.Rect.Dy()}
+	tex.pix = make([]mgl32.Vec3, tex.width*tex.height)
+	var toLinear [256]float32
+	for i := range toLinear {
+		toLinear[i] = float32(math.Pow(float64(i)/255.0, 2.2))
+	}
+	for i := range tex.pix {
+		tex.pix[i] = mgl32.Vec3{toLinear[rgba.Pix[i*4]], toLinear[rgba.Pix[i*4+1]], toLinear[rgba.Pix[i*4+2]]}
+	}
+	return tex
+}
+
+// sample returns the texel at the texture coordinates, repeated as the textures of the rasterizers
+func (tex *Texture) sample(uv mgl32.Vec2) mgl32.Vec3 {
+	if tex.width == 0 || tex.height == 0 {
+		return mgl32.Vec3{}
+	}
+	u := uv[0] - float32(math.Floor(float64(uv[0])))
+	v := uv[1] - float32(math.Floor(float64(uv[1])))
+	x := clampInt(int(u*float32(tex.width)), 0, tex.width-1)
+	y := clampInt(int(v*float32(tex.height)), 0, tex.height-1)
+	return tex.pix[y*tex.width+x]
+}
+
+func clampInt(i, min, max int) int {
+	if i < min {
+		return min
+	}
+	if i > max {
+		return max
+	}
+	return i
+}
@@ -0,0 +1,211 @@
+package pathtracer
+
+import (
+	"math"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+// minRoughness keeps the microfacet lobe from turning into a mirror that the point lights can never hit
+const minRoughness = 0.02
+
+// Material is the physically based description of a model surface.
+// The Phong colors of the .mtl materials are mapped to a diffuse lobe and a GGX specular lobe with the
+// roughness taken from the specular exponent, the PBR parameters drive the same lobes with a metallic workflow.
+type Material struct {
+	Diffuse, Specular, Emission mgl32.Vec3
+	SpecularExp                 float32
+
+	// Transparency is the opacity as in the .mtl files, 1 is opaque and the rest of the light goes through
+	// a smooth dielectric interface with OpticalDensity as its index of refraction
+	Transparency   float32
+	OpticalDensity float32
+
+	PBR                 bool
+	Metallic, Roughness float32
+
+	DiffuseTexture *Texture
+}
+
+// surface is the material evaluated at a hit point
+type surface struct {
+	diffuse   mgl32.Vec3
+	f0        mgl32.Vec3
+	alpha     float32
+	pSpecular float32
+	opacity   float32
+	ior       float32
+}
+
+// surfaceAt resolves the lobes of the material for the texture coordinates
+func (m *Material) surfaceAt(uv mgl32.Vec2) surface {
+	base := m.Diffuse
+	if m.DiffuseTexture != nil {
+		base = m.DiffuseTexture.sample(uv)
+	}
+
+	s := surface{opacity: clamp01(m.Transparency), ior: m.OpticalDensity}
+	if s.ior <= 0 {
+		s.ior = 1.0
+	}
+	if m.PBR {
+		metallic := clamp01(m.Metallic)
+		s.f0 = mix(mgl32.Vec3{0.04, 0.04, 0.04}, base, metallic)
+		s.diffuse = base.Mul(1.0 - metallic)
+		roughness := clamp01(m.Roughness)
+		s.alpha = roughness * roughness
+	} else {
+		s.f0 = clampVec(m.Specular)
+		// the diffuse lobe gets what the specular lobe doesn't reflect
+		s.diffuse = base.Mul(1.0 - maxComponent(s.f0))
+		s.alpha = float32(math.Sqrt(2.0 / (float64(m.SpecularExp) + 2.0)))
+	}
+	if s.alpha < minRoughness {
+		s.alpha = minRoughness
+	}
+
+	wd, ws := luminance(s.diffuse), luminance(s.f0)
+	if wd+ws > 0 {
+		s.pSpecular = ws / (wd + ws)
+	}
+	if s.pSpecular > 0 && s.pSpecular < 0.1 {
+		s.pSpecular = 0.1
+	} else if s.pSpecular > 0.9 && wd > 0 {
+		s.pSpecular = 0.9
+	}
+	return s
+}
+
+// eval returns the reflected radiance factor of the opaque lobes for the outgoing and incoming directions,
+// both pointing away from the surface, and the probability density of sampling wi
+func (s *surface) eval(n, wo, wi mgl32.Vec3) (mgl32.Vec3, float32) {
+	cosO, cosI := n.Dot(wo), n.Dot(wi)
+	if cosO <= 0 || cosI <= 0 {
+		return mgl32.Vec3{}, 0
+	}
+	h := wo.Add(wi).Normalize()
+	cosH, cosOH := n.Dot(h), wo.Dot(h)
+
+	fresnel := schlick(s.f0, cosOH)
+	d := ggxD(cosH, s.alpha)
+	g := smithG1(cosO, s.alpha) * smithG1(cosI, s.alpha)
+	specular := fresnel.Mul(d * g / (4.0 * cosO * cosI))
+
+	kd := 1.0 - maxComponent(fresnel)
+	diffuse := s.diffuse.Mul(kd / math.Pi)
+
+	pdf := (1.0-s.pSpecular)*cosI/math.Pi + s.pSpecular*d*cosH/(4.0*cosOH)
+	return diffuse.Add(specular), pdf
+}
+
+// sample picks an incoming direction from the opaque lobes, it returns the weight f * cos / pdf
+func (s *surface) sample(n, wo mgl32.Vec3, u1, u2, u3 float32) (mgl32.Vec3, mgl32.Vec3, bool) {
+	t, b := basis(n)
+	var wi mgl32.Vec3
+	if u1 < s.pSpecular {
+		// GGX normal distribution sampling
+		a2 := s.alpha * s.alpha
+		phi := 2.0 * math.Pi * float64(u2)
+		cos2 := (1.0 - u3) / (1.0 + (a2-1.0)*u3)
+		cosTheta := float32(math.Sqrt(float64(cos2)))
+		sinTheta := float32(math.Sqrt(math.Max(0, float64(1.0-cos2))))
+		h := toWorld(t, b, n, sinTheta*float32(math.Cos(phi)), sinTheta*float32(math.Sin(phi)), cosTheta)
+		wi = reflect(wo.Mul(-1), h)
+	} else {
+		// cosine weighted hemisphere sampling
+		r := float32(math.Sqrt(float64(u2)))
+		phi := 2.0 * math.Pi * float64(u3)
+		wi = toWorld(t, b, n, r*float32(math.Cos(phi)), r*float32(math.Sin(phi)), float32(math.Sqrt(math.Max(0, float64(1.0-u2)))))
+	}
+	f, pdf := s.eval(n, wo, wi)
+	if pdf <= 0 {
+		return wi, mgl32.Vec3{}, false
+	}
+	return wi, f.Mul(n.Dot(wi) / pdf), true
+}
+
+// sampleDielectric picks the reflected or the refracted direction through a smooth interface,
+// the normal faces the incoming side and inside is true when the ray leaves the object
+func (s *surface) sampleDielectric(n, wo mgl32.Vec3, inside bool, u float32) mgl32.Vec3 {
+	eta := 1.0 / s.ior
+	if inside {
+		eta = s.ior
+	}
+	cosI := n.Dot(wo)
+	sin2T := eta * eta * (1.0 - cosI*cosI)
+	if sin2T >= 1.0 {
+		return reflect(wo.Mul(-1), n)
+	}
+	cosT := float32(math.Sqrt(float64(1.0 - sin2T)))
+	rs := (eta*cosI - cosT) / (eta*cosI + cosT)
+	rp := (cosI - eta*cosT) / (cosI + eta*cosT)
+	if u < (rs*rs+rp*rp)/2.0 {
+		return reflect(wo.Mul(-1), n)
+	}
+	return wo.Mul(-eta).Add(n.Mul(eta*cosI - cosT)).Normalize()
+}
+
+func ggxD(cosH, alpha float32) float32 {
+	a2 := alpha * alpha
+	d := cosH*cosH*(a2-1.0) + 1.0
+	return a2 / (math.Pi * d * d)
+}
+
+func smithG1(cos, alpha float32) float32 {
+	a2 := alpha * alpha
+	return 2.0 * cos / (cos + float32(math.Sqrt(float64(a2+(1.0-a2)*cos*cos))))
+}
+
+func schlick(f0 mgl32.Vec3, cos float32) mgl32.Vec3 {
+	f := float32(math.Pow(float64(1.0-clamp01(cos)), 5))
+	return f0.Add(mgl32.Vec3{1, 1, 1}.Sub(f0).Mul(f))
+}
+
+func reflect(d, n mgl32.Vec3) mgl32.Vec3 {
+	return d.Sub(n.Mul(2.0 * d.Dot(n)))
+}
+
+// basis returns two tangents perpendicular to the normal, Duff et al.
+func basis(n mgl32.Vec3) (mgl32.Vec3, mgl32.Vec3) {
+	sign := float32(1.0)
+	if n[2] < 0 {
+		sign = -1.0
+	}
+	a := -1.0 / (sign + n[2])
+	b := n[0] * n[1] * a
+	return mgl32.Vec3{1.0 + sign*n[0]*n[0]*a, sign * b, -sign * n[0]}, mgl32.Vec3{b, sign + n[1]*n[1]*a, -n[1]}
+}
+
+func toWorld(t, b, n mgl32.Vec3, x, y, z float32) mgl32.Vec3 {
+	return t.Mul(x).Add(b.Mul(y)).Add(n.Mul(z))
+}
+
+func mulVec(a, b mgl32.Vec3) mgl32.Vec3 {
+	return mgl32.Vec3{a[0] * b[0], a[1] * b[1], a[2] * b[2]}
+}
+
+func mix(a, b mgl32.Vec3, t float32) mgl32.Vec3 {
+	return a.Mul(1.0 - t).Add(b.Mul(t))
+}
+
+func luminance(c mgl32.Vec3) float32 {
+	return 0.2126*c[0] + 0.7152*c[1] + 0.0722*c[2]
+}
+
+func maxComponent(c mgl32.Vec3) float32 {
+	return float32(math.Max(float64(c[0]), math.Max(float64(c[1]), float64(c[2]))))
+}
+
+func clamp01(f float32) float32 {
+	if f < 0 {
+		return 0
+	}
+	if f > 1 {
+		return 1
+	}
+	return f
+}
+
+func clampVec(c mgl32.Vec3) mgl32.Vec3 {
+	return mgl32.Vec3{clamp01(c[0]), clamp01(c[1]), clamp01(c[2])}
+}
@@ -0,0 +1,111 @@
+package pathtracer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+// TestSurfaceSample estimates the reflected light of every material twice, with the directions the material samples
+// and with uniformly sampled directions, the two only agree when sample picks the directions with the density eval returns
+func TestSurfaceSample(t *testing.T) {
+	tests := []struct {
+		name     string
+		material Material
+		// the bounds of the reflected light under a white sky, a diffuse surface reflects its color
+		min, max float32
+	}{
+		{"white diffuse", Material{Diffuse: mgl32.Vec3{1, 1, 1}, SpecularExp: 1}, 0.99, 1.01},
+		{"grey diffuse", Material{Diffuse: mgl32.Vec3{0.5, 0.5, 0.5}, SpecularExp: 1}, 0.49, 0.51},
+		{"plastic", Material{Diffuse: mgl32.Vec3{0.6, 0.1, 0.1}, Specular: mgl32.Vec3{0.3, 0.3, 0.3}, SpecularExp: 50}, 0.2, 1.0},
+		{"rough metal", Material{Diffuse: mgl32.Vec3{0.9, 0.6, 0.2}, PBR: true, Metallic: 1, Roughness: 0.6}, 0.5, 1.0},
+		{"rough dielectric", Material{Diffuse: mgl32.Vec3{0.2, 0.4, 0.8}, PBR: true, Metallic: 0, Roughness: 0.8}, 0.2, 1.0},
+	}
+
+	n := mgl32.Vec3{0, 0, 1}
+	wo := mgl32.Vec3{0.3, -0.2, 0.9}.Normalize()
+	const samples = 200000
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			surf := tc.material.surfaceAt(mgl32.Vec2{})
+			rng := newSampler(3, 4)
+
+			var importance, uniform mgl32.Vec3
+			for i := 0; i < samples; i++ {
+				wi, weight, ok := surf.sample(n, wo, rng.next(), rng.next(), rng.next())
+				if ok {
+					if wi.Dot(n) <= 0 {
+						t.Fatalf("sampled %v below the surface", wi)
+					}
+					if !approxEqual(wi.Len(), 1) {
+						t.Fatalf("sampled %v isn't normalized", wi)
+					}
+					importance = importance.Add(weight)
+				}
+
+				// uniform hemisphere, the density is 1 / 2pi
+				z := rng.next()
+				r := float32(math.Sqrt(math.Max(0, float64(1-z*z))))
+				phi := 2 * math.Pi * float64(rng.next())
+				wu := mgl32.Vec3{r * float32(math.Cos(phi)), r * float32(math.Sin(phi)), z}
+				f, _ := surf.eval(n, wo, wu)
+				uniform = uniform.Add(f.Mul(z * 2 * math.Pi))
+			}
+			importance = importance.Mul(1.0 / samples)
+			uniform = uniform.Mul(1.0 / samples)
+
+			for c := 0; c < 3; c++ {
+				if math.Abs(float64(importance[c]-uniform[c])) > 0.02+0.03*float64(uniform[c]) {
+					t.Errorf("reflected light sampled by the material = %v, uniformly sampled = %v", importance, uniform)
+					break
+				}
+			}
+			if a := maxComponent(importance); a < tc.min || a > tc.max {
+				t.Errorf("reflected light = %v, expected between %v and %v", importance, tc.min, tc.max)
+			}
+		})
+	}
+}
+
+func TestSurfaceSampleDielectric(t *testing.T) {
+	glass := Material{Transparency: 0, OpticalDensity: 1.5}
+	surf := glass.surfaceAt(mgl32.Vec2{})
+	n := mgl32.Vec3{0, 0, 1}
+
+	// 4% of the light is reflected at normal incidence, the rest goes straight through
+	wo := mgl32.Vec3{0, 0, 1}
+	if wi := surf.sampleDielectric(n, wo, false, 0.01); !wi.ApproxEqualThreshold(wo, 1e-5) {
+		t.Errorf("reflected = %v, expected %v", wi, wo)
+	}
+	if wi := surf.sampleDielectric(n, wo, false, 0.05); !wi.ApproxEqualThreshold(wo.Mul(-1), 1e-5) {
+		t.Errorf("refracted = %v, expected %v", wi, wo.Mul(-1))
+	}
+
+	// Snell's law entering the glass
+	wo = mgl32.Vec3{float32(math.Sin(math.Pi / 4)), 0, float32(math.Cos(math.Pi / 4))}
+	wi := surf.sampleDielectric(n, wo, false, 0.99)
+	sinT := math.Sqrt(float64(wi[0]*wi[0] + wi[1]*wi[1]))
+	if wi[2] >= 0 || math.Abs(sinT-math.Sin(math.Pi/4)/1.5) > 1e-4 {
+		t.Errorf("refracted = %v, expected a sine of %v below the surface", wi, math.Sin(math.Pi/4)/1.5)
+	}
+
+	// total internal reflection leaving the glass at a grazing angle
+	wo = mgl32.Vec3{0.9, 0, float32(math.Sqrt(1 - 0.81))}
+	if wi := surf.sampleDielectric(n, wo, true, 0.99); !wi.ApproxEqualThreshold(mgl32.Vec3{-wo[0], 0, wo[2]}, 1e-5) {
+		t.Errorf("reflected = %v, expected %v", wi, mgl32.Vec3{-wo[0], 0, wo[2]})
+	}
+}
+
+func TestMaterialTexture(t *testing.T) {
+	tex := &Texture{width: 2, height: 1, pix: []mgl32.Vec3{{1, 0, 0}, {0, 1, 0}}}
+	m := Material{Diffuse: mgl32.Vec3{0, 0, 1}, DiffuseTexture: tex, SpecularExp: 1}
+	if s := m.surfaceAt(mgl32.Vec2{0.25, 0.5}); !s.diffuse.ApproxEqual(mgl32.Vec3{1, 0, 0}) {
+		t.Errorf("diffuse = %v, expected the first texel", s.diffuse)
+	}
+	// the textures repeat
+	if s := m.surfaceAt(mgl32.Vec2{1.75, -0.5}); !s.diffuse.ApproxEqual(mgl32.Vec3{0, 1, 0}) {
+		t.Errorf("diffuse = %v, expected the second texel", s.diffuse)
+	}
+}
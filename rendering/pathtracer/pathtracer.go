@@ -0,0 +1,311 @@
+// Package pathtracer is a progressive, physically based path tracer running on the CPU.
+// It renders the Rendered view skin of the viewport and the reference images of the headless tools,
+// which don't need a GPU for it.
+package pathtracer
+
+import (
+	"image"
+	"math"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+// russianRouletteBounce is the bounce after which the dim paths are terminated randomly
+const russianRouletteBounce = 3
+
+// Options are the settings of a render
+type Options struct {
+	// Bounces is the maximum number of surface interactions along a path
+	Bounces int
+	// Exposure scales the light before the tone mapping
+	Exposure float32
+	// Workers is the number of goroutines rendering a pass, all CPUs when 0
+	Workers int
+}
+
+// DefaultOptions ...
+func DefaultOptions() Options {
+	return Options{Bounces: 6, Exposure: 1.0}
+}
+
+// Renderer accumulates passes of one sample per pixel into a progressively refined image
+type Renderer struct {
+	scene         *Scene
+	camera        Camera
+	options       Options
+	width, height int
+
+	mutex  sync.Mutex
+	accum  []float32
+	passes int
+
+	cancelled int32
+}
+
+// NewRenderer returns a renderer of the scene, the scene is built with the first pass
+func NewRenderer(scene *Scene, camera Camera, width, height int, options Options) *Renderer {
+	if options.Workers <= 0 {
+		options.Workers = runtime.NumCPU()
+	}
+	return &Renderer{
+		scene:   scene,
+		camera:  camera,
+		options: options,
+		width:   width,
+		height:  height,
+		accum:   make([]float32, width*height*3),
+	}
+}
+
+// Size returns the image size
+func (r *Renderer) Size() (int, int) {
+	return r.width, r.height
+}
+
+// Passes returns the number of samples per pixel accumulated so far
+func (r *Renderer) Passes() int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.passes
+}
+
+// Cancel stops the running pass, it is thrown away and no more passes are rendered
+func (r *Renderer) Cancel() {
+	atomic.StoreInt32(&r.cancelled, 1)
+}
+
+// Pass renders one more sample per pixel with all workers, it returns false when the renderer was cancelled
+func (r *Renderer) Pass() bool {
+	r.scene.Build()
+	pass := uint64(r.Passes())
+	samples := make([]float32, len(r.accum))
+	row := int32(-1)
+
+	var wg sync.WaitGroup
+	for w := 0; w < r.options.Workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				y := int(atomic.AddInt32(&row, 1))
+				if y >= r.height || atomic.LoadInt32(&r.cancelled) != 0 {
+					return
+				}
+				for x := 0; x < r.width; x++ {
+					// the same random sequence for the pixel and the pass, whichever worker renders it
+					rng := newSampler(pass, uint64(y*r.width+x))
+					primary := r.camera.ray(float32(x)+rng.next(), float32(y)+rng.next())
+					c := r.radiance(primary, &rng)
+					if isFiniteVec(c) {
+						i := (y*r.width + x) * 3
+						samples[i], samples[i+1], samples[i+2] = c[0], c[1], c[2]
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if atomic.LoadInt32(&r.cancelled) != 0 {
+		return false
+	}
+
+	r.mutex.Lock()
+	for i, s := range samples {
+		r.accum[i] += s
+	}
+	r.passes++
+	r.mutex.Unlock()
+	return true
+}
+
+// Render accumulates passes until the image has the samples per pixel and returns it
+func (r *Renderer) Render(samples int, doProgress func(float32)) *image.NRGBA {
+	for r.Passes() < samples {
+		if !r.Pass() {
+			break
+		}
+		doProgress(float32(r.Passes()) / float32(samples) * 100.0)
+	}
+	return r.Image()
+}
+
+// Image returns the average of the passes, tone mapped with the exposure and gamma corrected as the HDR skybox
+func (r *Renderer) Image() *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, r.width, r.height))
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.passes == 0 {
+		return img
+	}
+	scale := r.options.Exposure / float32(r.passes)
+	for i := 0; i < r.width*r.height; i++ {
+		img.Pix[i*4+0] = toneMap(r.accum[i*3+0] * scale)
+		img.Pix[i*4+1] = toneMap(r.accum[i*3+1] * scale)
+		img.Pix[i*4+2] = toneMap(r.accum[i*3+2] * scale)
+		img.Pix[i*4+3] = 255
+	}
+	return img
+}
+
+// Radiance returns the average of the passes as linear RGBA floats scaled by the exposure, for the HDR targets
+func (r *Renderer) Radiance() []float32 {
+	pix := make([]float32, r.width*r.height*4)
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.passes == 0 {
+		return pix
+	}
+	scale := r.options.Exposure / float32(r.passes)
+	for i := 0; i < r.width*r.height; i++ {
+		pix[i*4+0] = r.accum[i*3+0] * scale
+		pix[i*4+1] = r.accum[i*3+1] * scale
+		pix[i*4+2] = r.accum[i*3+2] * scale
+		pix[i*4+3] = 1.0
+	}
+	return pix
+}
+
+func toneMap(c float32) uint8 {
+	mapped := 1.0 - math.Exp(-math.Max(0, float64(c)))
+	return uint8(math.Pow(mapped, 1.0/2.2)*255.0 + 0.5)
+}
+
+// radiance follows the path of the camera ray and returns the light coming back along it
+func (r *Renderer) radiance(primary ray, rng *sampler) mgl32.Vec3 {
+	scene := r.scene
+	var radiance mgl32.Vec3
+	throughput := mgl32.Vec3{1, 1, 1}
+	current := primary
+
+	for bounce := 0; bounce <= r.options.Bounces; bounce++ {
+		h, ok := scene.bvh.intersect(&current, math.MaxFloat32)
+		if !ok {
+			env := scene.Environment.radiance(current.dir)
+			if bounce > 0 {
+				env = mgl32.Vec3{
+					float32(math.Min(float64(env[0]), environmentMaxRadiance)),
+					float32(math.Min(float64(env[1]), environmentMaxRadiance)),
+					float32(math.Min(float64(env[2]), environmentMaxRadiance)),
+				}
+			}
+			radiance = radiance.Add(mulVec(throughput, env))
+			break
+		}
+
+		tri := &scene.bvh.triangles[h.triangle]
+		material := &scene.materials[tri.material]
+		p := current.origin.Add(current.dir.Mul(h.t))
+		w := 1.0 - h.u - h.v
+		n := tri.n0.Mul(w).Add(tri.n1.Mul(h.u)).Add(tri.n2.Mul(h.v)).Normalize()
+		uv := tri.uv0.Mul(w).Add(tri.uv1.Mul(h.u)).Add(tri.uv2.Mul(h.v))
+
+		// both normals face the incoming ray, inside is true when it comes from the back of the triangle
+		ng := tri.normal
+		inside := ng.Dot(current.dir) > 0
+		if inside {
+			ng = ng.Mul(-1)
+		}
+		if n.Dot(ng) < 0 {
+			n = n.Mul(-1)
+		}
+		wo := current.dir.Mul(-1)
+		surf := material.surfaceAt(uv)
+
+		radiance = radiance.Add(mulVec(throughput, material.Emission))
+
+		if surf.opacity < 1.0 && rng.next() >= surf.opacity {
+			// the light that goes through the surface, the white dielectric doesn't change the throughput
+			nd := n
+			if nd.Dot(wo) <= 0 {
+				nd = ng
+			}
+			wi := surf.sampleDielectric(nd, wo, inside, rng.next())
+			current = newRay(offsetOrigin(p, ng, wi), wi)
+		} else {
+			radiance = radiance.Add(mulVec(throughput, r.directLight(&surf, p, n, ng, wo)))
+
+			wi, weight, ok := surf.sample(n, wo, rng.next(), rng.next(), rng.next())
+			if !ok || ng.Dot(wi) <= 0 {
+				break
+			}
+			throughput = mulVec(throughput, weight)
+			current = newRay(offsetOrigin(p, ng, wi), wi)
+		}
+
+		if bounce >= russianRouletteBounce {
+			survive := float32(math.Min(float64(maxComponent(throughput)), 0.95))
+			if rng.next() >= survive {
+				break
+			}
+			throughput = throughput.Mul(1.0 / survive)
+		}
+	}
+	return radiance
+}
+
+// directLight returns the light reflected from all light sources that see the point
+func (r *Renderer) directLight(surf *surface, p, n, ng, wo mgl32.Vec3) mgl32.Vec3 {
+	var direct mgl32.Vec3
+	for i := range r.scene.lights {
+		wi, distance, li := r.scene.lights[i].illuminate(p)
+		if li == (mgl32.Vec3{}) || n.Dot(wi) <= 0 || ng.Dot(wi) <= 0 {
+			continue
+		}
+		f, _ := surf.eval(n, wo, wi)
+		if f == (mgl32.Vec3{}) {
+			continue
+		}
+		shadow := newRay(offsetOrigin(p, ng, wi), wi)
+		if r.scene.bvh.occluded(&shadow, distance*(1.0-1e-4)) {
+			continue
+		}
+		direct = direct.Add(mulVec(f, li).Mul(n.Dot(wi)))
+	}
+	return direct
+}
+
+// offsetOrigin moves the point off the surface to the side of the direction, so that the ray doesn't hit its own triangle
+func offsetOrigin(p, ng, dir mgl32.Vec3) mgl32.Vec3 {
+	scale := float32(1e-4) * float32(math.Max(1.0, float64(maxComponent(mgl32.Vec3{abs(p[0]), abs(p[1]), abs(p[2])}))))
+	if ng.Dot(dir) < 0 {
+		scale = -scale
+	}
+	return p.Add(ng.Mul(scale))
+}
+
+func abs(f float32) float32 {
+	return float32(math.Abs(float64(f)))
+}
+
+func isFiniteVec(c mgl32.Vec3) bool {
+	for _, f := range c {
+		if math.IsNaN(float64(f)) || math.IsInf(float64(f), 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// sampler is a small PCG random number generator
+type sampler struct {
+	state uint64
+}
+
+func newSampler(pass, pixel uint64) sampler {
+	s := sampler{state: pass*0x9E3779B97F4A7C15 ^ (pixel+1)*0xBF58476D1CE4E5B9}
+	s.next()
+	return s
+}
+
+// next returns a uniform number in [0, 1)
+func (s *sampler) next() float32 {
+	old := s.state
+	s.state = old*6364136223846793005 + 1442695040888963407
+	xorshifted := uint32(((old >> 18) ^ old) >> 27)
+	rot := uint32(old >> 59)
+	x := (xorshifted >> rot) | (xorshifted << ((-rot) & 31))
+	return float32(x>>8) / float32(1<<24)
+}
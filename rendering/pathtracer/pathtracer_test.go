@@ -0,0 +1,126 @@
+package pathtracer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+const testImageSize = 32
+
+// addQuad adds the parallelogram with the corner and the two edges
+func addQuad(scene *Scene, corner, e1, e2 mgl32.Vec3, material Material) {
+	vertices := []mgl32.Vec3{corner, corner.Add(e1), corner.Add(e1).Add(e2), corner.Add(e2)}
+	scene.AddMesh(vertices, nil, nil, []uint32{0, 1, 2, 0, 2, 3}, mgl32.Ident4(), material)
+}
+
+// testScene is a unit cube at the origin in front of a wall at z = -1, both grey and diffuse
+func testScene(withWall bool) *Scene {
+	grey := Material{Diffuse: mgl32.Vec3{0.5, 0.5, 0.5}, SpecularExp: 1, Transparency: 1}
+	scene := NewScene()
+	x, y, z := mgl32.Vec3{1, 0, 0}, mgl32.Vec3{0, 1, 0}, mgl32.Vec3{0, 0, 1}
+	min, max := mgl32.Vec3{-0.5, -0.5, -0.5}, mgl32.Vec3{0.5, 0.5, 0.5}
+	addQuad(scene, min, x, y, grey)
+	addQuad(scene, min, y, z, grey)
+	addQuad(scene, min, z, x, grey)
+	addQuad(scene, max, x.Mul(-1), y.Mul(-1), grey)
+	addQuad(scene, max, y.Mul(-1), z.Mul(-1), grey)
+	addQuad(scene, max, z.Mul(-1), x.Mul(-1), grey)
+	if withWall {
+		addQuad(scene, mgl32.Vec3{-4, -4, -1}, x.Mul(8), y.Mul(8), grey)
+	}
+	return scene
+}
+
+func testRender(scene *Scene, workers, samples int) []float32 {
+	matrixCamera := mgl32.LookAtV(mgl32.Vec3{0, 0, 4}, mgl32.Vec3{}, mgl32.Vec3{0, 1, 0})
+	camera := NewCamera(matrixCamera, 45, testImageSize, testImageSize)
+	r := NewRenderer(scene, camera, testImageSize, testImageSize, Options{Bounces: 4, Exposure: 1, Workers: workers})
+	r.Render(samples, func(float32) {})
+	return r.Radiance()
+}
+
+// pixelMean is the average red of the pixels in the rectangle, the scenes are grey
+func pixelMean(pix []float32, x0, y0, x1, y1 int) float32 {
+	sum := float32(0)
+	for y := y0; y < y1; y++ {
+		for x := x0; x < x1; x++ {
+			sum += pix[(y*testImageSize+x)*4]
+		}
+	}
+	return sum / float32((x1-x0)*(y1-y0))
+}
+
+// TestRenderDirectionalLight lights the cube and the wall from the front right, the cube casts its shadow to the left
+func TestRenderDirectionalLight(t *testing.T) {
+	scene := testScene(true)
+	scene.AddLight(Light{Type: types.LightSourceTypeDirectional, Direction: mgl32.Vec3{-1, 0, -1}.Normalize(), Color: mgl32.Vec3{1, 1, 1}})
+	pix := testRender(scene, 0, 16)
+
+	// the front of the cube sees only the light, it reflects albedo / pi * cos
+	lit := float32(0.5 / math.Pi * math.Sqrt(0.5))
+	for y := 13; y < 19; y++ {
+		for x := 13; x < 19; x++ {
+			if c := pix[(y*testImageSize+x)*4]; !approxEqual(c, lit) {
+				t.Fatalf("cube pixel %v, %v = %v, expected %v", x, y, c, lit)
+			}
+		}
+	}
+
+	// the wall gets some more light from the side of the cube, the shadow only that
+	wall := pixelMean(pix, 25, 14, 28, 18)
+	shadow := pixelMean(pix, 4, 14, 7, 18)
+	if wall < lit || wall > lit*1.3 {
+		t.Errorf("lit wall = %v, expected between %v and %v", wall, lit, lit*1.3)
+	}
+	if shadow <= 0 || shadow > lit*0.3 {
+		t.Errorf("shadow = %v, expected some light from the cube below %v", shadow, lit*0.3)
+	}
+
+	for i := 0; i < len(pix); i += 4 {
+		if pix[i] != pix[i+1] || pix[i] != pix[i+2] || pix[i+3] != 1 {
+			t.Fatalf("pixel %v = %v, expected grey and opaque", i/4, pix[i:i+4])
+		}
+	}
+}
+
+// TestRenderEnvironment lights the cube with a white sky, every side of it sees only the sky
+func TestRenderEnvironment(t *testing.T) {
+	scene := testScene(false)
+	scene.Environment = &Environment{Color: mgl32.Vec3{1, 1, 1}}
+	pix := testRender(scene, 0, 16)
+
+	if c := pixelMean(pix, 0, 0, 4, 4); c != 1 {
+		t.Errorf("sky = %v, expected 1", c)
+	}
+	for y := 13; y < 19; y++ {
+		for x := 13; x < 19; x++ {
+			if c := pix[(y*testImageSize+x)*4]; !approxEqual(c, 0.5) {
+				t.Fatalf("cube pixel %v, %v = %v, expected the albedo 0.5", x, y, c)
+			}
+		}
+	}
+
+	// the front of the cube is 0.5 / 3.5 / tan(22.5) of the half image wide, the rest is sky
+	side := 0.5 / 3.5 / math.Tan(math.Pi/8) * testImageSize
+	expected := 1 - 0.5*side*side/(testImageSize*testImageSize)
+	if mean := pixelMean(pix, 0, 0, testImageSize, testImageSize); math.Abs(float64(mean)-expected) > 0.01 {
+		t.Errorf("image mean = %v, expected %v", mean, expected)
+	}
+}
+
+// TestRenderDeterministic renders the same image with one and with several workers
+func TestRenderDeterministic(t *testing.T) {
+	scene := testScene(true)
+	scene.Environment = &Environment{Color: mgl32.Vec3{0.2, 0.3, 0.4}}
+	scene.AddLight(Light{Type: types.LightSourceTypePoint, Position: mgl32.Vec3{2, 2, 2}, Color: mgl32.Vec3{4, 4, 4}, Constant: 1})
+	single := testRender(scene, 1, 4)
+	several := testRender(scene, 4, 4)
+	for i := range single {
+		if single[i] != several[i] {
+			t.Fatalf("pixel %v = %v with one worker and %v with four, expected the same", i/4, single[i], several[i])
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package pathtracer
+
+import (
+	"math"
+	"sync"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+// Light is a point, spot or directional light source, lit the same way as in the rasterizers -
+// the directional lights shine from their position towards the origin, the spot lights point at the origin
+// and the point and spot lights fade with the constant, linear and quadratic attenuation
+type Light struct {
+	Type types.LightSourceType
+
+	// Position is the world position of the point and spot lights, Direction is where the light travels
+	Position, Direction mgl32.Vec3
+	Color               mgl32.Vec3
+
+	CosCutOff, CosOuterCutOff   float32
+	Constant, Linear, Quadratic float32
+}
+
+// LightFromScene returns the light for an interchange scene light
+func LightFromScene(sl types.SceneLight) Light {
+	l := Light{
+		Type:      sl.LightType,
+		Color:     sl.Diffuse.Mul(sl.StrengthDiffuse),
+		Constant:  sl.Constant,
+		Linear:    sl.Linear,
+		Quadratic: sl.Quadratic,
+	}
+
+	// the lamp matrix of the light, the rotation around the center doesn't move it
+	matrixModel := mgl32.Scale3D(sl.Scale.X(), sl.Scale.Y(), sl.Scale.Z())
+	matrixModel = matrixModel.Mul4(mgl32.HomogRotate3D(mgl32.DegToRad(sl.Rotate.X()), mgl32.Vec3{1, 0, 0}))
+	matrixModel = matrixModel.Mul4(mgl32.HomogRotate3D(mgl32.DegToRad(sl.Rotate.Y()), mgl32.Vec3{0, 1, 0}))
+	matrixModel = matrixModel.Mul4(mgl32.HomogRotate3D(mgl32.DegToRad(sl.Rotate.Z()), mgl32.Vec3{0, 0, 1}))
+	matrixModel = matrixModel.Mul4(mgl32.Translate3D(sl.Position.X(), sl.Position.Y(), sl.Position.Z()))
+	l.Position = matrixModel.Col(3).Vec3()
+
+	switch sl.LightType {
+	case types.LightSourceTypeDirectional:
+		l.Direction = sl.Position.Mul(-1)
+		if l.Direction.Len() < 1e-6 {
+			l.Direction = mgl32.Vec3{0, -1, 0}
+		}
+	case types.LightSourceTypeSpot:
+		l.Direction = sl.Position.Mul(-1)
+		if l.Direction.Len() < 1e-6 {
+			l.Direction = mgl32.Vec3{0, -1, 0}
+		}
+		l.CosCutOff = float32(math.Cos(float64(mgl32.DegToRad(sl.CutOff))))
+		l.CosOuterCutOff = float32(math.Cos(float64(mgl32.DegToRad(sl.OuterCutOff))))
+	}
+	l.Direction = l.Direction.Normalize()
+	return l
+}
+
+// illuminate returns the direction to the light, the distance to it and the light arriving at the position
+func (l *Light) illuminate(p mgl32.Vec3) (mgl32.Vec3, float32, mgl32.Vec3) {
+	if l.Type == types.LightSourceTypeDirectional {
+		return l.Direction.Mul(-1), math.MaxFloat32, l.Color
+	}
+
+	toLight := l.Position.Sub(p)
+	distance := toLight.Len()
+	if distance < 1e-6 {
+		return mgl32.Vec3{}, 0, mgl32.Vec3{}
+	}
+	wi := toLight.Mul(1.0 / distance)
+	attenuation := l.Constant + l.Linear*distance + l.Quadratic*distance*distance
+	if attenuation <= 0 {
+		attenuation = 1.0
+	}
+	intensity := float32(1.0)
+	if l.Type == types.LightSourceTypeSpot {
+		theta := wi.Mul(-1).Dot(l.Direction)
+		epsilon := l.CosCutOff - l.CosOuterCutOff
+		if epsilon > 1e-6 {
+			intensity = clamp01((theta - l.CosOuterCutOff) / epsilon)
+		} else if theta < l.CosCutOff {
+			intensity = 0
+		}
+	}
+	return wi, distance, l.Color.Mul(intensity / attenuation)
+}
+
+// Scene is the geometry, the materials, the lights and the environment the path tracer renders
+type Scene struct {
+	Environment *Environment
+
+	triangles []triangle
+	materials []Material
+	lights    []Light
+	bvh       *bvh
+	buildOnce sync.Once
+}
+
+// NewScene returns an empty scene
+func NewScene() *Scene {
+	return &Scene{}
+}
+
+// AddMesh adds the indexed triangles of a mesh, moved into the world by matrixModel.
+// The normals and the texture coordinates are optional, they are used when there is one for every vertex.
+func (s *Scene) AddMesh(vertices, normals []mgl32.Vec3, uvs []mgl32.Vec2, indices []uint32, matrixModel mgl32.Mat4, material Material) {
+	if len(vertices) == 0 || len(indices) < 3 {
+		return
+	}
+
+	matrixNormal := matrixModel.Mat3().Inv().Transpose()
+
+	materialIndex := int32(len(s.materials))
+	s.materials = append(s.materials, material)
+
+	hasNormals := len(normals) == len(vertices)
+	hasUVs := len(uvs) == len(vertices)
+	for i := 0; i+2 < len(indices); i += 3 {
+		i0, i1, i2 := indices[i], indices[i+1], indices[i+2]
+		if int(i0) >= len(vertices) || int(i1) >= len(vertices) || int(i2) >= len(vertices) {
+			continue
+		}
+		p0 := mgl32.TransformCoordinate(vertices[i0], matrixModel)
+		p1 := mgl32.TransformCoordinate(vertices[i1], matrixModel)
+		p2 := mgl32.TransformCoordinate(vertices[i2], matrixModel)
+		tri := triangle{v0: p0, e1: p1.Sub(p0), e2: p2.Sub(p0), material: materialIndex}
+		normal := tri.e1.Cross(tri.e2)
+		if normal.Len() < 1e-12 {
+			continue
+		}
+		tri.normal = normal.Normalize()
+		if hasNormals {
+			tri.n0 = matrixNormal.Mul3x1(normals[i0]).Normalize()
+			tri.n1 = matrixNormal.Mul3x1(normals[i1]).Normalize()
+			tri.n2 = matrixNormal.Mul3x1(normals[i2]).Normalize()
+		} else {
+			tri.n0, tri.n1, tri.n2 = tri.normal, tri.normal, tri.normal
+		}
+		if hasUVs {
+			tri.uv0, tri.uv1, tri.uv2 = uvs[i0], uvs[i1], uvs[i2]
+		}
+		s.triangles = append(s.triangles, tri)
+	}
+}
+
+// AddLight adds a light source
+func (s *Scene) AddLight(l Light) {
+	s.lights = append(s.lights, l)
+}
+
+// Build creates the bounding volume hierarchy once, the scene can't be changed afterwards
+func (s *Scene) Build() {
+	s.buildOnce.Do(func() {
+		s.bvh = buildBVH(s.triangles)
+		s.triangles = nil
+	})
+}
+
+// Triangles returns the number of triangles in the scene
+func (s *Scene) Triangles() int {
+	if s.bvh != nil {
+		return len(s.bvh.triangles)
+	}
+	return len(s.triangles)
+}
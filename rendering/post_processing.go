@@ -0,0 +1,400 @@
+package rendering
+
+import (
+	"github.com/supudo/Kuplung-Go/engine"
+	"github.com/supudo/Kuplung-Go/engine/oglconsts"
+	"github.com/supudo/Kuplung-Go/interfaces"
+	"github.com/supudo/Kuplung-Go/settings"
+)
+
+// postProcessingBloomMips is the length of the bloom mip chain, the smallest mips are skipped for small viewports
+const postProcessingBloomMips = 6
+
+// postProcessingMip is one level of the bloom mip chain
+type postProcessingMip struct {
+	texture       uint32
+	width, height int32
+}
+
+// postProcessing renders the scene into an HDR offscreen target and resolves it to the window through the passes,
+// in order: bloom, exposure and tone mapping, color grading, vignette and FXAA.
+type postProcessing struct {
+	window interfaces.Window
+
+	shaderProgramDownsample uint32
+	shaderProgramUpsample   uint32
+	shaderProgramComposite  uint32
+	shaderProgramFXAA       uint32
+
+	glDownsampleSource, glDownsampleFirstMip int32
+	glUpsampleSource, glUpsampleFilterRadius int32
+
+	glCompositeScene, glCompositeBloom, glCompositeLUT      int32
+	glCompositeBloomInUse, glCompositeBloomStrength         int32
+	glCompositeToneMapping, glCompositeOperator, glExposure int32
+	glCompositeLUTInUse, glCompositeLUTIntensity            int32
+	glCompositeLUTDomainMin, glCompositeLUTDomainMax        int32
+	glCompositeVignetteInUse, glCompositeVignetteIntensity  int32
+	glCompositeVignetteSmoothness, glCompositeImageRegion   int32
+	glFXAASource                                            int32
+
+	fboScene, textureScene, depthScene uint32
+	fboBloom                           uint32
+	bloomMips                          []postProcessingMip
+	fboLDR, textureLDR                 uint32
+	width, height                      int32
+
+	lut                        uint32
+	lutFile                    string
+	lutDomainMin, lutDomainMax [3]float32
+
+	quadVAO, quadVBO uint32
+}
+
+func newPostProcessing(window interfaces.Window) *postProcessing {
+	pp := &postProcessing{}
+	pp.window = window
+	pp.init()
+	return pp
+}
+
+func (pp *postProcessing) init() {
+	sett := settings.GetSettings()
+	gl := pp.window.OpenGL()
+
+	sVertex := engine.GetShaderSource(sett.App.AppFolder + "shaders/post_quad.vert")
+	var err error
+
+	pp.shaderProgramDownsample, err = engine.LinkNewStandardProgram(gl, sVertex, engine.GetShaderSource(sett.App.AppFolder+"shaders/post_bloom_downsample.frag"))
+	if err != nil {
+		settings.LogWarn("[PostProcessing] Can't load the bloom downsample shaders: %v", err)
+	}
+	pp.glDownsampleSource = gl.GLGetUniformLocation(pp.shaderProgramDownsample, gl.Str("sampler_source\x00"))
+	pp.glDownsampleFirstMip = gl.GLGetUniformLocation(pp.shaderProgramDownsample, gl.Str("firstMip\x00"))
+
+	pp.shaderProgramUpsample, err = engine.LinkNewStandardProgram(gl, sVertex, engine.GetShaderSource(sett.App.AppFolder+"shaders/post_bloom_upsample.frag"))
+	if err != nil {
+		settings.LogWarn("[PostProcessing] Can't load the bloom upsample shaders: %v", err)
+	}
+	pp.glUpsampleSource = gl.GLGetUniformLocation(pp.shaderProgramUpsample, gl.Str("sampler_source\x00"))
+	pp.glUpsampleFilterRadius = gl.GLGetUniformLocation(pp.shaderProgramUpsample, gl.Str("filterRadius\x00"))
+
+	pp.shaderProgramComposite, err = engine.LinkNewStandardProgram(gl, sVertex, engine.GetShaderSource(sett.App.AppFolder+"shaders/post_composite.frag"))
+	if err != nil {
+		settings.LogWarn("[PostProcessing] Can't load the composite shaders: %v", err)
+	}
+	pp.glCompositeScene = gl.GLGetUniformLocation(pp.shaderProgramComposite, gl.Str("sampler_scene\x00"))
+	pp.glCompositeBloom = gl.GLGetUniformLocation(pp.shaderProgramComposite, gl.Str("sampler_bloom\x00"))
+	pp.glCompositeLUT = gl.GLGetUniformLocation(pp.shaderProgramComposite, gl.Str("sampler_lut\x00"))
+	pp.glCompositeBloomInUse = gl.GLGetUniformLocation(pp.shaderProgramComposite, gl.Str("bloom_inUse\x00"))
+	pp.glCompositeBloomStrength = gl.GLGetUniformLocation(pp.shaderProgramComposite, gl.Str("bloom_strength\x00"))
+	pp.glCompositeToneMapping = gl.GLGetUniformLocation(pp.shaderProgramComposite, gl.Str("toneMapping_inUse\x00"))
+	pp.glCompositeOperator = gl.GLGetUniformLocation(pp.shaderProgramComposite, gl.Str("toneMapping_operator\x00"))
+	pp.glExposure = gl.GLGetUniformLocation(pp.shaderProgramComposite, gl.Str("exposure\x00"))
+	pp.glCompositeLUTInUse = gl.GLGetUniformLocation(pp.shaderProgramComposite, gl.Str("lut_inUse\x00"))
+	pp.glCompositeLUTIntensity = gl.GLGetUniformLocation(pp.shaderProgramComposite, gl.Str("lut_intensity\x00"))
+	pp.glCompositeLUTDomainMin = gl.GLGetUniformLocation(pp.shaderProgramComposite, gl.Str("lut_domainMin\x00"))
+	pp.glCompositeLUTDomainMax = gl.GLGetUniformLocation(pp.shaderProgramComposite, gl.Str("lut_domainMax\x00"))
+	pp.glCompositeVignetteInUse = gl.GLGetUniformLocation(pp.shaderProgramComposite, gl.Str("vignette_inUse\x00"))
+	pp.glCompositeVignetteIntensity = gl.GLGetUniformLocation(pp.shaderProgramComposite, gl.Str("vignette_intensity\x00"))
+	pp.glCompositeVignetteSmoothness = gl.GLGetUniformLocation(pp.shaderProgramComposite, gl.Str("vignette_smoothness\x00"))
+	pp.glCompositeImageRegion = gl.GLGetUniformLocation(pp.shaderProgramComposite, gl.Str("imageRegion\x00"))
+
+	pp.shaderProgramFXAA, err = engine.LinkNewStandardProgram(gl, sVertex, engine.GetShaderSource(sett.App.AppFolder+"shaders/post_fxaa.frag"))
+	if err != nil {
+		settings.LogWarn("[PostProcessing] Can't load the FXAA shaders: %v", err)
+	}
+	pp.glFXAASource = gl.GLGetUniformLocation(pp.shaderProgramFXAA, gl.Str("sampler_source\x00"))
+
+	pp.fboScene = gl.GenFramebuffers(1)[0]
+	pp.textureScene = gl.GenTextures(1)[0]
+	pp.depthScene = gl.GenRenderbuffers(1)[0]
+	pp.fboBloom = gl.GenFramebuffers(1)[0]
+	pp.fboLDR = gl.GenFramebuffers(1)[0]
+	pp.textureLDR = gl.GenTextures(1)[0]
+
+	quadVertices := []float32{
+		-1.0, 1.0, 0.0, 0.0, 1.0,
+		-1.0, -1.0, 0.0, 0.0, 0.0,
+		1.0, 1.0, 0.0, 1.0, 1.0,
+		1.0, -1.0, 0.0, 1.0, 0.0,
+	}
+	pp.quadVAO = gl.GenVertexArrays(1)[0]
+	pp.quadVBO = gl.GenBuffers(1)[0]
+	gl.BindVertexArray(pp.quadVAO)
+	gl.BindBuffer(oglconsts.ARRAY_BUFFER, pp.quadVBO)
+	gl.BufferData(oglconsts.ARRAY_BUFFER, len(quadVertices)*4, gl.Ptr(quadVertices), oglconsts.STATIC_DRAW)
+	gl.EnableVertexAttribArray(0)
+	gl.VertexAttribPointer(0, 3, oglconsts.FLOAT, false, 5*4, gl.PtrOffset(0))
+	gl.EnableVertexAttribArray(1)
+	gl.VertexAttribPointer(1, 2, oglconsts.FLOAT, false, 5*4, gl.PtrOffset(3*4))
+	gl.BindVertexArray(0)
+
+	gl.CheckForOpenGLErrors("PostProcessing - init")
+	settings.LogInfo("[PostProcessing] Post-processing initialized.")
+}
+
+// resize (re)allocates the offscreen targets when the window size changes
+func (pp *postProcessing) resize(width, height int32) {
+	if width == pp.width && height == pp.height {
+		return
+	}
+	gl := pp.window.OpenGL()
+	pp.width, pp.height = width, height
+
+	// HDR scene, the depth format is the one of the deferred g-buffer so that its depth can be blitted in
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, pp.fboScene)
+	pp.allocateTexture(pp.textureScene, oglconsts.RGBA16F, oglconsts.RGBA, oglconsts.FLOAT, width, height)
+	gl.FramebufferTexture2D(oglconsts.FRAMEBUFFER, oglconsts.COLOR_ATTACHMENT0, oglconsts.TEXTURE_2D, pp.textureScene, 0)
+	gl.BindRenderbuffer(oglconsts.RENDERBUFFER, pp.depthScene)
+	gl.RenderbufferStorage(oglconsts.RENDERBUFFER, oglconsts.DEPTH_COMPONENT, width, height)
+	gl.FramebufferRenderbuffer(oglconsts.FRAMEBUFFER, oglconsts.DEPTH_ATTACHMENT, oglconsts.RENDERBUFFER, pp.depthScene)
+	if gl.CheckFramebufferStatus(oglconsts.FRAMEBUFFER) != oglconsts.FRAMEBUFFER_COMPLETE {
+		settings.LogWarn("[PostProcessing] Scene framebuffer (%vx%v) is not complete!", width, height)
+	}
+
+	// LDR result of the composite, FXAA runs on it
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, pp.fboLDR)
+	pp.allocateTexture(pp.textureLDR, oglconsts.RGBA8, oglconsts.RGBA, oglconsts.UNSIGNED_BYTE, width, height)
+	gl.FramebufferTexture2D(oglconsts.FRAMEBUFFER, oglconsts.COLOR_ATTACHMENT0, oglconsts.TEXTURE_2D, pp.textureLDR, 0)
+	if gl.CheckFramebufferStatus(oglconsts.FRAMEBUFFER) != oglconsts.FRAMEBUFFER_COMPLETE {
+		settings.LogWarn("[PostProcessing] LDR framebuffer (%vx%v) is not complete!", width, height)
+	}
+
+	// bloom mips, each half the size of the previous one
+	for _, mip := range pp.bloomMips {
+		gl.DeleteTextures([]uint32{mip.texture})
+	}
+	pp.bloomMips = pp.bloomMips[:0]
+	mipWidth, mipHeight := width, height
+	for i := 0; i < postProcessingBloomMips; i++ {
+		mipWidth, mipHeight = mipWidth/2, mipHeight/2
+		if mipWidth < 2 || mipHeight < 2 {
+			break
+		}
+		mip := postProcessingMip{texture: gl.GenTextures(1)[0], width: mipWidth, height: mipHeight}
+		pp.allocateTexture(mip.texture, oglconsts.R11F_G11F_B10F, oglconsts.RGB, oglconsts.FLOAT, mipWidth, mipHeight)
+		pp.bloomMips = append(pp.bloomMips, mip)
+	}
+
+	gl.BindTexture(oglconsts.TEXTURE_2D, 0)
+	gl.BindRenderbuffer(oglconsts.RENDERBUFFER, 0)
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, 0)
+
+	gl.CheckForOpenGLErrors("PostProcessing - resize")
+}
+
+func (pp *postProcessing) allocateTexture(texture, internalFormat, format, xtype uint32, width, height int32) {
+	gl := pp.window.OpenGL()
+	gl.BindTexture(oglconsts.TEXTURE_2D, texture)
+	gl.TexImage2D(oglconsts.TEXTURE_2D, 0, internalFormat, width, height, 0, format, xtype, nil)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_MIN_FILTER, oglconsts.LINEAR)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_MAG_FILTER, oglconsts.LINEAR)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_WRAP_S, oglconsts.CLAMP_TO_EDGE)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_WRAP_T, oglconsts.CLAMP_TO_EDGE)
+}
+
+// loadLUT uploads the lookup table as a 3D texture when the file changes, a failed file is not retried until it's changed again
+func (pp *postProcessing) loadLUT(file string) {
+	if file == pp.lutFile {
+		return
+	}
+	gl := pp.window.OpenGL()
+	pp.lutFile = file
+	if pp.lut != 0 {
+		gl.DeleteTextures([]uint32{pp.lut})
+		pp.lut = 0
+	}
+	if file == "" {
+		return
+	}
+
+	lut, err := engine.LoadCubeLUT(file)
+	if err != nil {
+		settings.LogWarn("[PostProcessing] Can't load the color grading lookup table %v : %v", file, err)
+		return
+	}
+	pp.lutDomainMin, pp.lutDomainMax = lut.DomainMin, lut.DomainMax
+
+	pp.lut = gl.GenTextures(1)[0]
+	gl.BindTexture(oglconsts.TEXTURE_3D, pp.lut)
+	gl.TexImage3D(oglconsts.TEXTURE_3D, 0, oglconsts.RGB16F, int32(lut.Size), int32(lut.Size), int32(lut.Size), 0, oglconsts.RGB, oglconsts.FLOAT, gl.Ptr(lut.Pix))
+	gl.TexParameteri(oglconsts.TEXTURE_3D, oglconsts.TEXTURE_MIN_FILTER, oglconsts.LINEAR)
+	gl.TexParameteri(oglconsts.TEXTURE_3D, oglconsts.TEXTURE_MAG_FILTER, oglconsts.LINEAR)
+	gl.TexParameteri(oglconsts.TEXTURE_3D, oglconsts.TEXTURE_WRAP_S, oglconsts.CLAMP_TO_EDGE)
+	gl.TexParameteri(oglconsts.TEXTURE_3D, oglconsts.TEXTURE_WRAP_T, oglconsts.CLAMP_TO_EDGE)
+	gl.TexParameteri(oglconsts.TEXTURE_3D, oglconsts.TEXTURE_WRAP_R, oglconsts.CLAMP_TO_EDGE)
+	gl.BindTexture(oglconsts.TEXTURE_3D, 0)
+
+	gl.CheckForOpenGLErrors("PostProcessing - loadLUT")
+	settings.LogInfo("[PostProcessing] Loaded the lookup table %v (%v^3)", file, lut.Size)
+}
+
+// Begin binds and clears the HDR target, the scene is drawn into the returned framebuffer
+func (pp *postProcessing) Begin(width, height int) uint32 {
+	gl := pp.window.OpenGL()
+
+	pp.resize(int32(width), int32(height))
+
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, pp.fboScene)
+	gl.Viewport(0, 0, pp.width, pp.height)
+	gl.Clear(oglconsts.COLOR_BUFFER_BIT | oglconsts.DEPTH_BUFFER_BIT)
+	return pp.fboScene
+}
+
+// End runs the passes over the HDR target and writes the result to the target framebuffer, 0 is the window.
+// region is the part of the whole image that is drawn, as x, y, width and height in [0, 1], so that the vignette of a tile is centered on the image.
+func (pp *postProcessing) End(target uint32, region [4]float32) {
+	rsett := settings.GetRenderingSettings()
+	gl := pp.window.OpenGL()
+
+	blend := gl.IsEnabled(oglconsts.BLEND)
+	depthTest := gl.IsEnabled(oglconsts.DEPTH_TEST)
+	gl.Disable(oglconsts.BLEND)
+	gl.Disable(oglconsts.DEPTH_TEST)
+	gl.PolygonMode(oglconsts.FRONT_AND_BACK, oglconsts.FILL)
+	gl.BindVertexArray(pp.quadVAO)
+
+	bloom := rsett.PostProcessing.Bloom && len(pp.bloomMips) > 0
+	if bloom {
+		pp.renderBloom(rsett.PostProcessing.BloomFilterRadius)
+	}
+
+	colorGrading := false
+	if rsett.PostProcessing.ColorGrading {
+		pp.loadLUT(rsett.PostProcessing.ColorGradingLUT)
+		colorGrading = pp.lut != 0
+	}
+
+	// composite, into the target or into the LDR target for the FXAA
+	if rsett.PostProcessing.FXAA {
+		gl.BindFramebuffer(oglconsts.FRAMEBUFFER, pp.fboLDR)
+	} else {
+		gl.BindFramebuffer(oglconsts.FRAMEBUFFER, target)
+	}
+	gl.Viewport(0, 0, pp.width, pp.height)
+	gl.UseProgram(pp.shaderProgramComposite)
+	gl.Uniform1i(pp.glCompositeScene, 0)
+	gl.Uniform1i(pp.glCompositeBloom, 1)
+	gl.Uniform1i(pp.glCompositeLUT, 2)
+	gl.ActiveTexture(oglconsts.TEXTURE0)
+	gl.BindTexture(oglconsts.TEXTURE_2D, pp.textureScene)
+	if bloom {
+		gl.Uniform1i(pp.glCompositeBloomInUse, 1)
+		gl.ActiveTexture(oglconsts.TEXTURE1)
+		gl.BindTexture(oglconsts.TEXTURE_2D, pp.bloomMips[0].texture)
+	} else {
+		gl.Uniform1i(pp.glCompositeBloomInUse, 0)
+	}
+	gl.Uniform1f(pp.glCompositeBloomStrength, rsett.PostProcessing.BloomStrength)
+	if rsett.PostProcessing.ToneMapping {
+		gl.Uniform1i(pp.glCompositeToneMapping, 1)
+	} else {
+		gl.Uniform1i(pp.glCompositeToneMapping, 0)
+	}
+	gl.Uniform1i(pp.glCompositeOperator, rsett.PostProcessing.ToneMappingOperator)
+	gl.Uniform1f(pp.glExposure, rsett.PostProcessing.Exposure)
+	if colorGrading {
+		gl.Uniform1i(pp.glCompositeLUTInUse, 1)
+		gl.ActiveTexture(oglconsts.TEXTURE2)
+		gl.BindTexture(oglconsts.TEXTURE_3D, pp.lut)
+		gl.Uniform3f(pp.glCompositeLUTDomainMin, pp.lutDomainMin[0], pp.lutDomainMin[1], pp.lutDomainMin[2])
+		gl.Uniform3f(pp.glCompositeLUTDomainMax, pp.lutDomainMax[0], pp.lutDomainMax[1], pp.lutDomainMax[2])
+	} else {
+		gl.Uniform1i(pp.glCompositeLUTInUse, 0)
+	}
+	gl.Uniform1f(pp.glCompositeLUTIntensity, rsett.PostProcessing.ColorGradingIntensity)
+	if rsett.PostProcessing.Vignette {
+		gl.Uniform1i(pp.glCompositeVignetteInUse, 1)
+	} else {
+		gl.Uniform1i(pp.glCompositeVignetteInUse, 0)
+	}
+	gl.Uniform1f(pp.glCompositeVignetteIntensity, rsett.PostProcessing.VignetteIntensity)
+	gl.Uniform1f(pp.glCompositeVignetteSmoothness, rsett.PostProcessing.VignetteSmoothness)
+	gl.Uniform4fv(pp.glCompositeImageRegion, &region)
+	gl.DrawArrays(oglconsts.TRIANGLE_STRIP, 0, 4)
+
+	if rsett.PostProcessing.FXAA {
+		gl.BindFramebuffer(oglconsts.FRAMEBUFFER, target)
+		gl.UseProgram(pp.shaderProgramFXAA)
+		gl.Uniform1i(pp.glFXAASource, 0)
+		gl.ActiveTexture(oglconsts.TEXTURE0)
+		gl.BindTexture(oglconsts.TEXTURE_2D, pp.textureLDR)
+		gl.DrawArrays(oglconsts.TRIANGLE_STRIP, 0, 4)
+	}
+
+	gl.BindVertexArray(0)
+	gl.ActiveTexture(oglconsts.TEXTURE0)
+	gl.UseProgram(0)
+	if blend {
+		gl.Enable(oglconsts.BLEND)
+	}
+	if depthTest {
+		gl.Enable(oglconsts.DEPTH_TEST)
+	}
+
+	gl.CheckForOpenGLErrors("PostProcessing - End")
+}
+
+// renderBloom downsamples the HDR scene through the mip chain and blurs it back up to the first mip
+func (pp *postProcessing) renderBloom(filterRadius float32) {
+	gl := pp.window.OpenGL()
+
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, pp.fboBloom)
+
+	gl.UseProgram(pp.shaderProgramDownsample)
+	gl.Uniform1i(pp.glDownsampleSource, 0)
+	gl.ActiveTexture(oglconsts.TEXTURE0)
+	gl.BindTexture(oglconsts.TEXTURE_2D, pp.textureScene)
+	for i, mip := range pp.bloomMips {
+		gl.Viewport(0, 0, mip.width, mip.height)
+		gl.FramebufferTexture2D(oglconsts.FRAMEBUFFER, oglconsts.COLOR_ATTACHMENT0, oglconsts.TEXTURE_2D, mip.texture, 0)
+		if i == 0 {
+			gl.Uniform1i(pp.glDownsampleFirstMip, 1)
+		} else {
+			gl.Uniform1i(pp.glDownsampleFirstMip, 0)
+		}
+		gl.DrawArrays(oglconsts.TRIANGLE_STRIP, 0, 4)
+		gl.BindTexture(oglconsts.TEXTURE_2D, mip.texture)
+	}
+
+	// every upsampled mip is added to the next larger one
+	gl.UseProgram(pp.shaderProgramUpsample)
+	gl.Uniform1i(pp.glUpsampleSource, 0)
+	gl.Uniform1f(pp.glUpsampleFilterRadius, filterRadius)
+	gl.Enable(oglconsts.BLEND)
+	gl.BlendFunc(oglconsts.ONE, oglconsts.ONE)
+	gl.BlendEquation(oglconsts.FUNC_ADD)
+	for i := len(pp.bloomMips) - 1; i > 0; i-- {
+		mip, next := pp.bloomMips[i], pp.bloomMips[i-1]
+		gl.BindTexture(oglconsts.TEXTURE_2D, mip.texture)
+		gl.Viewport(0, 0, next.width, next.height)
+		gl.FramebufferTexture2D(oglconsts.FRAMEBUFFER, oglconsts.COLOR_ATTACHMENT0, oglconsts.TEXTURE_2D, next.texture, 0)
+		gl.DrawArrays(oglconsts.TRIANGLE_STRIP, 0, 4)
+	}
+	gl.Disable(oglconsts.BLEND)
+	gl.BlendFunc(oglconsts.SRC_ALPHA, oglconsts.ONE_MINUS_SRC_ALPHA)
+
+	gl.CheckForOpenGLErrors("PostProcessing - renderBloom")
+}
+
+// Dispose ...
+func (pp *postProcessing) Dispose() {
+	gl := pp.window.OpenGL()
+
+	for _, mip := range pp.bloomMips {
+		gl.DeleteTextures([]uint32{mip.texture})
+	}
+	if pp.lut != 0 {
+		gl.DeleteTextures([]uint32{pp.lut})
+	}
+	gl.DeleteTextures([]uint32{pp.textureScene, pp.textureLDR})
+	gl.DeleteRenderbuffers([]uint32{pp.depthScene})
+	gl.DeleteFramebuffers([]uint32{pp.fboScene, pp.fboBloom, pp.fboLDR})
+	gl.DeleteBuffers([]uint32{pp.quadVBO})
+	gl.DeleteVertexArrays([]uint32{pp.quadVAO})
+	gl.DeleteProgram(pp.shaderProgramDownsample)
+	gl.DeleteProgram(pp.shaderProgramUpsample)
+	gl.DeleteProgram(pp.shaderProgramComposite)
+	gl.DeleteProgram(pp.shaderProgramFXAA)
+}
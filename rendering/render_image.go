@@ -0,0 +1,292 @@
+package rendering
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/sadlil/go-trigger"
+	"github.com/supudo/Kuplung-Go/engine/oglconsts"
+	"github.com/supudo/Kuplung-Go/rendering/renderers"
+	"github.com/supudo/Kuplung-Go/settings"
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+// renderImageMaxTile caps the side of a rendered tile, the g-buffers and the post-processing targets of larger tiles take too much video memory
+const renderImageMaxTile = 4096
+
+// renderImageMaxSize is the largest side of a rendered image
+const renderImageMaxSize = 32768
+
+// renderImageJPEGQuality ...
+const renderImageJPEGQuality = 95
+
+// RenderImageFileName returns the file the Render Image writes, the tokens in the name pattern are replaced with
+// {name} - the file name from the dialog, {width} and {height} - the image size, {renderer} - the renderer,
+// {date} and {time} - the current time, {n} - the first number that doesn't overwrite an existing file
+func RenderImageFileName(folder, name string) string {
+	sett := settings.GetSettings()
+
+	name = strings.TrimSuffix(name, filepath.Ext(name))
+	if name == "" {
+		name = "render"
+	}
+	renderer := "unknown"
+	if reg, ok := renderers.Lookup(sett.App.RendererType); ok {
+		renderer = strings.ReplaceAll(reg.Title, " ", "_")
+	}
+	ext := ".png"
+	if types.RenderImageFormat(sett.RenderImage.Format) == types.RenderImageFormatJPEG {
+		ext = ".jpg"
+	}
+
+	pattern := sett.RenderImage.NamePattern
+	if pattern == "" {
+		pattern = "{name}"
+	}
+	now := time.Now()
+	fileName := strings.NewReplacer(
+		"{name}", name,
+		"{width}", fmt.Sprint(sett.RenderImage.Width),
+		"{height}", fmt.Sprint(sett.RenderImage.Height),
+		"{renderer}", renderer,
+		"{date}", now.Format("2006-01-02"),
+		"{time}", now.Format("150405"),
+	).Replace(pattern)
+
+	if !strings.Contains(fileName, "{n}") {
+		return filepath.Join(folder, fileName+ext)
+	}
+	for n := 1; ; n++ {
+		file := filepath.Join(folder, strings.ReplaceAll(fileName, "{n}", fmt.Sprintf("%03d", n))+ext)
+		if _, err := os.Stat(file); os.IsNotExist(err) {
+			return file
+		}
+	}
+}
+
+func (rm *RenderManager) renderImage(file *types.FBEntity) {
+	rm.pendingImage = file
+}
+
+// writeImage renders the scene offscreen at the Render Image size and saves it
+func (rm *RenderManager) writeImage(file *types.FBEntity) {
+	sett := settings.GetSettings()
+	opts := sett.RenderImage
+
+	path := RenderImageFileName(filepath.Dir(file.Path), file.Title)
+	if opts.Width < 1 || opts.Height < 1 || opts.Width > renderImageMaxSize || opts.Height > renderImageMaxSize {
+		_, _ = trigger.Fire(types.ActionGuiShowError, "Can't render the image", fmt.Sprintf("%v\n\nThe image size has to be between 1 and %v pixels", path, renderImageMaxSize))
+		return
+	}
+
+	start := time.Now()
+	img := rm.renderImageTiles(int(opts.Width), int(opts.Height), int(opts.Supersampling), opts.Transparent)
+	if err := saveRenderImage(path, img, types.RenderImageFormat(opts.Format)); err != nil {
+		settings.LogWarn("[RenderManager] Can't save the rendered image %v : %v", path, err)
+		_, _ = trigger.Fire(types.ActionGuiShowError, "Can't save the rendered image", fmt.Sprintf("%v\n\n%v", path, err))
+		return
+	}
+	settings.LogInfo("[RenderManager] Rendered %vx%v image to %v in %v", opts.Width, opts.Height, path, time.Since(start))
+}
+
+// renderImageTiles draws the scene in tiles small enough for the framebuffers and returns the whole image.
+// Every tile is rendered supersampling times larger and box filtered down to its pixels.
+func (rm *RenderManager) renderImageTiles(width, height, supersampling int, transparent bool) *image.RGBA64 {
+	sett := settings.GetSettings()
+	rsett := settings.GetRenderingSettings()
+	gl := rm.Window.OpenGL()
+
+	if supersampling < 1 {
+		supersampling = 1
+	} else if supersampling > 4 {
+		supersampling = 4
+	}
+	var maxRenderbuffer, maxTexture int32
+	gl.GetIntegerv(oglconsts.MAX_RENDERBUFFER_SIZE, &maxRenderbuffer)
+	gl.GetIntegerv(oglconsts.MAX_TEXTURE_SIZE, &maxTexture)
+	maxTile := renderImageMaxTile
+	if int(maxRenderbuffer) < maxTile {
+		maxTile = int(maxRenderbuffer)
+	}
+	if int(maxTexture) < maxTile {
+		maxTile = int(maxTexture)
+	}
+	tileSize := maxTile / supersampling
+	tileWidth, tileHeight := tileSize, tileSize
+	if width < tileWidth {
+		tileWidth = width
+	}
+	if height < tileHeight {
+		tileHeight = height
+	}
+
+	// 16 bits per channel, so that the 16-bit PNG keeps the precision of the post-processing
+	fbo := gl.GenFramebuffers(1)[0]
+	texture := gl.GenTextures(1)[0]
+	depth := gl.GenRenderbuffers(1)[0]
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, fbo)
+	gl.BindTexture(oglconsts.TEXTURE_2D, texture)
+	gl.TexImage2D(oglconsts.TEXTURE_2D, 0, oglconsts.RGBA16, int32(tileWidth*supersampling), int32(tileHeight*supersampling), 0, oglconsts.RGBA, oglconsts.UNSIGNED_SHORT, nil)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_MIN_FILTER, oglconsts.NEAREST)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_MAG_FILTER, oglconsts.NEAREST)
+	gl.FramebufferTexture2D(oglconsts.FRAMEBUFFER, oglconsts.COLOR_ATTACHMENT0, oglconsts.TEXTURE_2D, texture, 0)
+	gl.BindRenderbuffer(oglconsts.RENDERBUFFER, depth)
+	gl.RenderbufferStorage(oglconsts.RENDERBUFFER, oglconsts.DEPTH_COMPONENT, int32(tileWidth*supersampling), int32(tileHeight*supersampling))
+	gl.FramebufferRenderbuffer(oglconsts.FRAMEBUFFER, oglconsts.DEPTH_ATTACHMENT, oglconsts.RENDERBUFFER, depth)
+	if gl.CheckFramebufferStatus(oglconsts.FRAMEBUFFER) != oglconsts.FRAMEBUFFER_COMPLETE {
+		settings.LogWarn("[RenderManager] Render Image framebuffer (%vx%v) is not complete!", tileWidth*supersampling, tileHeight*supersampling)
+	}
+	gl.BindTexture(oglconsts.TEXTURE_2D, 0)
+	gl.BindRenderbuffer(oglconsts.RENDERBUFFER, 0)
+
+	if transparent {
+		gl.ClearColor(0, 0, 0, 0)
+	}
+
+	reg, rend := rm.activeRenderer()
+	matrixProjection := rsett.MatrixProjection
+	projection := mgl32.Perspective(mgl32.DegToRad(rsett.General.Fov), float32(width)/float32(height), rsett.General.PlaneClose, rsett.General.PlaneFar)
+
+	img := image.NewRGBA64(image.Rect(0, 0, width, height))
+	pixels := make([]uint16, tileWidth*supersampling*tileHeight*supersampling*4)
+	// the tiles are in OpenGL coordinates, the rows start at the bottom
+	for y := 0; y < height; y += tileSize {
+		for x := 0; x < width; x += tileSize {
+			w, h := tileSize, tileSize
+			if x+w > width {
+				w = width - x
+			}
+			if y+h > height {
+				h = height - y
+			}
+			rw, rh := w*supersampling, h*supersampling
+
+			frame := rm.newFrameContext(rw, rh)
+			frame.MatrixProjection = renderImageTileProjection(projection, x, y, w, h, width, height)
+			frame.Framebuffer = fbo
+			rsett.MatrixProjection = frame.MatrixProjection
+
+			gl.BindFramebuffer(oglconsts.FRAMEBUFFER, fbo)
+			gl.Viewport(0, 0, int32(rw), int32(rh))
+			gl.Clear(oglconsts.COLOR_BUFFER_BIT | oglconsts.DEPTH_BUFFER_BIT)
+			if rsett.PostProcessing.Enabled {
+				frame.Framebuffer = rm.postProcessing.Begin(rw, rh)
+			}
+			if reg.SceneFirst {
+				rend.Render(frame)
+				if !transparent {
+					rm.SkyBox.Render()
+				}
+			} else {
+				if !transparent {
+					rm.SkyBox.Render()
+				}
+				rend.Render(frame)
+			}
+			if rsett.PostProcessing.Enabled {
+				region := [4]float32{float32(x) / float32(width), float32(y) / float32(height), float32(w) / float32(width), float32(h) / float32(height)}
+				rm.postProcessing.End(fbo, region)
+			}
+
+			gl.BindFramebuffer(oglconsts.FRAMEBUFFER, fbo)
+			gl.ReadPixels(0, 0, int32(rw), int32(rh), oglconsts.RGBA, oglconsts.UNSIGNED_SHORT, pixels)
+			resolveRenderImageTile(img, pixels, x, height-y-h, w, h, supersampling, transparent)
+		}
+	}
+
+	rsett.MatrixProjection = matrixProjection
+	gl.ClearColor(sett.AppGui.GUIClearColor[0], sett.AppGui.GUIClearColor[1], sett.AppGui.GUIClearColor[2], sett.AppGui.GUIClearColor[3])
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, 0)
+	ww, wh := rm.Window.Size()
+	gl.Viewport(0, 0, int32(ww), int32(wh))
+	gl.DeleteFramebuffers([]uint32{fbo})
+	gl.DeleteTextures([]uint32{texture})
+	gl.DeleteRenderbuffers([]uint32{depth})
+
+	gl.CheckForOpenGLErrors("RenderManager - renderImageTiles")
+	return img
+}
+
+// renderImageTileProjection narrows the projection of the whole image to the tile at x, y with size w, h
+func renderImageTileProjection(projection mgl32.Mat4, x, y, w, h, width, height int) mgl32.Mat4 {
+	left, right := 2*float32(x)/float32(width)-1, 2*float32(x+w)/float32(width)-1
+	bottom, top := 2*float32(y)/float32(height)-1, 2*float32(y+h)/float32(height)-1
+	scale := mgl32.Scale3D(2/(right-left), 2/(top-bottom), 1)
+	translate := mgl32.Translate3D(-(right+left)/(right-left), -(top+bottom)/(top-bottom), 0)
+	return translate.Mul4(scale).Mul4(projection)
+}
+
+// resolveRenderImageTile box filters the supersampled pixels of a tile into the image at x, top,
+// the pixels are premultiplied as they are blended in the framebuffer
+func resolveRenderImageTile(img *image.RGBA64, pixels []uint16, x, top, w, h, supersampling int, transparent bool) {
+	rowLength := w * supersampling * 4
+	count := uint32(supersampling * supersampling)
+	for ty := 0; ty < h; ty++ {
+		for tx := 0; tx < w; tx++ {
+			var sum [4]uint32
+			for sy := 0; sy < supersampling; sy++ {
+				row := (ty*supersampling + sy) * rowLength
+				for sx := 0; sx < supersampling; sx++ {
+					p := row + (tx*supersampling+sx)*4
+					sum[0] += uint32(pixels[p])
+					sum[1] += uint32(pixels[p+1])
+					sum[2] += uint32(pixels[p+2])
+					sum[3] += uint32(pixels[p+3])
+				}
+			}
+			var c [4]uint16
+			for i := 0; i < 4; i++ {
+				c[i] = uint16((sum[i] + count/2) / count)
+			}
+			if !transparent {
+				c[3] = 0xffff
+			}
+			for i := 0; i < 3; i++ {
+				if c[i] > c[3] {
+					c[i] = c[3]
+				}
+			}
+			// OpenGL rows start at the bottom
+			o := img.PixOffset(x+tx, top+h-1-ty)
+			for i := 0; i < 4; i++ {
+				img.Pix[o+i*2] = uint8(c[i] >> 8)
+				img.Pix[o+i*2+1] = uint8(c[i])
+			}
+		}
+	}
+}
+
+// saveRenderImage encodes the image in the format, JPEG has no alpha and is always opaque
+func saveRenderImage(path string, img *image.RGBA64, format types.RenderImageFormat) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case types.RenderImageFormatPNG16:
+		err = png.Encode(f, img)
+	case types.RenderImageFormatJPEG:
+		rgba := image.NewRGBA(img.Bounds())
+		draw.Draw(rgba, rgba.Bounds(), image.Black, image.Point{}, draw.Src)
+		draw.Draw(rgba, rgba.Bounds(), img, image.Point{}, draw.Over)
+		err = jpeg.Encode(f, rgba, &jpeg.Options{Quality: renderImageJPEGQuality})
+	default:
+		rgba := image.NewRGBA(img.Bounds())
+		draw.Draw(rgba, rgba.Bounds(), img, image.Point{}, draw.Src)
+		err = png.Encode(f, rgba)
+	}
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -16,6 +16,15 @@ import (
 	"github.com/supudo/Kuplung-Go/types"
 )
 
+func init() {
+	Register(Registration{
+		ID:         types.InAppRendererTypeDeferred,
+		Title:      "Deferred",
+		SceneFirst: true,
+		New:        func(window interfaces.Window) Renderer { return NewRendererDefered(window) },
+	})
+}
+
 // RendererDefered ...
 type RendererDefered struct {
 	window interfaces.Window
@@ -37,6 +46,8 @@ type RendererDefered struct {
 	mfLightsSpot        []*types.ModelFaceLightSourceSpot
 
 	gBuffer, gPosition, gNormal, gAlbedoSpec uint32
+	gDepth                                   uint32
+	gBufferWidth, gBufferHeight              int
 
 	lightPositions  []mgl32.Vec3
 	lightColors     []mgl32.Vec3
@@ -50,6 +61,12 @@ type RendererDefered struct {
 	cubeVBO uint32
 
 	fbWidth, fbHeight int
+	framebuffer       uint32
+
+	pointShadows        *pointShadows
+	pointShadowUniforms pointShadowUniforms
+
+	ssao *ssao
 }
 
 // NewRendererDefered ...
@@ -67,6 +84,13 @@ func (rend *RendererDefered) Init() {
 	rend.GLSLLightSourceNumberPoint = 0
 	rend.GLSLLightSourceNumberSpot = 0
 
+	if rend.pointShadows == nil {
+		rend.pointShadows = newPointShadows(rend.window)
+	}
+	if rend.ssao == nil {
+		rend.ssao = newSSAO(rend.window)
+	}
+
 	rend.initGeometryPass()
 	rend.initLighingPass()
 	rend.initLightObjects()
@@ -105,13 +129,14 @@ func (rend *RendererDefered) initLighingPass() {
 	gl := rend.window.OpenGL()
 
 	sVertex := engine.GetShaderSource(sett.App.AppFolder + "shaders/deferred_shading.vert")
-	sFragment := engine.GetShaderSource(sett.App.AppFolder + "shaders/deferred_shading.frag")
+	sFragment := engine.GetShaderSourcePartial(sett.App.AppFolder+"shaders/deferred_shading.frag") + engine.GetShaderSource(sett.App.AppFolder+"shaders/shadow_cubemaps.frag")
 
 	var err error
 	rend.shaderProgramLightingPass, err = engine.LinkNewStandardProgram(gl, sVertex, sFragment)
 	if err != nil {
 		settings.LogWarn("[RendererDefered] Can't load the renderer defered shaders: %v", err)
 	}
+	rend.pointShadowUniforms = newPointShadowUniforms(gl, rend.shaderProgramLightingPass)
 
 	gl.CheckForOpenGLErrors("DeferedRenderer - initLighingPass")
 	settings.LogInfo("[Defered Renderer] Lighting Pass initialized.")
@@ -143,6 +168,7 @@ func (rend *RendererDefered) initProps() {
 	gl.Uniform1i(gl.GLGetUniformLocation(rend.shaderProgramLightingPass, gl.Str("sampler_position\x00")), 0)
 	gl.Uniform1i(gl.GLGetUniformLocation(rend.shaderProgramLightingPass, gl.Str("sampler_normal\x00")), 1)
 	gl.Uniform1i(gl.GLGetUniformLocation(rend.shaderProgramLightingPass, gl.Str("sampler_albedospec\x00")), 2)
+	gl.Uniform1i(gl.GLGetUniformLocation(rend.shaderProgramLightingPass, gl.Str("sampler_ssao\x00")), 3)
 
 	rend.objectPositions = []mgl32.Vec3{}
 	if rsett.Defered.DeferredTestMode {
@@ -217,21 +243,39 @@ func (rend *RendererDefered) initGBuffer() {
 	gl.DrawBuffers(3, &attachments[0])
 
 	// - Create and attach depth buffer (renderbuffer)
-	rboDepth := gl.GenRenderbuffers(1)[0]
-	gl.BindRenderbuffer(oglconsts.RENDERBUFFER, rboDepth)
+	rend.gDepth = gl.GenRenderbuffers(1)[0]
+	gl.BindRenderbuffer(oglconsts.RENDERBUFFER, rend.gDepth)
 	gl.RenderbufferStorage(oglconsts.RENDERBUFFER, oglconsts.DEPTH_COMPONENT, int32(rend.fbWidth), int32(rend.fbHeight))
-	gl.FramebufferRenderbuffer(oglconsts.FRAMEBUFFER, oglconsts.DEPTH_ATTACHMENT, oglconsts.RENDERBUFFER, rboDepth)
+	gl.FramebufferRenderbuffer(oglconsts.FRAMEBUFFER, oglconsts.DEPTH_ATTACHMENT, oglconsts.RENDERBUFFER, rend.gDepth)
 	// - Finally check if framebuffer is complete
 	if gl.CheckFramebufferStatus(oglconsts.FRAMEBUFFER) != oglconsts.FRAMEBUFFER_COMPLETE {
 		settings.LogError("[Deferred Rendering T GBuffer] Framebuffer not complete!")
 	}
 
 	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, 0)
+	rend.gBufferWidth, rend.gBufferHeight = rend.fbWidth, rend.fbHeight
 
 	gl.CheckForOpenGLErrors("DeferedRenderer - initGBuffer")
 	settings.LogInfo("[Defered Renderer] GBuffer initialized.")
 }
 
+// resizeGBuffer recreates the g-buffer when the frame size changes, the Render Image draws frames of its own size
+func (rend *RendererDefered) resizeGBuffer(width, height int) {
+	rend.fbWidth, rend.fbHeight = width, height
+	if rend.fbWidth == rend.gBufferWidth && rend.fbHeight == rend.gBufferHeight {
+		return
+	}
+	rend.disposeGBuffer()
+	rend.initGBuffer()
+}
+
+func (rend *RendererDefered) disposeGBuffer() {
+	gl := rend.window.OpenGL()
+	gl.DeleteFramebuffers([]uint32{rend.gBuffer})
+	gl.DeleteTextures([]uint32{rend.gPosition, rend.gNormal, rend.gAlbedoSpec})
+	gl.DeleteRenderbuffers([]uint32{rend.gDepth})
+}
+
 func (rend *RendererDefered) initLights() {
 	gl := rend.window.OpenGL()
 
@@ -307,27 +351,33 @@ func (rend *RendererDefered) initLights() {
 }
 
 // Render ...
-func (rend *RendererDefered) Render(rp types.RenderProperties, meshModelFaces []*meshes.ModelFace, matrixGrid mgl32.Mat4, camPos mgl32.Vec3, selectedModel int32, lightSources []*objects.Light, w, h int) {
+func (rend *RendererDefered) Render(frame *FrameContext) {
 	rsett := settings.GetRenderingSettings()
 	gl := rend.window.OpenGL()
 
-	rend.fbWidth = w
-	rend.fbHeight = h
+	rend.framebuffer = frame.Framebuffer
+	rend.matrixProjection = frame.MatrixProjection
+	rend.matrixCamera = frame.MatrixCamera
 
 	if rsett.Defered.DeferredRandomizeLightPositions {
 		rend.Init()
 		rsett.Defered.DeferredRandomizeLightPositions = false
 	}
+	rend.resizeGBuffer(frame.Width, frame.Height)
 
-	rend.renderGBuffer(meshModelFaces, selectedModel)
-	rend.renderLightingPass(camPos, lightSources)
+	rend.pointShadows.Render(frame)
+	rend.renderGBuffer(frame.MeshModelFaces, frame.SelectedModel)
+	if rsett.Defered.SSAOEnabled {
+		rend.ssao.Render(rend.gPosition, rend.gNormal, int32(rend.fbWidth), int32(rend.fbHeight), rend.matrixCamera, rend.matrixProjection, rend.framebuffer, rend.renderQuad)
+	}
+	rend.renderLightingPass(frame.CameraPosition, frame.LightSources)
 	if rsett.Defered.DeferredTestLights {
 		rend.renderLightObjects()
 	} else {
 		gl.BindFramebuffer(oglconsts.READ_FRAMEBUFFER, rend.gBuffer)
-		gl.BindFramebuffer(oglconsts.DRAW_FRAMEBUFFER, 0)
+		gl.BindFramebuffer(oglconsts.DRAW_FRAMEBUFFER, rend.framebuffer)
 		gl.BlitFramebuffer(0, 0, int32(rend.fbWidth), int32(rend.fbHeight), 0, 0, int32(rend.fbWidth), int32(rend.fbHeight), oglconsts.DEPTH_BUFFER_BIT, oglconsts.NEAREST)
-		gl.BindFramebuffer(oglconsts.FRAMEBUFFER, 0)
+		gl.BindFramebuffer(oglconsts.FRAMEBUFFER, rend.framebuffer)
 	}
 
 	gl.CheckForOpenGLErrors("DeferedRenderer - Render")
@@ -342,8 +392,8 @@ func (rend *RendererDefered) renderGBuffer(meshModelFaces []*meshes.ModelFace, s
 	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, rend.gBuffer)
 	gl.Clear(oglconsts.COLOR_BUFFER_BIT | oglconsts.DEPTH_BUFFER_BIT)
 	gl.UseProgram(rend.shaderProgramGeometryPass)
-	gl.GLUniformMatrix4fv(gl.GLGetUniformLocation(rend.shaderProgramGeometryPass, gl.Str("projection\x00")), 1, false, &rsett.MatrixProjection[0])
-	gl.GLUniformMatrix4fv(gl.GLGetUniformLocation(rend.shaderProgramGeometryPass, gl.Str("view\x00")), 1, false, &rsett.MatrixCamera[0])
+	gl.GLUniformMatrix4fv(gl.GLGetUniformLocation(rend.shaderProgramGeometryPass, gl.Str("projection\x00")), 1, false, &rend.matrixProjection[0])
+	gl.GLUniformMatrix4fv(gl.GLGetUniformLocation(rend.shaderProgramGeometryPass, gl.Str("view\x00")), 1, false, &rend.matrixCamera[0])
 
 	op := int32(0)
 	if rsett.Defered.DeferredTestMode {
@@ -408,7 +458,7 @@ func (rend *RendererDefered) renderGBuffer(meshModelFaces []*meshes.ModelFace, s
 			mfd.Render(true)
 		}
 	}
-	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, 0)
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, rend.framebuffer)
 	gl.PolygonMode(oglconsts.FRONT_AND_BACK, oglconsts.FILL)
 
 	gl.CheckForOpenGLErrors("DeferedRenderer - renderGBuffer")
@@ -419,6 +469,7 @@ func (rend *RendererDefered) renderLightingPass(camPos mgl32.Vec3, lightSources
 
 	gl.Clear(oglconsts.COLOR_BUFFER_BIT | oglconsts.DEPTH_BUFFER_BIT)
 	gl.UseProgram(rend.shaderProgramLightingPass)
+	rend.pointShadows.apply(&rend.pointShadowUniforms)
 
 	gl.ActiveTexture(oglconsts.TEXTURE0)
 	gl.BindTexture(oglconsts.TEXTURE_2D, rend.gPosition)
@@ -426,6 +477,8 @@ func (rend *RendererDefered) renderLightingPass(camPos mgl32.Vec3, lightSources
 	gl.BindTexture(oglconsts.TEXTURE_2D, rend.gNormal)
 	gl.ActiveTexture(oglconsts.TEXTURE2)
 	gl.BindTexture(oglconsts.TEXTURE_2D, rend.gAlbedoSpec)
+	gl.ActiveTexture(oglconsts.TEXTURE3)
+	gl.BindTexture(oglconsts.TEXTURE_2D, rend.ssao.Texture())
 
 	// lights
 	// lights
@@ -489,7 +542,8 @@ func (rend *RendererDefered) renderLightingPass(camPos mgl32.Vec3, lightSources
 				gl.Uniform1i(f.InUse, 1)
 
 				// light
-				gl.Uniform3f(f.Direction, light.PositionX.Point, light.PositionY.Point, light.PositionZ.Point)
+				direction := spotLightDirection(light)
+				gl.Uniform3f(f.Direction, direction.X(), direction.Y(), direction.Z())
 				gl.Uniform3f(f.Position, light.MatrixModel[4*3+0], light.MatrixModel[4*3+1], light.MatrixModel[4*3+2])
 
 				// cutoff
@@ -566,6 +620,11 @@ func (rend *RendererDefered) renderLightingPass(camPos mgl32.Vec3, lightSources
 	gl.Uniform3fv(gl.GLGetUniformLocation(rend.shaderProgramLightingPass, gl.Str("viewPos\x00")), 1, &camPos[0])
 	gl.Uniform1i(gl.GLGetUniformLocation(rend.shaderProgramLightingPass, gl.Str("draw_mode\x00")), rsett.Defered.LightingPassDrawMode+1)
 	gl.Uniform1f(gl.GLGetUniformLocation(rend.shaderProgramLightingPass, gl.Str("ambientStrength\x00")), rsett.Defered.DeferredAmbientStrength)
+	if rsett.Defered.SSAOEnabled {
+		gl.Uniform1i(gl.GLGetUniformLocation(rend.shaderProgramLightingPass, gl.Str("ssao_inUse\x00")), 1)
+	} else {
+		gl.Uniform1i(gl.GLGetUniformLocation(rend.shaderProgramLightingPass, gl.Str("ssao_inUse\x00")), 0)
+	}
 	gl.Uniform1f(gl.GLGetUniformLocation(rend.shaderProgramLightingPass, gl.Str("gammaCoeficient\x00")), rsett.General.GammaCoeficient)
 	rend.renderQuad()
 
@@ -577,13 +636,13 @@ func (rend *RendererDefered) renderLightObjects() {
 	gl := rend.window.OpenGL()
 
 	gl.BindFramebuffer(oglconsts.READ_FRAMEBUFFER, rend.gBuffer)
-	gl.BindFramebuffer(oglconsts.DRAW_FRAMEBUFFER, 0)
+	gl.BindFramebuffer(oglconsts.DRAW_FRAMEBUFFER, rend.framebuffer)
 	gl.BlitFramebuffer(0, 0, int32(rend.fbWidth), int32(rend.fbHeight), 0, 0, int32(rend.fbWidth), int32(rend.fbHeight), oglconsts.DEPTH_BUFFER_BIT, oglconsts.NEAREST)
-	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, 0)
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, rend.framebuffer)
 
 	gl.UseProgram(rend.shaderProgramLightBox)
-	gl.GLUniformMatrix4fv(gl.GLGetUniformLocation(rend.shaderProgramLightBox, gl.Str("projection\x00")), 1, false, &rsett.MatrixProjection[0])
-	gl.GLUniformMatrix4fv(gl.GLGetUniformLocation(rend.shaderProgramLightBox, gl.Str("view\x00")), 1, false, &rsett.MatrixCamera[0])
+	gl.GLUniformMatrix4fv(gl.GLGetUniformLocation(rend.shaderProgramLightBox, gl.Str("projection\x00")), 1, false, &rend.matrixProjection[0])
+	gl.GLUniformMatrix4fv(gl.GLGetUniformLocation(rend.shaderProgramLightBox, gl.Str("view\x00")), 1, false, &rend.matrixCamera[0])
 	for i := int32(0); i < rsett.Defered.DeferredTestLightsNumber; i++ {
 		matrixModel := mgl32.Ident4()
 		matrixModel = matrixModel.Mul4(mgl32.Translate3D(rend.lightPositions[i].X(), rend.lightPositions[i].Y(), rend.lightPositions[i].Z()))
@@ -698,4 +757,7 @@ func (rend *RendererDefered) Dispose() {
 	gl.DeleteProgram(rend.shaderProgramGeometryPass)
 	gl.DeleteProgram(rend.shaderProgramLightingPass)
 	gl.DeleteProgram(rend.shaderProgramLightBox)
+	rend.disposeGBuffer()
+	rend.pointShadows.Dispose()
+	rend.ssao.Dispose()
 }
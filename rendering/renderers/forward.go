@@ -1,19 +1,22 @@
 package renderers
 
 import (
-	"fmt"
-	"math"
-
 	"github.com/go-gl/mathgl/mgl32"
 	"github.com/supudo/Kuplung-Go/engine"
 	"github.com/supudo/Kuplung-Go/engine/oglconsts"
 	"github.com/supudo/Kuplung-Go/interfaces"
-	"github.com/supudo/Kuplung-Go/meshes"
-	"github.com/supudo/Kuplung-Go/objects"
 	"github.com/supudo/Kuplung-Go/settings"
 	"github.com/supudo/Kuplung-Go/types"
 )
 
+func init() {
+	Register(Registration{
+		ID:    types.InAppRendererTypeForward,
+		Title: "Forward",
+		New:   func(window interfaces.Window) Renderer { return NewRendererForward(window) },
+	})
+}
+
 // RendererForward ...
 type RendererForward struct {
 	window interfaces.Window
@@ -29,11 +32,9 @@ type RendererForward struct {
 
 	glFS_solidSkin_materialColor int32
 
-	GLSL_LightSourceNumber_Directional, GLSL_LightSourceNumber_Point, GLSL_LightSourceNumber_Spot uint32
-
-	mfLights_Directional []*types.ModelFaceLightSourceDirectional
-	mfLights_Point       []*types.ModelFaceLightSourcePoint
-	mfLights_Spot        []*types.ModelFaceLightSourceSpot
+	// the lights are binned into clusters every frame, up to lightClustersMaxLights of them
+	clusters        *lightClusters
+	clusterUniforms lightClustersUniforms
 
 	// variables
 	glVS_MVPMatrix, glFS_MMatrix, glVS_WorldMatrix, glVS_NormalMatrix, glFS_MVMatrix int32
@@ -68,6 +69,13 @@ type RendererForward struct {
 
 	// PBR
 	glPBR_UsePBR, glPBR_Metallic, glPBR_Rougness, glPBR_AO int32
+	iblUniforms                                            iblUniforms
+
+	// shadows, set by the forward shadow mapping renderer
+	shadows             *shadowAtlas
+	shadowUniforms      shadowUniforms
+	pointShadows        *pointShadows
+	pointShadowUniforms pointShadowUniforms
 }
 
 // NewRendererForward ...
@@ -75,15 +83,18 @@ func NewRendererForward(window interfaces.Window) *RendererForward {
 	rend := &RendererForward{}
 	rend.window = window
 
-	rend.GLSL_LightSourceNumber_Directional = 8
-	rend.GLSL_LightSourceNumber_Point = 4
-	rend.GLSL_LightSourceNumber_Spot = 4
+	rend.clusters = newLightClusters(window)
 
-	rend.CompileShaders()
+	rend.Init()
 
 	return rend
 }
 
+// Init ...
+func (rend *RendererForward) Init() {
+	rend.CompileShaders()
+}
+
 // CompileShaders ...
 func (rend *RendererForward) CompileShaders() {
 	sett := settings.GetSettings()
@@ -105,6 +116,8 @@ func (rend *RendererForward) CompileShaders() {
 
 	rend.glFS_showShadows = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("fs_showShadows\x00"))
 	rend.glFS_ShadowPass = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("fs_shadowPass\x00"))
+	rend.shadowUniforms = newShadowUniforms(gl, rend.shaderProgram)
+	rend.pointShadowUniforms = newPointShadowUniforms(gl, rend.shaderProgram)
 
 	rend.glFS_planeClose = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("fs_planeClose\x00"))
 	rend.glFS_planeFar = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("fs_planeFar\x00"))
@@ -146,71 +159,8 @@ func (rend *RendererForward) CompileShaders() {
 	rend.solidLight.StrengthDiffuse = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("solidSkin_Light.strengthDiffuse\x00"))
 	rend.solidLight.StrengthSpecular = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("solidSkin_Light.strengthSpecular\x00"))
 
-	// light - directional
-	var i uint32
-	for i = 0; i < rend.GLSL_LightSourceNumber_Directional; i++ {
-		f := &types.ModelFaceLightSourceDirectional{}
-
-		f.InUse = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("directionalLights["+fmt.Sprint(i)+"].inUse\x00"))
-
-		f.Direction = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("directionalLights["+fmt.Sprint(i)+"].direction\x00"))
-
-		f.Ambient = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("directionalLights["+fmt.Sprint(i)+"].ambient\x00"))
-		f.Diffuse = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("directionalLights["+fmt.Sprint(i)+"].diffuse\x00"))
-		f.Specular = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("directionalLights["+fmt.Sprint(i)+"].specular\x00"))
-
-		f.StrengthAmbient = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("directionalLights["+fmt.Sprint(i)+"].strengthAmbient\x00"))
-		f.StrengthDiffuse = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("directionalLights["+fmt.Sprint(i)+"].strengthDiffuse\x00"))
-		f.StrengthSpecular = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("directionalLights["+fmt.Sprint(i)+"].strengthSpecular\x00"))
-		rend.mfLights_Directional = append(rend.mfLights_Directional, f)
-	}
-
-	// light - point
-	for i = 0; i < rend.GLSL_LightSourceNumber_Point; i++ {
-		f := &types.ModelFaceLightSourcePoint{}
-
-		f.InUse = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("pointLights["+fmt.Sprint(i)+"].inUse\x00"))
-		f.Position = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("pointLights["+fmt.Sprint(i)+"].position\x00"))
-
-		f.Constant = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("pointLights["+fmt.Sprint(i)+"].constant\x00"))
-		f.Linear = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("pointLights["+fmt.Sprint(i)+"].linear\x00"))
-		f.Quadratic = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("pointLights["+fmt.Sprint(i)+"].quadratic\x00"))
-
-		f.Ambient = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("pointLights["+fmt.Sprint(i)+"].ambient\x00"))
-		f.Diffuse = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("pointLights["+fmt.Sprint(i)+"].diffuse\x00"))
-		f.Specular = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("pointLights["+fmt.Sprint(i)+"].specular\x00"))
-
-		f.StrengthAmbient = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("pointLights["+fmt.Sprint(i)+"].strengthAmbient\x00"))
-		f.StrengthDiffuse = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("pointLights["+fmt.Sprint(i)+"].strengthDiffuse\x00"))
-		f.StrengthSpecular = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("pointLights["+fmt.Sprint(i)+"].strengthSpecular\x00"))
-		rend.mfLights_Point = append(rend.mfLights_Point, f)
-	}
-
-	// light - spot
-	for i = 0; i < rend.GLSL_LightSourceNumber_Spot; i++ {
-		f := &types.ModelFaceLightSourceSpot{}
-
-		f.InUse = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].inUse\x00"))
-
-		f.Position = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].position\x00"))
-		f.Direction = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].direction\x00"))
-
-		f.CutOff = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].cutOff\x00"))
-		f.OuterCutOff = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].outerCutOff\x00"))
-
-		f.Constant = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].constant\x00"))
-		f.Linear = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].linear\x00"))
-		f.Quadratic = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].quadratic\x00"))
-
-		f.Ambient = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].ambient\x00"))
-		f.Diffuse = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].diffuse\x00"))
-		f.Specular = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].specular\x00"))
-
-		f.StrengthAmbient = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].strengthAmbient\x00"))
-		f.StrengthDiffuse = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].strengthDiffuse\x00"))
-		f.StrengthSpecular = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].strengthSpecular\x00"))
-		rend.mfLights_Spot = append(rend.mfLights_Spot, f)
-	}
+	// lights
+	rend.clusterUniforms = newLightClustersUniforms(gl, rend.shaderProgram)
 
 	// material
 	rend.glMaterial_Refraction = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("material.refraction\x00"))
@@ -262,17 +212,37 @@ func (rend *RendererForward) CompileShaders() {
 	rend.glPBR_Metallic = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("fs_PBR_Metallic\x00"))
 	rend.glPBR_Rougness = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("fs_PBR_Roughness\x00"))
 	rend.glPBR_AO = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("fs_PBR_AO\x00"))
+	rend.iblUniforms = newIBLUniforms(gl, rend.shaderProgram)
 
 	gl.CheckForOpenGLErrors("ForwardRenderer")
 }
 
 // Render ...
-func (rend *RendererForward) Render(rp types.RenderProperties, meshModelFaces []*meshes.ModelFace, matrixGrid mgl32.Mat4, camPos mgl32.Vec3, selectedModel int32, lightSources []*objects.Light) {
+func (rend *RendererForward) Render(frame *FrameContext) {
 	gl := rend.window.OpenGL()
 	rsett := settings.GetRenderingSettings()
 
+	rp := frame.RenderProps
+	meshModelFaces := frame.MeshModelFaces
+	matrixGrid := frame.MatrixGrid
+	camPos := frame.CameraPosition
+	selectedModel := frame.SelectedModel
+
+	rend.clusters.Render(frame)
+
 	gl.UseProgram(rend.shaderProgram)
 
+	rend.clusters.apply(&rend.clusterUniforms)
+	if rend.shadows != nil {
+		rend.shadows.apply(&rend.shadowUniforms)
+	}
+	if rend.pointShadows != nil {
+		rend.pointShadows.apply(&rend.pointShadowUniforms)
+	} else {
+		rend.pointShadowUniforms.disable(gl)
+	}
+	rend.iblUniforms.apply(gl, frame.Environment)
+
 	querycount := int32(5)
 	queries := make([]uint32, querycount)
 	currentQuery := int32(0)
@@ -299,18 +269,7 @@ func (rend *RendererForward) Render(rp types.RenderProperties, meshModelFaces []
 		}
 		gl.Enable(oglconsts.CULL_FACE)
 
-		matrixModel := mgl32.Ident4()
-		matrixModel = matrixModel.Mul4(matrixGrid)
-		// scale
-		matrixModel = matrixModel.Mul4(mgl32.Scale3D(mfd.ScaleX.Point, mfd.ScaleY.Point, mfd.ScaleZ.Point))
-		// translate
-		matrixModel = matrixModel.Mul4(mgl32.Translate3D(mfd.PositionX.Point, mfd.PositionY.Point, mfd.PositionZ.Point))
-		// rotate
-		matrixModel = matrixModel.Mul4(mgl32.Translate3D(0, 0, 0))
-		matrixModel = matrixModel.Mul4(mgl32.HomogRotate3D(mgl32.DegToRad(mfd.RotateX.Point), mgl32.Vec3{1, 0, 0}))
-		matrixModel = matrixModel.Mul4(mgl32.HomogRotate3D(mgl32.DegToRad(mfd.RotateY.Point), mgl32.Vec3{0, 1, 0}))
-		matrixModel = matrixModel.Mul4(mgl32.HomogRotate3D(mgl32.DegToRad(mfd.RotateZ.Point), mgl32.Vec3{0, 0, 1}))
-		matrixModel = matrixModel.Mul4(mgl32.Translate3D(0, 0, 0))
+		matrixModel := mfd.ModelMatrix(matrixGrid)
 
 		mfd.MatrixModel = matrixModel
 
@@ -325,11 +284,11 @@ func (rend *RendererForward) Render(rp types.RenderProperties, meshModelFaces []
 		mfd.VertexSphereIsSphere = rsett.General.VertexSphereIsSphere
 		mfd.VertexSphereShowWireframes = rsett.General.VertexSphereShowWireframes
 
-		mvpMatrix := rsett.MatrixProjection.Mul4(rsett.MatrixCamera.Mul4(matrixModel))
+		mvpMatrix := frame.MatrixProjection.Mul4(frame.MatrixCamera.Mul4(matrixModel))
 		gl.GLUniformMatrix4fv(rend.glVS_MVPMatrix, 1, false, &mvpMatrix[0])
 		gl.GLUniformMatrix4fv(rend.glFS_MMatrix, 1, false, &matrixModel[0])
 
-		matrixModelView := rsett.MatrixCamera.Mul4(matrixModel)
+		matrixModelView := frame.MatrixCamera.Mul4(matrixModel)
 		gl.GLUniformMatrix4fv(rend.glFS_MVMatrix, 1, false, &matrixModelView[0])
 
 		matrixNormal := matrixModelView.Inv().Transpose()
@@ -393,9 +352,8 @@ func (rend *RendererForward) Render(rp types.RenderProperties, meshModelFaces []
 		gl.Uniform3f(rend.glFS_CameraPosition, camPos.X(), camPos.Y(), camPos.Z())
 
 		// screen size
-		w, h := rend.window.Size()
-		gl.Uniform1f(rend.glFS_ScreenResX, float32(w))
-		gl.Uniform1f(rend.glFS_ScreenResY, float32(h))
+		gl.Uniform1f(rend.glFS_ScreenResX, float32(frame.Width))
+		gl.Uniform1f(rend.glFS_ScreenResY, float32(frame.Height))
 
 		// Outline color
 		gl.Uniform3f(rend.glFS_OutlineColor, mfd.OutlineColor.X(), mfd.OutlineColor.Y(), mfd.OutlineColor.Z())
@@ -421,7 +379,11 @@ func (rend *RendererForward) Render(rp types.RenderProperties, meshModelFaces []
 		gl.Uniform3f(rend.glFS_solidSkin_materialColor, mfd.SolidLightSkinMaterialColor.X(), mfd.SolidLightSkinMaterialColor.Y(), mfd.SolidLightSkinMaterialColor.Z())
 
 		// shadows
-		gl.Uniform1i(rend.glFS_showShadows, 0)
+		if rend.shadows != nil && mfd.ShowShadows {
+			gl.Uniform1i(rend.glFS_showShadows, 1)
+		} else {
+			gl.Uniform1i(rend.glFS_showShadows, 0)
+		}
 
 		gl.Uniform1i(rend.solidLight.InUse, 1)
 		gl.Uniform3f(rend.solidLight.Direction, rp.SolidLightDirectionX, rp.SolidLightDirectionY, rp.SolidLightDirectionZ)
@@ -432,106 +394,6 @@ func (rend *RendererForward) Render(rp types.RenderProperties, meshModelFaces []
 		gl.Uniform1f(rend.solidLight.StrengthDiffuse, rp.SolidLightDiffuseStrength)
 		gl.Uniform1f(rend.solidLight.StrengthSpecular, rp.SolidLightSpecularStrength)
 
-		// lights
-		lightsCountDirectional := uint32(0)
-		lightsCountPoint := uint32(0)
-		lightsCountSpot := uint32(0)
-		for j := 0; j < len(lightSources); j++ {
-			light := lightSources[j]
-			switch light.LightType {
-			case types.LightSourceTypeDirectional:
-				if lightsCountDirectional < rend.GLSL_LightSourceNumber_Directional {
-					f := rend.mfLights_Directional[lightsCountDirectional]
-
-					gl.Uniform1i(f.InUse, 1)
-
-					// light
-					gl.Uniform3f(f.Direction, light.PositionX.Point, light.PositionY.Point, light.PositionZ.Point)
-
-					// color
-					gl.Uniform3f(f.Ambient, light.Ambient.Color.X(), light.Ambient.Color.Y(), light.Ambient.Color.Z())
-					gl.Uniform3f(f.Diffuse, light.Diffuse.Color.X(), light.Diffuse.Color.Y(), light.Diffuse.Color.Z())
-					gl.Uniform3f(f.Specular, light.Specular.Color.X(), light.Specular.Color.Y(), light.Specular.Color.Z())
-
-					// light factors
-					gl.Uniform1f(f.StrengthAmbient, light.Ambient.Strength)
-					gl.Uniform1f(f.StrengthDiffuse, light.Diffuse.Strength)
-					gl.Uniform1f(f.StrengthSpecular, light.Specular.Strength)
-
-					lightsCountDirectional++
-				}
-			case types.LightSourceTypePoint:
-				if lightsCountPoint < rend.GLSL_LightSourceNumber_Point {
-					f := rend.mfLights_Point[lightsCountPoint]
-
-					gl.Uniform1i(f.InUse, 1)
-
-					// light
-					gl.Uniform3f(f.Position, light.MatrixModel[4*3+0], light.MatrixModel[4*3+1], light.MatrixModel[4*3+2])
-
-					// factors
-					gl.Uniform1f(f.Constant, light.LConstant.Point)
-					gl.Uniform1f(f.Linear, light.LLinear.Point)
-					gl.Uniform1f(f.Quadratic, light.LQuadratic.Point)
-
-					// color
-					gl.Uniform3f(f.Ambient, light.Ambient.Color.X(), light.Ambient.Color.Y(), light.Ambient.Color.Z())
-					gl.Uniform3f(f.Diffuse, light.Diffuse.Color.X(), light.Diffuse.Color.Y(), light.Diffuse.Color.Z())
-					gl.Uniform3f(f.Specular, light.Specular.Color.X(), light.Specular.Color.Y(), light.Specular.Color.Z())
-
-					// light factors
-					gl.Uniform1f(f.StrengthAmbient, light.Ambient.Strength)
-					gl.Uniform1f(f.StrengthDiffuse, light.Diffuse.Strength)
-					gl.Uniform1f(f.StrengthSpecular, light.Specular.Strength)
-
-					lightsCountPoint++
-				}
-			case types.LightSourceTypeSpot:
-				if lightsCountSpot < rend.GLSL_LightSourceNumber_Spot {
-					f := rend.mfLights_Spot[lightsCountSpot]
-
-					gl.Uniform1i(f.InUse, 1)
-
-					// light
-					gl.Uniform3f(f.Direction, light.PositionX.Point, light.PositionY.Point, light.PositionZ.Point)
-					gl.Uniform3f(f.Position, light.MatrixModel[4*3+0], light.MatrixModel[4*3+1], light.MatrixModel[4*3+2])
-
-					// cutoff
-					gl.Uniform1f(f.CutOff, float32(math.Cos(float64(mgl32.DegToRad(light.LCutOff.Point)))))
-					gl.Uniform1f(f.OuterCutOff, float32(math.Cos(float64(mgl32.DegToRad(light.LOuterCutOff.Point)))))
-
-					// factors
-					gl.Uniform1f(f.Constant, light.LConstant.Point)
-					gl.Uniform1f(f.Linear, light.LLinear.Point)
-					gl.Uniform1f(f.Quadratic, light.LQuadratic.Point)
-
-					// color
-					gl.Uniform3f(f.Ambient, light.Ambient.Color.X(), light.Ambient.Color.Y(), light.Ambient.Color.Z())
-					gl.Uniform3f(f.Diffuse, light.Diffuse.Color.X(), light.Diffuse.Color.Y(), light.Diffuse.Color.Z())
-					gl.Uniform3f(f.Specular, light.Specular.Color.X(), light.Specular.Color.Y(), light.Specular.Color.Z())
-
-					// light factors
-					gl.Uniform1f(f.StrengthAmbient, light.Ambient.Strength)
-					gl.Uniform1f(f.StrengthDiffuse, light.Diffuse.Strength)
-					gl.Uniform1f(f.StrengthSpecular, light.Specular.Strength)
-
-					lightsCountSpot++
-				}
-			}
-		}
-
-		for j := lightsCountDirectional; j < rend.GLSL_LightSourceNumber_Directional; j++ {
-			gl.Uniform1i(rend.mfLights_Directional[j].InUse, 0)
-		}
-
-		for j := lightsCountPoint; j < rend.GLSL_LightSourceNumber_Point; j++ {
-			gl.Uniform1i(rend.mfLights_Point[j].InUse, 0)
-		}
-
-		for j := lightsCountSpot; j < rend.GLSL_LightSourceNumber_Spot; j++ {
-			gl.Uniform1i(rend.mfLights_Spot[j].InUse, 0)
-		}
-
 		// material
 		gl.Uniform1f(rend.glMaterial_Refraction, mfd.MaterialRefraction.Point)
 		gl.Uniform1f(rend.glMaterial_SpecularExp, mfd.MaterialSpecularExp.Point)
@@ -678,4 +540,5 @@ func (rend *RendererForward) Dispose() {
 
 	gl.DeleteVertexArrays([]uint32{rend.glVAO})
 	gl.DeleteProgram(rend.shaderProgram)
+	rend.clusters.Dispose()
 }
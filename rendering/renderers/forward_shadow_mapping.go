@@ -1,29 +1,74 @@
 package renderers
 
 import (
-	"github.com/go-gl/mathgl/mgl32"
 	"github.com/supudo/Kuplung-Go/interfaces"
-	"github.com/supudo/Kuplung-Go/meshes"
-	"github.com/supudo/Kuplung-Go/objects"
+	"github.com/supudo/Kuplung-Go/settings"
 	"github.com/supudo/Kuplung-Go/types"
 )
 
-// RendererForwardShadowMapping ...
+func init() {
+	Register(Registration{
+		ID:    types.InAppRendererTypeForwardShadowMapping,
+		Title: "Forward with Shadow Mapping",
+		New:   func(window interfaces.Window) Renderer { return NewRendererForwardShadowMapping(window) },
+	})
+}
+
+// RendererForwardShadowMapping is the forward renderer with shadows from the directional, spot and point lights
 type RendererForwardShadowMapping struct {
 	window interfaces.Window
+
+	forward      *RendererForward
+	shadows      *shadowAtlas
+	pointShadows *pointShadows
 }
 
 // NewRendererForwardShadowMapping ...
 func NewRendererForwardShadowMapping(window interfaces.Window) *RendererForwardShadowMapping {
 	rend := &RendererForwardShadowMapping{}
 	rend.window = window
+	rend.Init()
 	return rend
 }
 
+// Init ...
+func (rend *RendererForwardShadowMapping) Init() {
+	if rend.shadows == nil {
+		rend.shadows = newShadowAtlas(rend.window)
+	}
+	if rend.pointShadows == nil {
+		rend.pointShadows = newPointShadows(rend.window)
+	}
+	if rend.forward == nil {
+		rend.forward = NewRendererForward(rend.window)
+	} else {
+		rend.forward.Init()
+	}
+	rend.forward.shadows = rend.shadows
+	rend.forward.pointShadows = rend.pointShadows
+}
+
+// CompileShaders ...
+func (rend *RendererForwardShadowMapping) CompileShaders() {
+	rend.forward.CompileShaders()
+}
+
 // Render ...
-func (rend *RendererForwardShadowMapping) Render(rp types.RenderProperties, meshModelFaces []*meshes.ModelFace, matrixGrid mgl32.Mat4, camPos mgl32.Vec3, selectedModel int32, lightSources []*objects.Light) {
+func (rend *RendererForwardShadowMapping) Render(frame *FrameContext) {
+	rsett := settings.GetRenderingSettings()
+
+	rend.shadows.Render(frame)
+	rend.pointShadows.Render(frame)
+	rend.forward.Render(frame)
+
+	if rsett.General.DebugShadowTexture {
+		rend.shadows.renderDebug(frame.Width, frame.Height)
+	}
 }
 
 // Dispose ...
 func (rend *RendererForwardShadowMapping) Dispose() {
+	rend.forward.Dispose()
+	rend.shadows.Dispose()
+	rend.pointShadows.Dispose()
 }
@@ -0,0 +1,57 @@
+package renderers
+
+import (
+	"github.com/supudo/Kuplung-Go/engine/oglconsts"
+	"github.com/supudo/Kuplung-Go/interfaces"
+	"github.com/supudo/Kuplung-Go/objects"
+	"github.com/supudo/Kuplung-Go/settings"
+)
+
+// iblTextureUnit is the texture unit of the irradiance map, the prefiltered map and the BRDF lookup table use the next two units
+const iblTextureUnit = pointShadowsTextureUnit + pointShadowsMaxLights
+
+// iblUniforms are the locations of the image based lighting uniforms in the forward program
+type iblUniforms struct {
+	inUse, intensity, rotation, prefilterLevels int32
+	irradiance, prefilter, brdfLUT              int32
+}
+
+func newIBLUniforms(gl interfaces.OpenGL, program uint32) iblUniforms {
+	u := iblUniforms{}
+	u.inUse = gl.GLGetUniformLocation(program, gl.Str("ibl_inUse\x00"))
+	u.intensity = gl.GLGetUniformLocation(program, gl.Str("ibl_intensity\x00"))
+	u.rotation = gl.GLGetUniformLocation(program, gl.Str("ibl_rotation\x00"))
+	u.prefilterLevels = gl.GLGetUniformLocation(program, gl.Str("ibl_prefilterLevels\x00"))
+	u.irradiance = gl.GLGetUniformLocation(program, gl.Str("sampler_iblIrradiance\x00"))
+	u.prefilter = gl.GLGetUniformLocation(program, gl.Str("sampler_iblPrefilter\x00"))
+	u.brdfLUT = gl.GLGetUniformLocation(program, gl.Str("sampler_iblBRDFLUT\x00"))
+	return u
+}
+
+// apply binds the maps of the environment and sets the uniforms of the current program.
+// The samplers always get their own texture units, even without an environment, so the cube samplers never share a unit with a 2D sampler.
+func (u *iblUniforms) apply(gl interfaces.OpenGL, env *objects.EnvironmentMap) {
+	rsett := settings.GetRenderingSettings()
+
+	gl.Uniform1i(u.irradiance, iblTextureUnit)
+	gl.Uniform1i(u.prefilter, iblTextureUnit+1)
+	gl.Uniform1i(u.brdfLUT, iblTextureUnit+2)
+	if env == nil {
+		gl.Uniform1i(u.inUse, 0)
+		return
+	}
+
+	gl.Uniform1i(u.inUse, 1)
+	gl.Uniform1f(u.intensity, rsett.SkyBox.SkyboxExposure)
+	gl.Uniform1f(u.prefilterLevels, float32(env.PrefilterLevels))
+	matrixRotation := objects.EnvironmentRotation()
+	gl.UniformMatrix3fv(u.rotation, 1, false, &matrixRotation[0])
+
+	gl.ActiveTexture(oglconsts.TEXTURE0 + iblTextureUnit)
+	gl.BindTexture(oglconsts.TEXTURE_CUBE_MAP, env.IrradianceMap)
+	gl.ActiveTexture(oglconsts.TEXTURE0 + iblTextureUnit + 1)
+	gl.BindTexture(oglconsts.TEXTURE_CUBE_MAP, env.PrefilterMap)
+	gl.ActiveTexture(oglconsts.TEXTURE0 + iblTextureUnit + 2)
+	gl.BindTexture(oglconsts.TEXTURE_2D, env.BRDFLUT)
+	gl.ActiveTexture(oglconsts.TEXTURE0)
+}
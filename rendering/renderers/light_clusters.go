@@ -0,0 +1,416 @@
+package renderers
+
+import (
+	"math"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/engine/oglconsts"
+	"github.com/supudo/Kuplung-Go/interfaces"
+	"github.com/supudo/Kuplung-Go/objects"
+	"github.com/supudo/Kuplung-Go/settings"
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+// lightClustersX, lightClustersY and lightClustersZ are the screen tiles and the depth slices of the cluster grid
+const (
+	lightClustersX = 16
+	lightClustersY = 9
+	lightClustersZ = 24
+)
+
+// lightClustersTextureUnit is the texture unit of the cluster data, the one between the materials and the shadow atlas
+const lightClustersTextureUnit = shadowAtlasTextureUnit - 1
+
+// lightClustersDataWidth is the width of the data texture, the rows are added as the clusters need them
+const lightClustersDataWidth = 1024
+
+// lightClustersTexelsPerLight has to match lightClusters_texelsPerLight in light_clusters.frag
+const lightClustersTexelsPerLight = 6
+
+// lightClustersMaxLights has to match lightClusters_maxLights in light_clusters.frag,
+// it is as many lights as fit in the 16 KB every OpenGL 4.1 driver allows for a uniform block
+const lightClustersMaxLights = 16384 / (lightClustersTexelsPerLight * 16)
+
+// lightClustersBlockBinding is the uniform buffer binding point of the lights
+const lightClustersBlockBinding = 0
+
+// lightClustersCutOff is the fraction of the light intensity below which a light doesn't reach a cluster
+const lightClustersCutOff = 1.0 / 256.0
+
+// lightClustersUniforms are the locations of the cluster uniforms in a program that includes light_clusters.frag
+type lightClustersUniforms struct {
+	sampler, size, directionalCount, lightsCount int32
+	indexBase, near, far                         int32
+	debug, maxCount                              int32
+}
+
+func newLightClustersUniforms(gl interfaces.OpenGL, program uint32) lightClustersUniforms {
+	u := lightClustersUniforms{}
+	if block := gl.GetUniformBlockIndex(program, "LightClusters_Lights"); block != oglconsts.INVALID_INDEX {
+		gl.UniformBlockBinding(program, block, lightClustersBlockBinding)
+	}
+	u.sampler = gl.GLGetUniformLocation(program, gl.Str("sampler_lightClusters\x00"))
+	u.size = gl.GLGetUniformLocation(program, gl.Str("lightClusters_size\x00"))
+	u.directionalCount = gl.GLGetUniformLocation(program, gl.Str("lightClusters_directionalCount\x00"))
+	u.lightsCount = gl.GLGetUniformLocation(program, gl.Str("lightClusters_lightsCount\x00"))
+	u.indexBase = gl.GLGetUniformLocation(program, gl.Str("lightClusters_indexBase\x00"))
+	u.near = gl.GLGetUniformLocation(program, gl.Str("lightClusters_near\x00"))
+	u.far = gl.GLGetUniformLocation(program, gl.Str("lightClusters_far\x00"))
+	u.debug = gl.GLGetUniformLocation(program, gl.Str("lightClusters_debug\x00"))
+	u.maxCount = gl.GLGetUniformLocation(program, gl.Str("lightClusters_maxCount\x00"))
+	return u
+}
+
+// clusterLight is a point or a spot light with the view space sphere it reaches
+type clusterLight struct {
+	index  int32
+	center mgl32.Vec3
+	radius float32
+}
+
+// lightClusters bins the point and the spot lights into a grid of screen tiles and exponential depth slices,
+// so that every fragment only evaluates the lights reaching its cluster.
+// The lights go into a std140 uniform buffer. The cluster ranges and the light indices go into a float texture read with texelFetch,
+// their size grows with the lights per cluster and OpenGL 4.1 has no storage buffers, so they can't share the 16 KB uniform block.
+type lightClusters struct {
+	window interfaces.Window
+
+	buffer  uint32
+	texture uint32
+
+	// the view space bounds of every cluster, recomputed when the projection changes
+	projection mgl32.Mat4
+	near, far  float32
+	boundsMin  []mgl32.Vec3
+	boundsMax  []mgl32.Vec3
+
+	lights           [lightClustersMaxLights * lightClustersTexelsPerLight * 4]float32
+	data             []float32
+	clusters         [][]int32
+	directionalCount int32
+	lightsCount      int32
+	indexBase        int32
+	maxCount         int32
+	warnedMaxLights  bool
+}
+
+func newLightClusters(window interfaces.Window) *lightClusters {
+	lc := &lightClusters{}
+	lc.window = window
+	lc.clusters = make([][]int32, lightClustersX*lightClustersY*lightClustersZ)
+
+	gl := window.OpenGL()
+	lc.buffer = gl.GenBuffers(1)[0]
+	gl.BindBuffer(oglconsts.UNIFORM_BUFFER, lc.buffer)
+	gl.BufferData(oglconsts.UNIFORM_BUFFER, len(lc.lights)*4, nil, oglconsts.DYNAMIC_DRAW)
+	gl.BindBuffer(oglconsts.UNIFORM_BUFFER, 0)
+
+	lc.texture = gl.GenTextures(1)[0]
+	gl.BindTexture(oglconsts.TEXTURE_2D, lc.texture)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_MIN_FILTER, oglconsts.NEAREST)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_MAG_FILTER, oglconsts.NEAREST)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_WRAP_S, oglconsts.CLAMP_TO_EDGE)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_WRAP_T, oglconsts.CLAMP_TO_EDGE)
+	gl.BindTexture(oglconsts.TEXTURE_2D, 0)
+	return lc
+}
+
+// Render packs the lights of the frame and bins them into the clusters of the view
+func (lc *lightClusters) Render(frame *FrameContext) {
+	lc.updateBounds(frame.MatrixProjection)
+	for i := range lc.clusters {
+		lc.clusters[i] = lc.clusters[i][:0]
+	}
+
+	// the directional lights go first, they light every cluster
+	lc.lightsCount = 0
+	lc.directionalCount = 0
+	for _, light := range frame.LightSources {
+		if light.LightType == types.LightSourceTypeDirectional && lc.lightsCount < lightClustersMaxLights {
+			lc.appendLight(light, -1)
+			lc.directionalCount++
+		}
+	}
+
+	pointSlot := int32(0)
+	var lights []clusterLight
+	for _, light := range frame.LightSources {
+		if light.LightType == types.LightSourceTypeDirectional {
+			continue
+		}
+		// the point shadows follow the order of the point lights
+		shadowSlot := int32(-1)
+		if light.LightType == types.LightSourceTypePoint {
+			shadowSlot = pointSlot
+			pointSlot++
+		}
+		if lc.lightsCount == lightClustersMaxLights {
+			break
+		}
+		index := lc.lightsCount
+		radius := lc.appendLight(light, shadowSlot)
+		position := mgl32.Vec3{light.MatrixModel[4*3+0], light.MatrixModel[4*3+1], light.MatrixModel[4*3+2]}
+		center := mgl32.TransformCoordinate(position, frame.MatrixCamera)
+		lights = append(lights, clusterLight{index: index, center: center, radius: radius})
+	}
+	if lc.lightsCount == lightClustersMaxLights && len(frame.LightSources) > lightClustersMaxLights && !lc.warnedMaxLights {
+		settings.LogWarn("[LightClusters] Only the first %v of the %v lights are rendered", lightClustersMaxLights, len(frame.LightSources))
+		lc.warnedMaxLights = true
+	}
+
+	for _, light := range lights {
+		lc.bin(light)
+	}
+
+	// the ranges of the clusters, then their light indices, four per texel
+	lc.data = lc.data[:0]
+	offset := int32(0)
+	lc.maxCount = 0
+	for _, cluster := range lc.clusters {
+		count := int32(len(cluster))
+		lc.data = append(lc.data, float32(offset), float32(count), 0, 0)
+		offset += count
+		if count > lc.maxCount {
+			lc.maxCount = count
+		}
+	}
+	lc.indexBase = int32(len(lc.clusters))
+	for _, cluster := range lc.clusters {
+		for _, index := range cluster {
+			lc.data = append(lc.data, float32(index))
+		}
+	}
+	for len(lc.data)%4 != 0 {
+		lc.data = append(lc.data, 0)
+	}
+
+	texels := len(lc.data) / 4
+	rows := (texels + lightClustersDataWidth - 1) / lightClustersDataWidth
+	for len(lc.data) < rows*lightClustersDataWidth*4 {
+		lc.data = append(lc.data, 0)
+	}
+
+	gl := lc.window.OpenGL()
+	gl.BindBuffer(oglconsts.UNIFORM_BUFFER, lc.buffer)
+	gl.BufferData(oglconsts.UNIFORM_BUFFER, len(lc.lights)*4, gl.Ptr(&lc.lights[0]), oglconsts.DYNAMIC_DRAW)
+	gl.BindBuffer(oglconsts.UNIFORM_BUFFER, 0)
+
+	gl.BindTexture(oglconsts.TEXTURE_2D, lc.texture)
+	gl.TexImage2D(oglconsts.TEXTURE_2D, 0, oglconsts.RGBA32F, lightClustersDataWidth, int32(rows), 0, oglconsts.RGBA, oglconsts.FLOAT, gl.Ptr(lc.data))
+	gl.BindTexture(oglconsts.TEXTURE_2D, 0)
+
+	gl.CheckForOpenGLErrors("LightClusters - Render")
+}
+
+// appendLight packs the light into the uniform buffer data and returns the distance it reaches
+func (lc *lightClusters) appendLight(light *objects.Light, shadowSlot int32) float32 {
+	position := mgl32.Vec3{light.MatrixModel[4*3+0], light.MatrixModel[4*3+1], light.MatrixModel[4*3+2]}
+	ambient := light.Ambient.Color.Mul(light.Ambient.Strength)
+	diffuse := light.Diffuse.Color.Mul(light.Diffuse.Strength)
+	specular := light.Specular.Color.Mul(light.Specular.Strength)
+	cutOff := float32(math.Cos(float64(mgl32.DegToRad(light.LCutOff.Point))))
+	outerCutOff := float32(math.Cos(float64(mgl32.DegToRad(light.LOuterCutOff.Point))))
+
+	// the directional lights keep the position the shaders negate, the spot lights shine the way their shadows are cast
+	direction := mgl32.Vec3{light.PositionX.Point, light.PositionY.Point, light.PositionZ.Point}
+	if light.LightType == types.LightSourceTypeSpot {
+		direction = spotLightDirection(light)
+	}
+
+	intensity := float32(math.Max(float64(maxComponent(ambient)), math.Max(float64(maxComponent(diffuse)), float64(maxComponent(specular)))))
+	radius := lightRange(intensity, light.LConstant.Point, light.LLinear.Point, light.LQuadratic.Point)
+
+	copy(lc.lights[lc.lightsCount*lightClustersTexelsPerLight*4:], []float32{
+		position[0], position[1], position[2], float32(light.LightType),
+		direction[0], direction[1], direction[2], radius,
+		ambient[0], ambient[1], ambient[2], float32(shadowSlot),
+		diffuse[0], diffuse[1], diffuse[2], cutOff,
+		specular[0], specular[1], specular[2], outerCutOff,
+		light.LConstant.Point, light.LLinear.Point, light.LQuadratic.Point, 0,
+	})
+	lc.lightsCount++
+	return radius
+}
+
+// lightRange returns the distance where the attenuated light falls below the cut off, for the constant, linear and quadratic
+// attenuation of the Phong lighting and for the inverse square falloff of the PBR lighting
+func lightRange(intensity, constant, linear, quadratic float32) float32 {
+	if intensity <= 0 {
+		return 0
+	}
+	limit := float64(intensity / lightClustersCutOff)
+	var phong float64
+	switch {
+	case quadratic > 0:
+		phong = (-float64(linear) + math.Sqrt(float64(linear*linear)-4*float64(quadratic)*(float64(constant)-limit))) / (2 * float64(quadratic))
+	case linear > 0:
+		phong = (limit - float64(constant)) / float64(linear)
+	default:
+		return math.MaxFloat32
+	}
+	return float32(math.Max(phong, math.Sqrt(limit)))
+}
+
+// updateBounds computes the view space boxes of the clusters for the projection
+func (lc *lightClusters) updateBounds(projection mgl32.Mat4) {
+	if projection == lc.projection && lc.boundsMin != nil {
+		return
+	}
+	lc.projection = projection
+	lc.near = projection[14] / (projection[10] - 1.0)
+	lc.far = projection[14] / (projection[10] + 1.0)
+
+	count := lightClustersX * lightClustersY * lightClustersZ
+	lc.boundsMin = make([]mgl32.Vec3, count)
+	lc.boundsMax = make([]mgl32.Vec3, count)
+	for z := 0; z < lightClustersZ; z++ {
+		depths := [2]float32{lc.sliceDepth(z), lc.sliceDepth(z + 1)}
+		for y := 0; y < lightClustersY; y++ {
+			for x := 0; x < lightClustersX; x++ {
+				min := mgl32.Vec3{math.MaxFloat32, math.MaxFloat32, math.MaxFloat32}
+				max := mgl32.Vec3{-math.MaxFloat32, -math.MaxFloat32, -math.MaxFloat32}
+				for _, depth := range depths {
+					for _, ndc := range [4]mgl32.Vec2{
+						{float32(x)/lightClustersX*2 - 1, float32(y)/lightClustersY*2 - 1},
+						{float32(x+1)/lightClustersX*2 - 1, float32(y)/lightClustersY*2 - 1},
+						{float32(x)/lightClustersX*2 - 1, float32(y+1)/lightClustersY*2 - 1},
+						{float32(x+1)/lightClustersX*2 - 1, float32(y+1)/lightClustersY*2 - 1},
+					} {
+						p := lc.viewPosition(ndc, depth)
+						for i := 0; i < 3; i++ {
+							min[i] = float32(math.Min(float64(min[i]), float64(p[i])))
+							max[i] = float32(math.Max(float64(max[i]), float64(p[i])))
+						}
+					}
+				}
+				i := lc.clusterIndex(x, y, z)
+				lc.boundsMin[i], lc.boundsMax[i] = min, max
+			}
+		}
+	}
+}
+
+// sliceDepth returns the view distance where the depth slice starts, the slices get deeper exponentially
+func (lc *lightClusters) sliceDepth(slice int) float32 {
+	return lc.near * float32(math.Pow(float64(lc.far/lc.near), float64(slice)/lightClustersZ))
+}
+
+// depthSlice returns the depth slice of the view distance
+func (lc *lightClusters) depthSlice(depth float32) int {
+	if depth <= lc.near {
+		return 0
+	}
+	slice := int(math.Log(float64(depth/lc.near)) / math.Log(float64(lc.far/lc.near)) * lightClustersZ)
+	return clampSlice(slice, lightClustersZ)
+}
+
+// viewPosition returns the view space point of the normalized device coordinates at the view distance
+func (lc *lightClusters) viewPosition(ndc mgl32.Vec2, depth float32) mgl32.Vec3 {
+	p := lc.projection
+	return mgl32.Vec3{depth * (ndc[0] + p[8]) / p[0], depth * (ndc[1] + p[9]) / p[5], -depth}
+}
+
+func (lc *lightClusters) clusterIndex(x, y, z int) int {
+	return (z*lightClustersY+y)*lightClustersX + x
+}
+
+// bin adds the light to every cluster its sphere touches
+func (lc *lightClusters) bin(light clusterLight) {
+	depthMin, depthMax := -light.center[2]-light.radius, -light.center[2]+light.radius
+	if depthMax < lc.near || depthMin > lc.far || light.radius <= 0 {
+		return
+	}
+	z0, z1 := lc.depthSlice(depthMin), lc.depthSlice(depthMax)
+
+	// the screen rectangle of the sphere box, the whole screen when the sphere crosses the near plane
+	x0, y0, x1, y1 := 0, 0, lightClustersX-1, lightClustersY-1
+	if depthMin > lc.near {
+		ndcMin := mgl32.Vec2{math.MaxFloat32, math.MaxFloat32}
+		ndcMax := mgl32.Vec2{-math.MaxFloat32, -math.MaxFloat32}
+		for _, corner := range [8]mgl32.Vec3{{-1, -1, -1}, {1, -1, -1}, {-1, 1, -1}, {1, 1, -1}, {-1, -1, 1}, {1, -1, 1}, {-1, 1, 1}, {1, 1, 1}} {
+			ndc := mgl32.TransformCoordinate(light.center.Add(corner.Mul(light.radius)), lc.projection)
+			for i := 0; i < 2; i++ {
+				ndcMin[i] = float32(math.Min(float64(ndcMin[i]), float64(ndc[i])))
+				ndcMax[i] = float32(math.Max(float64(ndcMax[i]), float64(ndc[i])))
+			}
+		}
+		if ndcMax[0] < -1 || ndcMin[0] > 1 || ndcMax[1] < -1 || ndcMin[1] > 1 {
+			return
+		}
+		x0 = clampSlice(int((ndcMin[0]+1)/2*lightClustersX), lightClustersX)
+		x1 = clampSlice(int((ndcMax[0]+1)/2*lightClustersX), lightClustersX)
+		y0 = clampSlice(int((ndcMin[1]+1)/2*lightClustersY), lightClustersY)
+		y1 = clampSlice(int((ndcMax[1]+1)/2*lightClustersY), lightClustersY)
+	}
+
+	radius2 := light.radius * light.radius
+	for z := z0; z <= z1; z++ {
+		for y := y0; y <= y1; y++ {
+			for x := x0; x <= x1; x++ {
+				i := lc.clusterIndex(x, y, z)
+				if sphereBoxDistance2(light.center, lc.boundsMin[i], lc.boundsMax[i]) <= radius2 {
+					lc.clusters[i] = append(lc.clusters[i], light.index)
+				}
+			}
+		}
+	}
+}
+
+// apply binds the cluster data and sets the cluster uniforms of the current program
+func (lc *lightClusters) apply(u *lightClustersUniforms) {
+	rsett := settings.GetRenderingSettings()
+	gl := lc.window.OpenGL()
+
+	gl.BindBufferBase(oglconsts.UNIFORM_BUFFER, lightClustersBlockBinding, lc.buffer)
+	gl.ActiveTexture(oglconsts.TEXTURE0 + lightClustersTextureUnit)
+	gl.BindTexture(oglconsts.TEXTURE_2D, lc.texture)
+	gl.Uniform1i(u.sampler, lightClustersTextureUnit)
+	gl.ActiveTexture(oglconsts.TEXTURE0)
+
+	gl.Uniform3i(u.size, lightClustersX, lightClustersY, lightClustersZ)
+	gl.Uniform1i(u.directionalCount, lc.directionalCount)
+	gl.Uniform1i(u.lightsCount, lc.lightsCount)
+	gl.Uniform1i(u.indexBase, lc.indexBase)
+	gl.Uniform1f(u.near, lc.near)
+	gl.Uniform1f(u.far, lc.far)
+	gl.Uniform1f(u.maxCount, float32(lc.maxCount))
+	if rsett.General.DebugLightClusters {
+		gl.Uniform1i(u.debug, 1)
+	} else {
+		gl.Uniform1i(u.debug, 0)
+	}
+}
+
+// Dispose ...
+func (lc *lightClusters) Dispose() {
+	gl := lc.window.OpenGL()
+	gl.DeleteBuffers([]uint32{lc.buffer})
+	gl.DeleteTextures([]uint32{lc.texture})
+}
+
+func clampSlice(i, count int) int {
+	if i < 0 {
+		return 0
+	}
+	if i >= count {
+		return count - 1
+	}
+	return i
+}
+
+// sphereBoxDistance2 returns the squared distance from the point to the box
+func sphereBoxDistance2(p, min, max mgl32.Vec3) float32 {
+	distance := float32(0)
+	for i := 0; i < 3; i++ {
+		if p[i] < min[i] {
+			distance += (min[i] - p[i]) * (min[i] - p[i])
+		} else if p[i] > max[i] {
+			distance += (p[i] - max[i]) * (p[i] - max[i])
+		}
+	}
+	return distance
+}
+
+func maxComponent(v mgl32.Vec3) float32 {
+	return float32(math.Max(float64(v[0]), math.Max(float64(v[1]), float64(v[2]))))
+}
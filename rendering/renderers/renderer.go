@@ -0,0 +1,95 @@
+package renderers
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/interfaces"
+	"github.com/supudo/Kuplung-Go/meshes"
+	"github.com/supudo/Kuplung-Go/objects"
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+// FrameContext is everything a renderer needs to draw one frame of the scene
+type FrameContext struct {
+	RenderProps    types.RenderProperties
+	MeshModelFaces []*meshes.ModelFace
+	LightSources   []*objects.Light
+	SelectedModel  int32
+
+	MatrixGrid       mgl32.Mat4
+	MatrixProjection mgl32.Mat4
+	MatrixCamera     mgl32.Mat4
+	CameraPosition   mgl32.Vec3
+
+	// Environment is the HDR skybox for the image based lighting, nil when there's none
+	Environment *objects.EnvironmentMap
+
+	// Framebuffer is where the scene is drawn, 0 is the window and the post-processing uses its offscreen target
+	Framebuffer   uint32
+	Width, Height int
+}
+
+// Renderer draws the scene models, it's created with the window's OpenGL context current
+type Renderer interface {
+	// Init (re)creates the shaders and the GPU resources
+	Init()
+	// Render draws the models in frame.Framebuffer
+	Render(frame *FrameContext)
+	// Dispose releases the GPU resources
+	Dispose()
+}
+
+// ShaderCompiler is implemented by the renderers whose shaders can be edited in the IDE
+type ShaderCompiler interface {
+	CompileShaders()
+}
+
+// Registration describes a renderer in the registry
+type Registration struct {
+	ID    uint32
+	Title string
+	// SceneFirst renderers draw the models before the grid, the axis and the other scene elements
+	SceneFirst bool
+	New        func(window interfaces.Window) Renderer
+}
+
+var (
+	registryLock sync.RWMutex
+	registry     = make(map[uint32]Registration)
+)
+
+// Register adds a renderer to the registry, it panics if the id is already taken
+func Register(reg Registration) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	if reg.New == nil {
+		panic(fmt.Sprintf("renderers: renderer %v (%v) has no constructor", reg.ID, reg.Title))
+	}
+	if old, ok := registry[reg.ID]; ok {
+		panic(fmt.Sprintf("renderers: renderer id %v is used by both %v and %v", reg.ID, old.Title, reg.Title))
+	}
+	registry[reg.ID] = reg
+}
+
+// Lookup returns the registered renderer with the given id
+func Lookup(id uint32) (Registration, bool) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	reg, ok := registry[id]
+	return reg, ok
+}
+
+// Registered returns all registered renderers sorted by id
+func Registered() []Registration {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	regs := make([]Registration, 0, len(registry))
+	for _, reg := range registry {
+		regs = append(regs, reg)
+	}
+	sort.Slice(regs, func(i, j int) bool { return regs[i].ID < regs[j].ID })
+	return regs
+}
@@ -0,0 +1,566 @@
+package renderers
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/engine"
+	"github.com/supudo/Kuplung-Go/engine/oglconsts"
+	"github.com/supudo/Kuplung-Go/interfaces"
+	"github.com/supudo/Kuplung-Go/objects"
+	"github.com/supudo/Kuplung-Go/settings"
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+// shadowAtlasMaxLights has to match NR_SHADOW_LIGHTS in shadow_atlas.frag, every cascade takes one entry
+const shadowAtlasMaxLights = 8
+
+// shadowAtlasMaxCascades is the size of shadow_cascadeSplits in shadow_atlas.frag
+const shadowAtlasMaxCascades = 4
+
+// shadowAtlasTextureUnit is the texture unit the atlas is bound to, the materials use the units before it
+const shadowAtlasTextureUnit = 8
+
+// shadowLight is a light that casts shadows, with its tile in the atlas
+type shadowLight struct {
+	light            *objects.Light
+	isDirectional    bool
+	position         mgl32.Vec3
+	direction        mgl32.Vec3
+	lightSpaceMatrix mgl32.Mat4
+	atlasRect        mgl32.Vec4
+	// cascades is the number of entries of the light starting with this one, 0 for its following cascades
+	cascades int32
+}
+
+// shadowLightUniforms are the locations of one shadowLights[i] entry
+type shadowLightUniforms struct {
+	InUse, IsDirectional, LightSpaceMatrix, AtlasRect, Position, Direction, Cascades int32
+}
+
+// shadowUniforms are the locations of the shadow atlas uniforms in a program that includes shadow_atlas.frag
+type shadowUniforms struct {
+	lights                                                 []shadowLightUniforms
+	sampler, biasConstant, biasSlope, pcfRadius            int32
+	cameraView, cascadeSplits, cascadeBlend, debugCascades int32
+}
+
+// shadowAtlas renders the depth of the scene from every directional and spot light into one texture,
+// each light gets its own tile
+type shadowAtlas struct {
+	window interfaces.Window
+
+	shaderProgramDepth uint32
+	glDepthLightSpace  int32
+	glDepthModelMatrix int32
+	shaderProgramDebug uint32
+	glDebugSampler     int32
+	glDebugRect        int32
+	debugVAO, debugVBO uint32
+	fbo, depthTexture  uint32
+	size               int32
+	lights             []shadowLight
+	sceneCenter        mgl32.Vec3
+	sceneRadius        float32
+	matrixCamera       mgl32.Mat4
+	cascadeSplits      mgl32.Vec4
+}
+
+func newShadowAtlas(window interfaces.Window) *shadowAtlas {
+	sa := &shadowAtlas{}
+	sa.window = window
+	sa.init()
+	return sa
+}
+
+func (sa *shadowAtlas) init() {
+	sett := settings.GetSettings()
+	gl := sa.window.OpenGL()
+
+	sVertex := engine.GetShaderSource(sett.App.AppFolder + "shaders/shadow_mapping_depth.vert")
+	sFragment := engine.GetShaderSource(sett.App.AppFolder + "shaders/shadow_mapping_depth.frag")
+	var err error
+	sa.shaderProgramDepth, err = engine.LinkNewStandardProgram(gl, sVertex, sFragment)
+	if err != nil {
+		settings.LogWarn("[ShadowAtlas] Can't load the shadow depth shaders: %v", err)
+	}
+	sa.glDepthLightSpace = gl.GLGetUniformLocation(sa.shaderProgramDepth, gl.Str("vs_lightSpaceMatrix\x00"))
+	sa.glDepthModelMatrix = gl.GLGetUniformLocation(sa.shaderProgramDepth, gl.Str("vs_modelMatrix\x00"))
+
+	sVertex = engine.GetShaderSource(sett.App.AppFolder + "shaders/shadow_mapping_debug.vert")
+	sFragment = engine.GetShaderSource(sett.App.AppFolder + "shaders/shadow_mapping_debug.frag")
+	sa.shaderProgramDebug, err = engine.LinkNewStandardProgram(gl, sVertex, sFragment)
+	if err != nil {
+		settings.LogWarn("[ShadowAtlas] Can't load the shadow debug shaders: %v", err)
+	}
+	sa.glDebugSampler = gl.GLGetUniformLocation(sa.shaderProgramDebug, gl.Str("sampler_shadowAtlas\x00"))
+	sa.glDebugRect = gl.GLGetUniformLocation(sa.shaderProgramDebug, gl.Str("vs_screenRect\x00"))
+
+	quad := []float32{
+		0.0, 1.0,
+		0.0, 0.0,
+		1.0, 1.0,
+		1.0, 0.0}
+	sa.debugVAO = gl.GenVertexArrays(1)[0]
+	sa.debugVBO = gl.GenBuffers(1)[0]
+	gl.BindVertexArray(sa.debugVAO)
+	gl.BindBuffer(oglconsts.ARRAY_BUFFER, sa.debugVBO)
+	gl.BufferData(oglconsts.ARRAY_BUFFER, len(quad)*4, gl.Ptr(quad), oglconsts.STATIC_DRAW)
+	gl.EnableVertexAttribArray(0)
+	gl.VertexAttribPointer(0, 2, oglconsts.FLOAT, false, 2*4, gl.PtrOffset(0))
+	gl.BindBuffer(oglconsts.ARRAY_BUFFER, 0)
+	gl.BindVertexArray(0)
+
+	sa.fbo = gl.GenFramebuffers(1)[0]
+	sa.depthTexture = gl.GenTextures(1)[0]
+	sa.size = 0
+
+	gl.CheckForOpenGLErrors("ShadowAtlas - init")
+}
+
+// resize (re)allocates the depth texture when the atlas size changes
+func (sa *shadowAtlas) resize(size int32) {
+	if size == sa.size {
+		return
+	}
+	gl := sa.window.OpenGL()
+	sa.size = size
+
+	gl.BindTexture(oglconsts.TEXTURE_2D, sa.depthTexture)
+	gl.TexImage2D(oglconsts.TEXTURE_2D, 0, oglconsts.DEPTH_COMPONENT24, size, size, 0, oglconsts.DEPTH_COMPONENT, oglconsts.FLOAT, nil)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_MIN_FILTER, oglconsts.NEAREST)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_MAG_FILTER, oglconsts.NEAREST)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_WRAP_S, oglconsts.CLAMP_TO_BORDER)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_WRAP_T, oglconsts.CLAMP_TO_BORDER)
+	borderColor := []float32{1.0, 1.0, 1.0, 1.0}
+	gl.TexParameterfv(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_BORDER_COLOR, &borderColor[0])
+	gl.BindTexture(oglconsts.TEXTURE_2D, 0)
+
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, sa.fbo)
+	gl.FramebufferTexture2D(oglconsts.FRAMEBUFFER, oglconsts.DEPTH_ATTACHMENT, oglconsts.TEXTURE_2D, sa.depthTexture, 0)
+	gl.DrawBuffer(oglconsts.NONE)
+	gl.ReadBuffer(oglconsts.NONE)
+	if gl.CheckFramebufferStatus(oglconsts.FRAMEBUFFER) != oglconsts.FRAMEBUFFER_COMPLETE {
+		settings.LogWarn("[ShadowAtlas] Shadow atlas framebuffer (%vx%v) is not complete!", size, size)
+	}
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, 0)
+
+	gl.CheckForOpenGLErrors("ShadowAtlas - resize")
+}
+
+// Render finds the lights that cast shadows, places them in the atlas and renders their depth passes
+func (sa *shadowAtlas) Render(frame *FrameContext) {
+	rsett := settings.GetRenderingSettings()
+	gl := sa.window.OpenGL()
+
+	sa.resize(rsett.Shadows.AtlasSize)
+	sa.updateSceneBounds(frame)
+	sa.collectLights(frame, int(rsett.Shadows.MaxLights), int(rsett.Shadows.CascadeCount), rsett.Shadows.CascadeSplitLambda)
+
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, sa.fbo)
+	gl.Viewport(0, 0, sa.size, sa.size)
+	gl.Disable(oglconsts.SCISSOR_TEST)
+	gl.DepthMask(true)
+	gl.Clear(oglconsts.DEPTH_BUFFER_BIT)
+
+	if len(sa.lights) > 0 {
+		gl.UseProgram(sa.shaderProgramDepth)
+		gl.Enable(oglconsts.DEPTH_TEST)
+		gl.Enable(oglconsts.SCISSOR_TEST)
+		gl.Enable(oglconsts.POLYGON_OFFSET_FILL)
+		gl.PolygonOffset(1.1, 4.0)
+		gl.PolygonMode(oglconsts.FRONT_AND_BACK, oglconsts.FILL)
+
+		for i := range sa.lights {
+			sl := &sa.lights[i]
+			x := int32(sl.atlasRect.X() * float32(sa.size))
+			y := int32(sl.atlasRect.Y() * float32(sa.size))
+			w := int32(sl.atlasRect.Z() * float32(sa.size))
+			h := int32(sl.atlasRect.W() * float32(sa.size))
+			gl.Viewport(x, y, w, h)
+			gl.Scissor(x, y, w, h)
+
+			gl.GLUniformMatrix4fv(sa.glDepthLightSpace, 1, false, &sl.lightSpaceMatrix[0])
+			for _, mfd := range frame.MeshModelFaces {
+				if !mfd.ShowShadows {
+					continue
+				}
+				matrixModel := mfd.ModelMatrix(frame.MatrixGrid)
+				gl.GLUniformMatrix4fv(sa.glDepthModelMatrix, 1, false, &matrixModel[0])
+				gl.BindVertexArray(mfd.GLVAO)
+				gl.DrawElements(oglconsts.TRIANGLES, mfd.MeshModel.CountIndices, oglconsts.UNSIGNED_INT, 0)
+			}
+		}
+
+		gl.BindVertexArray(0)
+		gl.Disable(oglconsts.POLYGON_OFFSET_FILL)
+		gl.Disable(oglconsts.SCISSOR_TEST)
+		gl.UseProgram(0)
+	}
+
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, frame.Framebuffer)
+	gl.Viewport(0, 0, int32(frame.Width), int32(frame.Height))
+
+	gl.CheckForOpenGLErrors("ShadowAtlas - Render")
+}
+
+// updateSceneBounds fits a sphere around the models, the directional lights cover it with their orthographic projections
+func (sa *shadowAtlas) updateSceneBounds(frame *FrameContext) {
+	sa.sceneCenter, sa.sceneRadius = sceneBounds(frame)
+}
+
+// sceneBounds is the sphere around the bounding boxes of the models
+func sceneBounds(frame *FrameContext) (mgl32.Vec3, float32) {
+	minP := mgl32.Vec3{math.MaxFloat32, math.MaxFloat32, math.MaxFloat32}
+	maxP := mgl32.Vec3{-math.MaxFloat32, -math.MaxFloat32, -math.MaxFloat32}
+	found := false
+	for _, mfd := range frame.MeshModelFaces {
+		if mfd.BoundingBox == nil {
+			continue
+		}
+		bb := mfd.BoundingBox
+		matrixModel := mfd.ModelMatrix(frame.MatrixGrid)
+		for _, c := range [8]mgl32.Vec3{
+			{bb.MinX, bb.MinY, bb.MinZ}, {bb.MaxX, bb.MinY, bb.MinZ}, {bb.MinX, bb.MaxY, bb.MinZ}, {bb.MaxX, bb.MaxY, bb.MinZ},
+			{bb.MinX, bb.MinY, bb.MaxZ}, {bb.MaxX, bb.MinY, bb.MaxZ}, {bb.MinX, bb.MaxY, bb.MaxZ}, {bb.MaxX, bb.MaxY, bb.MaxZ}} {
+			p := mgl32.TransformCoordinate(c, matrixModel)
+			for k := 0; k < 3; k++ {
+				minP[k] = float32(math.Min(float64(minP[k]), float64(p[k])))
+				maxP[k] = float32(math.Max(float64(maxP[k]), float64(p[k])))
+			}
+			found = true
+		}
+	}
+	if !found {
+		return mgl32.Vec3{0, 0, 0}, 10.0
+	}
+	radius := maxP.Sub(minP).Len() * 0.5
+	if radius < 0.01 {
+		radius = 0.01
+	}
+	return minP.Add(maxP).Mul(0.5), radius
+}
+
+// collectLights picks the first maxLights directional and spot lights and gives each of them a tile,
+// the directional lights get a tile per cascade
+func (sa *shadowAtlas) collectLights(frame *FrameContext, maxLights, cascadeCount int, splitLambda float32) {
+	if maxLights > shadowAtlasMaxLights {
+		maxLights = shadowAtlasMaxLights
+	}
+	if cascadeCount > shadowAtlasMaxCascades {
+		cascadeCount = shadowAtlasMaxCascades
+	} else if cascadeCount < 1 {
+		cascadeCount = 1
+	}
+
+	// the tiles are laid out first, the cascades are snapped to the texels of their tiles
+	type plannedLight struct {
+		light    *objects.Light
+		cascades int
+	}
+	var planned []plannedLight
+	entries := 0
+	for _, light := range frame.LightSources {
+		room := shadowAtlasMaxLights - entries
+		if len(planned) >= maxLights || room == 0 {
+			break
+		}
+		if !light.CastShadows {
+			continue
+		}
+		switch light.LightType {
+		case types.LightSourceTypeDirectional:
+			cascades := cascadeCount
+			if cascades > room {
+				cascades = room
+			}
+			planned = append(planned, plannedLight{light: light, cascades: cascades})
+			entries += cascades
+		case types.LightSourceTypeSpot:
+			planned = append(planned, plannedLight{light: light, cascades: 1})
+			entries++
+		}
+	}
+
+	cols := int(math.Ceil(math.Sqrt(float64(entries))))
+	if cols < 1 {
+		cols = 1
+	}
+	tile := 1.0 / float32(cols)
+	tilePixels := float32(sa.size) * tile
+
+	sa.matrixCamera = frame.MatrixCamera
+	sa.updateCascadeSplits(frame, cascadeCount, splitLambda)
+
+	sa.lights = sa.lights[:0]
+	for _, pl := range planned {
+		switch {
+		case pl.light.LightType == types.LightSourceTypeSpot:
+			sa.lights = append(sa.lights, sa.spotLight(pl.light))
+		case pl.cascades > 1:
+			sa.lights = append(sa.lights, sa.directionalCascades(pl.light, frame, pl.cascades, tilePixels)...)
+		default:
+			sa.lights = append(sa.lights, sa.directionalLight(pl.light))
+		}
+	}
+	for i := range sa.lights {
+		sa.lights[i].atlasRect = mgl32.Vec4{float32(i%cols) * tile, float32(i/cols) * tile, tile, tile}
+	}
+}
+
+// updateCascadeSplits splits the camera frustum, clipped to the scene, between the uniform and the logarithmic split,
+// lambda 0 is uniform and 1 is logarithmic
+func (sa *shadowAtlas) updateCascadeSplits(frame *FrameContext, cascades int, lambda float32) {
+	rsett := settings.GetRenderingSettings()
+
+	near := rsett.General.PlaneClose
+	if near < 0.01 {
+		near = 0.01
+	}
+	sceneView := mgl32.TransformCoordinate(sa.sceneCenter, frame.MatrixCamera)
+	far := float32(math.Min(float64(rsett.General.PlaneFar), float64(-sceneView.Z()+sa.sceneRadius)))
+	if far < near+1 {
+		far = near + 1
+	}
+
+	sa.cascadeSplits = mgl32.Vec4{far, far, far, far}
+	for i := 1; i < cascades; i++ {
+		p := float32(i) / float32(cascades)
+		logSplit := near * float32(math.Pow(float64(far/near), float64(p)))
+		uniformSplit := near + (far-near)*p
+		sa.cascadeSplits[i-1] = lambda*logSplit + (1-lambda)*uniformSplit
+	}
+}
+
+func (sa *shadowAtlas) directionalLight(light *objects.Light) shadowLight {
+	toLight := directionToLight(light)
+
+	r := sa.sceneRadius
+	eye := sa.sceneCenter.Add(toLight.Mul(2 * r))
+	matrixView := mgl32.LookAtV(eye, sa.sceneCenter, shadowUpVector(toLight))
+	matrixProjection := mgl32.Ortho(-r, r, -r, r, 0.01*r, 4*r)
+
+	return shadowLight{
+		light:            light,
+		isDirectional:    true,
+		direction:        toLight,
+		lightSpaceMatrix: matrixProjection.Mul4(matrixView),
+		cascades:         1,
+	}
+}
+
+// directionalCascades fits an orthographic projection around every slice of the camera frustum.
+// The projections are spheres of a fixed size snapped to the texels of the tile, so they don't shimmer when the camera moves.
+func (sa *shadowAtlas) directionalCascades(light *objects.Light, frame *FrameContext, cascades int, tilePixels float32) []shadowLight {
+	toLight := directionToLight(light)
+	matrixView := mgl32.LookAtV(mgl32.Vec3{0, 0, 0}, toLight.Mul(-1), shadowUpVector(toLight))
+	matrixCameraInv := frame.MatrixCamera.Inv()
+	// the off-center terms are set for the tiles of the Render Image
+	tanX, tanY := 1/frame.MatrixProjection[0], 1/frame.MatrixProjection[5]
+	offX, offY := frame.MatrixProjection[8], frame.MatrixProjection[9]
+	sceneLight := mgl32.TransformCoordinate(sa.sceneCenter, matrixView)
+
+	result := make([]shadowLight, cascades)
+	sliceNear := settings.GetRenderingSettings().General.PlaneClose
+	for c := 0; c < cascades; c++ {
+		sliceFar := sa.cascadeSplits[c]
+
+		var corners [8]mgl32.Vec3
+		for i, z := range [2]float32{sliceNear, sliceFar} {
+			for j, xy := range [4][2]float32{{-1, -1}, {1, -1}, {-1, 1}, {1, 1}} {
+				corners[i*4+j] = mgl32.TransformCoordinate(mgl32.Vec3{(xy[0] + offX) * z * tanX, (xy[1] + offY) * z * tanY, -z}, matrixCameraInv)
+			}
+		}
+		center := mgl32.Vec3{}
+		for _, p := range corners {
+			center = center.Add(p)
+		}
+		center = center.Mul(1.0 / 8.0)
+		r := float32(0)
+		for _, p := range corners {
+			r = float32(math.Max(float64(r), float64(p.Sub(center).Len())))
+		}
+		r = float32(math.Ceil(float64(r)*16.0) / 16.0)
+
+		centerLight := mgl32.TransformCoordinate(center, matrixView)
+		texel := 2 * r / tilePixels
+		cx := float32(math.Floor(float64(centerLight.X()/texel))) * texel
+		cy := float32(math.Floor(float64(centerLight.Y()/texel))) * texel
+		// the depth range covers the whole scene, so the models outside of the slice still cast shadows into it
+		zNear := float32(math.Min(float64(-centerLight.Z()-r), float64(-sceneLight.Z()-sa.sceneRadius)))
+		zFar := float32(math.Max(float64(-centerLight.Z()+r), float64(-sceneLight.Z()+sa.sceneRadius)))
+		matrixProjection := mgl32.Ortho(cx-r, cx+r, cy-r, cy+r, zNear, zFar)
+
+		result[c] = shadowLight{
+			isDirectional:    true,
+			direction:        toLight,
+			lightSpaceMatrix: matrixProjection.Mul4(matrixView),
+		}
+		sliceNear = sliceFar
+	}
+	result[0].light = light
+	result[0].cascades = int32(cascades)
+	return result
+}
+
+// directionToLight is the normalized direction towards a directional light,
+// directional lights shine from their position towards the origin, same as in the forward renderer
+func directionToLight(light *objects.Light) mgl32.Vec3 {
+	toLight := mgl32.Vec3{light.PositionX.Point, light.PositionY.Point, light.PositionZ.Point}
+	if toLight.Len() < 1e-6 {
+		toLight = mgl32.Vec3{0, 1, 0}
+	}
+	return toLight.Normalize()
+}
+
+// spotLightDirection is the normalized direction a spot light shines in, from its position towards the origin.
+// The lighting uniforms and the shadow matrices both use it, so the cone and its shadow always match.
+func spotLightDirection(light *objects.Light) mgl32.Vec3 {
+	direction := mgl32.Vec3{-light.PositionX.Point, -light.PositionY.Point, -light.PositionZ.Point}
+	if direction.Len() < 1e-6 {
+		direction = mgl32.Vec3{0, -1, 0}
+	}
+	return direction.Normalize()
+}
+
+func (sa *shadowAtlas) spotLight(light *objects.Light) shadowLight {
+	position := mgl32.Vec3{light.MatrixModel[4*3+0], light.MatrixModel[4*3+1], light.MatrixModel[4*3+2]}
+	direction := spotLightDirection(light)
+
+	fov := 2 * light.LOuterCutOff.Point
+	if fov < 1 {
+		fov = 1
+	} else if fov > 170 {
+		fov = 170
+	}
+	far := position.Sub(sa.sceneCenter).Len() + sa.sceneRadius
+	if far < 1 {
+		far = 1
+	}
+	matrixView := mgl32.LookAtV(position, position.Add(direction), shadowUpVector(direction))
+	matrixProjection := mgl32.Perspective(mgl32.DegToRad(fov), 1.0, far*0.001, far)
+
+	return shadowLight{
+		light:            light,
+		position:         position,
+		direction:        direction,
+		lightSpaceMatrix: matrixProjection.Mul4(matrixView),
+		cascades:         1,
+	}
+}
+
+func shadowUpVector(direction mgl32.Vec3) mgl32.Vec3 {
+	if math.Abs(float64(direction.Y())) > 0.99 {
+		return mgl32.Vec3{0, 0, 1}
+	}
+	return mgl32.Vec3{0, 1, 0}
+}
+
+// lightIndex returns the shadow index of the light, or -1 if it doesn't cast shadows
+func (sa *shadowAtlas) lightIndex(light *objects.Light) int32 {
+	for i := range sa.lights {
+		if sa.lights[i].light == light {
+			return int32(i)
+		}
+	}
+	return -1
+}
+
+func newShadowUniforms(gl interfaces.OpenGL, program uint32) shadowUniforms {
+	u := shadowUniforms{}
+	u.sampler = gl.GLGetUniformLocation(program, gl.Str("sampler_shadowAtlas\x00"))
+	u.biasConstant = gl.GLGetUniformLocation(program, gl.Str("shadow_biasConstant\x00"))
+	u.biasSlope = gl.GLGetUniformLocation(program, gl.Str("shadow_biasSlope\x00"))
+	u.pcfRadius = gl.GLGetUniformLocation(program, gl.Str("shadow_pcfRadius\x00"))
+	u.cameraView = gl.GLGetUniformLocation(program, gl.Str("shadow_cameraView\x00"))
+	u.cascadeSplits = gl.GLGetUniformLocation(program, gl.Str("shadow_cascadeSplits\x00"))
+	u.cascadeBlend = gl.GLGetUniformLocation(program, gl.Str("shadow_cascadeBlend\x00"))
+	u.debugCascades = gl.GLGetUniformLocation(program, gl.Str("shadow_debugCascades\x00"))
+	u.lights = make([]shadowLightUniforms, shadowAtlasMaxLights)
+	for i := 0; i < shadowAtlasMaxLights; i++ {
+		u.lights[i].InUse = gl.GLGetUniformLocation(program, gl.Str("shadowLights["+fmt.Sprint(i)+"].inUse\x00"))
+		u.lights[i].IsDirectional = gl.GLGetUniformLocation(program, gl.Str("shadowLights["+fmt.Sprint(i)+"].isDirectional\x00"))
+		u.lights[i].LightSpaceMatrix = gl.GLGetUniformLocation(program, gl.Str("shadowLights["+fmt.Sprint(i)+"].lightSpaceMatrix\x00"))
+		u.lights[i].AtlasRect = gl.GLGetUniformLocation(program, gl.Str("shadowLights["+fmt.Sprint(i)+"].atlasRect\x00"))
+		u.lights[i].Position = gl.GLGetUniformLocation(program, gl.Str("shadowLights["+fmt.Sprint(i)+"].position\x00"))
+		u.lights[i].Direction = gl.GLGetUniformLocation(program, gl.Str("shadowLights["+fmt.Sprint(i)+"].direction\x00"))
+		u.lights[i].Cascades = gl.GLGetUniformLocation(program, gl.Str("shadowLights["+fmt.Sprint(i)+"].cascades\x00"))
+	}
+	return u
+}
+
+// apply binds the atlas and sets the shadow uniforms of the current program
+func (sa *shadowAtlas) apply(u *shadowUniforms) {
+	rsett := settings.GetRenderingSettings()
+	gl := sa.window.OpenGL()
+
+	gl.ActiveTexture(oglconsts.TEXTURE0 + shadowAtlasTextureUnit)
+	gl.BindTexture(oglconsts.TEXTURE_2D, sa.depthTexture)
+	gl.Uniform1i(u.sampler, shadowAtlasTextureUnit)
+	gl.Uniform1f(u.biasConstant, rsett.Shadows.BiasConstant)
+	gl.Uniform1f(u.biasSlope, rsett.Shadows.BiasSlope)
+	gl.Uniform1i(u.pcfRadius, rsett.Shadows.PCFRadius)
+	gl.GLUniformMatrix4fv(u.cameraView, 1, false, &sa.matrixCamera[0])
+	gl.Uniform4f(u.cascadeSplits, sa.cascadeSplits.X(), sa.cascadeSplits.Y(), sa.cascadeSplits.Z(), sa.cascadeSplits.W())
+	gl.Uniform1f(u.cascadeBlend, rsett.Shadows.CascadeBlend)
+	if rsett.Shadows.DebugCascades {
+		gl.Uniform1i(u.debugCascades, 1)
+	} else {
+		gl.Uniform1i(u.debugCascades, 0)
+	}
+
+	for i := 0; i < shadowAtlasMaxLights; i++ {
+		lu := u.lights[i]
+		if i >= len(sa.lights) {
+			gl.Uniform1i(lu.InUse, 0)
+			continue
+		}
+		sl := sa.lights[i]
+		gl.Uniform1i(lu.InUse, 1)
+		if sl.isDirectional {
+			gl.Uniform1i(lu.IsDirectional, 1)
+		} else {
+			gl.Uniform1i(lu.IsDirectional, 0)
+		}
+		gl.GLUniformMatrix4fv(lu.LightSpaceMatrix, 1, false, &sl.lightSpaceMatrix[0])
+		gl.Uniform4f(lu.AtlasRect, sl.atlasRect.X(), sl.atlasRect.Y(), sl.atlasRect.Z(), sl.atlasRect.W())
+		gl.Uniform3f(lu.Position, sl.position.X(), sl.position.Y(), sl.position.Z())
+		gl.Uniform3f(lu.Direction, sl.direction.X(), sl.direction.Y(), sl.direction.Z())
+		gl.Uniform1i(lu.Cascades, sl.cascades)
+	}
+	gl.ActiveTexture(oglconsts.TEXTURE0)
+}
+
+// renderDebug draws the atlas in the bottom left corner of the viewport
+func (sa *shadowAtlas) renderDebug(w, h int) {
+	gl := sa.window.OpenGL()
+
+	side := float32(h) / 3.0
+	gl.Disable(oglconsts.DEPTH_TEST)
+	gl.UseProgram(sa.shaderProgramDebug)
+	gl.Uniform4f(sa.glDebugRect, -1.0, -1.0, 2.0*side/float32(w), 2.0*side/float32(h))
+	gl.ActiveTexture(oglconsts.TEXTURE0)
+	gl.BindTexture(oglconsts.TEXTURE_2D, sa.depthTexture)
+	gl.Uniform1i(sa.glDebugSampler, 0)
+	gl.BindVertexArray(sa.debugVAO)
+	gl.DrawArrays(oglconsts.TRIANGLE_STRIP, 0, 4)
+	gl.BindVertexArray(0)
+	gl.BindTexture(oglconsts.TEXTURE_2D, 0)
+	gl.UseProgram(0)
+	gl.Enable(oglconsts.DEPTH_TEST)
+
+	gl.CheckForOpenGLErrors("ShadowAtlas - renderDebug")
+}
+
+// Dispose ...
+func (sa *shadowAtlas) Dispose() {
+	gl := sa.window.OpenGL()
+
+	gl.DeleteFramebuffers([]uint32{sa.fbo})
+	gl.DeleteTextures([]uint32{sa.depthTexture})
+	gl.DeleteBuffers([]uint32{sa.debugVBO})
+	gl.DeleteVertexArrays([]uint32{sa.debugVAO})
+	gl.DeleteProgram(sa.shaderProgramDepth)
+	gl.DeleteProgram(sa.shaderProgramDebug)
+}
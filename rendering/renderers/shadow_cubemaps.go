@@ -0,0 +1,246 @@
+package renderers
+
+import (
+	"fmt"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/engine"
+	"github.com/supudo/Kuplung-Go/engine/oglconsts"
+	"github.com/supudo/Kuplung-Go/interfaces"
+	"github.com/supudo/Kuplung-Go/settings"
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+// pointShadowsMaxLights has to match NR_SHADOW_POINT_LIGHTS in shadow_cubemaps.frag and NR_POINT_LIGHTS in the deferred and the shadow mapping shaders
+const pointShadowsMaxLights = 4
+
+// pointShadowsTextureUnit is the texture unit of the first cube map, the shadow atlas uses the unit before it
+const pointShadowsTextureUnit = shadowAtlasTextureUnit + 1
+
+// pointShadowFaces are the directions and the up vectors of the cube faces, in the order of TEXTURE_CUBE_MAP_POSITIVE_X and the following faces
+var pointShadowFaces = [6][2]mgl32.Vec3{
+	{{1, 0, 0}, {0, -1, 0}},
+	{{-1, 0, 0}, {0, -1, 0}},
+	{{0, 1, 0}, {0, 0, 1}},
+	{{0, -1, 0}, {0, 0, -1}},
+	{{0, 0, 1}, {0, -1, 0}},
+	{{0, 0, -1}, {0, -1, 0}},
+}
+
+// pointShadowUniforms are the locations of the point shadow uniforms in a program that includes shadow_cubemaps.frag
+type pointShadowUniforms struct {
+	inUse, farPlane, samplers []int32
+	bias, softness            int32
+}
+
+// pointShadows renders the linear distance to every shadow casting point light into a cube map,
+// all six faces are rendered in a single pass with a geometry shader
+type pointShadows struct {
+	window interfaces.Window
+
+	shaderProgram    uint32
+	glModelMatrix    int32
+	glShadowMatrices int32
+	glLightPosition  int32
+	glFarPlane       int32
+	fbo              uint32
+	cubeTextures     []uint32
+	size             int32
+	inUse            [pointShadowsMaxLights]bool
+	farPlanes        [pointShadowsMaxLights]float32
+	matricesShadow   [6]mgl32.Mat4
+	sceneCenter      mgl32.Vec3
+	sceneRadius      float32
+}
+
+func newPointShadows(window interfaces.Window) *pointShadows {
+	ps := &pointShadows{}
+	ps.window = window
+	ps.init()
+	return ps
+}
+
+func (ps *pointShadows) init() {
+	sett := settings.GetSettings()
+	gl := ps.window.OpenGL()
+
+	sVertex := engine.GetShaderSource(sett.App.AppFolder + "shaders/shadow_cubemap_depth.vert")
+	sGeom := engine.GetShaderSource(sett.App.AppFolder + "shaders/shadow_cubemap_depth.geom")
+	sFragment := engine.GetShaderSource(sett.App.AppFolder + "shaders/shadow_cubemap_depth.frag")
+	var err error
+	ps.shaderProgram, err = engine.LinkNewGeometryProgram(gl, sVertex, sGeom, sFragment)
+	if err != nil {
+		settings.LogWarn("[PointShadows] Can't load the point shadow depth shaders: %v", err)
+	}
+	ps.glModelMatrix = gl.GLGetUniformLocation(ps.shaderProgram, gl.Str("vs_modelMatrix\x00"))
+	ps.glShadowMatrices = gl.GLGetUniformLocation(ps.shaderProgram, gl.Str("gs_shadowMatrices\x00"))
+	ps.glLightPosition = gl.GLGetUniformLocation(ps.shaderProgram, gl.Str("fs_lightPosition\x00"))
+	ps.glFarPlane = gl.GLGetUniformLocation(ps.shaderProgram, gl.Str("fs_farPlane\x00"))
+
+	ps.fbo = gl.GenFramebuffers(1)[0]
+	ps.cubeTextures = gl.GenTextures(pointShadowsMaxLights)
+	ps.size = 0
+
+	gl.CheckForOpenGLErrors("PointShadows - init")
+}
+
+// resize (re)allocates the cube maps when their size changes
+func (ps *pointShadows) resize(size int32) {
+	if size == ps.size {
+		return
+	}
+	gl := ps.window.OpenGL()
+	ps.size = size
+
+	for _, cube := range ps.cubeTextures {
+		gl.BindTexture(oglconsts.TEXTURE_CUBE_MAP, cube)
+		for face := uint32(0); face < 6; face++ {
+			gl.TexImage2D(oglconsts.TEXTURE_CUBE_MAP_POSITIVE_X+face, 0, oglconsts.DEPTH_COMPONENT24, size, size, 0, oglconsts.DEPTH_COMPONENT, oglconsts.FLOAT, nil)
+		}
+		gl.TexParameteri(oglconsts.TEXTURE_CUBE_MAP, oglconsts.TEXTURE_MIN_FILTER, oglconsts.LINEAR)
+		gl.TexParameteri(oglconsts.TEXTURE_CUBE_MAP, oglconsts.TEXTURE_MAG_FILTER, oglconsts.LINEAR)
+		gl.TexParameteri(oglconsts.TEXTURE_CUBE_MAP, oglconsts.TEXTURE_WRAP_S, oglconsts.CLAMP_TO_EDGE)
+		gl.TexParameteri(oglconsts.TEXTURE_CUBE_MAP, oglconsts.TEXTURE_WRAP_T, oglconsts.CLAMP_TO_EDGE)
+		gl.TexParameteri(oglconsts.TEXTURE_CUBE_MAP, oglconsts.TEXTURE_WRAP_R, oglconsts.CLAMP_TO_EDGE)
+	}
+	gl.BindTexture(oglconsts.TEXTURE_CUBE_MAP, 0)
+
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, ps.fbo)
+	gl.FramebufferTexture(oglconsts.FRAMEBUFFER, oglconsts.DEPTH_ATTACHMENT, ps.cubeTextures[0], 0)
+	gl.DrawBuffer(oglconsts.NONE)
+	gl.ReadBuffer(oglconsts.NONE)
+	if gl.CheckFramebufferStatus(oglconsts.FRAMEBUFFER) != oglconsts.FRAMEBUFFER_COMPLETE {
+		settings.LogWarn("[PointShadows] Point shadows framebuffer (%vx%v) is not complete!", size, size)
+	}
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, 0)
+
+	gl.CheckForOpenGLErrors("PointShadows - resize")
+}
+
+// Render draws the cube maps of the point lights that cast shadows.
+// The cube maps follow the order of the point lights in the lighting shaders, the first point light uses the first cube map.
+func (ps *pointShadows) Render(frame *FrameContext) {
+	rsett := settings.GetRenderingSettings()
+	gl := ps.window.OpenGL()
+
+	ps.resize(rsett.Shadows.PointMapSize)
+	ps.sceneCenter, ps.sceneRadius = sceneBounds(frame)
+
+	ps.inUse = [pointShadowsMaxLights]bool{}
+	casters, slot := 0, 0
+	for _, light := range frame.LightSources {
+		if light.LightType != types.LightSourceTypePoint {
+			continue
+		}
+		if slot >= pointShadowsMaxLights {
+			break
+		}
+		if light.CastShadows {
+			if casters == 0 {
+				gl.BindFramebuffer(oglconsts.FRAMEBUFFER, ps.fbo)
+				gl.Viewport(0, 0, ps.size, ps.size)
+				gl.Disable(oglconsts.SCISSOR_TEST)
+				gl.Enable(oglconsts.DEPTH_TEST)
+				gl.DepthMask(true)
+				gl.PolygonMode(oglconsts.FRONT_AND_BACK, oglconsts.FILL)
+				gl.UseProgram(ps.shaderProgram)
+			}
+			position := mgl32.Vec3{light.MatrixModel[4*3+0], light.MatrixModel[4*3+1], light.MatrixModel[4*3+2]}
+			ps.renderCube(frame, slot, position)
+			casters++
+		}
+		slot++
+	}
+
+	if casters > 0 {
+		gl.BindVertexArray(0)
+		gl.UseProgram(0)
+	}
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, frame.Framebuffer)
+	gl.Viewport(0, 0, int32(frame.Width), int32(frame.Height))
+
+	gl.CheckForOpenGLErrors("PointShadows - Render")
+}
+
+func (ps *pointShadows) renderCube(frame *FrameContext, slot int, position mgl32.Vec3) {
+	gl := ps.window.OpenGL()
+
+	farPlane := position.Sub(ps.sceneCenter).Len() + ps.sceneRadius
+	if farPlane < 1 {
+		farPlane = 1
+	}
+	ps.inUse[slot] = true
+	ps.farPlanes[slot] = farPlane
+
+	matrixProjection := mgl32.Perspective(mgl32.DegToRad(90.0), 1.0, farPlane*0.001, farPlane)
+	for face, dir := range pointShadowFaces {
+		ps.matricesShadow[face] = matrixProjection.Mul4(mgl32.LookAtV(position, position.Add(dir[0]), dir[1]))
+	}
+
+	gl.FramebufferTexture(oglconsts.FRAMEBUFFER, oglconsts.DEPTH_ATTACHMENT, ps.cubeTextures[slot], 0)
+	gl.Clear(oglconsts.DEPTH_BUFFER_BIT)
+
+	gl.GLUniformMatrix4fv(ps.glShadowMatrices, 6, false, &ps.matricesShadow[0][0])
+	gl.Uniform3f(ps.glLightPosition, position.X(), position.Y(), position.Z())
+	gl.Uniform1f(ps.glFarPlane, farPlane)
+	for _, mfd := range frame.MeshModelFaces {
+		if !mfd.ShowShadows {
+			continue
+		}
+		matrixModel := mfd.ModelMatrix(frame.MatrixGrid)
+		gl.GLUniformMatrix4fv(ps.glModelMatrix, 1, false, &matrixModel[0])
+		gl.BindVertexArray(mfd.GLVAO)
+		gl.DrawElements(oglconsts.TRIANGLES, mfd.MeshModel.CountIndices, oglconsts.UNSIGNED_INT, 0)
+	}
+}
+
+func newPointShadowUniforms(gl interfaces.OpenGL, program uint32) pointShadowUniforms {
+	u := pointShadowUniforms{}
+	u.bias = gl.GLGetUniformLocation(program, gl.Str("shadow_pointBias\x00"))
+	u.softness = gl.GLGetUniformLocation(program, gl.Str("shadow_pointSoftness\x00"))
+	for i := 0; i < pointShadowsMaxLights; i++ {
+		u.inUse = append(u.inUse, gl.GLGetUniformLocation(program, gl.Str("pointShadows["+fmt.Sprint(i)+"].inUse\x00")))
+		u.farPlane = append(u.farPlane, gl.GLGetUniformLocation(program, gl.Str("pointShadows["+fmt.Sprint(i)+"].farPlane\x00")))
+		u.samplers = append(u.samplers, gl.GLGetUniformLocation(program, gl.Str("sampler_pointShadows["+fmt.Sprint(i)+"]\x00")))
+	}
+	return u
+}
+
+// disable turns the point shadows off in the current program.
+// The cube samplers still get their own texture units, so they never share a unit with a 2D sampler.
+func (u *pointShadowUniforms) disable(gl interfaces.OpenGL) {
+	for i := 0; i < pointShadowsMaxLights; i++ {
+		gl.Uniform1i(u.samplers[i], int32(pointShadowsTextureUnit+i))
+		gl.Uniform1i(u.inUse[i], 0)
+	}
+}
+
+// apply binds the cube maps and sets the point shadow uniforms of the current program
+func (ps *pointShadows) apply(u *pointShadowUniforms) {
+	rsett := settings.GetRenderingSettings()
+	gl := ps.window.OpenGL()
+
+	gl.Uniform1f(u.bias, rsett.Shadows.PointBias)
+	gl.Uniform1f(u.softness, rsett.Shadows.PointSoftness)
+	for i := 0; i < pointShadowsMaxLights; i++ {
+		gl.ActiveTexture(oglconsts.TEXTURE0 + uint32(pointShadowsTextureUnit+i))
+		gl.BindTexture(oglconsts.TEXTURE_CUBE_MAP, ps.cubeTextures[i])
+		gl.Uniform1i(u.samplers[i], int32(pointShadowsTextureUnit+i))
+		if ps.inUse[i] {
+			gl.Uniform1i(u.inUse[i], 1)
+			gl.Uniform1f(u.farPlane[i], ps.farPlanes[i])
+		} else {
+			gl.Uniform1i(u.inUse[i], 0)
+		}
+	}
+	gl.ActiveTexture(oglconsts.TEXTURE0)
+}
+
+// Dispose ...
+func (ps *pointShadows) Dispose() {
+	gl := ps.window.OpenGL()
+
+	gl.DeleteFramebuffers([]uint32{ps.fbo})
+	gl.DeleteTextures(ps.cubeTextures)
+	gl.DeleteProgram(ps.shaderProgram)
+}
@@ -1,29 +1,324 @@
 package renderers
 
 import (
+	"fmt"
+	"math"
+
 	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/engine"
+	"github.com/supudo/Kuplung-Go/engine/oglconsts"
 	"github.com/supudo/Kuplung-Go/interfaces"
-	"github.com/supudo/Kuplung-Go/meshes"
-	"github.com/supudo/Kuplung-Go/objects"
+	"github.com/supudo/Kuplung-Go/settings"
 	"github.com/supudo/Kuplung-Go/types"
 )
 
-// RendererShadowMapping ...
+func init() {
+	Register(Registration{
+		ID:    types.InAppRendererTypeShadowMapping,
+		Title: "Shadow Mapping",
+		New:   func(window interfaces.Window) Renderer { return NewRendererShadowMapping(window) },
+	})
+}
+
+// RendererShadowMapping draws the models with Blinn-Phong lighting and shadows from the directional, spot and point lights
 type RendererShadowMapping struct {
 	window interfaces.Window
+
+	shadows      *shadowAtlas
+	pointShadows *pointShadows
+
+	shaderProgram uint32
+
+	glVS_MVPMatrix, glVS_ModelMatrix                 int32
+	glFS_CameraPosition, glFS_UIAmbient              int32
+	glFS_GammaCoeficient, glFS_ShowShadows           int32
+	glMaterial_Ambient, glMaterial_Diffuse           int32
+	glMaterial_Specular, glMaterial_SpecularExp      int32
+	glMaterial_HasTextureDiffuse, glMaterial_Sampler int32
+
+	solidLight *types.ModelFaceLightSourceDirectional
+
+	mfLights_Directional []*types.ModelFaceLightSourceDirectional
+	mfLights_Point       []*types.ModelFaceLightSourcePoint
+	mfLights_Spot        []*types.ModelFaceLightSourceSpot
+
+	glShadowIndex_Directional, glShadowIndex_Spot []int32
+
+	shadowUniforms      shadowUniforms
+	pointShadowUniforms pointShadowUniforms
 }
 
 // NewRendererShadowMapping ...
 func NewRendererShadowMapping(window interfaces.Window) *RendererShadowMapping {
 	rend := &RendererShadowMapping{}
 	rend.window = window
+	rend.Init()
 	return rend
 }
 
+// Init ...
+func (rend *RendererShadowMapping) Init() {
+	sett := settings.GetSettings()
+	gl := rend.window.OpenGL()
+
+	if rend.shadows == nil {
+		rend.shadows = newShadowAtlas(rend.window)
+	}
+	if rend.pointShadows == nil {
+		rend.pointShadows = newPointShadows(rend.window)
+	}
+	if rend.shaderProgram != 0 {
+		gl.DeleteProgram(rend.shaderProgram)
+	}
+
+	sVertex := engine.GetShaderSource(sett.App.AppFolder + "shaders/shadow_mapping.vert")
+	sFragment := engine.GetShaderSourcePartial(sett.App.AppFolder+"shaders/shadow_mapping.frag") + engine.GetShaderSourcePartial(sett.App.AppFolder+"shaders/shadow_atlas.frag") + engine.GetShaderSource(sett.App.AppFolder+"shaders/shadow_cubemaps.frag")
+
+	var err error
+	rend.shaderProgram, err = engine.LinkNewStandardProgram(gl, sVertex, sFragment)
+	if err != nil {
+		settings.LogWarn("[RendererShadowMapping] Can't load the shadow mapping shaders: %v", err)
+	}
+
+	rend.glVS_MVPMatrix = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("vs_MVPMatrix\x00"))
+	rend.glVS_ModelMatrix = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("vs_ModelMatrix\x00"))
+	rend.glFS_CameraPosition = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("fs_cameraPosition\x00"))
+	rend.glFS_UIAmbient = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("fs_UIAmbient\x00"))
+	rend.glFS_GammaCoeficient = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("fs_gammaCoeficient\x00"))
+	rend.glFS_ShowShadows = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("fs_showShadows\x00"))
+
+	rend.glMaterial_Ambient = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("material_ambient\x00"))
+	rend.glMaterial_Diffuse = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("material_diffuse\x00"))
+	rend.glMaterial_Specular = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("material_specular\x00"))
+	rend.glMaterial_SpecularExp = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("material_specularExp\x00"))
+	rend.glMaterial_HasTextureDiffuse = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("material_hasTextureDiffuse\x00"))
+	rend.glMaterial_Sampler = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("material_samplerDiffuse\x00"))
+
+	rend.solidLight = &types.ModelFaceLightSourceDirectional{}
+	rend.solidLight.InUse = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("solidSkin_Light.inUse\x00"))
+	rend.solidLight.Direction = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("solidSkin_Light.direction\x00"))
+	rend.solidLight.Ambient = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("solidSkin_Light.ambient\x00"))
+	rend.solidLight.Diffuse = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("solidSkin_Light.diffuse\x00"))
+	rend.solidLight.Specular = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("solidSkin_Light.specular\x00"))
+	rend.solidLight.StrengthAmbient = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("solidSkin_Light.strengthAmbient\x00"))
+	rend.solidLight.StrengthDiffuse = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("solidSkin_Light.strengthDiffuse\x00"))
+	rend.solidLight.StrengthSpecular = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("solidSkin_Light.strengthSpecular\x00"))
+
+	rend.mfLights_Directional = nil
+	rend.glShadowIndex_Directional = nil
+	for i := 0; i < 8; i++ {
+		f := &types.ModelFaceLightSourceDirectional{}
+		f.InUse = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("directionalLights["+fmt.Sprint(i)+"].inUse\x00"))
+		f.Direction = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("directionalLights["+fmt.Sprint(i)+"].direction\x00"))
+		f.Ambient = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("directionalLights["+fmt.Sprint(i)+"].ambient\x00"))
+		f.Diffuse = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("directionalLights["+fmt.Sprint(i)+"].diffuse\x00"))
+		f.Specular = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("directionalLights["+fmt.Sprint(i)+"].specular\x00"))
+		f.StrengthAmbient = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("directionalLights["+fmt.Sprint(i)+"].strengthAmbient\x00"))
+		f.StrengthDiffuse = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("directionalLights["+fmt.Sprint(i)+"].strengthDiffuse\x00"))
+		f.StrengthSpecular = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("directionalLights["+fmt.Sprint(i)+"].strengthSpecular\x00"))
+		rend.mfLights_Directional = append(rend.mfLights_Directional, f)
+		rend.glShadowIndex_Directional = append(rend.glShadowIndex_Directional, gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("directionalLights["+fmt.Sprint(i)+"].shadowIndex\x00")))
+	}
+
+	rend.mfLights_Point = nil
+	for i := 0; i < 4; i++ {
+		f := &types.ModelFaceLightSourcePoint{}
+		f.InUse = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("pointLights["+fmt.Sprint(i)+"].inUse\x00"))
+		f.Position = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("pointLights["+fmt.Sprint(i)+"].position\x00"))
+		f.Constant = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("pointLights["+fmt.Sprint(i)+"].constant\x00"))
+		f.Linear = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("pointLights["+fmt.Sprint(i)+"].linear\x00"))
+		f.Quadratic = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("pointLights["+fmt.Sprint(i)+"].quadratic\x00"))
+		f.Ambient = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("pointLights["+fmt.Sprint(i)+"].ambient\x00"))
+		f.Diffuse = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("pointLights["+fmt.Sprint(i)+"].diffuse\x00"))
+		f.Specular = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("pointLights["+fmt.Sprint(i)+"].specular\x00"))
+		f.StrengthAmbient = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("pointLights["+fmt.Sprint(i)+"].strengthAmbient\x00"))
+		f.StrengthDiffuse = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("pointLights["+fmt.Sprint(i)+"].strengthDiffuse\x00"))
+		f.StrengthSpecular = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("pointLights["+fmt.Sprint(i)+"].strengthSpecular\x00"))
+		rend.mfLights_Point = append(rend.mfLights_Point, f)
+	}
+
+	rend.mfLights_Spot = nil
+	rend.glShadowIndex_Spot = nil
+	for i := 0; i < 4; i++ {
+		f := &types.ModelFaceLightSourceSpot{}
+		f.InUse = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].inUse\x00"))
+		f.Position = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].position\x00"))
+		f.Direction = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].direction\x00"))
+		f.CutOff = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].cutOff\x00"))
+		f.OuterCutOff = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].outerCutOff\x00"))
+		f.Constant = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].constant\x00"))
+		f.Linear = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].linear\x00"))
+		f.Quadratic = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].quadratic\x00"))
+		f.Ambient = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].ambient\x00"))
+		f.Diffuse = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].diffuse\x00"))
+		f.Specular = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].specular\x00"))
+		f.StrengthAmbient = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].strengthAmbient\x00"))
+		f.StrengthDiffuse = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].strengthDiffuse\x00"))
+		f.StrengthSpecular = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].strengthSpecular\x00"))
+		rend.mfLights_Spot = append(rend.mfLights_Spot, f)
+		rend.glShadowIndex_Spot = append(rend.glShadowIndex_Spot, gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("spotLights["+fmt.Sprint(i)+"].shadowIndex\x00")))
+	}
+
+	rend.shadowUniforms = newShadowUniforms(gl, rend.shaderProgram)
+	rend.pointShadowUniforms = newPointShadowUniforms(gl, rend.shaderProgram)
+
+	gl.CheckForOpenGLErrors("RendererShadowMapping - Init")
+}
+
 // Render ...
-func (rend *RendererShadowMapping) Render(rp types.RenderProperties, meshModelFaces []*meshes.ModelFace, matrixGrid mgl32.Mat4, camPos mgl32.Vec3, selectedModel int32, lightSources []*objects.Light) {
+func (rend *RendererShadowMapping) Render(frame *FrameContext) {
+	gl := rend.window.OpenGL()
+	rsett := settings.GetRenderingSettings()
+	rp := frame.RenderProps
+
+	rend.shadows.Render(frame)
+	rend.pointShadows.Render(frame)
+
+	gl.UseProgram(rend.shaderProgram)
+	gl.Enable(oglconsts.DEPTH_TEST)
+
+	gl.Uniform3f(rend.glFS_CameraPosition, frame.CameraPosition.X(), frame.CameraPosition.Y(), frame.CameraPosition.Z())
+	gl.Uniform3f(rend.glFS_UIAmbient, rp.UIAmbientLightX, rp.UIAmbientLightY, rp.UIAmbientLightZ)
+	gl.Uniform1f(rend.glFS_GammaCoeficient, rsett.General.GammaCoeficient)
+
+	rend.shadows.apply(&rend.shadowUniforms)
+	rend.pointShadows.apply(&rend.pointShadowUniforms)
+	rend.setLights(frame)
+
+	for i, mfd := range frame.MeshModelFaces {
+		matrixModel := mfd.ModelMatrix(frame.MatrixGrid)
+
+		mfd.MatrixModel = matrixModel
+		mfd.ModelViewSkin = rsett.General.SelectedViewModelSkin
+		mfd.OutlineColor = rsett.General.OutlineColor
+		mfd.IsModelSelected = int32(i) == frame.SelectedModel
+
+		mvpMatrix := frame.MatrixProjection.Mul4(frame.MatrixCamera.Mul4(matrixModel))
+		gl.GLUniformMatrix4fv(rend.glVS_MVPMatrix, 1, false, &mvpMatrix[0])
+		gl.GLUniformMatrix4fv(rend.glVS_ModelMatrix, 1, false, &matrixModel[0])
+
+		if mfd.ShowShadows {
+			gl.Uniform1i(rend.glFS_ShowShadows, 1)
+		} else {
+			gl.Uniform1i(rend.glFS_ShowShadows, 0)
+		}
+
+		gl.Uniform3f(rend.glMaterial_Ambient, mfd.MaterialAmbient.Color.X(), mfd.MaterialAmbient.Color.Y(), mfd.MaterialAmbient.Color.Z())
+		gl.Uniform3f(rend.glMaterial_Diffuse, mfd.MaterialDiffuse.Color.X(), mfd.MaterialDiffuse.Color.Y(), mfd.MaterialDiffuse.Color.Z())
+		gl.Uniform3f(rend.glMaterial_Specular, mfd.MaterialSpecular.Color.X(), mfd.MaterialSpecular.Color.Y(), mfd.MaterialSpecular.Color.Z())
+		gl.Uniform1f(rend.glMaterial_SpecularExp, mfd.MaterialSpecularExp.Point)
+
+		if mfd.HasTextureDiffuse && mfd.MeshModel.ModelMaterial.TextureDiffuse.UseTexture {
+			gl.Uniform1i(rend.glMaterial_HasTextureDiffuse, 1)
+			gl.Uniform1i(rend.glMaterial_Sampler, 0)
+			gl.ActiveTexture(oglconsts.TEXTURE0)
+			gl.BindTexture(oglconsts.TEXTURE_2D, mfd.VboTextureDiffuse)
+		} else {
+			gl.Uniform1i(rend.glMaterial_HasTextureDiffuse, 0)
+		}
+
+		mfd.Render(false)
+	}
+
+	gl.UseProgram(0)
+
+	if rsett.General.DebugShadowTexture {
+		rend.shadows.renderDebug(frame.Width, frame.Height)
+	}
+
+	gl.CheckForOpenGLErrors("RendererShadowMapping - Render")
+}
+
+func (rend *RendererShadowMapping) setLights(frame *FrameContext) {
+	gl := rend.window.OpenGL()
+	rp := frame.RenderProps
+
+	countDirectional, countPoint, countSpot := 0, 0, 0
+	for _, light := range frame.LightSources {
+		switch light.LightType {
+		case types.LightSourceTypeDirectional:
+			if countDirectional < len(rend.mfLights_Directional) {
+				f := rend.mfLights_Directional[countDirectional]
+				gl.Uniform1i(f.InUse, 1)
+				gl.Uniform1i(rend.glShadowIndex_Directional[countDirectional], rend.shadows.lightIndex(light))
+				gl.Uniform3f(f.Direction, light.PositionX.Point, light.PositionY.Point, light.PositionZ.Point)
+				gl.Uniform3f(f.Ambient, light.Ambient.Color.X(), light.Ambient.Color.Y(), light.Ambient.Color.Z())
+				gl.Uniform3f(f.Diffuse, light.Diffuse.Color.X(), light.Diffuse.Color.Y(), light.Diffuse.Color.Z())
+				gl.Uniform3f(f.Specular, light.Specular.Color.X(), light.Specular.Color.Y(), light.Specular.Color.Z())
+				gl.Uniform1f(f.StrengthAmbient, light.Ambient.Strength)
+				gl.Uniform1f(f.StrengthDiffuse, light.Diffuse.Strength)
+				gl.Uniform1f(f.StrengthSpecular, light.Specular.Strength)
+				countDirectional++
+			}
+		case types.LightSourceTypePoint:
+			if countPoint < len(rend.mfLights_Point) {
+				f := rend.mfLights_Point[countPoint]
+				gl.Uniform1i(f.InUse, 1)
+				gl.Uniform3f(f.Position, light.MatrixModel[4*3+0], light.MatrixModel[4*3+1], light.MatrixModel[4*3+2])
+				gl.Uniform1f(f.Constant, light.LConstant.Point)
+				gl.Uniform1f(f.Linear, light.LLinear.Point)
+				gl.Uniform1f(f.Quadratic, light.LQuadratic.Point)
+				gl.Uniform3f(f.Ambient, light.Ambient.Color.X(), light.Ambient.Color.Y(), light.Ambient.Color.Z())
+				gl.Uniform3f(f.Diffuse, light.Diffuse.Color.X(), light.Diffuse.Color.Y(), light.Diffuse.Color.Z())
+				gl.Uniform3f(f.Specular, light.Specular.Color.X(), light.Specular.Color.Y(), light.Specular.Color.Z())
+				gl.Uniform1f(f.StrengthAmbient, light.Ambient.Strength)
+				gl.Uniform1f(f.StrengthDiffuse, light.Diffuse.Strength)
+				gl.Uniform1f(f.StrengthSpecular, light.Specular.Strength)
+				countPoint++
+			}
+		case types.LightSourceTypeSpot:
+			if countSpot < len(rend.mfLights_Spot) {
+				f := rend.mfLights_Spot[countSpot]
+				gl.Uniform1i(f.InUse, 1)
+				gl.Uniform1i(rend.glShadowIndex_Spot[countSpot], rend.shadows.lightIndex(light))
+				direction := spotLightDirection(light)
+				gl.Uniform3f(f.Position, light.MatrixModel[4*3+0], light.MatrixModel[4*3+1], light.MatrixModel[4*3+2])
+				gl.Uniform3f(f.Direction, direction.X(), direction.Y(), direction.Z())
+				gl.Uniform1f(f.CutOff, float32(math.Cos(float64(mgl32.DegToRad(light.LCutOff.Point)))))
+				gl.Uniform1f(f.OuterCutOff, float32(math.Cos(float64(mgl32.DegToRad(light.LOuterCutOff.Point)))))
+				gl.Uniform1f(f.Constant, light.LConstant.Point)
+				gl.Uniform1f(f.Linear, light.LLinear.Point)
+				gl.Uniform1f(f.Quadratic, light.LQuadratic.Point)
+				gl.Uniform3f(f.Ambient, light.Ambient.Color.X(), light.Ambient.Color.Y(), light.Ambient.Color.Z())
+				gl.Uniform3f(f.Diffuse, light.Diffuse.Color.X(), light.Diffuse.Color.Y(), light.Diffuse.Color.Z())
+				gl.Uniform3f(f.Specular, light.Specular.Color.X(), light.Specular.Color.Y(), light.Specular.Color.Z())
+				gl.Uniform1f(f.StrengthAmbient, light.Ambient.Strength)
+				gl.Uniform1f(f.StrengthDiffuse, light.Diffuse.Strength)
+				gl.Uniform1f(f.StrengthSpecular, light.Specular.Strength)
+				countSpot++
+			}
+		}
+	}
+	for i := countDirectional; i < len(rend.mfLights_Directional); i++ {
+		gl.Uniform1i(rend.mfLights_Directional[i].InUse, 0)
+	}
+	for i := countPoint; i < len(rend.mfLights_Point); i++ {
+		gl.Uniform1i(rend.mfLights_Point[i].InUse, 0)
+	}
+	for i := countSpot; i < len(rend.mfLights_Spot); i++ {
+		gl.Uniform1i(rend.mfLights_Spot[i].InUse, 0)
+	}
+
+	// without scene lights the models are lit by the solid light, like in the other renderers
+	if len(frame.LightSources) == 0 {
+		gl.Uniform1i(rend.solidLight.InUse, 1)
+		gl.Uniform3f(rend.solidLight.Direction, rp.SolidLightDirectionX, rp.SolidLightDirectionY, rp.SolidLightDirectionZ)
+		gl.Uniform3f(rend.solidLight.Ambient, rp.SolidLightAmbient.X(), rp.SolidLightAmbient.Y(), rp.SolidLightAmbient.Z())
+		gl.Uniform3f(rend.solidLight.Diffuse, rp.SolidLightDiffuse.X(), rp.SolidLightDiffuse.Y(), rp.SolidLightDiffuse.Z())
+		gl.Uniform3f(rend.solidLight.Specular, rp.SolidLightSpecular.X(), rp.SolidLightSpecular.Y(), rp.SolidLightSpecular.Z())
+		gl.Uniform1f(rend.solidLight.StrengthAmbient, rp.SolidLightAmbientStrength)
+		gl.Uniform1f(rend.solidLight.StrengthDiffuse, rp.SolidLightDiffuseStrength)
+		gl.Uniform1f(rend.solidLight.StrengthSpecular, rp.SolidLightSpecularStrength)
+	} else {
+		gl.Uniform1i(rend.solidLight.InUse, 0)
+	}
 }
 
 // Dispose ...
 func (rend *RendererShadowMapping) Dispose() {
+	gl := rend.window.OpenGL()
+
+	rend.shadows.Dispose()
+	rend.pointShadows.Dispose()
+	gl.DeleteProgram(rend.shaderProgram)
 }
@@ -5,11 +5,18 @@ import (
 	"github.com/supudo/Kuplung-Go/engine"
 	"github.com/supudo/Kuplung-Go/engine/oglconsts"
 	"github.com/supudo/Kuplung-Go/interfaces"
-	"github.com/supudo/Kuplung-Go/meshes"
 	"github.com/supudo/Kuplung-Go/settings"
 	"github.com/supudo/Kuplung-Go/types"
 )
 
+func init() {
+	Register(Registration{
+		ID:    types.InAppRendererTypeSimple,
+		Title: "Simple",
+		New:   func(window interfaces.Window) Renderer { return NewRendererSimple(window) },
+	})
+}
+
 // RendererSimple ...
 type RendererSimple struct {
 	window interfaces.Window
@@ -26,11 +33,20 @@ type RendererSimple struct {
 
 // NewRendererSimple ...
 func NewRendererSimple(window interfaces.Window) *RendererSimple {
-	sett := settings.GetSettings()
-	gl := window.OpenGL()
-
 	rend := &RendererSimple{}
 	rend.window = window
+	rend.Init()
+	return rend
+}
+
+// Init ...
+func (rend *RendererSimple) Init() {
+	sett := settings.GetSettings()
+	gl := rend.window.OpenGL()
+
+	if rend.shaderProgram != 0 {
+		gl.DeleteProgram(rend.shaderProgram)
+	}
 
 	sVertex := engine.GetShaderSource(sett.App.AppFolder + "shaders/rendering_simple.vert")
 	sTcs := engine.GetShaderSource(sett.App.AppFolder + "shaders/rendering_simple.tcs")
@@ -65,23 +81,23 @@ func NewRendererSimple(window interfaces.Window) *RendererSimple {
 	rend.solidLight.StrengthDiffuse = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("solidSkin_Light.strengthDiffuse\x00"))
 	rend.solidLight.StrengthSpecular = gl.GLGetUniformLocation(rend.shaderProgram, gl.Str("solidSkin_Light.strengthSpecular\x00"))
 
-	gl.CheckForOpenGLErrors("ForwardRenderer")
-
-	return rend
+	gl.CheckForOpenGLErrors("SimpleRenderer")
 }
 
 // Render ...
-func (rend *RendererSimple) Render(rp types.RenderProperties, meshModelFaces []*meshes.ModelFace, matrixGrid mgl32.Mat4, camPos mgl32.Vec3) {
+func (rend *RendererSimple) Render(frame *FrameContext) {
 	gl := rend.window.OpenGL()
 	rsett := settings.GetRenderingSettings()
+	rp := frame.RenderProps
+	camPos := frame.CameraPosition
 
 	gl.UseProgram(rend.shaderProgram)
 
-	for i := 0; i < len(meshModelFaces); i++ {
-		mfd := meshModelFaces[i]
+	for i := 0; i < len(frame.MeshModelFaces); i++ {
+		mfd := frame.MeshModelFaces[i]
 
 		matrixModel := mgl32.Ident4()
-		matrixModel = matrixModel.Mul4(matrixGrid)
+		matrixModel = matrixModel.Mul4(frame.MatrixGrid)
 		// scale
 		matrixModel = matrixModel.Mul4(mgl32.Scale3D(mfd.ScaleX.Point, mfd.ScaleY.Point, mfd.ScaleZ.Point))
 		// rotate
@@ -96,7 +112,7 @@ func (rend *RendererSimple) Render(rp types.RenderProperties, meshModelFaces []*
 		mfd.MatrixModel = matrixModel
 		mfd.ModelViewSkin = rsett.General.SelectedViewModelSkin
 
-		mvpMatrix := rsett.MatrixProjection.Mul4(rsett.MatrixCamera.Mul4(matrixModel))
+		mvpMatrix := frame.MatrixProjection.Mul4(frame.MatrixCamera.Mul4(matrixModel))
 		gl.GLUniformMatrix4fv(rend.glMVPMatrix, 1, false, &mvpMatrix[0])
 
 		gl.GLUniformMatrix4fv(rend.glWorldMatrix, 1, false, &matrixModel[0])
@@ -0,0 +1,209 @@
+package renderers
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/engine"
+	"github.com/supudo/Kuplung-Go/engine/oglconsts"
+	"github.com/supudo/Kuplung-Go/interfaces"
+	"github.com/supudo/Kuplung-Go/settings"
+)
+
+// ssaoMaxSamples has to match MAX_KERNEL_SIZE in ssao.frag
+const ssaoMaxSamples = 64
+
+// ssaoNoiseSize is the side of the tiled rotation noise texture, has to match NOISE_SIZE in ssao.frag and ssao_blur.frag
+const ssaoNoiseSize = 4
+
+// ssao computes screen-space ambient occlusion from the positions and the normals in a g-buffer.
+// The occlusion is sampled in a normal oriented hemisphere, rotated per pixel by a tiled noise texture and blurred afterwards.
+type ssao struct {
+	window interfaces.Window
+
+	shaderProgram     uint32
+	shaderProgramBlur uint32
+
+	glPosition, glNormal, glNoise  int32
+	glView, glProjection           int32
+	glKernelSize, glRadius, glBias int32
+	glPower                        int32
+	glSamples                      []int32
+	glBlurInput                    int32
+
+	fbo, fboBlur                uint32
+	texture, textureBlur, noise uint32
+	width, height               int32
+	kernel                      [ssaoMaxSamples]mgl32.Vec3
+}
+
+func newSSAO(window interfaces.Window) *ssao {
+	ao := &ssao{}
+	ao.window = window
+	ao.init()
+	return ao
+}
+
+func (ao *ssao) init() {
+	sett := settings.GetSettings()
+	gl := ao.window.OpenGL()
+
+	sVertex := engine.GetShaderSource(sett.App.AppFolder + "shaders/deferred_shading.vert")
+	sFragment := engine.GetShaderSource(sett.App.AppFolder + "shaders/ssao.frag")
+	var err error
+	ao.shaderProgram, err = engine.LinkNewStandardProgram(gl, sVertex, sFragment)
+	if err != nil {
+		settings.LogWarn("[SSAO] Can't load the SSAO shaders: %v", err)
+	}
+	ao.glPosition = gl.GLGetUniformLocation(ao.shaderProgram, gl.Str("sampler_position\x00"))
+	ao.glNormal = gl.GLGetUniformLocation(ao.shaderProgram, gl.Str("sampler_normal\x00"))
+	ao.glNoise = gl.GLGetUniformLocation(ao.shaderProgram, gl.Str("sampler_noise\x00"))
+	ao.glView = gl.GLGetUniformLocation(ao.shaderProgram, gl.Str("view\x00"))
+	ao.glProjection = gl.GLGetUniformLocation(ao.shaderProgram, gl.Str("projection\x00"))
+	ao.glKernelSize = gl.GLGetUniformLocation(ao.shaderProgram, gl.Str("kernelSize\x00"))
+	ao.glRadius = gl.GLGetUniformLocation(ao.shaderProgram, gl.Str("radius\x00"))
+	ao.glBias = gl.GLGetUniformLocation(ao.shaderProgram, gl.Str("bias\x00"))
+	ao.glPower = gl.GLGetUniformLocation(ao.shaderProgram, gl.Str("power\x00"))
+	ao.glSamples = make([]int32, ssaoMaxSamples)
+	for i := 0; i < ssaoMaxSamples; i++ {
+		ao.glSamples[i] = gl.GLGetUniformLocation(ao.shaderProgram, gl.Str("samples["+fmt.Sprint(i)+"]\x00"))
+	}
+
+	sFragment = engine.GetShaderSource(sett.App.AppFolder + "shaders/ssao_blur.frag")
+	ao.shaderProgramBlur, err = engine.LinkNewStandardProgram(gl, sVertex, sFragment)
+	if err != nil {
+		settings.LogWarn("[SSAO] Can't load the SSAO blur shaders: %v", err)
+	}
+	ao.glBlurInput = gl.GLGetUniformLocation(ao.shaderProgramBlur, gl.Str("sampler_ssao\x00"))
+
+	// hemisphere kernel, the samples get denser towards the origin
+	for i := 0; i < ssaoMaxSamples; i++ {
+		sample := mgl32.Vec3{
+			rand.Float32()*2.0 - 1.0,
+			rand.Float32()*2.0 - 1.0,
+			rand.Float32()}
+		sample = sample.Normalize().Mul(rand.Float32())
+		scale := float32(i) / float32(ssaoMaxSamples)
+		scale = 0.1 + scale*scale*0.9
+		ao.kernel[i] = sample.Mul(scale)
+	}
+
+	// rotation vectors around the z axis (tangent space)
+	noise := make([]float32, 0, ssaoNoiseSize*ssaoNoiseSize*3)
+	for i := 0; i < ssaoNoiseSize*ssaoNoiseSize; i++ {
+		noise = append(noise, rand.Float32()*2.0-1.0, rand.Float32()*2.0-1.0, 0.0)
+	}
+	ao.noise = gl.GenTextures(1)[0]
+	gl.BindTexture(oglconsts.TEXTURE_2D, ao.noise)
+	gl.TexImage2D(oglconsts.TEXTURE_2D, 0, oglconsts.RGB16F, ssaoNoiseSize, ssaoNoiseSize, 0, oglconsts.RGB, oglconsts.FLOAT, gl.Ptr(noise))
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_MIN_FILTER, oglconsts.NEAREST)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_MAG_FILTER, oglconsts.NEAREST)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_WRAP_S, oglconsts.REPEAT)
+	gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_WRAP_T, oglconsts.REPEAT)
+	gl.BindTexture(oglconsts.TEXTURE_2D, 0)
+
+	ao.fbo = gl.GenFramebuffers(1)[0]
+	ao.fboBlur = gl.GenFramebuffers(1)[0]
+	ao.texture = gl.GenTextures(1)[0]
+	ao.textureBlur = gl.GenTextures(1)[0]
+
+	gl.CheckForOpenGLErrors("SSAO - init")
+}
+
+// resize (re)allocates the occlusion textures when the size of the g-buffer changes
+func (ao *ssao) resize(width, height int32) {
+	if width == ao.width && height == ao.height {
+		return
+	}
+	gl := ao.window.OpenGL()
+	ao.width, ao.height = width, height
+
+	for _, target := range [][2]uint32{{ao.fbo, ao.texture}, {ao.fboBlur, ao.textureBlur}} {
+		gl.BindFramebuffer(oglconsts.FRAMEBUFFER, target[0])
+		gl.BindTexture(oglconsts.TEXTURE_2D, target[1])
+		gl.TexImage2D(oglconsts.TEXTURE_2D, 0, oglconsts.R8, width, height, 0, oglconsts.RED, oglconsts.UNSIGNED_BYTE, nil)
+		gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_MIN_FILTER, oglconsts.NEAREST)
+		gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_MAG_FILTER, oglconsts.NEAREST)
+		gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_WRAP_S, oglconsts.CLAMP_TO_EDGE)
+		gl.TexParameteri(oglconsts.TEXTURE_2D, oglconsts.TEXTURE_WRAP_T, oglconsts.CLAMP_TO_EDGE)
+		gl.FramebufferTexture2D(oglconsts.FRAMEBUFFER, oglconsts.COLOR_ATTACHMENT0, oglconsts.TEXTURE_2D, target[1], 0)
+		if gl.CheckFramebufferStatus(oglconsts.FRAMEBUFFER) != oglconsts.FRAMEBUFFER_COMPLETE {
+			settings.LogWarn("[SSAO] SSAO framebuffer (%vx%v) is not complete!", width, height)
+		}
+	}
+	gl.BindTexture(oglconsts.TEXTURE_2D, 0)
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, 0)
+
+	gl.CheckForOpenGLErrors("SSAO - resize")
+}
+
+// Render computes the blurred occlusion of the g-buffer, drawQuad has to draw a full screen quad.
+// The result is in Texture() and target is bound again afterwards.
+func (ao *ssao) Render(gPosition, gNormal uint32, width, height int32, view, projection mgl32.Mat4, target uint32, drawQuad func()) {
+	rsett := settings.GetRenderingSettings()
+	gl := ao.window.OpenGL()
+
+	ao.resize(width, height)
+
+	kernelSize := rsett.Defered.SSAOSamples
+	if kernelSize < 1 {
+		kernelSize = 1
+	} else if kernelSize > ssaoMaxSamples {
+		kernelSize = ssaoMaxSamples
+	}
+
+	gl.Viewport(0, 0, width, height)
+
+	// occlusion
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, ao.fbo)
+	gl.Clear(oglconsts.COLOR_BUFFER_BIT)
+	gl.UseProgram(ao.shaderProgram)
+	gl.Uniform1i(ao.glPosition, 0)
+	gl.Uniform1i(ao.glNormal, 1)
+	gl.Uniform1i(ao.glNoise, 2)
+	gl.ActiveTexture(oglconsts.TEXTURE0)
+	gl.BindTexture(oglconsts.TEXTURE_2D, gPosition)
+	gl.ActiveTexture(oglconsts.TEXTURE1)
+	gl.BindTexture(oglconsts.TEXTURE_2D, gNormal)
+	gl.ActiveTexture(oglconsts.TEXTURE2)
+	gl.BindTexture(oglconsts.TEXTURE_2D, ao.noise)
+	gl.GLUniformMatrix4fv(ao.glView, 1, false, &view[0])
+	gl.GLUniformMatrix4fv(ao.glProjection, 1, false, &projection[0])
+	gl.Uniform1i(ao.glKernelSize, kernelSize)
+	gl.Uniform1f(ao.glRadius, rsett.Defered.SSAORadius)
+	gl.Uniform1f(ao.glBias, rsett.Defered.SSAOBias)
+	gl.Uniform1f(ao.glPower, rsett.Defered.SSAOStrength)
+	for i := int32(0); i < kernelSize; i++ {
+		gl.Uniform3fv(ao.glSamples[i], 1, &ao.kernel[i][0])
+	}
+	drawQuad()
+
+	// blur
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, ao.fboBlur)
+	gl.Clear(oglconsts.COLOR_BUFFER_BIT)
+	gl.UseProgram(ao.shaderProgramBlur)
+	gl.Uniform1i(ao.glBlurInput, 0)
+	gl.ActiveTexture(oglconsts.TEXTURE0)
+	gl.BindTexture(oglconsts.TEXTURE_2D, ao.texture)
+	drawQuad()
+
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, target)
+
+	gl.CheckForOpenGLErrors("SSAO - Render")
+}
+
+// Texture returns the blurred occlusion, 1.0 is not occluded
+func (ao *ssao) Texture() uint32 {
+	return ao.textureBlur
+}
+
+// Dispose ...
+func (ao *ssao) Dispose() {
+	gl := ao.window.OpenGL()
+
+	gl.DeleteFramebuffers([]uint32{ao.fbo, ao.fboBlur})
+	gl.DeleteTextures([]uint32{ao.texture, ao.textureBlur, ao.noise})
+	gl.DeleteProgram(ao.shaderProgram)
+	gl.DeleteProgram(ao.shaderProgramBlur)
+}
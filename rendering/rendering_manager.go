@@ -2,9 +2,12 @@ package rendering
 
 import (
 	"fmt"
+	"path/filepath"
+	"strings"
 
 	"github.com/go-gl/mathgl/mgl32"
 	"github.com/sadlil/go-trigger"
+	"github.com/supudo/Kuplung-Go/engine"
 	"github.com/supudo/Kuplung-Go/engine/export"
 	"github.com/supudo/Kuplung-Go/engine/parsers"
 	"github.com/supudo/Kuplung-Go/interfaces"
@@ -27,12 +30,12 @@ type RenderManager struct {
 	CameraModel *objects.CameraModel
 	miniAxis    *objects.MiniAxis
 	SkyBox      *objects.SkyBox
+	cutPlane    *objects.CutPlane
 
-	rendererDefered              *renderers.RendererDefered
-	rendererForward              *renderers.RendererForward
-	rendererForwardShadowMapping *renderers.RendererForwardShadowMapping
-	rendererShadowMapping        *renderers.RendererShadowMapping
-	rendererSimple               *renderers.RendererSimple
+	// rendererInstances are created on first use and kept by renderer id
+	rendererInstances map[uint32]renderers.Renderer
+	postProcessing    *postProcessing
+	pathTracing       *pathTracing
 
 	gridSize int32
 
@@ -40,6 +43,10 @@ type RenderManager struct {
 	fileParser      *parsers.ParserManager
 	sceneExporter   *export.ExporterManager
 	saveOpenManager *saveopen.SOManager
+	// pendingSave is saved after the next rendered scene, so that its thumbnail shows the viewport without the GUI
+	pendingSave *types.FBEntity
+	// pendingImage is rendered after the next rendered scene, outside of the GUI frame
+	pendingImage *types.FBEntity
 
 	systemModels map[string]types.MeshModel
 
@@ -75,7 +82,10 @@ func NewRenderManager(window interfaces.Window, doProgress func(float32)) *Rende
 	rm.initCameraModel()
 	rm.initMiniAxis()
 	rm.initSkyBox()
+	rm.initCutPlane()
 	rm.initRenderers()
+	rm.initPostProcessing()
+	rm.initPathTracing()
 	rm.initSaveOpen()
 
 	rm.rayPicker = InitRayPicking(window)
@@ -87,6 +97,12 @@ func NewRenderManager(window interfaces.Window, doProgress func(float32)) *Rende
 	trigger.On(types.ActionFileExport, rm.fileExport)
 	trigger.On(types.ActionFileSaverSaveScene, rm.saveScene)
 	trigger.On(types.ActionFileSaverOpenScene, rm.openScene)
+	trigger.On(types.ActionFileSaverUnpack, rm.unpackResources)
+	trigger.On(types.ActionFileSaverAppend, rm.appendScene)
+	trigger.On(types.ActionFileSaverRenderer, rm.renderImage)
+	trigger.On(types.ActionFileSaverEnvironmentMap, rm.loadEnvironmentMap)
+	trigger.On(types.ActionFileSaverColorGradingLUT, rm.loadColorGradingLUT)
+	trigger.On(types.ActionGuiActionExit, rm.saveOpenManager.EndSession)
 	trigger.On(types.ActionEventMouseLeftDown, rm.rayPickerAction)
 
 	return rm
@@ -106,22 +122,51 @@ func (rm *RenderManager) ResetSettings() {
 
 // Render handles rendering of all scene objects
 func (rm *RenderManager) Render() {
-	sett := settings.GetSettings()
+	rsett := settings.GetRenderingSettings()
+	frame := rm.prepareFrame()
+	reg, rend := rm.activeRenderer()
+
+	if rsett.PostProcessing.Enabled {
+		frame.Framebuffer = rm.postProcessing.Begin(frame.Width, frame.Height)
+	}
 
-	if sett.App.RendererType == types.InAppRendererTypeDeferred {
-		w, h := rm.Window.Size()
-		rm.Window.OpenGL().Viewport(0, 0, int32(w), int32(h))
-		rm.rendererDefered.Render(rm.RenderProps, rm.MeshModelFaces, rm.wgrid.MatrixModel, rm.Camera.CameraPosition, rm.SceneSelectedModelObject, rm.LightSources, w, h)
-		rm.renderElements()
+	// the Rendered skin shows the path traced image once its first pass is done, the rasterized scene until then
+	pathTraced := false
+	if rsett.General.SelectedViewModelSkin == types.ViewModelSkinRendered {
+		pathTraced = rm.pathTracing.Render(frame)
 	} else {
-		rm.renderElements()
-		rm.renderScene()
+		rm.pathTracing.Stop()
+	}
+
+	if !pathTraced {
+		if reg.SceneFirst {
+			rm.renderScene(rend, frame)
+			rm.renderElements()
+		} else {
+			rm.renderElements()
+			rm.renderScene(rend, frame)
+		}
 	}
 
 	rm.renderRays()
+
+	if rsett.PostProcessing.Enabled {
+		rm.postProcessing.End(0, [4]float32{0, 0, 1, 1})
+	}
+
+	if rm.pendingSave != nil {
+		rm.writeScene(rm.pendingSave, rm.captureThumbnail())
+		rm.pendingSave = nil
+	}
+	if rm.pendingImage != nil {
+		rm.writeImage(rm.pendingImage)
+		rm.pendingImage = nil
+	}
+	rm.saveOpenManager.Autosave(rm.MeshModelFaces, rm.LightSources, rm.RenderProps, rm.Camera, rm.wgrid)
 }
 
-func (rm *RenderManager) renderElements() {
+// prepareFrame sets the viewport, updates the projection and the camera and collects what the renderers need
+func (rm *RenderManager) prepareFrame() *renderers.FrameContext {
 	rsett := settings.GetRenderingSettings()
 
 	w, h := rm.Window.Size()
@@ -131,6 +176,47 @@ func (rm *RenderManager) renderElements() {
 	rm.Camera.Render()
 	rsett.MatrixCamera = rm.Camera.MatrixCamera
 
+	return rm.newFrameContext(w, h)
+}
+
+// newFrameContext collects the scene for a frame of the size, with the projection and the camera of the viewport
+func (rm *RenderManager) newFrameContext(w, h int) *renderers.FrameContext {
+	rsett := settings.GetRenderingSettings()
+	return &renderers.FrameContext{
+		RenderProps:      rm.RenderProps,
+		MeshModelFaces:   rm.MeshModelFaces,
+		LightSources:     rm.LightSources,
+		SelectedModel:    rm.SceneSelectedModelObject,
+		MatrixGrid:       rm.wgrid.MatrixModel,
+		MatrixProjection: rsett.MatrixProjection,
+		MatrixCamera:     rsett.MatrixCamera,
+		CameraPosition:   rm.Camera.CameraPosition,
+		Environment:      rm.SkyBox.Environment(),
+		Width:            w,
+		Height:           h,
+	}
+}
+
+// activeRenderer returns the renderer selected in the settings, creating it on first use
+func (rm *RenderManager) activeRenderer() (renderers.Registration, renderers.Renderer) {
+	sett := settings.GetSettings()
+	reg, ok := renderers.Lookup(sett.App.RendererType)
+	if !ok {
+		settings.LogWarn("[RenderManager] Unknown renderer %v, switching to the forward renderer", sett.App.RendererType)
+		sett.App.RendererType = types.InAppRendererTypeForward
+		reg, _ = renderers.Lookup(sett.App.RendererType)
+	}
+	rend, ok := rm.rendererInstances[reg.ID]
+	if !ok {
+		rend = reg.New(rm.Window)
+		rm.rendererInstances[reg.ID] = rend
+	}
+	return reg, rend
+}
+
+func (rm *RenderManager) renderElements() {
+	rsett := settings.GetRenderingSettings()
+
 	ahPosition := float32(rsett.Grid.WorldGridSizeSquares / 2)
 
 	if rsett.Grid.WorldGridSizeSquares != rm.gridSize {
@@ -161,25 +247,23 @@ func (rm *RenderManager) renderElements() {
 		for i := 0; i < len(rm.LightSources); i++ {
 			rm.LightSources[i].Render()
 		}
+
+		if rsett.CrossSection.ShowPlane {
+			rm.cutPlane.Render(rm.wgrid.MatrixModel)
+		}
 	}
 }
 
-func (rm *RenderManager) renderScene() {
+func (rm *RenderManager) renderScene(rend renderers.Renderer, frame *renderers.FrameContext) {
 	sett := settings.GetSettings()
-	if sett.Components.ShouldRecompileShaders && sett.App.RendererType == types.InAppRendererTypeForward {
-		rm.rendererForward.CompileShaders()
+	if sett.Components.ShouldRecompileShaders {
+		if compiler, ok := rend.(renderers.ShaderCompiler); ok {
+			compiler.CompileShaders()
+		}
+		sett.Components.ShouldRecompileShaders = false
 	}
 
-	switch sett.App.RendererType {
-	case types.InAppRendererTypeForward:
-		rm.rendererForward.Render(rm.RenderProps, rm.MeshModelFaces, rm.wgrid.MatrixModel, rm.Camera.CameraPosition, rm.SceneSelectedModelObject, rm.LightSources)
-	case types.InAppRendererTypeForwardShadowMapping:
-		rm.rendererForwardShadowMapping.Render(rm.RenderProps, rm.MeshModelFaces, rm.wgrid.MatrixModel, rm.Camera.CameraPosition, rm.SceneSelectedModelObject, rm.LightSources)
-	case types.InAppRendererTypeShadowMapping:
-		rm.rendererShadowMapping.Render(rm.RenderProps, rm.MeshModelFaces, rm.wgrid.MatrixModel, rm.Camera.CameraPosition, rm.SceneSelectedModelObject, rm.LightSources)
-	case types.InAppRendererTypeSimple:
-		rm.rendererSimple.Render(rm.RenderProps, rm.MeshModelFaces, rm.wgrid.MatrixModel, rm.Camera.CameraPosition)
-	}
+	rend.Render(frame)
 }
 
 func (rm *RenderManager) renderRays() {
@@ -231,6 +315,7 @@ func (rm *RenderManager) Dispose() {
 	rm.CameraModel.Dispose()
 	rm.miniAxis.Dispose()
 	rm.SkyBox.Dispose()
+	rm.cutPlane.Dispose()
 	for i := 0; i < len(rm.MeshModelFaces); i++ {
 		rm.MeshModelFaces[i].Dispose()
 	}
@@ -240,11 +325,12 @@ func (rm *RenderManager) Dispose() {
 	for i := 0; i < len(rm.rayLines); i++ {
 		rm.rayLines[i].Dispose()
 	}
-	rm.rendererSimple.Dispose()
-	rm.rendererDefered.Dispose()
-	rm.rendererForward.Dispose()
-	rm.rendererForwardShadowMapping.Dispose()
-	rm.rendererShadowMapping.Dispose()
+	for _, rend := range rm.rendererInstances {
+		rend.Dispose()
+	}
+	rm.postProcessing.Dispose()
+	rm.pathTracing.Dispose()
+	rm.saveOpenManager.EndSession()
 }
 
 func (rm *RenderManager) initSettings() {
@@ -341,6 +427,10 @@ func (rm *RenderManager) initSkyBox() {
 	rm.SkyBox.InitBuffers()
 }
 
+func (rm *RenderManager) initCutPlane() {
+	rm.cutPlane = objects.InitCutPlane(rm.Window)
+}
+
 func (rm *RenderManager) addShape(shape types.ShapeType) {
 	parsingChan := make(chan []types.MeshModel)
 	go rm.addShapeAsync(parsingChan, shape)
@@ -441,6 +531,7 @@ func (rm *RenderManager) clearScene() {
 	rm.MeshModelFaces = nil
 	rm.LightSources = nil
 	rm.rayLines = nil
+	engine.ClearPackedResources()
 	sett := settings.GetSettings()
 	sett.MemSettings.TotalVertices = 0
 	sett.MemSettings.TotalIndices = 0
@@ -452,11 +543,21 @@ func (rm *RenderManager) clearScene() {
 }
 
 func (rm *RenderManager) initRenderers() {
-	rm.rendererDefered = renderers.NewRendererDefered(rm.Window)
-	rm.rendererForward = renderers.NewRendererForward(rm.Window)
-	rm.rendererForwardShadowMapping = renderers.NewRendererForwardShadowMapping(rm.Window)
-	rm.rendererShadowMapping = renderers.NewRendererShadowMapping(rm.Window)
-	rm.rendererSimple = renderers.NewRendererSimple(rm.Window)
+	rm.rendererInstances = make(map[uint32]renderers.Renderer)
+	rm.activeRenderer()
+}
+
+func (rm *RenderManager) initPostProcessing() {
+	rm.postProcessing = newPostProcessing(rm.Window)
+}
+
+func (rm *RenderManager) initPathTracing() {
+	rm.pathTracing = newPathTracing(rm.Window)
+}
+
+// PathTracingProgress returns the passes of the Rendered skin shown in the viewport and the passes it's refined to
+func (rm *RenderManager) PathTracingProgress() (int, int) {
+	return rm.pathTracing.Progress()
 }
 
 func (rm *RenderManager) fileImport(entity *types.FBEntity, setts []string, itype types.ImportExportFormat) {
@@ -464,8 +565,16 @@ func (rm *RenderManager) fileImport(entity *types.FBEntity, setts []string, ityp
 	go rm.fileImportAsync(parsingChan, entity, setts, itype)
 	mmodels := <-parsingChan
 
+	source := types.MeshModelSource{Path: entity.Path, Format: itype, Settings: setts}
+	if err := saveopen.StatModelSource(&source); err != nil {
+		settings.LogWarn("[RenderManager] Can't stat %v : %v", entity.Path, err)
+	}
+
 	sett := settings.GetSettings()
 	for i := 0; i < len(mmodels); i++ {
+		mmodels[i].Source = source
+		mmodels[i].Source.Index = int32(i)
+		mmodels[i].Source.Title = mmodels[i].ModelTitle
 		mesh := meshes.NewModelFace(rm.Window, mmodels[i])
 		mesh.InitProperties()
 		mesh.InitBuffers()
@@ -479,12 +588,22 @@ func (rm *RenderManager) fileImport(entity *types.FBEntity, setts []string, ityp
 		sett.MemSettings.TotalObjects++
 	}
 
-	_, _ = trigger.Fire(types.ActionFileImportAddToRecentFiles, entity)
+	lights, cameras := rm.fileParser.SceneObjects(itype)
+	for i := 0; i < len(lights); i++ {
+		rm.addLight(lights[i].LightType)
+		rm.LightSources[len(rm.LightSources)-1].ApplySceneLight(lights[i])
+	}
+	if len(cameras) > 0 {
+		rsett := settings.GetRenderingSettings()
+		rsett.General.Fov = rm.Camera.ApplySceneCamera(cameras[0])
+	}
+
+	_, _ = trigger.Fire(types.ActionFileImportAddToRecentFiles, entity, setts, itype)
 }
 
 func (rm *RenderManager) fileImportAsync(parsingChannel chan []types.MeshModel, entity *types.FBEntity, setts []string, itype types.ImportExportFormat) {
 	_, _ = trigger.Fire(types.ActionParsingShow)
-	mmodels := rm.fileParser.Parse(entity.Path, nil, itype)
+	mmodels := rm.fileParser.Parse(entity.Path, setts, itype)
 	_, _ = trigger.Fire(types.ActionParsingHide)
 	parsingChannel <- mmodels
 }
@@ -494,17 +613,123 @@ func (rm *RenderManager) fileExport(entity types.FBEntity, setts []string, itype
 }
 
 func (rm *RenderManager) fileExportAsync(entity types.FBEntity, setts []string, itype types.ImportExportFormat) {
-	rm.sceneExporter.Export(rm.MeshModelFaces, entity, setts, itype)
+	rsett := settings.GetRenderingSettings()
+	var lights []types.SceneLight
+	for i := 0; i < len(rm.LightSources); i++ {
+		lights = append(lights, rm.LightSources[i].SceneLight())
+	}
+	camera := rm.Camera.SceneCamera(rsett.General.Fov)
+	if err := rm.sceneExporter.Export(rm.MeshModelFaces, lights, &camera, entity, setts, itype); err != nil {
+		settings.LogWarn("[RenderManager] Can't export %v : %v", entity.Path, err)
+		_, _ = trigger.Fire(types.ActionGuiShowError, "Can't export the scene", fmt.Sprintf("%v\n\n%v", entity.Path, err))
+	}
 }
 
 func (rm *RenderManager) initSaveOpen() {
 	rm.saveOpenManager = saveopen.NewSaveOpenManager(rm.doProgress)
+	if rm.saveOpenManager.BeginSession() {
+		if snapshots := saveopen.RecoverySnapshots(); len(snapshots) > 0 {
+			settings.LogWarn("[RenderManager] The previous session didn't shut down cleanly, the newest autosave is %v", snapshots[0].Path)
+			_, _ = trigger.Fire(types.ActionGuiShowRecovery, snapshots[0])
+		}
+	}
 }
 
 func (rm *RenderManager) saveScene(file *types.FBEntity) {
-	rm.saveOpenManager.Save(file, rm.MeshModelFaces, rm.LightSources, rm.RenderProps, rm.Camera, rm.wgrid)
+	rm.pendingSave = file
+}
+
+func (rm *RenderManager) writeScene(file *types.FBEntity, thumbnail []byte) {
+	path, err := rm.saveOpenManager.Save(file, rm.MeshModelFaces, rm.LightSources, rm.RenderProps, rm.Camera, rm.wgrid, thumbnail)
+	if err != nil {
+		settings.LogWarn("[RenderManager] Can't save scene %v : %v", path, err)
+		_, _ = trigger.Fire(types.ActionGuiShowError, "Can't save the scene", fmt.Sprintf("%v\n\n%v", path, err))
+		return
+	}
+
+	// the recent files list the scene as it was written, with the extension added by the save
+	saved := *file
+	saved.Path, saved.Title, saved.Extension = path, filepath.Base(path), filepath.Ext(path)
+	rm.addToRecentFiles(&saved)
 }
 
 func (rm *RenderManager) openScene(file *types.FBEntity) {
-	rm.saveOpenManager.Open(file, rm.Window, rm.systemModels, &rm.MeshModelFaces, &rm.LightSources, &rm.RenderProps, rm.Camera, rm.wgrid)
+	warnings, err := rm.saveOpenManager.Open(file, rm.Window, rm.systemModels, &rm.MeshModelFaces, &rm.LightSources, &rm.RenderProps, rm.Camera, rm.wgrid)
+	if err != nil {
+		settings.LogWarn("[RenderManager] Can't open scene %v : %v", file.Path, err)
+		_, _ = trigger.Fire(types.ActionGuiShowError, "Can't open the scene", fmt.Sprintf("%v\n\n%v", file.Path, err))
+		return
+	}
+	rm.addToRecentFiles(file)
+	if len(warnings) > 0 {
+		for _, w := range warnings {
+			settings.LogWarn("[RenderManager] Linked model : %v", w)
+		}
+		_, _ = trigger.Fire(types.ActionGuiShowError, "Linked models", strings.Join(warnings, "\n"))
+	}
+}
+
+// addToRecentFiles lists the scene in Open Recent, unless it's an autosave
+func (rm *RenderManager) addToRecentFiles(file *types.FBEntity) {
+	if filepath.Dir(filepath.Clean(file.Path)) == filepath.Clean(saveopen.RecoveryFolder()) {
+		return
+	}
+	_, _ = trigger.Fire(types.ActionFileSaverAddToRecentFiles, file)
+}
+
+func (rm *RenderManager) appendScene(file *types.FBEntity) {
+	sett := settings.GetSettings()
+	opts := saveopen.AppendOptions{
+		Camera:         sett.App.AppendCamera,
+		Grid:           sett.App.AppendGrid,
+		RenderSettings: sett.App.AppendRendering,
+	}
+	warnings, err := rm.saveOpenManager.Append(file, rm.Window, rm.systemModels, &rm.MeshModelFaces, &rm.LightSources, &rm.RenderProps, rm.Camera, rm.wgrid, opts)
+	if err != nil {
+		settings.LogWarn("[RenderManager] Can't append scene %v : %v", file.Path, err)
+		_, _ = trigger.Fire(types.ActionGuiShowError, "Can't append the scene", fmt.Sprintf("%v\n\n%v", file.Path, err))
+		return
+	}
+	if len(warnings) > 0 {
+		for _, w := range warnings {
+			settings.LogWarn("[RenderManager] Linked model : %v", w)
+		}
+		_, _ = trigger.Fire(types.ActionGuiShowError, "Linked models", strings.Join(warnings, "\n"))
+	}
+}
+
+// loadEnvironmentMap adds the .hdr file to the skyboxes and selects it, the skybox converts it on the next frame
+func (rm *RenderManager) loadEnvironmentMap(file *types.FBEntity) {
+	if !strings.EqualFold(filepath.Ext(file.Path), ".hdr") {
+		_, _ = trigger.Fire(types.ActionGuiShowError, "Can't load the environment map", fmt.Sprintf("%v\n\nOnly Radiance .hdr images are supported", file.Path))
+		return
+	}
+	rsett := settings.GetRenderingSettings()
+	rsett.SkyBox.SkyboxSelectedItem = rm.SkyBox.AddHDR(file.Path)
+}
+
+func (rm *RenderManager) unpackResources(folder *types.FBEntity) {
+	count, err := rm.saveOpenManager.UnpackResources(folder.Path, rm.MeshModelFaces)
+	if err != nil {
+		settings.LogWarn("[RenderManager] Can't unpack the resources to %v : %v", folder.Path, err)
+		_, _ = trigger.Fire(types.ActionGuiShowError, "Can't unpack the resources", fmt.Sprintf("%v\n\n%v", folder.Path, err))
+		return
+	}
+	settings.LogInfo("[RenderManager] Unpacked %v resources to %v", count, folder.Path)
+}
+
+// loadColorGradingLUT sets the .cube file as the lookup table of the post-processing color grading
+func (rm *RenderManager) loadColorGradingLUT(file *types.FBEntity) {
+	if !strings.EqualFold(filepath.Ext(file.Path), ".cube") {
+		_, _ = trigger.Fire(types.ActionGuiShowError, "Can't load the lookup table", fmt.Sprintf("%v\n\nOnly .cube 3D lookup tables are supported", file.Path))
+		return
+	}
+	if _, err := engine.LoadCubeLUT(file.Path); err != nil {
+		_, _ = trigger.Fire(types.ActionGuiShowError, "Can't load the lookup table", fmt.Sprintf("%v\n\n%v", file.Path, err))
+		return
+	}
+	rsett := settings.GetRenderingSettings()
+	rsett.PostProcessing.ColorGradingLUT = file.Path
+	rsett.PostProcessing.ColorGrading = true
+	rsett.PostProcessing.Enabled = true
 }
@@ -0,0 +1,70 @@
+package rendering
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+
+	"github.com/supudo/Kuplung-Go/engine/oglconsts"
+	"github.com/supudo/Kuplung-Go/settings"
+)
+
+// thumbnailWidth is the width of the scene thumbnails, the height keeps the viewport aspect
+const thumbnailWidth = 256
+
+// captureThumbnail reads the rendered viewport and returns it scaled down as PNG
+func (rm *RenderManager) captureThumbnail() []byte {
+	w, h := rm.Window.Size()
+	if w <= 0 || h <= 0 {
+		return nil
+	}
+
+	gl := rm.Window.OpenGL()
+	pixels := make([]uint8, w*h*4)
+	gl.BindFramebuffer(oglconsts.FRAMEBUFFER, 0)
+	gl.ReadPixels(0, 0, int32(w), int32(h), oglconsts.RGBA, oglconsts.UNSIGNED_BYTE, pixels)
+
+	tw := thumbnailWidth
+	if w < tw {
+		tw = w
+	}
+	th := h * tw / w
+	if th < 1 {
+		th = 1
+	}
+
+	// box filter every thumbnail pixel over its area of the viewport, OpenGL rows start at the bottom
+	thumb := image.NewRGBA(image.Rect(0, 0, tw, th))
+	for ty := 0; ty < th; ty++ {
+		y0, y1 := ty*h/th, (ty+1)*h/th
+		for tx := 0; tx < tw; tx++ {
+			x0, x1 := tx*w/tw, (tx+1)*w/tw
+			var sum [3]int
+			for y := y0; y < y1; y++ {
+				row := (h - 1 - y) * w * 4
+				for x := x0; x < x1; x++ {
+					p := row + x*4
+					sum[0] += int(pixels[p])
+					sum[1] += int(pixels[p+1])
+					sum[2] += int(pixels[p+2])
+				}
+			}
+			count := (y1 - y0) * (x1 - x0)
+			if count == 0 {
+				continue
+			}
+			o := thumb.PixOffset(tx, ty)
+			thumb.Pix[o] = uint8(sum[0] / count)
+			thumb.Pix[o+1] = uint8(sum[1] / count)
+			thumb.Pix[o+2] = uint8(sum[2] / count)
+			thumb.Pix[o+3] = 255
+		}
+	}
+
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, thumb); err != nil {
+		settings.LogWarn("[RenderManager] Can't encode the scene thumbnail : %v", err)
+		return nil
+	}
+	return buffer.Bytes()
+}
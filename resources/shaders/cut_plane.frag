@@ -0,0 +1,8 @@
+#version 410 core
+
+uniform vec4 fs_color;
+out vec4 fragColor;
+
+void main(void) {
+    fragColor = fs_color;
+}
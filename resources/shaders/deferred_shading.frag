@@ -6,6 +6,8 @@ in vec2 TexCoords;
 uniform sampler2D sampler_position;
 uniform sampler2D sampler_normal;
 uniform sampler2D sampler_albedospec;
+uniform sampler2D sampler_ssao;
+uniform bool ssao_inUse;
 
 struct Light {
   vec3 Position;
@@ -59,6 +61,7 @@ uniform LightSource_Spot spotLights[NR_SPOT_LIGHTS];
 vec3 calculateLightDirectional(vec3 directionNormal, vec3 directionView, vec3 colorDiffuse);
 vec3 calculateLightPoint(vec3 fragmentPosition, vec3 directionNormal, vec3 directionView, vec3 colorDiffuse);
 vec3 calculateLightSpot(vec3 fragmentPosition, vec3 directionNormal, vec3 directionView, vec3 colorDiffuse);
+float calculatePointShadow(int idx, vec3 lightPosition, vec3 fragmentPosition, vec3 viewPosition);
 
 void main() {
   // Retrieve data from gbuffer
@@ -66,6 +69,7 @@ void main() {
   vec3 Normal = texture(sampler_normal, TexCoords).rgb;
   vec3 Diffuse = texture(sampler_albedospec, TexCoords).rgb;
   float Specular = texture(sampler_albedospec, TexCoords).a;
+  float AmbientOcclusion = ssao_inUse ? texture(sampler_ssao, TexCoords).r : 1.0;
   vec3 viewDir = normalize(viewPos - FragPos);
 
   // directional lights color
@@ -84,7 +88,7 @@ void main() {
     lightsSpot = calculateLightSpot(FragPos, Normal, viewDir, Diffuse);
 
   // Then calculate lighting as usual
-  vec3 lighting = Diffuse * ambientStrength;
+  vec3 lighting = Diffuse * ambientStrength * AmbientOcclusion;
   for (int i=0; i<NR_LIGHTS; ++i) {
     // Calculate distance between light source and current fragment
     float distance = length(lights[i].Position - FragPos);
@@ -117,6 +121,8 @@ void main() {
     fragColor = vec4(Diffuse, 1.0);
   else if (draw_mode == 5)
     fragColor = vec4(vec3(Specular), 1.0);
+  else if (draw_mode == 6)
+    fragColor = vec4(vec3(AmbientOcclusion), 1.0);
 
   // gamma correction
   fragColor.rgb = pow(fragColor.rgb, vec3(1.0 / gammaCoeficient));
@@ -170,7 +176,10 @@ vec3 calculateLightPoint(vec3 fragmentPosition, vec3 directionNormal, vec3 direc
       float lightDistance = length(pointLights[i].position - fragmentPosition);
       float attenuation = 1.0f / (pointLights[i].constant + pointLights[i].linear * lightDistance + pointLights[i].quadratic * (lightDistance * lightDistance));
 
-      result += pointLights[i].strengthDiffuse * pointLights[i].diffuse * lambertFactor * attenuation * colorDiffuse;
+      // Shadow
+      float shadow = calculatePointShadow(i, pointLights[i].position, fragmentPosition, viewPos);
+
+      result += (1.0 - shadow) * pointLights[i].strengthDiffuse * pointLights[i].diffuse * lambertFactor * attenuation * colorDiffuse;
     }
   }
   return result;
@@ -0,0 +1,50 @@
+#version 410 core
+
+in vec2 fs_textureCoord;
+
+out vec2 fragColor;
+
+vec2 hammersley(uint i, uint n);
+vec3 importanceSampleGGX(vec2 Xi, vec3 N, float roughness);
+
+const uint SAMPLE_COUNT = 1024u;
+
+float geometrySchlickGGX(float NdotV, float roughness) {
+  // k for IBL is different than the one for the analytic lights
+  float k = (roughness * roughness) / 2.0;
+  return NdotV / (NdotV * (1.0 - k) + k);
+}
+
+float geometrySmith(float NdotV, float NdotL, float roughness) {
+  return geometrySchlickGGX(NdotV, roughness) * geometrySchlickGGX(NdotL, roughness);
+}
+
+// the scale and the bias of F0 for the split-sum approximation
+vec2 integrateBRDF(float NdotV, float roughness) {
+  vec3 V = vec3(sqrt(1.0 - NdotV * NdotV), 0.0, NdotV);
+  vec3 N = vec3(0.0, 0.0, 1.0);
+
+  float A = 0.0;
+  float B = 0.0;
+  for (uint i = 0u; i < SAMPLE_COUNT; ++i) {
+    vec2 Xi = hammersley(i, SAMPLE_COUNT);
+    vec3 H = importanceSampleGGX(Xi, N, roughness);
+    vec3 L = normalize(2.0 * dot(V, H) * H - V);
+
+    float NdotL = max(L.z, 0.0);
+    float NdotH = max(H.z, 0.0);
+    float VdotH = max(dot(V, H), 0.0);
+    if (NdotL > 0.0) {
+      float G = geometrySmith(NdotV, NdotL, roughness);
+      float G_Vis = (G * VdotH) / (NdotH * NdotV);
+      float Fc = pow(1.0 - VdotH, 5.0);
+      A += (1.0 - Fc) * G_Vis;
+      B += Fc * G_Vis;
+    }
+  }
+  return vec2(A, B) / float(SAMPLE_COUNT);
+}
+
+void main(void) {
+  fragColor = integrateBRDF(max(fs_textureCoord.x, 0.0001), fs_textureCoord.y);
+}
@@ -0,0 +1,10 @@
+#version 410 core
+
+layout (location = 0) in vec2 vs_position;
+
+out vec2 fs_textureCoord;
+
+void main(void) {
+  fs_textureCoord = vs_position;
+  gl_Position = vec4(vs_position * 2.0 - 1.0, 0.0, 1.0);
+}
@@ -0,0 +1,13 @@
+#version 410 core
+
+layout (location = 0) in vec3 vs_vertexPosition;
+
+uniform mat4 vs_matrixProjection;
+uniform mat4 vs_matrixView;
+
+out vec3 fs_direction;
+
+void main(void) {
+  fs_direction = vs_vertexPosition;
+  gl_Position = vs_matrixProjection * vs_matrixView * vec4(vs_vertexPosition, 1.0);
+}
@@ -0,0 +1,21 @@
+#version 410 core
+
+uniform sampler2D sampler_equirectangular;
+
+in vec3 fs_direction;
+
+out vec4 fragColor;
+
+const vec2 invAtan = vec2(0.1591, 0.3183);
+
+vec2 sampleSphericalMap(vec3 v) {
+  vec2 uv = vec2(atan(v.z, v.x), asin(v.y));
+  return uv * invAtan + 0.5;
+}
+
+void main(void) {
+  vec2 uv = sampleSphericalMap(normalize(fs_direction));
+  // keep the sun and the other very bright texels from turning into fireflies in the convolutions
+  vec3 color = min(texture(sampler_equirectangular, uv).rgb, vec3(64.0));
+  fragColor = vec4(color, 1.0);
+}
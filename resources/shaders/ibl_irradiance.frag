@@ -0,0 +1,32 @@
+#version 410 core
+
+uniform samplerCube sampler_environment;
+
+in vec3 fs_direction;
+
+out vec4 fragColor;
+
+const float PI = 3.14159265359;
+
+// cosine weighted convolution of the hemisphere around the normal
+void main(void) {
+  vec3 N = normalize(fs_direction);
+  vec3 up = abs(N.y) < 0.999 ? vec3(0.0, 1.0, 0.0) : vec3(1.0, 0.0, 0.0);
+  vec3 right = normalize(cross(up, N));
+  up = cross(N, right);
+
+  vec3 irradiance = vec3(0.0);
+  float sampleDelta = 0.025;
+  float nrSamples = 0.0;
+  for (float phi = 0.0; phi < 2.0 * PI; phi += sampleDelta) {
+    for (float theta = 0.0; theta < 0.5 * PI; theta += sampleDelta) {
+      vec3 tangentSample = vec3(sin(theta) * cos(phi), sin(theta) * sin(phi), cos(theta));
+      vec3 sampleVec = tangentSample.x * right + tangentSample.y * up + tangentSample.z * N;
+      irradiance += texture(sampler_environment, sampleVec).rgb * cos(theta) * sin(theta);
+      nrSamples++;
+    }
+  }
+  irradiance = PI * irradiance * (1.0 / nrSamples);
+
+  fragColor = vec4(irradiance, 1.0);
+}
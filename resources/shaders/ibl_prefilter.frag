@@ -0,0 +1,46 @@
+#version 410 core
+
+uniform samplerCube sampler_environment;
+uniform float fs_roughness;
+uniform float fs_resolution;
+
+in vec3 fs_direction;
+
+out vec4 fragColor;
+
+vec2 hammersley(uint i, uint n);
+vec3 importanceSampleGGX(vec2 Xi, vec3 N, float roughness);
+float distributionGGX(float NdotH, float roughness);
+
+const uint SAMPLE_COUNT = 1024u;
+
+// split-sum approximation, the view and the reflection directions are assumed to be the normal
+void main(void) {
+  vec3 N = normalize(fs_direction);
+  vec3 V = N;
+
+  vec3 prefilteredColor = vec3(0.0);
+  float totalWeight = 0.0;
+  for (uint i = 0u; i < SAMPLE_COUNT; ++i) {
+    vec2 Xi = hammersley(i, SAMPLE_COUNT);
+    vec3 H = importanceSampleGGX(Xi, N, fs_roughness);
+    vec3 L = normalize(2.0 * dot(V, H) * H - V);
+
+    float NdotL = max(dot(N, L), 0.0);
+    if (NdotL > 0.0) {
+      // sample a lower mip of the environment for the less probable directions to avoid the bright dots
+      float NdotH = max(dot(N, H), 0.0);
+      float HdotV = max(dot(H, V), 0.0);
+      float pdf = distributionGGX(NdotH, fs_roughness) * NdotH / (4.0 * HdotV) + 0.0001;
+      float saTexel = 4.0 * 3.14159265359 / (6.0 * fs_resolution * fs_resolution);
+      float saSample = 1.0 / (float(SAMPLE_COUNT) * pdf + 0.0001);
+      float mipLevel = fs_roughness == 0.0 ? 0.0 : 0.5 * log2(saSample / saTexel);
+
+      prefilteredColor += textureLod(sampler_environment, L, mipLevel).rgb * NdotL;
+      totalWeight += NdotL;
+    }
+  }
+  prefilteredColor = prefilteredColor / totalWeight;
+
+  fragColor = vec4(prefilteredColor, 1.0);
+}
@@ -0,0 +1,94 @@
+// =================================================
+//
+// Light Clusters
+//
+// =================================================
+
+// the lights in a uniform buffer, the light ranges of the clusters and the light indices in a texture, see rendering/renderers/light_clusters.go
+#define lightClusters_texelsPerLight 6
+#define lightClusters_maxLights 170
+
+layout (std140) uniform LightClusters_Lights {
+  vec4 lightClusters_lights[lightClusters_maxLights * lightClusters_texelsPerLight];
+};
+
+uniform sampler2D sampler_lightClusters;
+uniform ivec3 lightClusters_size;
+uniform int lightClusters_directionalCount;
+uniform int lightClusters_lightsCount;
+uniform int lightClusters_indexBase;
+uniform float lightClusters_near;
+uniform float lightClusters_far;
+uniform bool lightClusters_debug;
+uniform float lightClusters_maxCount;
+
+struct ClusterLight {
+  int lightType;
+  vec3 position;
+  vec3 direction;
+  float range;
+  vec3 ambient, diffuse, specular;
+  float cutOff, outerCutOff;
+  float constant, linear, quadratic;
+  int shadowSlot;
+};
+
+vec4 lightClusterTexel(int idx) {
+  int width = textureSize(sampler_lightClusters, 0).x;
+  return texelFetch(sampler_lightClusters, ivec2(idx % width, idx / width), 0);
+}
+
+// the strengths are already multiplied into the colors
+ClusterLight clusterLight(int idx) {
+  int base = idx * lightClusters_texelsPerLight;
+  vec4 t0 = lightClusters_lights[base];
+  vec4 t1 = lightClusters_lights[base + 1];
+  vec4 t2 = lightClusters_lights[base + 2];
+  vec4 t3 = lightClusters_lights[base + 3];
+  vec4 t4 = lightClusters_lights[base + 4];
+  vec4 t5 = lightClusters_lights[base + 5];
+
+  ClusterLight light;
+  light.position = t0.xyz;
+  light.lightType = int(t0.w);
+  light.direction = t1.xyz;
+  light.range = t1.w;
+  light.ambient = t2.xyz;
+  light.shadowSlot = int(t2.w);
+  light.diffuse = t3.xyz;
+  light.cutOff = t3.w;
+  light.specular = t4.xyz;
+  light.outerCutOff = t4.w;
+  light.constant = t5.x;
+  light.linear = t5.y;
+  light.quadratic = t5.z;
+  return light;
+}
+
+// the first light index and the number of lights of the cluster of the fragment
+ivec2 lightCluster() {
+  vec2 screen = clamp(gl_FragCoord.xy / vec2(fs_screenResX, fs_screenResY), 0.0, 0.9999);
+  float ndcDepth = gl_FragCoord.z * 2.0 - 1.0;
+  float depth = (2.0 * lightClusters_near * lightClusters_far) / (lightClusters_far + lightClusters_near - ndcDepth * (lightClusters_far - lightClusters_near));
+  int slice = int(log(max(depth, lightClusters_near) / lightClusters_near) / log(lightClusters_far / lightClusters_near) * float(lightClusters_size.z));
+  slice = clamp(slice, 0, lightClusters_size.z - 1);
+  ivec2 tile = ivec2(screen * vec2(lightClusters_size.xy));
+  int cluster = (slice * lightClusters_size.y + tile.y) * lightClusters_size.x + tile.x;
+  return ivec2(lightClusterTexel(cluster).xy);
+}
+
+// the index of the i-th light of the cluster list
+int clusterLightIndex(int i) {
+  vec4 indices = lightClusterTexel(lightClusters_indexBase + i / 4);
+  return int(indices[i % 4]);
+}
+
+// heatmap of the number of lights in the cluster of the fragment, blue for none to red for the busiest cluster
+vec3 lightClusterHeatmap(vec3 color) {
+  int count = lightCluster().y;
+  float heat = lightClusters_maxCount > 0.0 ? float(count) / lightClusters_maxCount : 0.0;
+  vec3 heatColor = clamp(vec3(heat * 2.0 - 1.0, 1.0 - abs(heat * 2.0 - 1.0), 1.0 - heat * 2.0), 0.0, 1.0);
+  if (count == 0)
+    heatColor = vec3(0.0, 0.0, 0.2);
+  return mix(color, heatColor, 0.75);
+}
@@ -79,19 +79,13 @@ void main(void) {
             }
 
           // directional lights color
-          vec3 lightsDirectional = vec3(0.0);
-          if (directionalLights.length() > 0)
-            lightsDirectional = calculateLightDirectional(fragmentNormal, viewDirection, processedColor_Ambient, processedColor_Diffuse, processedColor_Specular);
+          vec3 lightsDirectional = calculateLightDirectional(fragmentNormal, viewDirection, processedColor_Ambient, processedColor_Diffuse, processedColor_Specular);
 
           // point lights color
-          vec3 lightsPoint = vec3(0.0);
-          if (pointLights.length() > 0)
-            lightsPoint = calculateLightPoint(fragmentPosition, fragmentNormal, viewDirection, processedColor_Ambient, processedColor_Diffuse, processedColor_Specular);
+          vec3 lightsPoint = calculateLightPoint(fragmentPosition, fragmentNormal, viewDirection, processedColor_Ambient, processedColor_Diffuse, processedColor_Specular);
 
           // spot lights color
-          vec3 lightsSpot = vec3(0.0);
-          if (spotLights.length() > 0)
-            lightsSpot = calculateLightSpot(fragmentPosition, fragmentNormal, viewDirection, processedColor_Ambient, processedColor_Diffuse, processedColor_Specular);
+          vec3 lightsSpot = calculateLightSpot(fragmentPosition, fragmentNormal, viewDirection, processedColor_Ambient, processedColor_Diffuse, processedColor_Specular);
 
           // Refraction
           vec3 processedColorRefraction = (material.emission + lightsDirectional) + (lightsPoint + lightsSpot) + fs_UIAmbient;
@@ -135,14 +129,13 @@ void main(void) {
             fragColor.rgb = fragColor.rgb / (fragColor.rgb + vec3(1.0));
 
           // shadows
-          if (fs_showShadows)
+          if (fs_showShadows) {
             fragColor = (processedColor_Ambient + (1.0 - calculateShadowValue(fragmentPosition)) * (processedColor_Diffuse + processedColor_Specular)) * fragColor;
+            fragColor.rgb *= calculateShadowCascadeTint(fragmentPosition);
+          }
 
           // gamma correction
           fragColor.rgb = pow(fragColor.rgb, vec3(1.0 / fs_gammaCoeficient));
-
-          if (fs_debugShadowTexture)
-            fragColor = texture(sampler_shadowMap, textureCoords);
         }
         else
           fragColor = vec4(0.7, 0.7, 0.7, fs_alpha);
@@ -153,5 +146,9 @@ void main(void) {
       float depth = linearizeDepth(gl_FragCoord.z) / fs_planeFar;
       fragColor = vec4(vec3(depth), 1.0f);
     }
+
+    // lights per cluster
+    if (lightClusters_debug)
+      fragColor.rgb = lightClusterHeatmap(fragColor.rgb);
   }
 }
@@ -31,24 +31,23 @@ vec3 calculateLightSolid(vec3 directionNormal, vec3 directionView, vec4 colorAmb
 
 vec3 calculateLightDirectional(vec3 directionNormal, vec3 directionView, vec4 colorAmbient, vec4 colorDiffuse, vec4 colorSpecular) {
   vec3 result = vec3(0.0);
-  for (int i=0; i<NR_DIRECTIONAL_LIGHTS; i++) {
-    if (directionalLights[i].inUse) {
-      vec3 directionLight = normalize(vec3(-1.0 * directionalLights[i].direction));
+  for (int i=0; i<lightClusters_directionalCount; i++) {
+    ClusterLight light = clusterLight(i);
+    vec3 directionLight = normalize(vec3(-1.0 * light.direction));
 
-      // Diffuse shading - lambert factor
-      float lambertFactor = max(dot(directionNormal, -directionLight), 0.0);
+    // Diffuse shading - lambert factor
+    float lambertFactor = max(dot(directionNormal, -directionLight), 0.0);
 
-      // Specular shading
-      vec3 directionReflection = normalize(reflect(-directionLight, directionNormal));
-      float specularFactor = pow(max(dot(directionView, directionReflection), 0.0), material.refraction);
+    // Specular shading
+    vec3 directionReflection = normalize(reflect(-directionLight, directionNormal));
+    float specularFactor = pow(max(dot(directionView, directionReflection), 0.0), material.refraction);
 
-      // Combine results
-      vec3 ambient = directionalLights[i].strengthAmbient * directionalLights[i].ambient * colorAmbient.rgb;
-      vec3 diffuse = directionalLights[i].strengthDiffuse * directionalLights[i].diffuse * lambertFactor * colorDiffuse.rgb;
-      vec3 specular = directionalLights[i].strengthSpecular * directionalLights[i].specular * specularFactor * colorSpecular.rgb;
+    // Combine results
+    vec3 ambient = light.ambient * colorAmbient.rgb;
+    vec3 diffuse = light.diffuse * lambertFactor * colorDiffuse.rgb;
+    vec3 specular = light.specular * specularFactor * colorSpecular.rgb;
 
-      result += ambient + diffuse + specular;
-    }
+    result += ambient + diffuse + specular;
   }
   return result;
 }
@@ -61,28 +60,36 @@ vec3 calculateLightDirectional(vec3 directionNormal, vec3 directionView, vec4 co
 
 vec3 calculateLightPoint(vec3 fragmentPosition, vec3 directionNormal, vec3 directionView, vec4 colorAmbient, vec4 colorDiffuse, vec4 colorSpecular) {
   vec3 result = vec3(0.0);
-  for (int i=0; i<NR_POINT_LIGHTS; i++) {
-    if (pointLights[i].inUse) {
-      vec3 directionLight = normalize(pointLights[i].position - fragmentPosition);
+  ivec2 cluster = lightCluster();
+  for (int i=0; i<cluster.y; i++) {
+    ClusterLight light = clusterLight(clusterLightIndex(cluster.x + i));
+    if (light.lightType != 1)
+      continue;
 
-      // Diffuse shading - lambert factor
-      float lambertFactor = max(dot(directionNormal, directionLight), 0.0);
+    // Attenuation
+    float lightDistance = length(light.position - fragmentPosition);
+    if (lightDistance > light.range)
+      continue;
+    float attenuation = 1.0f / (light.constant + light.linear * lightDistance + light.quadratic * (lightDistance * lightDistance));
 
-      // Specular shading
-      vec3 directionReflection = reflect(-directionLight, directionNormal);
-      float specularFactor = pow(max(dot(directionView, directionReflection), 0.0), material.refraction);
+    vec3 directionLight = normalize(light.position - fragmentPosition);
 
-      // Attenuation
-      float lightDistance = length(pointLights[i].position - fragmentPosition);
-      float attenuation = 1.0f / (pointLights[i].constant + pointLights[i].linear * lightDistance + pointLights[i].quadratic * (lightDistance * lightDistance));
+    // Diffuse shading - lambert factor
+    float lambertFactor = max(dot(directionNormal, directionLight), 0.0);
 
-      // Combine results
-      vec3 ambient = pointLights[i].strengthAmbient * pointLights[i].ambient * attenuation * colorAmbient.rgb;
-      vec3 diffuse = pointLights[i].strengthDiffuse * pointLights[i].diffuse * lambertFactor * attenuation * colorDiffuse.rgb;
-      vec3 specular = pointLights[i].strengthSpecular * pointLights[i].specular * specularFactor * attenuation * colorSpecular.rgb;
+    // Specular shading
+    vec3 directionReflection = reflect(-directionLight, directionNormal);
+    float specularFactor = pow(max(dot(directionView, directionReflection), 0.0), material.refraction);
 
-      result += ambient + diffuse + specular;
-    }
+    // Combine results
+    vec3 ambient = light.ambient * attenuation * colorAmbient.rgb;
+    vec3 diffuse = light.diffuse * lambertFactor * attenuation * colorDiffuse.rgb;
+    vec3 specular = light.specular * specularFactor * attenuation * colorSpecular.rgb;
+
+    // Shadow
+    float shadow = fs_showShadows ? calculatePointShadow(light.shadowSlot, light.position, fragmentPosition, fs_cameraPosition) : 0.0;
+
+    result += ambient + (1.0 - shadow) * (diffuse + specular);
   }
   return result;
 }
@@ -95,33 +102,38 @@ vec3 calculateLightPoint(vec3 fragmentPosition, vec3 directionNormal, vec3 direc
 
 vec3 calculateLightSpot(vec3 fragmentPosition, vec3 directionNormal, vec3 directionView, vec4 colorAmbient, vec4 colorDiffuse, vec4 colorSpecular) {
   vec3 result = vec3(0.0);
-  for (int i=0; i<NR_SPOT_LIGHTS; i++) {
-    if (spotLights[i].inUse) {
-      vec3 directionLight = normalize((-1.0 * spotLights[i].position) - fragmentPosition);
-
-      // Diffuse shading - lambert factor
-      float lambertFactor = max(dot(directionNormal, directionLight), 0.0);
-
-      // Specular shading
-      vec3 directionReflection = reflect(-directionLight, directionNormal);
-      float specularFactor = pow(max(dot(directionView, directionReflection), 0.0), material.refraction);
-
-      // Attenuation
-      float lightDistance = length(spotLights[i].position - fragmentPosition);
-      float attenuation = 1.0f / (spotLights[i].constant + spotLights[i].linear * lightDistance + spotLights[i].quadratic * (lightDistance * lightDistance));
-
-      // Spotlight intensity
-      float theta = dot(directionLight, normalize(-spotLights[i].direction));
-      float epsilon = spotLights[i].cutOff - spotLights[i].outerCutOff;
-      float intensity = clamp((theta - spotLights[i].outerCutOff) / epsilon, 0.0, 1.0);
-
-      // Combine results
-      vec3 ambient = spotLights[i].strengthAmbient * spotLights[i].ambient * attenuation * intensity * colorAmbient.rgb;
-      vec3 diffuse = spotLights[i].strengthDiffuse * spotLights[i].diffuse * lambertFactor * attenuation * intensity * colorDiffuse.rgb;
-      vec3 specular = spotLights[i].strengthSpecular * spotLights[i].specular * specularFactor * attenuation * intensity * colorSpecular.rgb;
-
-      result += ambient + diffuse + specular;
-    }
+  ivec2 cluster = lightCluster();
+  for (int i=0; i<cluster.y; i++) {
+    ClusterLight light = clusterLight(clusterLightIndex(cluster.x + i));
+    if (light.lightType != 2)
+      continue;
+
+    // Attenuation
+    float lightDistance = length(light.position - fragmentPosition);
+    if (lightDistance > light.range)
+      continue;
+    float attenuation = 1.0f / (light.constant + light.linear * lightDistance + light.quadratic * (lightDistance * lightDistance));
+
+    vec3 directionLight = normalize(light.position - fragmentPosition);
+
+    // Diffuse shading - lambert factor
+    float lambertFactor = max(dot(directionNormal, directionLight), 0.0);
+
+    // Specular shading
+    vec3 directionReflection = reflect(-directionLight, directionNormal);
+    float specularFactor = pow(max(dot(directionView, directionReflection), 0.0), material.refraction);
+
+    // Spotlight intensity
+    float theta = dot(directionLight, normalize(-light.direction));
+    float epsilon = light.cutOff - light.outerCutOff;
+    float intensity = clamp((theta - light.outerCutOff) / epsilon, 0.0, 1.0);
+
+    // Combine results
+    vec3 ambient = light.ambient * attenuation * intensity * colorAmbient.rgb;
+    vec3 diffuse = light.diffuse * lambertFactor * attenuation * intensity * colorDiffuse.rgb;
+    vec3 specular = light.specular * specularFactor * attenuation * intensity * colorSpecular.rgb;
+
+    result += ambient + diffuse + specular;
   }
   return result;
 }
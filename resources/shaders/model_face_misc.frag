@@ -23,9 +23,8 @@ vec4 celShadingColor() {
 
   vec3 N = normalize(eyeSpaceNormal);
   vec3 L;
-  for (int i=0; i<NR_DIRECTIONAL_LIGHTS; i++)
-    if (directionalLights[i].inUse)
-      L += normalize(directionalLights[i].direction);
+  for (int i=0; i<lightClusters_directionalCount; i++)
+    L += normalize(clusterLight(i).direction);
   vec3 Eye = vec3(0, 0, 1);
   vec3 H = normalize(L + Eye);
 
@@ -54,9 +53,8 @@ vec4 celShadingColor() {
     sf = step(0.5, sf);
 
   vec3 celAmbient;
-  for (int i=0; i<NR_DIRECTIONAL_LIGHTS; i++)
-    if (directionalLights[i].inUse)
-      celAmbient += material.ambient * directionalLights[i].specular * directionalLights[i].strengthSpecular;
+  for (int i=0; i<lightClusters_directionalCount; i++)
+    celAmbient += material.ambient * clusterLight(i).specular;
   vec3 celDiffuse = df * material.diffuse;// * directionalLights[0].diffuse * directionalLights[0].strengthDiffuse;
   vec3 celSpecular = sf * material.specular;// * directionalLights[0].specular * directionalLights[0].strengthSpecular;
 
@@ -40,6 +40,10 @@ vec3 fresnelSchlick(float cosTheta, vec3 F0) {
   return F0 + (1.0 - F0) * pow(1.0 - cosTheta, 5.0);
 }
 
+vec3 fresnelSchlickRoughness(float cosTheta, vec3 F0, float roughness) {
+  return F0 + (max(vec3(1.0 - roughness), F0) - F0) * pow(1.0 - cosTheta, 5.0);
+}
+
 vec3 getNormalFromMap() {
   if (material.has_texture_bump) {
     vec3 tangentNormal = texture(material.sampler_bump, fs_textureCoord).xyz * 2.0 - 1.0;
@@ -82,14 +86,16 @@ vec3 calculatePBR() {
 
   // reflectance equation
   vec3 Lo = vec3(0.0);
-  for (int i=0; i<NR_POINT_LIGHTS; i++) {
-    if (pointLights[i].inUse) {
+  ivec2 cluster = lightCluster();
+  for (int i=0; i<cluster.y; i++) {
+    ClusterLight light = clusterLight(clusterLightIndex(cluster.x + i));
+    if (light.lightType == 1) {
       // calculate per-light radiance
-      vec3 L = normalize(pointLights[i].position - WorldPos);
+      vec3 L = normalize(light.position - WorldPos);
       vec3 H = normalize(V + L);
-      float distance = length(pointLights[i].position - WorldPos);
+      float distance = length(light.position - WorldPos);
       float attenuation = 1.0 / (distance * distance);
-      vec3 radiance = pointLights[i].diffuse * attenuation * pointLights[i].strengthDiffuse;
+      vec3 radiance = light.diffuse * attenuation;
 
       // Cook-Torrance BRDF
       float NDF = DistributionGGX(N, H, roughness);
@@ -114,15 +120,33 @@ vec3 calculatePBR() {
       // scale light by NdotL
       float NdotL = max(dot(N, L), 0.0);
 
+      // shadow
+      float shadow = fs_showShadows ? calculatePointShadow(light.shadowSlot, light.position, WorldPos, fs_cameraPosition) : 0.0;
+
       // add to outgoing radiance Lo
       // note that we already multiplied the BRDF by the Fresnel (kS) so we won't multiply by kS again
-      Lo += (kD * albedo / PI + brdf) * radiance * NdotL;
+      Lo += (kD * albedo / PI + brdf) * radiance * NdotL * (1.0 - shadow);
     }
   }
 
-  // ambient lighting (note that the next IBL tutorial will replace
-  // this ambient lighting with environment lighting).
+  // ambient lighting, from the environment map when there is one
   vec3 ambient = vec3(0.03) * albedo * ao;
+  if (ibl_inUse) {
+    float NdotV = max(dot(N, V), 0.0);
+    vec3 F = fresnelSchlickRoughness(NdotV, F0, roughness);
+    vec3 kD = (vec3(1.0) - F) * (1.0 - metallic);
+
+    vec3 irradiance = texture(sampler_iblIrradiance, ibl_rotation * N).rgb;
+    vec3 diffuse = irradiance * albedo;
+
+    // split-sum approximation of the specular part
+    vec3 R = reflect(-V, N);
+    vec3 prefilteredColor = textureLod(sampler_iblPrefilter, ibl_rotation * R, roughness * (ibl_prefilterLevels - 1.0)).rgb;
+    vec2 brdf = texture(sampler_iblBRDFLUT, vec2(NdotV, roughness)).rg;
+    vec3 specular = prefilteredColor * (F * brdf.x + brdf.y);
+
+    ambient = (kD * diffuse + specular) * ao * ibl_intensity;
+  }
 
   vec3 returnColor = ambient + Lo;
 
@@ -4,43 +4,8 @@
 //
 // =================================================
 
-float calculateShadowValue(vec3 fragmentPosition) {
-    // perform perspective divide
-    vec3 projCoords = fs_shadow_FragPosLightSpace.xyz / fs_shadow_FragPosLightSpace.w;
-
-    // Transform to [0,1] range
-    projCoords = projCoords * 0.5 + 0.5;
-
-    // Get closest depth value from light's perspective (using [0,1] range fragPosLight as coords)
-    float closestDepth = texture(sampler_shadowMap, projCoords.xy).r;
-
-    // Get depth of current fragment from light's perspective
-    float currentDepth = projCoords.z;
-
-    // Calculate bias (based on depth map resolution and slope)
-    vec3 normal = normalize(fs_shadow_Normal);
+float calculateShadowAtlas(vec3 fragmentPosition, vec3 normal);
 
-    vec3 lightPos = pointLights[0].position;
-
-    vec3 lightDir = normalize(lightPos - fragmentPosition);
-    float bias = max(0.05 * (1.0 - dot(normal, lightDir)), 0.005);
-
-    // Check whether current frag pos is in shadow
-    // float shadow = currentDepth - bias > closestDepth  ? 1.0 : 0.0;
-    // PCF
-    float shadow = 0.0f;
-    vec2 texelSize = 1.0 / textureSize(sampler_shadowMap, 0);
-    for (int x = -1; x <= 1; ++x) {
-        for (int y = -1; y <= 1; ++y) {
-            float pcfDepth = texture(sampler_shadowMap, projCoords.xy + vec2(x, y) * texelSize).r;
-            shadow += currentDepth - bias > pcfDepth  ? 1.0 : 0.0;
-        }
-    }
-    shadow /= 9.0;
-
-    // Keep the shadow at 0.0 when outside the far_plane region of the light's frustum.
-    if (projCoords.z > 1.0)
-        shadow = 0.0;
-
-    return shadow;
+float calculateShadowValue(vec3 fragmentPosition) {
+  return calculateShadowAtlas(fragmentPosition, normalize(fs_vertexNormal));
 }
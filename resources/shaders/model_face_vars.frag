@@ -62,23 +62,6 @@ struct LightSource_Directional {
   float strengthAmbient, strengthDiffuse, strengthSpecular;
 };
 
-struct LightSource_Point {
-  bool inUse;
-  vec3 position;
-  float constant, linear, quadratic;
-  vec3 ambient, diffuse, specular;
-  float strengthAmbient, strengthDiffuse, strengthSpecular;
-};
-
-struct LightSource_Spot {
-  bool inUse;
-  vec3 position, direction;
-  float cutOff, outerCutOff;
-  float constant, linear, quadratic;
-  vec3 ambient, diffuse, specular;
-  float strengthAmbient, strengthDiffuse, strengthSpecular;
-};
-
 struct Effect_GaussianBlur {
   float gauss_w;
   float gauss_radius;
@@ -95,13 +78,7 @@ struct Effect_Bloom {
   float bloom_VignetteAtt;
 };
 
-// lights & mats
-#define NR_DIRECTIONAL_LIGHTS 8
-#define NR_POINT_LIGHTS 4
-#define NR_SPOT_LIGHTS 4
-uniform LightSource_Directional directionalLights[NR_DIRECTIONAL_LIGHTS];
-uniform LightSource_Point pointLights[NR_POINT_LIGHTS];
-uniform LightSource_Spot spotLights[NR_SPOT_LIGHTS];
+// mats, the lights are in the light clusters
 uniform ModelMaterial material;
 
 // solid skin
@@ -121,8 +98,6 @@ vec2 diffuse_dxy = vec2(1.0 / max(diffuse_texture_width, diffuse_texture_height)
 // shadows
 uniform bool fs_showShadows;
 uniform bool fs_shadowPass;
-uniform bool fs_debugShadowTexture;
-uniform sampler2D sampler_shadowMap;
 in vec3 fs_shadow_Normal;
 in vec4 fs_shadow_FragPosLightSpace;
 
@@ -138,6 +113,8 @@ float stepmix(float edge0, float edge1, float E, float x);
 mat3 cotangent_frame(vec3 normal, vec3 position, vec2 texCoords);
 vec3 ACESFilmRec2020(vec3 x);
 float calculateShadowValue(vec3 fragmentPosition);
+vec3 calculateShadowCascadeTint(vec3 fragmentPosition);
+float calculatePointShadow(int idx, vec3 lightPosition, vec3 fragmentPosition, vec3 viewPosition);
 float linearizeDepth(float depth);
 
 // PBR
@@ -151,7 +128,17 @@ float DistributionGGX(vec3 N, vec3 H, float roughness);
 float GeometrySchlickGGX(float NdotV, float roughness);
 float GeometrySmith(vec3 N, vec3 V, vec3 L, float roughness);
 vec3 fresnelSchlick(float cosTheta, vec3 F0);
+vec3 fresnelSchlickRoughness(float cosTheta, vec3 F0, float roughness);
 vec3 getNormalFromMap();
 
+// IBL
+uniform bool ibl_inUse;
+uniform float ibl_intensity;
+uniform float ibl_prefilterLevels;
+uniform mat3 ibl_rotation;
+uniform samplerCube sampler_iblIrradiance;
+uniform samplerCube sampler_iblPrefilter;
+uniform sampler2D sampler_iblBRDFLUT;
+
 // out color
 out vec4 fragColor;
@@ -0,0 +1,62 @@
+#version 410 core
+
+// 13-tap downsample from "Next Generation Post Processing in Call of Duty: Advanced Warfare"
+
+out vec3 fragColor;
+in vec2 TexCoords;
+
+uniform sampler2D sampler_source;
+// the first mip uses a Karis average against the fireflies
+uniform bool firstMip;
+
+float karisWeight(vec3 c) {
+  float luma = dot(c, vec3(0.2126, 0.7152, 0.0722));
+  return 1.0 / (1.0 + luma);
+}
+
+void main() {
+  vec2 texel = 1.0 / vec2(textureSize(sampler_source, 0));
+  float x = texel.x;
+  float y = texel.y;
+
+  // a - b - c
+  // - j - k -
+  // d - e - f
+  // - l - m -
+  // g - h - i
+  vec3 a = texture(sampler_source, vec2(TexCoords.x - 2.0 * x, TexCoords.y + 2.0 * y)).rgb;
+  vec3 b = texture(sampler_source, vec2(TexCoords.x, TexCoords.y + 2.0 * y)).rgb;
+  vec3 c = texture(sampler_source, vec2(TexCoords.x + 2.0 * x, TexCoords.y + 2.0 * y)).rgb;
+  vec3 d = texture(sampler_source, vec2(TexCoords.x - 2.0 * x, TexCoords.y)).rgb;
+  vec3 e = texture(sampler_source, vec2(TexCoords.x, TexCoords.y)).rgb;
+  vec3 f = texture(sampler_source, vec2(TexCoords.x + 2.0 * x, TexCoords.y)).rgb;
+  vec3 g = texture(sampler_source, vec2(TexCoords.x - 2.0 * x, TexCoords.y - 2.0 * y)).rgb;
+  vec3 h = texture(sampler_source, vec2(TexCoords.x, TexCoords.y - 2.0 * y)).rgb;
+  vec3 i = texture(sampler_source, vec2(TexCoords.x + 2.0 * x, TexCoords.y - 2.0 * y)).rgb;
+  vec3 j = texture(sampler_source, vec2(TexCoords.x - x, TexCoords.y + y)).rgb;
+  vec3 k = texture(sampler_source, vec2(TexCoords.x + x, TexCoords.y + y)).rgb;
+  vec3 l = texture(sampler_source, vec2(TexCoords.x - x, TexCoords.y - y)).rgb;
+  vec3 m = texture(sampler_source, vec2(TexCoords.x + x, TexCoords.y - y)).rgb;
+
+  if (firstMip) {
+    // five overlapping boxes, each weighted by its luma
+    vec3 g0 = (a + b + d + e) * 0.25;
+    vec3 g1 = (b + c + e + f) * 0.25;
+    vec3 g2 = (d + e + g + h) * 0.25;
+    vec3 g3 = (e + f + h + i) * 0.25;
+    vec3 g4 = (j + k + l + m) * 0.25;
+    float w0 = karisWeight(g0) * 0.125;
+    float w1 = karisWeight(g1) * 0.125;
+    float w2 = karisWeight(g2) * 0.125;
+    float w3 = karisWeight(g3) * 0.125;
+    float w4 = karisWeight(g4) * 0.5;
+    fragColor = (g0 * w0 + g1 * w1 + g2 * w2 + g3 * w3 + g4 * w4) / (w0 + w1 + w2 + w3 + w4);
+  }
+  else {
+    fragColor = e * 0.125;
+    fragColor += (a + c + g + i) * 0.03125;
+    fragColor += (b + d + f + h) * 0.0625;
+    fragColor += (j + k + l + m) * 0.125;
+  }
+  fragColor = max(fragColor, 0.0001);
+}
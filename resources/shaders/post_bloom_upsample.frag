@@ -0,0 +1,30 @@
+#version 410 core
+
+// 3x3 tent filter upsample, the result is added to the larger mip
+
+out vec3 fragColor;
+in vec2 TexCoords;
+
+uniform sampler2D sampler_source;
+// radius in texture coordinates
+uniform float filterRadius;
+
+void main() {
+  float x = filterRadius;
+  float y = filterRadius;
+
+  vec3 a = texture(sampler_source, vec2(TexCoords.x - x, TexCoords.y + y)).rgb;
+  vec3 b = texture(sampler_source, vec2(TexCoords.x, TexCoords.y + y)).rgb;
+  vec3 c = texture(sampler_source, vec2(TexCoords.x + x, TexCoords.y + y)).rgb;
+  vec3 d = texture(sampler_source, vec2(TexCoords.x - x, TexCoords.y)).rgb;
+  vec3 e = texture(sampler_source, vec2(TexCoords.x, TexCoords.y)).rgb;
+  vec3 f = texture(sampler_source, vec2(TexCoords.x + x, TexCoords.y)).rgb;
+  vec3 g = texture(sampler_source, vec2(TexCoords.x - x, TexCoords.y - y)).rgb;
+  vec3 h = texture(sampler_source, vec2(TexCoords.x, TexCoords.y - y)).rgb;
+  vec3 i = texture(sampler_source, vec2(TexCoords.x + x, TexCoords.y - y)).rgb;
+
+  fragColor = e * 4.0;
+  fragColor += (b + d + f + h) * 2.0;
+  fragColor += (a + c + g + i);
+  fragColor *= 1.0 / 16.0;
+}
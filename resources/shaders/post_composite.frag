@@ -0,0 +1,97 @@
+#version 410 core
+
+out vec4 fragColor;
+in vec2 TexCoords;
+
+uniform sampler2D sampler_scene;
+uniform sampler2D sampler_bloom;
+uniform sampler3D sampler_lut;
+
+uniform bool bloom_inUse;
+uniform float bloom_strength;
+
+uniform bool toneMapping_inUse;
+// 0 - Reinhard, 1 - ACES filmic, 2 - Uncharted 2
+uniform int toneMapping_operator;
+uniform float exposure;
+
+uniform bool lut_inUse;
+uniform float lut_intensity;
+uniform vec3 lut_domainMin;
+uniform vec3 lut_domainMax;
+
+uniform bool vignette_inUse;
+uniform float vignette_intensity;
+uniform float vignette_smoothness;
+
+// the part of the whole image in this target, as x, y, width and height
+uniform vec4 imageRegion;
+
+vec3 toneMapReinhard(vec3 color) {
+  return color / (color + vec3(1.0));
+}
+
+vec3 toneMapACES(vec3 color) {
+  // Narkowicz's fit of the ACES filmic curve
+  const float a = 2.51;
+  const float b = 0.03;
+  const float c = 2.43;
+  const float d = 0.59;
+  const float e = 0.14;
+  return clamp((color * (a * color + b)) / (color * (c * color + d) + e), 0.0, 1.0);
+}
+
+vec3 uncharted2Curve(vec3 x) {
+  const float A = 0.15;
+  const float B = 0.50;
+  const float C = 0.10;
+  const float D = 0.20;
+  const float E = 0.02;
+  const float F = 0.30;
+  return ((x * (A * x + C * B) + D * E) / (x * (A * x + B) + D * F)) - E / F;
+}
+
+vec3 toneMapUncharted2(vec3 color) {
+  const float W = 11.2;
+  return uncharted2Curve(2.0 * color) / uncharted2Curve(vec3(W));
+}
+
+vec3 colorGrade(vec3 color) {
+  // sample at the texel centers of the table
+  float size = float(textureSize(sampler_lut, 0).x);
+  vec3 uvw = clamp((color - lut_domainMin) / (lut_domainMax - lut_domainMin), 0.0, 1.0);
+  uvw = uvw * ((size - 1.0) / size) + 0.5 / size;
+  return mix(color, texture(sampler_lut, uvw).rgb, lut_intensity);
+}
+
+void main() {
+  vec4 scene = texture(sampler_scene, TexCoords);
+  vec3 color = scene.rgb;
+
+  if (bloom_inUse)
+    color = mix(color, texture(sampler_bloom, TexCoords).rgb, bloom_strength);
+
+  color *= exposure;
+  if (toneMapping_inUse) {
+    if (toneMapping_operator == 0)
+      color = toneMapReinhard(color);
+    else if (toneMapping_operator == 1)
+      color = toneMapACES(color);
+    else
+      color = toneMapUncharted2(color);
+  }
+  color = clamp(color, 0.0, 1.0);
+
+  if (lut_inUse)
+    color = colorGrade(color);
+
+  if (vignette_inUse) {
+    vec2 uv = imageRegion.xy + TexCoords * imageRegion.zw;
+    float distance = length(uv - vec2(0.5)) * 1.41421356;
+    float vignette = smoothstep(1.0 - vignette_smoothness, 1.0 + vignette_smoothness * 0.5, distance);
+    color *= 1.0 - vignette * vignette_intensity;
+  }
+
+  // the alpha is kept for the transparent Render Image
+  fragColor = vec4(color, scene.a);
+}
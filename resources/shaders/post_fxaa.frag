@@ -0,0 +1,60 @@
+#version 410 core
+
+// FXAA 3.11 console quality
+
+out vec4 fragColor;
+in vec2 TexCoords;
+
+uniform sampler2D sampler_source;
+
+#define FXAA_EDGE_THRESHOLD (1.0 / 8.0)
+#define FXAA_EDGE_THRESHOLD_MIN (1.0 / 24.0)
+#define FXAA_SPAN_MAX 8.0
+#define FXAA_REDUCE_MUL (1.0 / 8.0)
+#define FXAA_REDUCE_MIN (1.0 / 128.0)
+
+float luma(vec3 color) {
+  return dot(color, vec3(0.299, 0.587, 0.114));
+}
+
+void main() {
+  vec2 texel = 1.0 / vec2(textureSize(sampler_source, 0));
+
+  float lumaNW = luma(texture(sampler_source, TexCoords + vec2(-1.0, 1.0) * texel).rgb);
+  float lumaNE = luma(texture(sampler_source, TexCoords + vec2(1.0, 1.0) * texel).rgb);
+  float lumaSW = luma(texture(sampler_source, TexCoords + vec2(-1.0, -1.0) * texel).rgb);
+  float lumaSE = luma(texture(sampler_source, TexCoords + vec2(1.0, -1.0) * texel).rgb);
+  vec4 center = texture(sampler_source, TexCoords);
+  float lumaM = luma(center.rgb);
+
+  float lumaMin = min(lumaM, min(min(lumaNW, lumaNE), min(lumaSW, lumaSE)));
+  float lumaMax = max(lumaM, max(max(lumaNW, lumaNE), max(lumaSW, lumaSE)));
+
+  // no edge here
+  if (lumaMax - lumaMin < max(FXAA_EDGE_THRESHOLD_MIN, lumaMax * FXAA_EDGE_THRESHOLD)) {
+    fragColor = center;
+    return;
+  }
+
+  // direction along the edge
+  vec2 dir;
+  dir.x = -((lumaNW + lumaNE) - (lumaSW + lumaSE));
+  dir.y = ((lumaNW + lumaSW) - (lumaNE + lumaSE));
+  float dirReduce = max((lumaNW + lumaNE + lumaSW + lumaSE) * (0.25 * FXAA_REDUCE_MUL), FXAA_REDUCE_MIN);
+  float rcpDirMin = 1.0 / (min(abs(dir.x), abs(dir.y)) + dirReduce);
+  dir = clamp(dir * rcpDirMin, vec2(-FXAA_SPAN_MAX), vec2(FXAA_SPAN_MAX)) * texel;
+
+  vec3 rgbA = 0.5 * (
+    texture(sampler_source, TexCoords + dir * (1.0 / 3.0 - 0.5)).rgb +
+    texture(sampler_source, TexCoords + dir * (2.0 / 3.0 - 0.5)).rgb);
+  vec3 rgbB = rgbA * 0.5 + 0.25 * (
+    texture(sampler_source, TexCoords + dir * -0.5).rgb +
+    texture(sampler_source, TexCoords + dir * 0.5).rgb);
+
+  // the wider sampling went past the edge
+  float lumaB = luma(rgbB);
+  if (lumaB < lumaMin || lumaB > lumaMax)
+    fragColor = vec4(rgbA, center.a);
+  else
+    fragColor = vec4(rgbB, center.a);
+}
@@ -0,0 +1,133 @@
+// =================================================
+//
+// Shadow Atlas
+//
+// =================================================
+
+#define NR_SHADOW_LIGHTS 8
+
+struct ShadowLight {
+  bool inUse;
+  bool isDirectional;
+  mat4 lightSpaceMatrix;
+  // x, y - tile offset, z, w - tile size, in atlas texture coordinates
+  vec4 atlasRect;
+  vec3 position;
+  // towards the light for directional lights, the cone axis for spot lights
+  vec3 direction;
+  // number of entries, starting with this one, that are cascades of the light, 0 for the following cascades
+  int cascades;
+};
+
+uniform ShadowLight shadowLights[NR_SHADOW_LIGHTS];
+uniform sampler2D sampler_shadowAtlas;
+uniform float shadow_biasConstant;
+uniform float shadow_biasSlope;
+uniform int shadow_pcfRadius;
+
+// cascaded directional lights, the splits are the far view distances of the cascades
+uniform mat4 shadow_cameraView;
+uniform vec4 shadow_cascadeSplits;
+uniform float shadow_cascadeBlend;
+uniform bool shadow_debugCascades;
+
+float sampleShadowTile(int idx, vec3 fragmentPosition, vec3 normal) {
+  vec4 fragPosLightSpace = shadowLights[idx].lightSpaceMatrix * vec4(fragmentPosition, 1.0);
+  vec3 projCoords = fragPosLightSpace.xyz / fragPosLightSpace.w;
+  projCoords = projCoords * 0.5 + 0.5;
+
+  // outside of the light's frustum
+  if (projCoords.z > 1.0 || projCoords.x < 0.0 || projCoords.x > 1.0 || projCoords.y < 0.0 || projCoords.y > 1.0)
+    return 0.0;
+
+  vec3 directionLight = shadowLights[idx].isDirectional ? normalize(shadowLights[idx].direction) : normalize(shadowLights[idx].position - fragmentPosition);
+  float bias = max(shadow_biasSlope * (1.0 - dot(normal, directionLight)), shadow_biasConstant);
+
+  // PCF, clamped to the light's tile
+  vec4 rect = shadowLights[idx].atlasRect;
+  vec2 texelSize = 1.0 / vec2(textureSize(sampler_shadowAtlas, 0));
+  vec2 tileMin = rect.xy + 0.5 * texelSize;
+  vec2 tileMax = rect.xy + rect.zw - 0.5 * texelSize;
+  vec2 uv = rect.xy + projCoords.xy * rect.zw;
+  float shadow = 0.0;
+  for (int x = -shadow_pcfRadius; x <= shadow_pcfRadius; ++x) {
+    for (int y = -shadow_pcfRadius; y <= shadow_pcfRadius; ++y) {
+      float pcfDepth = texture(sampler_shadowAtlas, clamp(uv + vec2(x, y) * texelSize, tileMin, tileMax)).r;
+      shadow += (projCoords.z - bias > pcfDepth) ? 1.0 : 0.0;
+    }
+  }
+  float samples = float((2 * shadow_pcfRadius + 1) * (2 * shadow_pcfRadius + 1));
+  return shadow / samples;
+}
+
+float shadowViewDepth(vec3 fragmentPosition) {
+  return -(shadow_cameraView * vec4(fragmentPosition, 1.0)).z;
+}
+
+int shadowCascade(int cascades, float viewDepth) {
+  int cascade = 0;
+  for (int i = 0; i < cascades - 1; i++) {
+    if (viewDepth > shadow_cascadeSplits[i])
+      cascade = i + 1;
+  }
+  return cascade;
+}
+
+// how far into the blend band at the end of the cascade the fragment is, 0 outside of it
+float shadowCascadeBlend(int cascade, int cascades, float viewDepth) {
+  if (cascade >= cascades - 1)
+    return 0.0;
+  float splitFar = shadow_cascadeSplits[cascade];
+  float splitNear = cascade > 0 ? shadow_cascadeSplits[cascade - 1] : 0.0;
+  float band = (splitFar - splitNear) * shadow_cascadeBlend;
+  if (band <= 0.0)
+    return 0.0;
+  return clamp((viewDepth - (splitFar - band)) / band, 0.0, 1.0);
+}
+
+float calculateShadowLight(int idx, vec3 fragmentPosition, vec3 normal) {
+  if (idx < 0 || idx >= NR_SHADOW_LIGHTS || !shadowLights[idx].inUse)
+    return 0.0;
+
+  int cascades = shadowLights[idx].cascades;
+  if (cascades <= 1)
+    return sampleShadowTile(idx, fragmentPosition, normal);
+
+  float viewDepth = shadowViewDepth(fragmentPosition);
+  int cascade = shadowCascade(cascades, viewDepth);
+  float shadow = sampleShadowTile(idx + cascade, fragmentPosition, normal);
+  float blend = shadowCascadeBlend(cascade, cascades, viewDepth);
+  if (blend > 0.0)
+    shadow = mix(shadow, sampleShadowTile(idx + cascade + 1, fragmentPosition, normal), blend);
+  return shadow;
+}
+
+float calculateShadowAtlas(vec3 fragmentPosition, vec3 normal) {
+  float shadow = 0.0;
+  int lights = 0;
+  for (int i = 0; i < NR_SHADOW_LIGHTS; i++) {
+    if (shadowLights[i].inUse && shadowLights[i].cascades > 0) {
+      shadow += calculateShadowLight(i, fragmentPosition, normal);
+      lights++;
+    }
+  }
+  return lights > 0 ? shadow / float(lights) : 0.0;
+}
+
+// tints the fragment with the color of its cascade when the cascades debug view is on
+vec3 calculateShadowCascadeTint(vec3 fragmentPosition) {
+  if (!shadow_debugCascades)
+    return vec3(1.0);
+
+  vec3 colors[4] = vec3[](vec3(1.0, 0.4, 0.4), vec3(0.4, 1.0, 0.4), vec3(0.4, 0.4, 1.0), vec3(1.0, 1.0, 0.4));
+  for (int i = 0; i < NR_SHADOW_LIGHTS; i++) {
+    int cascades = shadowLights[i].cascades;
+    if (shadowLights[i].inUse && cascades > 1) {
+      float viewDepth = shadowViewDepth(fragmentPosition);
+      int cascade = shadowCascade(cascades, viewDepth);
+      float blend = shadowCascadeBlend(cascade, cascades, viewDepth);
+      return mix(colors[cascade], colors[min(cascade + 1, 3)], blend);
+    }
+  }
+  return vec3(1.0);
+}
@@ -0,0 +1,11 @@
+#version 410 core
+
+in vec4 fs_fragmentPosition;
+
+uniform vec3 fs_lightPosition;
+uniform float fs_farPlane;
+
+void main(void) {
+  // linear distance to the light, mapped to [0, 1]
+  gl_FragDepth = length(fs_fragmentPosition.xyz - fs_lightPosition) / fs_farPlane;
+}
@@ -0,0 +1,9 @@
+#version 410 core
+
+layout (location = 0) in vec3 vs_vertexPosition;
+
+uniform mat4 vs_modelMatrix;
+
+void main(void) {
+  gl_Position = vs_modelMatrix * vec4(vs_vertexPosition, 1.0);
+}
@@ -0,0 +1,47 @@
+// =================================================
+//
+// Point Light Shadows
+//
+// =================================================
+
+// pointShadows[i] is the shadow of the i-th point light
+#define NR_SHADOW_POINT_LIGHTS 4
+
+struct PointShadow {
+  bool inUse;
+  float farPlane;
+};
+
+uniform PointShadow pointShadows[NR_SHADOW_POINT_LIGHTS];
+uniform samplerCube sampler_pointShadows[NR_SHADOW_POINT_LIGHTS];
+uniform float shadow_pointBias;
+uniform float shadow_pointSoftness;
+
+const vec3 pointShadowOffsets[20] = vec3[](
+  vec3(1, 1, 1), vec3(1, -1, 1), vec3(-1, -1, 1), vec3(-1, 1, 1),
+  vec3(1, 1, -1), vec3(1, -1, -1), vec3(-1, -1, -1), vec3(-1, 1, -1),
+  vec3(1, 1, 0), vec3(1, -1, 0), vec3(-1, -1, 0), vec3(-1, 1, 0),
+  vec3(1, 0, 1), vec3(-1, 0, 1), vec3(1, 0, -1), vec3(-1, 0, -1),
+  vec3(0, 1, 1), vec3(0, -1, 1), vec3(0, -1, -1), vec3(0, 1, -1)
+);
+
+float calculatePointShadow(int idx, vec3 lightPosition, vec3 fragmentPosition, vec3 viewPosition) {
+  if (idx < 0 || idx >= NR_SHADOW_POINT_LIGHTS || !pointShadows[idx].inUse)
+    return 0.0;
+
+  vec3 fragmentToLight = fragmentPosition - lightPosition;
+  float farPlane = pointShadows[idx].farPlane;
+  float currentDepth = length(fragmentToLight);
+  if (currentDepth > farPlane)
+    return 0.0;
+
+  // the filter gets wider further away from the camera
+  float viewDistance = length(viewPosition - fragmentPosition);
+  float diskRadius = shadow_pointSoftness * (1.0 + viewDistance / farPlane);
+  float shadow = 0.0;
+  for (int i = 0; i < 20; ++i) {
+    float closestDepth = texture(sampler_pointShadows[idx], fragmentToLight + pointShadowOffsets[i] * diskRadius).r * farPlane;
+    shadow += (currentDepth - shadow_pointBias > closestDepth) ? 1.0 : 0.0;
+  }
+  return shadow / 20.0;
+}
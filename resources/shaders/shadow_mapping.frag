@@ -0,0 +1,122 @@
+#version 410 core
+
+struct LightSource_Directional {
+  bool inUse;
+  int shadowIndex;
+  vec3 direction;
+  vec3 ambient, diffuse, specular;
+  float strengthAmbient, strengthDiffuse, strengthSpecular;
+};
+
+struct LightSource_Point {
+  bool inUse;
+  vec3 position;
+  float constant, linear, quadratic;
+  vec3 ambient, diffuse, specular;
+  float strengthAmbient, strengthDiffuse, strengthSpecular;
+};
+
+struct LightSource_Spot {
+  bool inUse;
+  int shadowIndex;
+  vec3 position, direction;
+  float cutOff, outerCutOff;
+  float constant, linear, quadratic;
+  vec3 ambient, diffuse, specular;
+  float strengthAmbient, strengthDiffuse, strengthSpecular;
+};
+
+#define NR_DIRECTIONAL_LIGHTS 8
+#define NR_POINT_LIGHTS 4
+#define NR_SPOT_LIGHTS 4
+uniform LightSource_Directional directionalLights[NR_DIRECTIONAL_LIGHTS];
+uniform LightSource_Point pointLights[NR_POINT_LIGHTS];
+uniform LightSource_Spot spotLights[NR_SPOT_LIGHTS];
+
+// used when the scene has no lights
+uniform LightSource_Directional solidSkin_Light;
+
+uniform vec3 fs_cameraPosition;
+uniform vec3 fs_UIAmbient;
+uniform float fs_gammaCoeficient;
+uniform bool fs_showShadows;
+
+uniform vec3 material_ambient;
+uniform vec3 material_diffuse;
+uniform vec3 material_specular;
+uniform float material_specularExp;
+uniform bool material_hasTextureDiffuse;
+uniform sampler2D material_samplerDiffuse;
+
+in vec3 fs_fragmentPosition;
+in vec3 fs_vertexNormal;
+in vec2 fs_textureCoord;
+
+out vec4 fragColor;
+
+float calculateShadowLight(int idx, vec3 fragmentPosition, vec3 normal);
+vec3 calculateShadowCascadeTint(vec3 fragmentPosition);
+float calculatePointShadow(int idx, vec3 lightPosition, vec3 fragmentPosition, vec3 viewPosition);
+
+vec3 shadeLight(vec3 directionLight, vec3 normal, vec3 directionView, vec3 diffuseColor, vec3 ambient, vec3 diffuse, vec3 specular, vec3 strengths, float shadow) {
+  float lambertFactor = max(dot(normal, directionLight), 0.0);
+  vec3 halfway = normalize(directionLight + directionView);
+  float specularFactor = lambertFactor > 0.0 ? pow(max(dot(normal, halfway), 0.0), max(material_specularExp, 1.0)) : 0.0;
+  vec3 colorAmbient = strengths.x * ambient * material_ambient * diffuseColor;
+  vec3 colorDiffuse = strengths.y * diffuse * lambertFactor * diffuseColor;
+  vec3 colorSpecular = strengths.z * specular * specularFactor * material_specular;
+  return colorAmbient + (1.0 - shadow) * (colorDiffuse + colorSpecular);
+}
+
+void main(void) {
+  vec3 normal = normalize(fs_vertexNormal);
+  vec3 directionView = normalize(fs_cameraPosition - fs_fragmentPosition);
+  vec3 diffuseColor = material_hasTextureDiffuse ? texture(material_samplerDiffuse, fs_textureCoord).rgb : material_diffuse;
+
+  vec3 color = fs_UIAmbient * diffuseColor;
+
+  if (solidSkin_Light.inUse) {
+    vec3 strengths = vec3(solidSkin_Light.strengthAmbient, solidSkin_Light.strengthDiffuse, solidSkin_Light.strengthSpecular);
+    color += shadeLight(normalize(solidSkin_Light.direction), normal, directionView, diffuseColor, solidSkin_Light.ambient, solidSkin_Light.diffuse, solidSkin_Light.specular, strengths, 0.0);
+  }
+
+  for (int i = 0; i < NR_DIRECTIONAL_LIGHTS; i++) {
+    if (directionalLights[i].inUse) {
+      vec3 directionLight = normalize(directionalLights[i].direction);
+      float shadow = fs_showShadows ? calculateShadowLight(directionalLights[i].shadowIndex, fs_fragmentPosition, normal) : 0.0;
+      vec3 strengths = vec3(directionalLights[i].strengthAmbient, directionalLights[i].strengthDiffuse, directionalLights[i].strengthSpecular);
+      color += shadeLight(directionLight, normal, directionView, diffuseColor, directionalLights[i].ambient, directionalLights[i].diffuse, directionalLights[i].specular, strengths, shadow);
+    }
+  }
+
+  for (int i = 0; i < NR_POINT_LIGHTS; i++) {
+    if (pointLights[i].inUse) {
+      vec3 directionLight = normalize(pointLights[i].position - fs_fragmentPosition);
+      float distance = length(pointLights[i].position - fs_fragmentPosition);
+      float attenuation = 1.0 / max(pointLights[i].constant + pointLights[i].linear * distance + pointLights[i].quadratic * distance * distance, 0.0001);
+      vec3 strengths = vec3(pointLights[i].strengthAmbient, pointLights[i].strengthDiffuse, pointLights[i].strengthSpecular);
+      float shadow = fs_showShadows ? calculatePointShadow(i, pointLights[i].position, fs_fragmentPosition, fs_cameraPosition) : 0.0;
+      color += attenuation * shadeLight(directionLight, normal, directionView, diffuseColor, pointLights[i].ambient, pointLights[i].diffuse, pointLights[i].specular, strengths, shadow);
+    }
+  }
+
+  for (int i = 0; i < NR_SPOT_LIGHTS; i++) {
+    if (spotLights[i].inUse) {
+      vec3 directionLight = normalize(spotLights[i].position - fs_fragmentPosition);
+      float distance = length(spotLights[i].position - fs_fragmentPosition);
+      float attenuation = 1.0 / max(spotLights[i].constant + spotLights[i].linear * distance + spotLights[i].quadratic * distance * distance, 0.0001);
+      float theta = dot(directionLight, normalize(-spotLights[i].direction));
+      float epsilon = spotLights[i].cutOff - spotLights[i].outerCutOff;
+      float intensity = clamp((theta - spotLights[i].outerCutOff) / max(epsilon, 0.0001), 0.0, 1.0);
+      float shadow = fs_showShadows ? calculateShadowLight(spotLights[i].shadowIndex, fs_fragmentPosition, normal) : 0.0;
+      vec3 strengths = vec3(spotLights[i].strengthAmbient, spotLights[i].strengthDiffuse * intensity, spotLights[i].strengthSpecular * intensity);
+      color += attenuation * shadeLight(directionLight, normal, directionView, diffuseColor, spotLights[i].ambient, spotLights[i].diffuse, spotLights[i].specular, strengths, shadow);
+    }
+  }
+
+  if (fs_showShadows)
+    color *= calculateShadowCascadeTint(fs_fragmentPosition);
+
+  color = pow(color, vec3(1.0 / fs_gammaCoeficient));
+  fragColor = vec4(color, 1.0);
+}
@@ -0,0 +1,19 @@
+#version 410 core
+
+layout (location = 0) in vec3 vs_vertexPosition;
+layout (location = 1) in vec3 vs_vertexNormal;
+layout (location = 2) in vec2 vs_textureCoord;
+
+uniform mat4 vs_MVPMatrix;
+uniform mat4 vs_ModelMatrix;
+
+out vec3 fs_fragmentPosition;
+out vec3 fs_vertexNormal;
+out vec2 fs_textureCoord;
+
+void main(void) {
+  fs_fragmentPosition = vec3(vs_ModelMatrix * vec4(vs_vertexPosition, 1.0));
+  fs_vertexNormal = transpose(inverse(mat3(vs_ModelMatrix))) * vs_vertexNormal;
+  fs_textureCoord = vs_textureCoord;
+  gl_Position = vs_MVPMatrix * vec4(vs_vertexPosition, 1.0);
+}
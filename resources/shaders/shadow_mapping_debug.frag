@@ -0,0 +1,12 @@
+#version 410 core
+
+uniform sampler2D sampler_shadowAtlas;
+
+in vec2 fs_textureCoord;
+
+out vec4 fragColor;
+
+void main(void) {
+  float depth = texture(sampler_shadowAtlas, fs_textureCoord).r;
+  fragColor = vec4(vec3(depth), 1.0);
+}
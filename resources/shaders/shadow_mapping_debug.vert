@@ -0,0 +1,13 @@
+#version 410 core
+
+layout (location = 0) in vec2 vs_position;
+
+// x, y - bottom left corner, z, w - size, in normalized device coordinates
+uniform vec4 vs_screenRect;
+
+out vec2 fs_textureCoord;
+
+void main(void) {
+  fs_textureCoord = vs_position;
+  gl_Position = vec4(vs_screenRect.xy + vs_position * vs_screenRect.zw, 0.0, 1.0);
+}
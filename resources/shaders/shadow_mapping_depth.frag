@@ -0,0 +1,5 @@
+#version 410 core
+
+void main(void) {
+  // only the depth is written
+}
@@ -1,9 +1,18 @@
 #version 410 core
 
 uniform samplerCube u_sampler;
+uniform bool fs_isHDR;
+uniform float fs_exposure;
 in vec3 vs_textureCoord;
 out vec4 fragColor;
 
 void main(void) {
-  fragColor = texture(u_sampler, vs_textureCoord);
+  if (fs_isHDR) {
+    // exposure tone mapping and gamma correction of the linear HDR colors
+    vec3 hdrColor = texture(u_sampler, vs_textureCoord).rgb;
+    vec3 mapped = vec3(1.0) - exp(-hdrColor * fs_exposure);
+    fragColor = vec4(pow(mapped, vec3(1.0 / 2.2)), 1.0);
+  }
+  else
+    fragColor = texture(u_sampler, vs_textureCoord);
 }
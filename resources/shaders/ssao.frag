@@ -0,0 +1,54 @@
+#version 410 core
+
+out float fragColor;
+in vec2 TexCoords;
+
+uniform sampler2D sampler_position;
+uniform sampler2D sampler_normal;
+uniform sampler2D sampler_noise;
+
+#define MAX_KERNEL_SIZE 64
+#define NOISE_SIZE 4.0
+
+uniform vec3 samples[MAX_KERNEL_SIZE];
+uniform int kernelSize;
+uniform float radius;
+uniform float bias;
+uniform float power;
+
+uniform mat4 view;
+uniform mat4 projection;
+
+void main() {
+  // the g-buffer is in world space, the occlusion is sampled in view space
+  vec3 fragPos = (view * vec4(texture(sampler_position, TexCoords).xyz, 1.0)).xyz;
+  vec3 normal = normalize(mat3(view) * texture(sampler_normal, TexCoords).rgb);
+
+  // tile the noise texture over the screen
+  vec2 noiseScale = vec2(textureSize(sampler_position, 0)) / NOISE_SIZE;
+  vec3 randomVec = normalize(texture(sampler_noise, TexCoords * noiseScale).xyz);
+
+  // TBN from the random vector, Gramm-Schmidt
+  vec3 tangent = normalize(randomVec - normal * dot(randomVec, normal));
+  vec3 bitangent = cross(normal, tangent);
+  mat3 TBN = mat3(tangent, bitangent, normal);
+
+  float occlusion = 0.0;
+  for (int i=0; i<kernelSize; ++i) {
+    vec3 samplePos = fragPos + (TBN * samples[i]) * radius;
+
+    // sample position in screen space
+    vec4 offset = projection * vec4(samplePos, 1.0);
+    offset.xyz /= offset.w;
+    offset.xyz = offset.xyz * 0.5 + 0.5;
+
+    // depth of the geometry at the sample
+    float sampleDepth = (view * vec4(texture(sampler_position, offset.xy).xyz, 1.0)).z;
+
+    // range check, far away geometry should not occlude
+    float rangeCheck = smoothstep(0.0, 1.0, radius / abs(fragPos.z - sampleDepth));
+    occlusion += (sampleDepth >= samplePos.z + bias ? 1.0 : 0.0) * rangeCheck;
+  }
+  occlusion = 1.0 - (occlusion / float(kernelSize));
+  fragColor = pow(occlusion, power);
+}
@@ -0,0 +1,21 @@
+#version 410 core
+
+out float fragColor;
+in vec2 TexCoords;
+
+uniform sampler2D sampler_ssao;
+
+#define NOISE_SIZE 4
+
+void main() {
+  // box blur over the size of the noise tile removes the noise pattern
+  vec2 texelSize = 1.0 / vec2(textureSize(sampler_ssao, 0));
+  float result = 0.0;
+  for (int x=-NOISE_SIZE/2; x<NOISE_SIZE/2; ++x) {
+    for (int y=-NOISE_SIZE/2; y<NOISE_SIZE/2; ++y) {
+      vec2 offset = vec2(float(x), float(y)) * texelSize;
+      result += texture(sampler_ssao, TexCoords + offset).r;
+    }
+  }
+  fragColor = result / float(NOISE_SIZE * NOISE_SIZE);
+}
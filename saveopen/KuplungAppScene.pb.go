@@ -60,78 +60,80 @@ func (m *Scene) GetModels() []*MeshModel {
 }
 
 type MeshModel struct {
-	ModelID                          *int32            `protobuf:"varint,1,req,name=ModelID" json:"ModelID,omitempty"`
-	Settings_DeferredRender          *bool             `protobuf:"varint,2,req,name=Settings_DeferredRender,json=SettingsDeferredRender" json:"Settings_DeferredRender,omitempty"`
-	Setting_CelShading               *bool             `protobuf:"varint,3,req,name=Setting_CelShading,json=SettingCelShading" json:"Setting_CelShading,omitempty"`
-	Setting_Wireframe                *bool             `protobuf:"varint,4,req,name=Setting_Wireframe,json=SettingWireframe" json:"Setting_Wireframe,omitempty"`
-	Setting_UseTessellation          *bool             `protobuf:"varint,5,req,name=Setting_UseTessellation,json=SettingUseTessellation" json:"Setting_UseTessellation,omitempty"`
-	Setting_UseCullFace              *bool             `protobuf:"varint,6,req,name=Setting_UseCullFace,json=SettingUseCullFace" json:"Setting_UseCullFace,omitempty"`
-	Setting_Alpha                    *float32          `protobuf:"fixed32,7,req,name=Setting_Alpha,json=SettingAlpha" json:"Setting_Alpha,omitempty"`
-	Setting_TessellationSubdivision  *int32            `protobuf:"varint,8,req,name=Setting_TessellationSubdivision,json=SettingTessellationSubdivision" json:"Setting_TessellationSubdivision,omitempty"`
-	PositionX                        *ObjectCoordinate `protobuf:"bytes,9,req,name=positionX" json:"positionX,omitempty"`
-	PositionY                        *ObjectCoordinate `protobuf:"bytes,10,req,name=positionY" json:"positionY,omitempty"`
-	PositionZ                        *ObjectCoordinate `protobuf:"bytes,11,req,name=positionZ" json:"positionZ,omitempty"`
-	ScaleX                           *ObjectCoordinate `protobuf:"bytes,12,req,name=scaleX" json:"scaleX,omitempty"`
-	ScaleY                           *ObjectCoordinate `protobuf:"bytes,13,req,name=scaleY" json:"scaleY,omitempty"`
-	ScaleZ                           *ObjectCoordinate `protobuf:"bytes,14,req,name=scaleZ" json:"scaleZ,omitempty"`
-	RotateX                          *ObjectCoordinate `protobuf:"bytes,15,req,name=rotateX" json:"rotateX,omitempty"`
-	RotateY                          *ObjectCoordinate `protobuf:"bytes,16,req,name=rotateY" json:"rotateY,omitempty"`
-	RotateZ                          *ObjectCoordinate `protobuf:"bytes,17,req,name=rotateZ" json:"rotateZ,omitempty"`
-	DisplaceX                        *ObjectCoordinate `protobuf:"bytes,18,req,name=displaceX" json:"displaceX,omitempty"`
-	DisplaceY                        *ObjectCoordinate `protobuf:"bytes,19,req,name=displaceY" json:"displaceY,omitempty"`
-	DisplaceZ                        *ObjectCoordinate `protobuf:"bytes,20,req,name=displaceZ" json:"displaceZ,omitempty"`
-	Setting_MaterialRefraction       *ObjectCoordinate `protobuf:"bytes,21,req,name=Setting_MaterialRefraction,json=SettingMaterialRefraction" json:"Setting_MaterialRefraction,omitempty"`
-	Setting_MaterialSpecularExp      *ObjectCoordinate `protobuf:"bytes,22,req,name=Setting_MaterialSpecularExp,json=SettingMaterialSpecularExp" json:"Setting_MaterialSpecularExp,omitempty"`
-	Setting_ModelViewSkin            *int32            `protobuf:"varint,23,req,name=Setting_ModelViewSkin,json=SettingModelViewSkin" json:"Setting_ModelViewSkin,omitempty"`
-	SolidLightSkin_MaterialColor     *Vec3             `protobuf:"bytes,24,req,name=solidLightSkin_MaterialColor,json=solidLightSkinMaterialColor" json:"solidLightSkin_MaterialColor,omitempty"`
-	SolidLightSkin_Ambient           *Vec3             `protobuf:"bytes,25,req,name=solidLightSkin_Ambient,json=solidLightSkinAmbient" json:"solidLightSkin_Ambient,omitempty"`
-	SolidLightSkin_Diffuse           *Vec3             `protobuf:"bytes,26,req,name=solidLightSkin_Diffuse,json=solidLightSkinDiffuse" json:"solidLightSkin_Diffuse,omitempty"`
-	SolidLightSkin_Specular          *Vec3             `protobuf:"bytes,27,req,name=solidLightSkin_Specular,json=solidLightSkinSpecular" json:"solidLightSkin_Specular,omitempty"`
-	SolidLightSkin_Ambient_Strength  *float32          `protobuf:"fixed32,28,req,name=solidLightSkin_Ambient_Strength,json=solidLightSkinAmbientStrength" json:"solidLightSkin_Ambient_Strength,omitempty"`
-	SolidLightSkin_Diffuse_Strength  *float32          `protobuf:"fixed32,29,req,name=solidLightSkin_Diffuse_Strength,json=solidLightSkinDiffuseStrength" json:"solidLightSkin_Diffuse_Strength,omitempty"`
-	SolidLightSkin_Specular_Strength *float32          `protobuf:"fixed32,30,req,name=solidLightSkin_Specular_Strength,json=solidLightSkinSpecularStrength" json:"solidLightSkin_Specular_Strength,omitempty"`
-	Setting_LightPosition            *Vec3             `protobuf:"bytes,31,req,name=Setting_LightPosition,json=SettingLightPosition" json:"Setting_LightPosition,omitempty"`
-	Setting_LightDirection           *Vec3             `protobuf:"bytes,32,req,name=Setting_LightDirection,json=SettingLightDirection" json:"Setting_LightDirection,omitempty"`
-	Setting_LightAmbient             *Vec3             `protobuf:"bytes,33,req,name=Setting_LightAmbient,json=SettingLightAmbient" json:"Setting_LightAmbient,omitempty"`
-	Setting_LightDiffuse             *Vec3             `protobuf:"bytes,34,req,name=Setting_LightDiffuse,json=SettingLightDiffuse" json:"Setting_LightDiffuse,omitempty"`
-	Setting_LightSpecular            *Vec3             `protobuf:"bytes,35,req,name=Setting_LightSpecular,json=SettingLightSpecular" json:"Setting_LightSpecular,omitempty"`
-	Setting_LightStrengthAmbient     *float32          `protobuf:"fixed32,36,req,name=Setting_LightStrengthAmbient,json=SettingLightStrengthAmbient" json:"Setting_LightStrengthAmbient,omitempty"`
-	Setting_LightStrengthDiffuse     *float32          `protobuf:"fixed32,37,req,name=Setting_LightStrengthDiffuse,json=SettingLightStrengthDiffuse" json:"Setting_LightStrengthDiffuse,omitempty"`
-	Setting_LightStrengthSpecular    *float32          `protobuf:"fixed32,38,req,name=Setting_LightStrengthSpecular,json=SettingLightStrengthSpecular" json:"Setting_LightStrengthSpecular,omitempty"`
-	MaterialIlluminationModel        *int32            `protobuf:"varint,39,req,name=materialIlluminationModel" json:"materialIlluminationModel,omitempty"`
-	DisplacementHeightScale          *ObjectCoordinate `protobuf:"bytes,40,req,name=displacementHeightScale" json:"displacementHeightScale,omitempty"`
-	ShowMaterialEditor               *bool             `protobuf:"varint,41,req,name=showMaterialEditor" json:"showMaterialEditor,omitempty"`
-	MaterialAmbient                  *MaterialColor    `protobuf:"bytes,42,req,name=materialAmbient" json:"materialAmbient,omitempty"`
-	MaterialDiffuse                  *MaterialColor    `protobuf:"bytes,43,req,name=materialDiffuse" json:"materialDiffuse,omitempty"`
-	MaterialSpecular                 *MaterialColor    `protobuf:"bytes,44,req,name=materialSpecular" json:"materialSpecular,omitempty"`
-	MaterialEmission                 *MaterialColor    `protobuf:"bytes,45,req,name=materialEmission" json:"materialEmission,omitempty"`
-	Setting_ParallaxMapping          *bool             `protobuf:"varint,46,req,name=Setting_ParallaxMapping,json=SettingParallaxMapping" json:"Setting_ParallaxMapping,omitempty"`
-	Effect_GBlur_Mode                *int32            `protobuf:"varint,47,req,name=Effect_GBlur_Mode,json=EffectGBlurMode" json:"Effect_GBlur_Mode,omitempty"`
-	Effect_GBlur_Radius              *ObjectCoordinate `protobuf:"bytes,48,req,name=Effect_GBlur_Radius,json=EffectGBlurRadius" json:"Effect_GBlur_Radius,omitempty"`
-	Effect_GBlur_Width               *ObjectCoordinate `protobuf:"bytes,49,req,name=Effect_GBlur_Width,json=EffectGBlurWidth" json:"Effect_GBlur_Width,omitempty"`
-	Effect_Bloom_DoBloom             *bool             `protobuf:"varint,50,req,name=Effect_Bloom_DoBloom,json=EffectBloomDoBloom" json:"Effect_Bloom_DoBloom,omitempty"`
-	Effect_Bloom_WeightA             *float32          `protobuf:"fixed32,51,req,name=Effect_Bloom_WeightA,json=EffectBloomWeightA" json:"Effect_Bloom_WeightA,omitempty"`
-	Effect_Bloom_WeightB             *float32          `protobuf:"fixed32,52,req,name=Effect_Bloom_WeightB,json=EffectBloomWeightB" json:"Effect_Bloom_WeightB,omitempty"`
-	Effect_Bloom_WeightC             *float32          `protobuf:"fixed32,53,req,name=Effect_Bloom_WeightC,json=EffectBloomWeightC" json:"Effect_Bloom_WeightC,omitempty"`
-	Effect_Bloom_WeightD             *float32          `protobuf:"fixed32,54,req,name=Effect_Bloom_WeightD,json=EffectBloomWeightD" json:"Effect_Bloom_WeightD,omitempty"`
-	Effect_Bloom_Vignette            *float32          `protobuf:"fixed32,55,req,name=Effect_Bloom_Vignette,json=EffectBloomVignette" json:"Effect_Bloom_Vignette,omitempty"`
-	Effect_Bloom_VignetteAtt         *float32          `protobuf:"fixed32,56,req,name=Effect_Bloom_VignetteAtt,json=EffectBloomVignetteAtt" json:"Effect_Bloom_VignetteAtt,omitempty"`
-	Setting_LightingPass_DrawMode    *int32            `protobuf:"varint,57,req,name=Setting_LightingPass_DrawMode,json=SettingLightingPassDrawMode" json:"Setting_LightingPass_DrawMode,omitempty"`
-	MeshObject                       *Mesh             `protobuf:"bytes,58,req,name=meshObject" json:"meshObject,omitempty"`
-	EffectToneMappingACESFilmRec2020 *bool             `protobuf:"varint,59,req,name=EffectToneMappingACESFilmRec2020" json:"EffectToneMappingACESFilmRec2020,omitempty"`
-	EffectHDRTonemapping             *bool             `protobuf:"varint,60,req,name=EffectHDRTonemapping" json:"EffectHDRTonemapping,omitempty"`
-	ShowShadows                      *bool             `protobuf:"varint,61,req,name=ShowShadows" json:"ShowShadows,omitempty"`
-	RenderingPBR                     *bool             `protobuf:"varint,62,req,name=RenderingPBR" json:"RenderingPBR,omitempty"`
-	RenderingPBRMetallic             *float32          `protobuf:"fixed32,63,req,name=RenderingPBRMetallic" json:"RenderingPBRMetallic,omitempty"`
-	RenderingPBRRoughness            *float32          `protobuf:"fixed32,64,req,name=RenderingPBRRoughness" json:"RenderingPBRRoughness,omitempty"`
-	RenderingPBRAO                   *float32          `protobuf:"fixed32,65,req,name=RenderingPBRAO" json:"RenderingPBRAO,omitempty"`
-	SolidLightSkinMaterialColor      *Vec3             `protobuf:"bytes,66,req,name=SolidLightSkinMaterialColor" json:"SolidLightSkinMaterialColor,omitempty"`
-	SolidLightSkinAmbient            *Vec3             `protobuf:"bytes,67,req,name=SolidLightSkinAmbient" json:"SolidLightSkinAmbient,omitempty"`
-	SolidLightSkinDiffuse            *Vec3             `protobuf:"bytes,68,req,name=SolidLightSkinDiffuse" json:"SolidLightSkinDiffuse,omitempty"`
-	SolidLightSkinSpecular           *Vec3             `protobuf:"bytes,69,req,name=SolidLightSkinSpecular" json:"SolidLightSkinSpecular,omitempty"`
-	XXX_NoUnkeyedLiteral             struct{}          `json:"-"`
-	XXX_unrecognized                 []byte            `json:"-"`
-	XXX_sizecache                    int32             `json:"-"`
+	ModelID                          *int32            `protobuf:"varint,1,opt,name=ModelID" json:"ModelID,omitempty"`
+	Settings_DeferredRender          *bool             `protobuf:"varint,2,opt,name=Settings_DeferredRender,json=SettingsDeferredRender" json:"Settings_DeferredRender,omitempty"`
+	Setting_CelShading               *bool             `protobuf:"varint,3,opt,name=Setting_CelShading,json=SettingCelShading" json:"Setting_CelShading,omitempty"`
+	Setting_Wireframe                *bool             `protobuf:"varint,4,opt,name=Setting_Wireframe,json=SettingWireframe" json:"Setting_Wireframe,omitempty"`
+	Setting_UseTessellation          *bool             `protobuf:"varint,5,opt,name=Setting_UseTessellation,json=SettingUseTessellation" json:"Setting_UseTessellation,omitempty"`
+	Setting_UseCullFace              *bool             `protobuf:"varint,6,opt,name=Setting_UseCullFace,json=SettingUseCullFace" json:"Setting_UseCullFace,omitempty"`
+	Setting_Alpha                    *float32          `protobuf:"fixed32,7,opt,name=Setting_Alpha,json=SettingAlpha" json:"Setting_Alpha,omitempty"`
+	Setting_TessellationSubdivision  *int32            `protobuf:"varint,8,opt,name=Setting_TessellationSubdivision,json=SettingTessellationSubdivision" json:"Setting_TessellationSubdivision,omitempty"`
+	PositionX                        *ObjectCoordinate `protobuf:"bytes,9,opt,name=positionX" json:"positionX,omitempty"`
+	PositionY                        *ObjectCoordinate `protobuf:"bytes,10,opt,name=positionY" json:"positionY,omitempty"`
+	PositionZ                        *ObjectCoordinate `protobuf:"bytes,11,opt,name=positionZ" json:"positionZ,omitempty"`
+	ScaleX                           *ObjectCoordinate `protobuf:"bytes,12,opt,name=scaleX" json:"scaleX,omitempty"`
+	ScaleY                           *ObjectCoordinate `protobuf:"bytes,13,opt,name=scaleY" json:"scaleY,omitempty"`
+	ScaleZ                           *ObjectCoordinate `protobuf:"bytes,14,opt,name=scaleZ" json:"scaleZ,omitempty"`
+	RotateX                          *ObjectCoordinate `protobuf:"bytes,15,opt,name=rotateX" json:"rotateX,omitempty"`
+	RotateY                          *ObjectCoordinate `protobuf:"bytes,16,opt,name=rotateY" json:"rotateY,omitempty"`
+	RotateZ                          *ObjectCoordinate `protobuf:"bytes,17,opt,name=rotateZ" json:"rotateZ,omitempty"`
+	DisplaceX                        *ObjectCoordinate `protobuf:"bytes,18,opt,name=displaceX" json:"displaceX,omitempty"`
+	DisplaceY                        *ObjectCoordinate `protobuf:"bytes,19,opt,name=displaceY" json:"displaceY,omitempty"`
+	DisplaceZ                        *ObjectCoordinate `protobuf:"bytes,20,opt,name=displaceZ" json:"displaceZ,omitempty"`
+	Setting_MaterialRefraction       *ObjectCoordinate `protobuf:"bytes,21,opt,name=Setting_MaterialRefraction,json=SettingMaterialRefraction" json:"Setting_MaterialRefraction,omitempty"`
+	Setting_MaterialSpecularExp      *ObjectCoordinate `protobuf:"bytes,22,opt,name=Setting_MaterialSpecularExp,json=SettingMaterialSpecularExp" json:"Setting_MaterialSpecularExp,omitempty"`
+	Setting_ModelViewSkin            *int32            `protobuf:"varint,23,opt,name=Setting_ModelViewSkin,json=SettingModelViewSkin" json:"Setting_ModelViewSkin,omitempty"`
+	SolidLightSkin_MaterialColor     *Vec3             `protobuf:"bytes,24,opt,name=solidLightSkin_MaterialColor,json=solidLightSkinMaterialColor" json:"solidLightSkin_MaterialColor,omitempty"`
+	SolidLightSkin_Ambient           *Vec3             `protobuf:"bytes,25,opt,name=solidLightSkin_Ambient,json=solidLightSkinAmbient" json:"solidLightSkin_Ambient,omitempty"`
+	SolidLightSkin_Diffuse           *Vec3             `protobuf:"bytes,26,opt,name=solidLightSkin_Diffuse,json=solidLightSkinDiffuse" json:"solidLightSkin_Diffuse,omitempty"`
+	SolidLightSkin_Specular          *Vec3             `protobuf:"bytes,27,opt,name=solidLightSkin_Specular,json=solidLightSkinSpecular" json:"solidLightSkin_Specular,omitempty"`
+	SolidLightSkin_Ambient_Strength  *float32          `protobuf:"fixed32,28,opt,name=solidLightSkin_Ambient_Strength,json=solidLightSkinAmbientStrength" json:"solidLightSkin_Ambient_Strength,omitempty"`
+	SolidLightSkin_Diffuse_Strength  *float32          `protobuf:"fixed32,29,opt,name=solidLightSkin_Diffuse_Strength,json=solidLightSkinDiffuseStrength" json:"solidLightSkin_Diffuse_Strength,omitempty"`
+	SolidLightSkin_Specular_Strength *float32          `protobuf:"fixed32,30,opt,name=solidLightSkin_Specular_Strength,json=solidLightSkinSpecularStrength" json:"solidLightSkin_Specular_Strength,omitempty"`
+	Setting_LightPosition            *Vec3             `protobuf:"bytes,31,opt,name=Setting_LightPosition,json=SettingLightPosition" json:"Setting_LightPosition,omitempty"`
+	Setting_LightDirection           *Vec3             `protobuf:"bytes,32,opt,name=Setting_LightDirection,json=SettingLightDirection" json:"Setting_LightDirection,omitempty"`
+	Setting_LightAmbient             *Vec3             `protobuf:"bytes,33,opt,name=Setting_LightAmbient,json=SettingLightAmbient" json:"Setting_LightAmbient,omitempty"`
+	Setting_LightDiffuse             *Vec3             `protobuf:"bytes,34,opt,name=Setting_LightDiffuse,json=SettingLightDiffuse" json:"Setting_LightDiffuse,omitempty"`
+	Setting_LightSpecular            *Vec3             `protobuf:"bytes,35,opt,name=Setting_LightSpecular,json=SettingLightSpecular" json:"Setting_LightSpecular,omitempty"`
+	Setting_LightStrengthAmbient     *float32          `protobuf:"fixed32,36,opt,name=Setting_LightStrengthAmbient,json=SettingLightStrengthAmbient" json:"Setting_LightStrengthAmbient,omitempty"`
+	Setting_LightStrengthDiffuse     *float32          `protobuf:"fixed32,37,opt,name=Setting_LightStrengthDiffuse,json=SettingLightStrengthDiffuse" json:"Setting_LightStrengthDiffuse,omitempty"`
+	Setting_LightStrengthSpecular    *float32          `protobuf:"fixed32,38,opt,name=Setting_LightStrengthSpecular,json=SettingLightStrengthSpecular" json:"Setting_LightStrengthSpecular,omitempty"`
+	MaterialIlluminationModel        *int32            `protobuf:"varint,39,opt,name=materialIlluminationModel" json:"materialIlluminationModel,omitempty"`
+	DisplacementHeightScale          *ObjectCoordinate `protobuf:"bytes,40,opt,name=displacementHeightScale" json:"displacementHeightScale,omitempty"`
+	ShowMaterialEditor               *bool             `protobuf:"varint,41,opt,name=showMaterialEditor" json:"showMaterialEditor,omitempty"`
+	MaterialAmbient                  *MaterialColor    `protobuf:"bytes,42,opt,name=materialAmbient" json:"materialAmbient,omitempty"`
+	MaterialDiffuse                  *MaterialColor    `protobuf:"bytes,43,opt,name=materialDiffuse" json:"materialDiffuse,omitempty"`
+	MaterialSpecular                 *MaterialColor    `protobuf:"bytes,44,opt,name=materialSpecular" json:"materialSpecular,omitempty"`
+	MaterialEmission                 *MaterialColor    `protobuf:"bytes,45,opt,name=materialEmission" json:"materialEmission,omitempty"`
+	Setting_ParallaxMapping          *bool             `protobuf:"varint,46,opt,name=Setting_ParallaxMapping,json=SettingParallaxMapping" json:"Setting_ParallaxMapping,omitempty"`
+	Effect_GBlur_Mode                *int32            `protobuf:"varint,47,opt,name=Effect_GBlur_Mode,json=EffectGBlurMode" json:"Effect_GBlur_Mode,omitempty"`
+	Effect_GBlur_Radius              *ObjectCoordinate `protobuf:"bytes,48,opt,name=Effect_GBlur_Radius,json=EffectGBlurRadius" json:"Effect_GBlur_Radius,omitempty"`
+	Effect_GBlur_Width               *ObjectCoordinate `protobuf:"bytes,49,opt,name=Effect_GBlur_Width,json=EffectGBlurWidth" json:"Effect_GBlur_Width,omitempty"`
+	Effect_Bloom_DoBloom             *bool             `protobuf:"varint,50,opt,name=Effect_Bloom_DoBloom,json=EffectBloomDoBloom" json:"Effect_Bloom_DoBloom,omitempty"`
+	Effect_Bloom_WeightA             *float32          `protobuf:"fixed32,51,opt,name=Effect_Bloom_WeightA,json=EffectBloomWeightA" json:"Effect_Bloom_WeightA,omitempty"`
+	Effect_Bloom_WeightB             *float32          `protobuf:"fixed32,52,opt,name=Effect_Bloom_WeightB,json=EffectBloomWeightB" json:"Effect_Bloom_WeightB,omitempty"`
+	Effect_Bloom_WeightC             *float32          `protobuf:"fixed32,53,opt,name=Effect_Bloom_WeightC,json=EffectBloomWeightC" json:"Effect_Bloom_WeightC,omitempty"`
+	Effect_Bloom_WeightD             *float32          `protobuf:"fixed32,54,opt,name=Effect_Bloom_WeightD,json=EffectBloomWeightD" json:"Effect_Bloom_WeightD,omitempty"`
+	Effect_Bloom_Vignette            *float32          `protobuf:"fixed32,55,opt,name=Effect_Bloom_Vignette,json=EffectBloomVignette" json:"Effect_Bloom_Vignette,omitempty"`
+	Effect_Bloom_VignetteAtt         *float32          `protobuf:"fixed32,56,opt,name=Effect_Bloom_VignetteAtt,json=EffectBloomVignetteAtt" json:"Effect_Bloom_VignetteAtt,omitempty"`
+	Setting_LightingPass_DrawMode    *int32            `protobuf:"varint,57,opt,name=Setting_LightingPass_DrawMode,json=SettingLightingPassDrawMode" json:"Setting_LightingPass_DrawMode,omitempty"`
+	MeshObject                       *Mesh             `protobuf:"bytes,58,opt,name=meshObject" json:"meshObject,omitempty"`
+	EffectToneMappingACESFilmRec2020 *bool             `protobuf:"varint,59,opt,name=EffectToneMappingACESFilmRec2020" json:"EffectToneMappingACESFilmRec2020,omitempty"`
+	EffectHDRTonemapping             *bool             `protobuf:"varint,60,opt,name=EffectHDRTonemapping" json:"EffectHDRTonemapping,omitempty"`
+	ShowShadows                      *bool             `protobuf:"varint,61,opt,name=ShowShadows" json:"ShowShadows,omitempty"`
+	RenderingPBR                     *bool             `protobuf:"varint,62,opt,name=RenderingPBR" json:"RenderingPBR,omitempty"`
+	RenderingPBRMetallic             *float32          `protobuf:"fixed32,63,opt,name=RenderingPBRMetallic" json:"RenderingPBRMetallic,omitempty"`
+	RenderingPBRRoughness            *float32          `protobuf:"fixed32,64,opt,name=RenderingPBRRoughness" json:"RenderingPBRRoughness,omitempty"`
+	RenderingPBRAO                   *float32          `protobuf:"fixed32,65,opt,name=RenderingPBRAO" json:"RenderingPBRAO,omitempty"`
+	SolidLightSkinMaterialColor      *Vec3             `protobuf:"bytes,66,opt,name=SolidLightSkinMaterialColor" json:"SolidLightSkinMaterialColor,omitempty"`
+	SolidLightSkinAmbient            *Vec3             `protobuf:"bytes,67,opt,name=SolidLightSkinAmbient" json:"SolidLightSkinAmbient,omitempty"`
+	SolidLightSkinDiffuse            *Vec3             `protobuf:"bytes,68,opt,name=SolidLightSkinDiffuse" json:"SolidLightSkinDiffuse,omitempty"`
+	SolidLightSkinSpecular           *Vec3             `protobuf:"bytes,69,opt,name=SolidLightSkinSpecular" json:"SolidLightSkinSpecular,omitempty"`
+	// version 3
+	Source               *ModelSource `protobuf:"bytes,70,opt,name=Source" json:"Source,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *MeshModel) Reset()         { *m = MeshModel{} }
@@ -642,92 +644,205 @@ func (m *MeshModel) GetSolidLightSkinSpecular() *Vec3 {
 	return nil
 }
 
+func (m *MeshModel) GetSource() *ModelSource {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+// ModelSource is the file the model was imported from, linked models are re-imported from it on open and the scene doesn't store their geometry
+type ModelSource struct {
+	SourcePath           *string  `protobuf:"bytes,1,opt,name=SourcePath" json:"SourcePath,omitempty"`
+	ImportFormat         *int32   `protobuf:"varint,2,opt,name=ImportFormat" json:"ImportFormat,omitempty"`
+	ImportSettings       []string `protobuf:"bytes,3,rep,name=ImportSettings" json:"ImportSettings,omitempty"`
+	SourceIndex          *int32   `protobuf:"varint,4,opt,name=SourceIndex" json:"SourceIndex,omitempty"`
+	SourceSize           *int64   `protobuf:"varint,5,opt,name=SourceSize" json:"SourceSize,omitempty"`
+	SourceModTime        *int64   `protobuf:"varint,6,opt,name=SourceModTime" json:"SourceModTime,omitempty"`
+	Linked               *bool    `protobuf:"varint,7,opt,name=Linked" json:"Linked,omitempty"`
+	SourceTitle          *string  `protobuf:"bytes,8,opt,name=SourceTitle" json:"SourceTitle,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModelSource) Reset()         { *m = ModelSource{} }
+func (m *ModelSource) String() string { return proto.CompactTextString(m) }
+func (*ModelSource) ProtoMessage()    {}
+func (*ModelSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a2cd885987a1d0, []int{2}
+}
+
+func (m *ModelSource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModelSource.Unmarshal(m, b)
+}
+func (m *ModelSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModelSource.Marshal(b, m, deterministic)
+}
+func (m *ModelSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModelSource.Merge(m, src)
+}
+func (m *ModelSource) XXX_Size() int {
+	return xxx_messageInfo_ModelSource.Size(m)
+}
+func (m *ModelSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModelSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModelSource proto.InternalMessageInfo
+
+func (m *ModelSource) GetSourcePath() string {
+	if m != nil && m.SourcePath != nil {
+		return *m.SourcePath
+	}
+	return ""
+}
+
+func (m *ModelSource) GetImportFormat() int32 {
+	if m != nil && m.ImportFormat != nil {
+		return *m.ImportFormat
+	}
+	return 0
+}
+
+func (m *ModelSource) GetImportSettings() []string {
+	if m != nil {
+		return m.ImportSettings
+	}
+	return nil
+}
+
+func (m *ModelSource) GetSourceIndex() int32 {
+	if m != nil && m.SourceIndex != nil {
+		return *m.SourceIndex
+	}
+	return 0
+}
+
+func (m *ModelSource) GetSourceSize() int64 {
+	if m != nil && m.SourceSize != nil {
+		return *m.SourceSize
+	}
+	return 0
+}
+
+func (m *ModelSource) GetSourceModTime() int64 {
+	if m != nil && m.SourceModTime != nil {
+		return *m.SourceModTime
+	}
+	return 0
+}
+
+func (m *ModelSource) GetLinked() bool {
+	if m != nil && m.Linked != nil {
+		return *m.Linked
+	}
+	return false
+}
+
+func (m *ModelSource) GetSourceTitle() string {
+	if m != nil && m.SourceTitle != nil {
+		return *m.SourceTitle
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Scene)(nil), "saveopen.Scene")
 	proto.RegisterType((*MeshModel)(nil), "saveopen.MeshModel")
+	proto.RegisterType((*ModelSource)(nil), "saveopen.ModelSource")
 }
 
 func init() { proto.RegisterFile("KuplungAppScene.proto", fileDescriptor_89a2cd885987a1d0) }
 
 var fileDescriptor_89a2cd885987a1d0 = []byte{
-	// 1252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x97, 0x6b, 0x57, 0xdb, 0x46,
-	0x10, 0x86, 0x4f, 0x9c, 0xe6, 0xb6, 0xe4, 0x02, 0x6b, 0x0c, 0x13, 0x20, 0x89, 0x4b, 0xda, 0xd4,
-	0x4d, 0x1a, 0x87, 0x3a, 0xb4, 0x49, 0xdb, 0xf4, 0x22, 0x5b, 0x06, 0x92, 0x96, 0x13, 0x2a, 0x51,
-	0xc0, 0xee, 0x07, 0x1f, 0x61, 0xaf, 0xed, 0x6d, 0x75, 0x3b, 0xd2, 0x3a, 0xe4, 0x27, 0xf7, 0x67,
-	0xf4, 0x68, 0xad, 0x95, 0x56, 0x62, 0x15, 0xeb, 0x13, 0x78, 0xe6, 0x7d, 0x5e, 0xed, 0x65, 0x34,
-	0x1e, 0xa3, 0xda, 0xef, 0x33, 0xdf, 0x9e, 0xb9, 0x13, 0xcd, 0xf7, 0xcd, 0x21, 0x71, 0x49, 0xd3,
-	0x0f, 0x3c, 0xe6, 0xe1, 0x9b, 0xa1, 0xf5, 0x81, 0x78, 0x3e, 0x71, 0x37, 0x20, 0x16, 0xe8, 0x64,
-	0x4c, 0x5d, 0xca, 0xa8, 0xe7, 0x86, 0x73, 0xcd, 0xf6, 0x2e, 0xba, 0xc6, 0x11, 0xfc, 0x0c, 0x5d,
-	0x77, 0xbc, 0x11, 0xb1, 0x43, 0xb8, 0x52, 0xbf, 0xda, 0x58, 0x6a, 0x55, 0x9b, 0x82, 0x6e, 0x1e,
-	0x92, 0x70, 0x7a, 0x18, 0xe5, 0x8c, 0x58, 0xb2, 0xfd, 0x5f, 0x1d, 0xdd, 0x4a, 0xa2, 0x18, 0xd0,
-	0x0d, 0xfe, 0xcf, 0x5b, 0x1d, 0xae, 0xd4, 0x2b, 0x8d, 0x6b, 0x86, 0xf8, 0x88, 0x5f, 0xa1, 0x75,
-	0x93, 0x30, 0x46, 0xdd, 0x49, 0x38, 0xd0, 0xc9, 0x98, 0x04, 0x01, 0x19, 0x19, 0xc4, 0x1d, 0x91,
-	0x00, 0x2a, 0xf5, 0x4a, 0xe3, 0xa6, 0xb1, 0x26, 0xd2, 0xd9, 0x2c, 0x7e, 0x8e, 0x70, 0x9c, 0x19,
-	0x74, 0x88, 0x6d, 0x4e, 0xad, 0x11, 0x75, 0x27, 0x70, 0x95, 0x33, 0x2b, 0x71, 0x26, 0x4d, 0xe0,
-	0x67, 0x48, 0x04, 0x07, 0xa7, 0x34, 0x20, 0xe3, 0xc0, 0x72, 0x08, 0x7c, 0xc6, 0xd5, 0xcb, 0x71,
-	0x22, 0x89, 0x4b, 0x8b, 0x1a, 0xfc, 0x15, 0x92, 0x63, 0x12, 0x86, 0xc4, 0xb6, 0xad, 0xe8, 0x50,
-	0xe0, 0x5a, 0x66, 0x51, 0xb9, 0x2c, 0x7e, 0x81, 0xaa, 0x12, 0xd8, 0x99, 0xd9, 0xf6, 0x9e, 0x35,
-	0x24, 0x70, 0x9d, 0x43, 0x38, 0x85, 0x44, 0x06, 0x3f, 0x46, 0x77, 0x04, 0xa0, 0xd9, 0xfe, 0xd4,
-	0x82, 0x1b, 0xf5, 0x4a, 0xa3, 0x62, 0xdc, 0x8e, 0x83, 0x3c, 0x86, 0xf7, 0xd1, 0x23, 0x21, 0x92,
-	0x9f, 0x66, 0xce, 0xce, 0x47, 0xf4, 0x03, 0x0d, 0xa3, 0x65, 0xdd, 0xe4, 0xa7, 0xfa, 0x30, 0x96,
-	0x15, 0xa8, 0xf0, 0x6b, 0x74, 0xcb, 0xf7, 0x42, 0x7e, 0xbb, 0x67, 0x70, 0xab, 0x5e, 0x69, 0x2c,
-	0xb5, 0x36, 0xd2, 0x4b, 0x7c, 0x7f, 0xfe, 0x0f, 0x19, 0xb2, 0x8e, 0xe7, 0x05, 0x23, 0xea, 0x5a,
-	0x8c, 0x18, 0xa9, 0x58, 0x26, 0x7b, 0x80, 0xca, 0x93, 0x3d, 0x99, 0xec, 0xc3, 0x52, 0x79, 0xb2,
-	0x8f, 0x5b, 0xe8, 0x7a, 0x38, 0xb4, 0x6c, 0x72, 0x06, 0xb7, 0x17, 0x62, 0xb1, 0x32, 0x61, 0x7a,
-	0x70, 0xa7, 0x24, 0xd3, 0x4b, 0x98, 0x3e, 0xdc, 0x2d, 0xc9, 0xf4, 0xf1, 0x2e, 0xba, 0x11, 0x78,
-	0xcc, 0x62, 0xe4, 0x0c, 0xee, 0x2d, 0x84, 0x84, 0x34, 0xa5, 0x7a, 0xb0, 0x5c, 0x96, 0xea, 0xa5,
-	0x54, 0x1f, 0x56, 0xca, 0x52, 0xfd, 0xe8, 0xdc, 0x47, 0x34, 0xf4, 0x6d, 0x6b, 0x48, 0xce, 0x00,
-	0x2f, 0x3e, 0xf7, 0x44, 0x2c, 0x93, 0x3d, 0xa8, 0x96, 0x27, 0x7b, 0x32, 0xd9, 0x87, 0xd5, 0xf2,
-	0x64, 0x1f, 0xf7, 0xd0, 0x86, 0x28, 0xf1, 0x43, 0x8b, 0x91, 0x80, 0x5a, 0xb6, 0x11, 0xbd, 0x8c,
-	0x43, 0xfe, 0xd2, 0xd5, 0x16, 0x5a, 0xdd, 0x8f, 0xe9, 0xcb, 0x30, 0xfe, 0x1b, 0x6d, 0xe6, 0xad,
-	0x4d, 0x9f, 0x0c, 0x67, 0xb6, 0x15, 0x74, 0x3f, 0xfa, 0xb0, 0xb6, 0xd0, 0x7b, 0x23, 0xe7, 0x2d,
-	0xd1, 0xf8, 0x25, 0xaa, 0x25, 0xe6, 0x51, 0x47, 0x3b, 0xa1, 0xe4, 0xc2, 0xfc, 0x97, 0xba, 0xb0,
-	0xce, 0x5f, 0xc8, 0x55, 0x81, 0xca, 0x39, 0xfc, 0x27, 0xda, 0x0a, 0x3d, 0x9b, 0x8e, 0xfe, 0xa0,
-	0x93, 0x29, 0x8b, 0x22, 0xc9, 0xc2, 0x3a, 0x9e, 0xed, 0x05, 0x00, 0x7c, 0x49, 0x77, 0xd3, 0x25,
-	0x9d, 0x90, 0xe1, 0x4b, 0x63, 0x33, 0xcb, 0x64, 0x10, 0xdc, 0x45, 0x6b, 0x39, 0x4b, 0xcd, 0x39,
-	0xa7, 0xc4, 0x65, 0x70, 0x5f, 0x69, 0x56, 0xcb, 0xaa, 0x63, 0xb1, 0xc2, 0x46, 0xa7, 0xe3, 0xf1,
-	0x2c, 0x24, 0xb0, 0x51, 0xc6, 0x26, 0x16, 0xe3, 0x7d, 0xb4, 0x9e, 0xb3, 0x11, 0x67, 0x06, 0x9b,
-	0x4a, 0x9f, 0xdc, 0x53, 0x85, 0x1a, 0xef, 0xa1, 0x47, 0xea, 0x6d, 0x0d, 0x4c, 0x16, 0x10, 0x77,
-	0xc2, 0xa6, 0xb0, 0xc5, 0x1b, 0xe6, 0x03, 0xe5, 0x7e, 0x84, 0x48, 0xe1, 0x13, 0x2f, 0x35, 0xf5,
-	0x79, 0xa0, 0xf2, 0x89, 0x55, 0x89, 0xcf, 0x01, 0xaa, 0x17, 0x6c, 0x2c, 0x35, 0x7a, 0xc8, 0x8d,
-	0x1e, 0xaa, 0x77, 0x94, 0x38, 0x75, 0xd2, 0xc2, 0xe1, 0xa2, 0xa3, 0xb8, 0xed, 0xc1, 0x23, 0xe5,
-	0x01, 0x89, 0x42, 0xca, 0x68, 0xa3, 0xeb, 0xca, 0x98, 0xe8, 0x34, 0x20, 0xf3, 0x37, 0xa6, 0xae,
-	0xbe, 0x2e, 0xd9, 0x25, 0x11, 0x63, 0x0d, 0xad, 0x66, 0x6c, 0x44, 0xe9, 0x7c, 0xae, 0x34, 0xa9,
-	0xca, 0x26, 0xa2, 0x70, 0xf2, 0x16, 0xa2, 0x6c, 0xb6, 0x17, 0x5b, 0x88, 0xa2, 0xc9, 0x9f, 0x48,
-	0x52, 0x32, 0x8f, 0x17, 0x9f, 0x48, 0x52, 0x30, 0x1a, 0xda, 0xca, 0x9a, 0xc4, 0xe7, 0x2d, 0xb6,
-	0xf4, 0x05, 0xbf, 0x9c, 0xcd, 0x0c, 0x9b, 0x95, 0x14, 0x5a, 0x88, 0x2d, 0x7d, 0x59, 0x6c, 0x91,
-	0x6e, 0xe5, 0x81, 0xd2, 0x22, 0xd9, 0xd2, 0x13, 0xee, 0xb1, 0xa5, 0xf2, 0x48, 0xb6, 0xf2, 0x06,
-	0xdd, 0x77, 0xe2, 0x77, 0xfc, 0xad, 0x6d, 0xcf, 0x9c, 0xa8, 0x19, 0x51, 0xcf, 0xe5, 0xad, 0x04,
-	0xbe, 0xe2, 0xed, 0xa5, 0x58, 0x80, 0x8f, 0xd1, 0xba, 0xe8, 0xae, 0x0e, 0x71, 0xd9, 0x01, 0xe1,
-	0xcf, 0x88, 0xbe, 0xbc, 0xa0, 0xb1, 0xb0, 0xe3, 0x15, 0xa1, 0xb8, 0x89, 0x70, 0x38, 0xf5, 0x2e,
-	0x44, 0xef, 0xe9, 0x8e, 0x28, 0xf3, 0x02, 0xf8, 0x7a, 0x3e, 0xde, 0x5c, 0xce, 0x60, 0x0d, 0xdd,
-	0x13, 0x4b, 0x14, 0x37, 0xf0, 0x94, 0x3f, 0x7d, 0x5d, 0x9a, 0x1d, 0xe5, 0x46, 0x66, 0xe4, 0xf5,
-	0xb2, 0x85, 0xb8, 0x81, 0x67, 0x25, 0x2d, 0xd2, 0xeb, 0x58, 0x76, 0x72, 0xbd, 0x1b, 0xbe, 0xf9,
-	0xb4, 0xc7, 0x25, 0x40, 0x36, 0xe9, 0x3a, 0x34, 0xe4, 0x53, 0xd7, 0xf3, 0x92, 0x26, 0x02, 0x90,
-	0x07, 0xcb, 0x23, 0x2b, 0xb0, 0x6c, 0xdb, 0xfa, 0x78, 0x68, 0xf9, 0x7e, 0x34, 0xb9, 0x36, 0x33,
-	0x83, 0x65, 0x2e, 0x8b, 0x9f, 0xa2, 0x95, 0xee, 0x78, 0x4c, 0x86, 0x6c, 0xb0, 0xdf, 0xb6, 0x67,
-	0x01, 0xff, 0xb2, 0x81, 0x17, 0xbc, 0x08, 0xee, 0xcd, 0x13, 0x3c, 0x1e, 0x85, 0xf1, 0x3b, 0x54,
-	0xcd, 0x68, 0x0d, 0x6b, 0x44, 0x67, 0x21, 0xec, 0x2c, 0xbc, 0xf6, 0x15, 0xc9, 0x69, 0x0e, 0xe1,
-	0x03, 0x84, 0x33, 0x5e, 0xa7, 0x74, 0xc4, 0xa6, 0xf0, 0xed, 0x42, 0xab, 0x65, 0xc9, 0x8a, 0x33,
-	0x78, 0x07, 0xad, 0xc6, 0x4e, 0x6d, 0xdb, 0xf3, 0x9c, 0x81, 0xee, 0xf1, 0xbf, 0xd0, 0x9a, 0x17,
-	0xcf, 0x3c, 0xc7, 0x43, 0x71, 0xe6, 0x12, 0x71, 0xca, 0x0b, 0x51, 0x83, 0x97, 0xfc, 0xe5, 0x91,
-	0x89, 0x38, 0x53, 0x40, 0xb4, 0x61, 0xb7, 0x80, 0x68, 0x17, 0x10, 0x1d, 0xf8, 0xae, 0x80, 0xe8,
-	0x14, 0x10, 0x3a, 0x7c, 0x5f, 0x40, 0xe8, 0xb8, 0x85, 0x6a, 0x19, 0xe2, 0x84, 0x4e, 0x5c, 0xc2,
-	0x18, 0x81, 0x57, 0x1c, 0xa9, 0x4a, 0x88, 0x48, 0xe1, 0xd7, 0x08, 0x94, 0x8c, 0xc6, 0x18, 0xbc,
-	0xe6, 0xd8, 0x9a, 0x02, 0xd3, 0x18, 0xc3, 0xed, 0x5c, 0xef, 0xe1, 0xc5, 0x14, 0x86, 0x03, 0x3d,
-	0xb0, 0x2e, 0x78, 0xd5, 0xfc, 0xc0, 0xab, 0x26, 0xd3, 0xbf, 0x62, 0x8d, 0x90, 0xe0, 0x26, 0x42,
-	0x0e, 0x09, 0xa7, 0xf3, 0x5b, 0x85, 0x1f, 0xf3, 0xfd, 0x37, 0xfa, 0x5d, 0x67, 0x48, 0x0a, 0xfc,
-	0x0e, 0xd5, 0xe7, 0xab, 0x39, 0xf6, 0x5c, 0x12, 0x97, 0xac, 0xd6, 0xe9, 0x9a, 0x7b, 0xd4, 0x76,
-	0x0c, 0x32, 0x6c, 0xed, 0xb4, 0x76, 0xe0, 0x27, 0x7e, 0xcf, 0x0b, 0x75, 0xb8, 0x25, 0xce, 0xf7,
-	0x40, 0x37, 0x22, 0x99, 0x13, 0xbf, 0x1f, 0x6f, 0x38, 0xaf, 0xcc, 0xe1, 0x3a, 0x5a, 0x32, 0xa7,
-	0xde, 0x45, 0xf4, 0x5b, 0xcf, 0xbb, 0x08, 0xe1, 0x67, 0x2e, 0x95, 0x43, 0x78, 0x1b, 0xdd, 0x9e,
-	0xff, 0x6e, 0x8c, 0xb6, 0xda, 0x36, 0xe0, 0x17, 0x2e, 0xc9, 0xc4, 0xa2, 0x27, 0xcb, 0x9f, 0x0f,
-	0x09, 0xb3, 0x6c, 0x9b, 0x0e, 0xe1, 0x57, 0x7e, 0xde, 0xca, 0x1c, 0xde, 0x45, 0x35, 0x39, 0x6e,
-	0x78, 0xb3, 0xc9, 0xd4, 0x25, 0x61, 0x08, 0xbf, 0x71, 0x48, 0x9d, 0xc4, 0x4f, 0xd0, 0x5d, 0x39,
-	0xa1, 0xbd, 0x07, 0x8d, 0xcb, 0x73, 0x51, 0x7c, 0x84, 0x36, 0xcd, 0xe2, 0xa1, 0x0f, 0xda, 0xea,
-	0x39, 0xf1, 0x13, 0x08, 0xd6, 0x51, 0xcd, 0x54, 0x4d, 0x4a, 0xd0, 0x29, 0x18, 0x18, 0x94, 0x63,
-	0xe2, 0x25, 0x17, 0xd1, 0x99, 0xf5, 0x32, 0x2e, 0xa2, 0x2d, 0xef, 0xa1, 0x35, 0x53, 0x39, 0x24,
-	0x41, 0x57, 0x3d, 0x24, 0xaa, 0xd5, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x75, 0x3f, 0xff, 0xb2,
-	0xdc, 0x10, 0x00, 0x00,
+	// 1395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x98, 0x5b, 0x57, 0xdb, 0x46,
+	0x10, 0xc7, 0x0f, 0xa1, 0x10, 0x18, 0x72, 0x81, 0x05, 0xc3, 0x04, 0x08, 0x71, 0x48, 0x9a, 0xba,
+	0x49, 0x43, 0xa8, 0x43, 0x9b, 0xb4, 0x4d, 0x2f, 0xbe, 0x11, 0x48, 0xc3, 0x09, 0x95, 0x68, 0x82,
+	0xdd, 0x07, 0x1f, 0xc5, 0x5a, 0xdb, 0xdb, 0xe8, 0xe2, 0x23, 0xad, 0x43, 0x4e, 0xbf, 0x48, 0x1f,
+	0xfa, 0x65, 0x7b, 0xb4, 0xd2, 0x4a, 0x2b, 0x21, 0xc5, 0x7e, 0x02, 0xcf, 0xfc, 0x7f, 0x7f, 0xed,
+	0x65, 0x34, 0x5e, 0x2f, 0x94, 0x7e, 0x1f, 0x8f, 0xac, 0xb1, 0x33, 0xa8, 0x8d, 0x46, 0x7a, 0x8f,
+	0x3a, 0x74, 0x6f, 0xe4, 0xb9, 0xdc, 0x25, 0x0b, 0xbe, 0xf1, 0x91, 0xba, 0x23, 0xea, 0x6c, 0x62,
+	0x24, 0x68, 0xd2, 0x3e, 0x73, 0x18, 0x67, 0xae, 0xe3, 0x87, 0x9a, 0xdd, 0x03, 0x98, 0x13, 0x08,
+	0x79, 0x04, 0xf3, 0xb6, 0x6b, 0x52, 0xcb, 0xc7, 0x99, 0xf2, 0x6c, 0x65, 0xa9, 0xba, 0xba, 0x27,
+	0xe9, 0xbd, 0x13, 0xea, 0x0f, 0x4f, 0x82, 0x9c, 0x16, 0x49, 0x76, 0xff, 0xbd, 0x0b, 0x8b, 0x71,
+	0x94, 0x20, 0x5c, 0x15, 0xff, 0x1c, 0x37, 0x71, 0xa6, 0x3c, 0x53, 0x99, 0xd3, 0xe4, 0x47, 0xf2,
+	0x0c, 0x36, 0x74, 0xca, 0x39, 0x73, 0x06, 0x7e, 0xb7, 0x49, 0xfb, 0xd4, 0xf3, 0xa8, 0xa9, 0x51,
+	0xc7, 0xa4, 0x1e, 0x5e, 0x29, 0xcf, 0x54, 0x16, 0xb4, 0x75, 0x99, 0x4e, 0x67, 0xc9, 0x63, 0x20,
+	0x51, 0xa6, 0xdb, 0xa0, 0x96, 0x3e, 0x34, 0x4c, 0xe6, 0x0c, 0x70, 0x56, 0x30, 0x2b, 0x51, 0x26,
+	0x49, 0x90, 0x47, 0x20, 0x83, 0xdd, 0x77, 0xcc, 0xa3, 0x7d, 0xcf, 0xb0, 0x29, 0x7e, 0x21, 0xd4,
+	0xcb, 0x51, 0x22, 0x8e, 0x2b, 0x83, 0xea, 0xfe, 0xe9, 0xd3, 0x33, 0xea, 0xfb, 0xd4, 0xb2, 0x8c,
+	0x60, 0x51, 0x70, 0x2e, 0x35, 0xa8, 0x4c, 0x96, 0x3c, 0x81, 0x55, 0x05, 0x6c, 0x8c, 0x2d, 0xeb,
+	0xd0, 0xe8, 0x51, 0x9c, 0x17, 0x10, 0x49, 0x20, 0x99, 0x21, 0xf7, 0xe0, 0xba, 0x04, 0x6a, 0xd6,
+	0x68, 0x68, 0xe0, 0xd5, 0xf2, 0x4c, 0xe5, 0x8a, 0x76, 0x2d, 0x0a, 0x8a, 0x18, 0x79, 0x09, 0x77,
+	0xa4, 0x48, 0x7d, 0x9a, 0x3e, 0x7e, 0x6f, 0xb2, 0x8f, 0xcc, 0x0f, 0x86, 0xb5, 0x20, 0x56, 0x75,
+	0x27, 0x92, 0x15, 0xa8, 0xc8, 0x73, 0x58, 0x1c, 0xb9, 0xbe, 0xd8, 0xdd, 0x73, 0x5c, 0x2c, 0xcf,
+	0x54, 0x96, 0xaa, 0x9b, 0xc9, 0x26, 0xbe, 0x79, 0xff, 0x37, 0xed, 0xf1, 0x86, 0xeb, 0x7a, 0x26,
+	0x73, 0x0c, 0x4e, 0xb5, 0x44, 0xac, 0x92, 0x6d, 0x84, 0xe9, 0xc9, 0xb6, 0x4a, 0x76, 0x70, 0x69,
+	0x7a, 0xb2, 0x43, 0xaa, 0x30, 0xef, 0xf7, 0x0c, 0x8b, 0x9e, 0xe3, 0xb5, 0x89, 0x58, 0xa4, 0x8c,
+	0x99, 0x36, 0x5e, 0x9f, 0x92, 0x69, 0xc7, 0x4c, 0x07, 0x6f, 0x4c, 0xc9, 0x74, 0xc8, 0x01, 0x5c,
+	0xf5, 0x5c, 0x6e, 0x70, 0x7a, 0x8e, 0x37, 0x27, 0x42, 0x52, 0x9a, 0x50, 0x6d, 0x5c, 0x9e, 0x96,
+	0x6a, 0x27, 0x54, 0x07, 0x57, 0xa6, 0xa5, 0x3a, 0xc1, 0xba, 0x9b, 0xcc, 0x1f, 0x59, 0x46, 0x8f,
+	0x9e, 0x23, 0x99, 0xbc, 0xee, 0xb1, 0x58, 0x25, 0xdb, 0xb8, 0x3a, 0x3d, 0xd9, 0x56, 0xc9, 0x0e,
+	0xae, 0x4d, 0x4f, 0x76, 0x48, 0x1b, 0x36, 0x65, 0x89, 0x9f, 0x18, 0x9c, 0x7a, 0xcc, 0xb0, 0xb4,
+	0xe0, 0x65, 0xec, 0x89, 0x97, 0xae, 0x34, 0xd1, 0xea, 0x56, 0x44, 0x5f, 0x86, 0xc9, 0x5f, 0xb0,
+	0x95, 0xb5, 0xd6, 0x47, 0xb4, 0x37, 0xb6, 0x0c, 0xaf, 0xf5, 0x69, 0x84, 0xeb, 0x13, 0xbd, 0x37,
+	0x33, 0xde, 0x0a, 0x4d, 0x9e, 0x42, 0x29, 0x36, 0x0f, 0x3a, 0xda, 0x5b, 0x46, 0x2f, 0xf4, 0x0f,
+	0xcc, 0xc1, 0x0d, 0xf1, 0x42, 0xae, 0x49, 0x54, 0xcd, 0x91, 0x3f, 0x60, 0xdb, 0x77, 0x2d, 0x66,
+	0xbe, 0x66, 0x83, 0x21, 0x0f, 0x22, 0xf1, 0xc0, 0x1a, 0xae, 0xe5, 0x7a, 0x88, 0x62, 0x48, 0x37,
+	0x92, 0x21, 0xbd, 0xa5, 0xbd, 0xa7, 0xda, 0x56, 0x9a, 0x49, 0x21, 0xa4, 0x05, 0xeb, 0x19, 0xcb,
+	0x9a, 0xfd, 0x9e, 0x51, 0x87, 0xe3, 0xad, 0x5c, 0xb3, 0x52, 0x5a, 0x1d, 0x89, 0x73, 0x6c, 0x9a,
+	0xac, 0xdf, 0x1f, 0xfb, 0x14, 0x37, 0xa7, 0xb1, 0x89, 0xc4, 0xe4, 0x25, 0x6c, 0x64, 0x6c, 0xe4,
+	0x9a, 0xe1, 0x56, 0xae, 0x4f, 0xe6, 0xa9, 0x52, 0x4d, 0x0e, 0xe1, 0x4e, 0xfe, 0xb4, 0xba, 0x3a,
+	0xf7, 0xa8, 0x33, 0xe0, 0x43, 0xdc, 0x16, 0x0d, 0xf3, 0x76, 0xee, 0x7c, 0xa4, 0x28, 0xc7, 0x27,
+	0x1a, 0x6a, 0xe2, 0x73, 0x3b, 0xcf, 0x27, 0x52, 0xc5, 0x3e, 0x47, 0x50, 0x2e, 0x98, 0x58, 0x62,
+	0xb4, 0x23, 0x8c, 0x76, 0xf2, 0x67, 0x14, 0x3b, 0x35, 0x92, 0xc2, 0x11, 0xa2, 0xd3, 0xa8, 0xed,
+	0xe1, 0x9d, 0xdc, 0x05, 0x92, 0x85, 0x94, 0xd2, 0x06, 0xdb, 0x95, 0x32, 0x69, 0x32, 0x8f, 0x86,
+	0x6f, 0x4c, 0x39, 0x7f, 0xbb, 0x54, 0x97, 0x58, 0x4c, 0x6a, 0xb0, 0x96, 0xb2, 0x91, 0xa5, 0x73,
+	0x37, 0xd7, 0x64, 0x55, 0x35, 0x91, 0x85, 0x93, 0xb5, 0x90, 0x65, 0xb3, 0x3b, 0xd9, 0x42, 0x16,
+	0x4d, 0x76, 0x45, 0xe2, 0x92, 0xb9, 0x37, 0x79, 0x45, 0xe2, 0x82, 0xa9, 0xc1, 0x76, 0xda, 0x24,
+	0x5a, 0x6f, 0x39, 0xa5, 0xfb, 0x62, 0x73, 0xb6, 0x52, 0x6c, 0x5a, 0x52, 0x68, 0x21, 0xa7, 0xf4,
+	0x65, 0xb1, 0x45, 0x32, 0x95, 0xdb, 0xb9, 0x16, 0xf1, 0x94, 0x1e, 0x08, 0x8f, 0xed, 0x3c, 0x8f,
+	0x78, 0x2a, 0x2f, 0xe0, 0x96, 0x1d, 0xbd, 0xe3, 0xc7, 0x96, 0x35, 0xb6, 0x83, 0x66, 0xc4, 0x5c,
+	0x47, 0xb4, 0x12, 0xfc, 0x4a, 0xb4, 0x97, 0x62, 0x01, 0x39, 0x83, 0x0d, 0xd9, 0x5d, 0x6d, 0xea,
+	0xf0, 0x23, 0x2a, 0x9e, 0x11, 0x7c, 0x79, 0x61, 0x65, 0x62, 0xc7, 0x2b, 0x42, 0xc9, 0x1e, 0x10,
+	0x7f, 0xe8, 0x5e, 0xc8, 0xde, 0xd3, 0x32, 0x19, 0x77, 0x3d, 0xfc, 0x3a, 0x3c, 0xde, 0x5c, 0xce,
+	0x90, 0x1a, 0xdc, 0x94, 0x43, 0x94, 0x3b, 0xf0, 0x50, 0x3c, 0x7d, 0x43, 0x39, 0x3b, 0xaa, 0x8d,
+	0x4c, 0xcb, 0xea, 0x55, 0x0b, 0xb9, 0x03, 0x8f, 0xa6, 0xb4, 0x48, 0xb6, 0x63, 0xd9, 0xce, 0xf4,
+	0x6e, 0xfc, 0xe6, 0xf3, 0x1e, 0x97, 0x00, 0xd5, 0xa4, 0x65, 0x33, 0x5f, 0x9c, 0xba, 0x1e, 0x4f,
+	0x69, 0x22, 0x01, 0xf5, 0x60, 0x79, 0x6a, 0x78, 0x86, 0x65, 0x19, 0x9f, 0x4e, 0x8c, 0xd1, 0x28,
+	0x38, 0xb9, 0xee, 0xa5, 0x0e, 0x96, 0x99, 0x2c, 0x79, 0x08, 0x2b, 0xad, 0x7e, 0x9f, 0xf6, 0x78,
+	0xf7, 0x65, 0xdd, 0x1a, 0x7b, 0xe2, 0xcb, 0x06, 0x9f, 0x88, 0x22, 0xb8, 0x19, 0x26, 0x44, 0x3c,
+	0x08, 0x93, 0x57, 0xb0, 0x9a, 0xd2, 0x6a, 0x86, 0xc9, 0xc6, 0x3e, 0xee, 0x4f, 0xdc, 0xf6, 0x15,
+	0xc5, 0x29, 0x84, 0xc8, 0x11, 0x90, 0x94, 0xd7, 0x3b, 0x66, 0xf2, 0x21, 0x7e, 0x3b, 0xd1, 0x6a,
+	0x59, 0xb1, 0x12, 0x0c, 0xd9, 0x87, 0xb5, 0xc8, 0xa9, 0x6e, 0xb9, 0xae, 0xdd, 0x6d, 0xba, 0xe2,
+	0x2f, 0x56, 0xc3, 0xe2, 0x09, 0x73, 0x22, 0x14, 0x65, 0x2e, 0x11, 0xef, 0x44, 0x21, 0xd6, 0xf0,
+	0xa9, 0x78, 0x79, 0x54, 0x22, 0xca, 0x14, 0x10, 0x75, 0x3c, 0x28, 0x20, 0xea, 0x05, 0x44, 0x03,
+	0xbf, 0x2b, 0x20, 0x1a, 0x05, 0x44, 0x13, 0xbf, 0x2f, 0x20, 0x9a, 0xa4, 0x0a, 0xa5, 0x14, 0xf1,
+	0x96, 0x0d, 0x1c, 0xca, 0x39, 0xc5, 0x67, 0x02, 0x59, 0x55, 0x10, 0x99, 0x22, 0xcf, 0x01, 0x73,
+	0x99, 0x1a, 0xe7, 0xf8, 0x5c, 0x60, 0xeb, 0x39, 0x58, 0x8d, 0x73, 0x52, 0xcf, 0xf4, 0x1e, 0x51,
+	0x4c, 0xbe, 0xdf, 0x6d, 0x7a, 0xc6, 0x85, 0xa8, 0x9a, 0x1f, 0x44, 0xd5, 0xa4, 0xfa, 0x57, 0xa4,
+	0x91, 0x12, 0xb2, 0x07, 0x60, 0x53, 0x7f, 0x18, 0xee, 0x2a, 0xfe, 0x98, 0xed, 0xbf, 0xc1, 0xef,
+	0x3a, 0x4d, 0x51, 0x90, 0x57, 0x50, 0x0e, 0x47, 0x73, 0xe6, 0x3a, 0x34, 0x2a, 0xd9, 0x5a, 0xa3,
+	0xa5, 0x1f, 0x32, 0xcb, 0xd6, 0x68, 0xaf, 0xba, 0x5f, 0xdd, 0xc7, 0x9f, 0xc4, 0x3e, 0x4f, 0xd4,
+	0x91, 0xaa, 0x5c, 0xdf, 0xa3, 0xa6, 0x16, 0xc8, 0xec, 0xe8, 0xfd, 0x78, 0x21, 0xf8, 0xdc, 0x1c,
+	0x29, 0xc3, 0x92, 0x3e, 0x74, 0x2f, 0x82, 0xdf, 0x7a, 0xee, 0x85, 0x8f, 0x3f, 0x0b, 0xa9, 0x1a,
+	0x22, 0xbb, 0x70, 0x2d, 0xfc, 0xdd, 0x18, 0x4c, 0xb5, 0xae, 0xe1, 0x2f, 0x42, 0x92, 0x8a, 0x05,
+	0x4f, 0x56, 0x3f, 0x9f, 0x50, 0x6e, 0x58, 0x16, 0xeb, 0xe1, 0xaf, 0x62, 0xbd, 0x73, 0x73, 0xe4,
+	0x00, 0x4a, 0x6a, 0x5c, 0x73, 0xc7, 0x83, 0xa1, 0x43, 0x7d, 0x1f, 0x7f, 0x13, 0x50, 0x7e, 0x92,
+	0x3c, 0x80, 0x1b, 0x6a, 0xa2, 0xf6, 0x06, 0x6b, 0x42, 0x9e, 0x89, 0x92, 0x53, 0xd8, 0xd2, 0x8b,
+	0x0f, 0x7d, 0x58, 0xcf, 0x3f, 0x27, 0x7e, 0x06, 0x21, 0x4d, 0x28, 0xe9, 0x79, 0x27, 0x25, 0x6c,
+	0x14, 0x1c, 0x18, 0xf2, 0xc4, 0x97, 0x5d, 0x64, 0x67, 0x6e, 0x4e, 0xe3, 0x22, 0xdb, 0xf2, 0x21,
+	0xac, 0xeb, 0xb9, 0x87, 0x24, 0x6c, 0xe5, 0x1f, 0x12, 0xf3, 0xd5, 0xe4, 0x31, 0xcc, 0xeb, 0xee,
+	0xd8, 0xeb, 0x51, 0x3c, 0x14, 0x5c, 0x49, 0xa9, 0xd4, 0xe0, 0xbb, 0x30, 0x4c, 0x6a, 0x91, 0x68,
+	0xf7, 0xbf, 0x2b, 0xb0, 0xa4, 0xc4, 0xc9, 0x0e, 0x40, 0xf8, 0xdf, 0xa9, 0xc1, 0x87, 0xe2, 0x7a,
+	0x62, 0x51, 0x53, 0x22, 0x41, 0xe9, 0x1c, 0xdb, 0x23, 0xd7, 0xe3, 0x87, 0xae, 0x67, 0x1b, 0x5c,
+	0x5c, 0x4b, 0xcc, 0x69, 0xa9, 0x58, 0xb0, 0xa1, 0xe1, 0x67, 0x79, 0x59, 0x81, 0xb3, 0xe5, 0xd9,
+	0xca, 0xa2, 0x96, 0x89, 0x8a, 0x42, 0x15, 0xce, 0xc7, 0x8e, 0x49, 0x3f, 0x89, 0xfb, 0x87, 0x39,
+	0x4d, 0x0d, 0x25, 0xa3, 0xd1, 0xd9, 0x3f, 0x54, 0xdc, 0x36, 0xcc, 0x6a, 0x4a, 0x84, 0xdc, 0x87,
+	0xeb, 0xe1, 0xa7, 0x13, 0xd7, 0x3c, 0x63, 0x76, 0x78, 0xb7, 0x30, 0xab, 0xa5, 0x83, 0x64, 0x1d,
+	0xe6, 0x5f, 0x33, 0xe7, 0x03, 0x35, 0xc5, 0x7d, 0xc2, 0x82, 0x16, 0x7d, 0x4a, 0x9e, 0x7f, 0xc6,
+	0xb8, 0x45, 0xc5, 0xad, 0xc1, 0xa2, 0xa6, 0x86, 0xfe, 0x1f, 0x00, 0x10, 0xf2, 0x6a, 0x39, 0x29,
+	0x12, 0x00, 0x00,
 }
@@ -21,82 +21,109 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type GUISettings struct {
-	ShowCube                           *bool           `protobuf:"varint,1,req,name=ShowCube" json:"ShowCube,omitempty"`
-	Fov                                *float32        `protobuf:"fixed32,2,req,name=Fov" json:"Fov,omitempty"`
-	RatioWidth                         *float32        `protobuf:"fixed32,3,req,name=RatioWidth" json:"RatioWidth,omitempty"`
-	RatioHeight                        *float32        `protobuf:"fixed32,4,req,name=RatioHeight" json:"RatioHeight,omitempty"`
-	PlaneClose                         *float32        `protobuf:"fixed32,5,req,name=PlaneClose" json:"PlaneClose,omitempty"`
-	PlaneFar                           *float32        `protobuf:"fixed32,6,req,name=PlaneFar" json:"PlaneFar,omitempty"`
-	GammaCoeficient                    *float32        `protobuf:"fixed32,7,req,name=GammaCoeficient" json:"GammaCoeficient,omitempty"`
-	ShowPickRays                       *bool           `protobuf:"varint,8,req,name=ShowPickRays" json:"ShowPickRays,omitempty"`
-	ShowPickRaysSingle                 *bool           `protobuf:"varint,9,req,name=ShowPickRaysSingle" json:"ShowPickRaysSingle,omitempty"`
-	RayAnimate                         *bool           `protobuf:"varint,10,req,name=RayAnimate" json:"RayAnimate,omitempty"`
-	RayOriginX                         *float32        `protobuf:"fixed32,11,req,name=RayOriginX" json:"RayOriginX,omitempty"`
-	RayOriginY                         *float32        `protobuf:"fixed32,12,req,name=RayOriginY" json:"RayOriginY,omitempty"`
-	RayOriginZ                         *float32        `protobuf:"fixed32,13,req,name=RayOriginZ" json:"RayOriginZ,omitempty"`
-	RayOriginXS                        *string         `protobuf:"bytes,14,req,name=RayOriginXS" json:"RayOriginXS,omitempty"`
-	RayOriginYS                        *string         `protobuf:"bytes,15,req,name=RayOriginYS" json:"RayOriginYS,omitempty"`
-	RayOriginZS                        *string         `protobuf:"bytes,16,req,name=RayOriginZS" json:"RayOriginZS,omitempty"`
-	RayDraw                            *bool           `protobuf:"varint,17,req,name=RayDraw" json:"RayDraw,omitempty"`
-	RayDirectionX                      *float32        `protobuf:"fixed32,18,req,name=RayDirectionX" json:"RayDirectionX,omitempty"`
-	RayDirectionY                      *float32        `protobuf:"fixed32,19,req,name=RayDirectionY" json:"RayDirectionY,omitempty"`
-	RayDirectionZ                      *float32        `protobuf:"fixed32,20,req,name=RayDirectionZ" json:"RayDirectionZ,omitempty"`
-	RayDirectionXS                     *string         `protobuf:"bytes,21,req,name=RayDirectionXS" json:"RayDirectionXS,omitempty"`
-	RayDirectionYS                     *string         `protobuf:"bytes,22,req,name=RayDirectionYS" json:"RayDirectionYS,omitempty"`
-	RayDirectionZS                     *string         `protobuf:"bytes,23,req,name=RayDirectionZS" json:"RayDirectionZS,omitempty"`
-	OcclusionCulling                   *bool           `protobuf:"varint,24,req,name=OcclusionCulling" json:"OcclusionCulling,omitempty"`
-	RenderingDepth                     *bool           `protobuf:"varint,25,req,name=RenderingDepth" json:"RenderingDepth,omitempty"`
-	SelectedViewModelSkin              *uint32         `protobuf:"varint,26,req,name=SelectedViewModelSkin" json:"SelectedViewModelSkin,omitempty"`
-	ShowBoundingBox                    *bool           `protobuf:"varint,27,req,name=ShowBoundingBox" json:"ShowBoundingBox,omitempty"`
-	BoundingBoxRefresh                 *bool           `protobuf:"varint,28,req,name=BoundingBoxRefresh" json:"BoundingBoxRefresh,omitempty"`
-	BoundingBoxPadding                 *float32        `protobuf:"fixed32,29,req,name=BoundingBoxPadding" json:"BoundingBoxPadding,omitempty"`
-	OutlineColor                       *Vec4           `protobuf:"bytes,30,req,name=OutlineColor" json:"OutlineColor,omitempty"`
-	OutlineColorPickerOpen             *bool           `protobuf:"varint,31,req,name=OutlineColorPickerOpen" json:"OutlineColorPickerOpen,omitempty"`
-	OutlineThickness                   *float32        `protobuf:"fixed32,32,req,name=OutlineThickness" json:"OutlineThickness,omitempty"`
-	VertexSphereVisible                *bool           `protobuf:"varint,33,req,name=VertexSphereVisible" json:"VertexSphereVisible,omitempty"`
-	VertexSphereColorPickerOpen        *bool           `protobuf:"varint,34,req,name=VertexSphereColorPickerOpen" json:"VertexSphereColorPickerOpen,omitempty"`
-	VertexSphereIsSphere               *bool           `protobuf:"varint,35,req,name=VertexSphereIsSphere" json:"VertexSphereIsSphere,omitempty"`
-	VertexSphereShowWireframes         *bool           `protobuf:"varint,36,req,name=VertexSphereShowWireframes" json:"VertexSphereShowWireframes,omitempty"`
-	VertexSphereRadius                 *float32        `protobuf:"fixed32,37,req,name=VertexSphereRadius" json:"VertexSphereRadius,omitempty"`
-	VertexSphereSegments               *int32          `protobuf:"varint,38,req,name=VertexSphereSegments" json:"VertexSphereSegments,omitempty"`
-	VertexSphereColor                  *Vec4           `protobuf:"bytes,39,req,name=VertexSphereColor" json:"VertexSphereColor,omitempty"`
-	ShowAllVisualArtefacts             *bool           `protobuf:"varint,40,req,name=ShowAllVisualArtefacts" json:"ShowAllVisualArtefacts,omitempty"`
-	ShowZAxis                          *bool           `protobuf:"varint,41,req,name=ShowZAxis" json:"ShowZAxis,omitempty"`
-	WorldGridSizeSquares               *int32          `protobuf:"varint,42,req,name=WorldGridSizeSquares" json:"WorldGridSizeSquares,omitempty"`
-	WorldGridFixedWithWorld            *bool           `protobuf:"varint,43,req,name=WorldGridFixedWithWorld" json:"WorldGridFixedWithWorld,omitempty"`
-	ShowGrid                           *bool           `protobuf:"varint,44,req,name=ShowGrid" json:"ShowGrid,omitempty"`
-	ActAsMirror                        *bool           `protobuf:"varint,45,req,name=ActAsMirror" json:"ActAsMirror,omitempty"`
-	SkyboxSelectedItem                 *int32          `protobuf:"varint,46,req,name=SkyboxSelectedItem" json:"SkyboxSelectedItem,omitempty"`
-	Camera                             *CameraSettings `protobuf:"bytes,47,req,name=camera" json:"camera,omitempty"`
+	ShowCube                           *bool           `protobuf:"varint,1,opt,name=ShowCube" json:"ShowCube,omitempty"`
+	Fov                                *float32        `protobuf:"fixed32,2,opt,name=Fov" json:"Fov,omitempty"`
+	RatioWidth                         *float32        `protobuf:"fixed32,3,opt,name=RatioWidth" json:"RatioWidth,omitempty"`
+	RatioHeight                        *float32        `protobuf:"fixed32,4,opt,name=RatioHeight" json:"RatioHeight,omitempty"`
+	PlaneClose                         *float32        `protobuf:"fixed32,5,opt,name=PlaneClose" json:"PlaneClose,omitempty"`
+	PlaneFar                           *float32        `protobuf:"fixed32,6,opt,name=PlaneFar" json:"PlaneFar,omitempty"`
+	GammaCoeficient                    *float32        `protobuf:"fixed32,7,opt,name=GammaCoeficient" json:"GammaCoeficient,omitempty"`
+	ShowPickRays                       *bool           `protobuf:"varint,8,opt,name=ShowPickRays" json:"ShowPickRays,omitempty"`
+	ShowPickRaysSingle                 *bool           `protobuf:"varint,9,opt,name=ShowPickRaysSingle" json:"ShowPickRaysSingle,omitempty"`
+	RayAnimate                         *bool           `protobuf:"varint,10,opt,name=RayAnimate" json:"RayAnimate,omitempty"`
+	RayOriginX                         *float32        `protobuf:"fixed32,11,opt,name=RayOriginX" json:"RayOriginX,omitempty"`
+	RayOriginY                         *float32        `protobuf:"fixed32,12,opt,name=RayOriginY" json:"RayOriginY,omitempty"`
+	RayOriginZ                         *float32        `protobuf:"fixed32,13,opt,name=RayOriginZ" json:"RayOriginZ,omitempty"`
+	RayOriginXS                        *string         `protobuf:"bytes,14,opt,name=RayOriginXS" json:"RayOriginXS,omitempty"`
+	RayOriginYS                        *string         `protobuf:"bytes,15,opt,name=RayOriginYS" json:"RayOriginYS,omitempty"`
+	RayOriginZS                        *string         `protobuf:"bytes,16,opt,name=RayOriginZS" json:"RayOriginZS,omitempty"`
+	RayDraw                            *bool           `protobuf:"varint,17,opt,name=RayDraw" json:"RayDraw,omitempty"`
+	RayDirectionX                      *float32        `protobuf:"fixed32,18,opt,name=RayDirectionX" json:"RayDirectionX,omitempty"`
+	RayDirectionY                      *float32        `protobuf:"fixed32,19,opt,name=RayDirectionY" json:"RayDirectionY,omitempty"`
+	RayDirectionZ                      *float32        `protobuf:"fixed32,20,opt,name=RayDirectionZ" json:"RayDirectionZ,omitempty"`
+	RayDirectionXS                     *string         `protobuf:"bytes,21,opt,name=RayDirectionXS" json:"RayDirectionXS,omitempty"`
+	RayDirectionYS                     *string         `protobuf:"bytes,22,opt,name=RayDirectionYS" json:"RayDirectionYS,omitempty"`
+	RayDirectionZS                     *string         `protobuf:"bytes,23,opt,name=RayDirectionZS" json:"RayDirectionZS,omitempty"`
+	OcclusionCulling                   *bool           `protobuf:"varint,24,opt,name=OcclusionCulling" json:"OcclusionCulling,omitempty"`
+	RenderingDepth                     *bool           `protobuf:"varint,25,opt,name=RenderingDepth" json:"RenderingDepth,omitempty"`
+	SelectedViewModelSkin              *uint32         `protobuf:"varint,26,opt,name=SelectedViewModelSkin" json:"SelectedViewModelSkin,omitempty"`
+	ShowBoundingBox                    *bool           `protobuf:"varint,27,opt,name=ShowBoundingBox" json:"ShowBoundingBox,omitempty"`
+	BoundingBoxRefresh                 *bool           `protobuf:"varint,28,opt,name=BoundingBoxRefresh" json:"BoundingBoxRefresh,omitempty"`
+	BoundingBoxPadding                 *float32        `protobuf:"fixed32,29,opt,name=BoundingBoxPadding" json:"BoundingBoxPadding,omitempty"`
+	OutlineColor                       *Vec4           `protobuf:"bytes,30,opt,name=OutlineColor" json:"OutlineColor,omitempty"`
+	OutlineColorPickerOpen             *bool           `protobuf:"varint,31,opt,name=OutlineColorPickerOpen" json:"OutlineColorPickerOpen,omitempty"`
+	OutlineThickness                   *float32        `protobuf:"fixed32,32,opt,name=OutlineThickness" json:"OutlineThickness,omitempty"`
+	VertexSphereVisible                *bool           `protobuf:"varint,33,opt,name=VertexSphereVisible" json:"VertexSphereVisible,omitempty"`
+	VertexSphereColorPickerOpen        *bool           `protobuf:"varint,34,opt,name=VertexSphereColorPickerOpen" json:"VertexSphereColorPickerOpen,omitempty"`
+	VertexSphereIsSphere               *bool           `protobuf:"varint,35,opt,name=VertexSphereIsSphere" json:"VertexSphereIsSphere,omitempty"`
+	VertexSphereShowWireframes         *bool           `protobuf:"varint,36,opt,name=VertexSphereShowWireframes" json:"VertexSphereShowWireframes,omitempty"`
+	VertexSphereRadius                 *float32        `protobuf:"fixed32,37,opt,name=VertexSphereRadius" json:"VertexSphereRadius,omitempty"`
+	VertexSphereSegments               *int32          `protobuf:"varint,38,opt,name=VertexSphereSegments" json:"VertexSphereSegments,omitempty"`
+	VertexSphereColor                  *Vec4           `protobuf:"bytes,39,opt,name=VertexSphereColor" json:"VertexSphereColor,omitempty"`
+	ShowAllVisualArtefacts             *bool           `protobuf:"varint,40,opt,name=ShowAllVisualArtefacts" json:"ShowAllVisualArtefacts,omitempty"`
+	ShowZAxis                          *bool           `protobuf:"varint,41,opt,name=ShowZAxis" json:"ShowZAxis,omitempty"`
+	WorldGridSizeSquares               *int32          `protobuf:"varint,42,opt,name=WorldGridSizeSquares" json:"WorldGridSizeSquares,omitempty"`
+	WorldGridFixedWithWorld            *bool           `protobuf:"varint,43,opt,name=WorldGridFixedWithWorld" json:"WorldGridFixedWithWorld,omitempty"`
+	ShowGrid                           *bool           `protobuf:"varint,44,opt,name=ShowGrid" json:"ShowGrid,omitempty"`
+	ActAsMirror                        *bool           `protobuf:"varint,45,opt,name=ActAsMirror" json:"ActAsMirror,omitempty"`
+	SkyboxSelectedItem                 *int32          `protobuf:"varint,46,opt,name=SkyboxSelectedItem" json:"SkyboxSelectedItem,omitempty"`
+	Camera                             *CameraSettings `protobuf:"bytes,47,opt,name=camera" json:"camera,omitempty"`
 	Grid                               *GridSettings   `protobuf:"bytes,48,opt,name=grid" json:"grid,omitempty"`
 	Lights                             []*LightObject  `protobuf:"bytes,49,rep,name=lights" json:"lights,omitempty"`
-	UIAmbientLightX                    *float32        `protobuf:"fixed32,50,req,name=UIAmbientLightX" json:"UIAmbientLightX,omitempty"`
-	UIAmbientLightY                    *float32        `protobuf:"fixed32,51,req,name=UIAmbientLightY" json:"UIAmbientLightY,omitempty"`
-	UIAmbientLightZ                    *float32        `protobuf:"fixed32,52,req,name=UIAmbientLightZ" json:"UIAmbientLightZ,omitempty"`
-	SolidLightDirectionX               *float32        `protobuf:"fixed32,53,req,name=SolidLightDirectionX" json:"SolidLightDirectionX,omitempty"`
-	SolidLightDirectionY               *float32        `protobuf:"fixed32,54,req,name=SolidLightDirectionY" json:"SolidLightDirectionY,omitempty"`
-	SolidLightDirectionZ               *float32        `protobuf:"fixed32,55,req,name=SolidLightDirectionZ" json:"SolidLightDirectionZ,omitempty"`
-	SolidLightMaterialColor            *Vec3           `protobuf:"bytes,56,req,name=SolidLightMaterialColor" json:"SolidLightMaterialColor,omitempty"`
-	SolidLightAmbient                  *Vec3           `protobuf:"bytes,57,req,name=SolidLightAmbient" json:"SolidLightAmbient,omitempty"`
-	SolidLightDiffuse                  *Vec3           `protobuf:"bytes,58,req,name=SolidLightDiffuse" json:"SolidLightDiffuse,omitempty"`
-	SolidLightSpecular                 *Vec3           `protobuf:"bytes,59,req,name=SolidLightSpecular" json:"SolidLightSpecular,omitempty"`
-	SolidLightAmbientStrength          *float32        `protobuf:"fixed32,60,req,name=SolidLightAmbientStrength" json:"SolidLightAmbientStrength,omitempty"`
-	SolidLightDiffuseStrength          *float32        `protobuf:"fixed32,61,req,name=SolidLightDiffuseStrength" json:"SolidLightDiffuseStrength,omitempty"`
-	SolidLightSpecularStrength         *float32        `protobuf:"fixed32,62,req,name=SolidLightSpecularStrength" json:"SolidLightSpecularStrength,omitempty"`
-	SolidLightMaterialColorColorPicker *bool           `protobuf:"varint,63,req,name=SolidLightMaterialColorColorPicker" json:"SolidLightMaterialColorColorPicker,omitempty"`
-	SolidLightAmbientColorPicker       *bool           `protobuf:"varint,64,req,name=SolidLightAmbientColorPicker" json:"SolidLightAmbientColorPicker,omitempty"`
-	SolidLightDiffuseColorPicker       *bool           `protobuf:"varint,65,req,name=SolidLightDiffuseColorPicker" json:"SolidLightDiffuseColorPicker,omitempty"`
-	SolidLightSpecularColorPicker      *bool           `protobuf:"varint,66,req,name=SolidLightSpecularColorPicker" json:"SolidLightSpecularColorPicker,omitempty"`
-	DeferredTestMode                   *bool           `protobuf:"varint,67,req,name=DeferredTestMode" json:"DeferredTestMode,omitempty"`
-	DeferredTestLights                 *bool           `protobuf:"varint,68,req,name=DeferredTestLights" json:"DeferredTestLights,omitempty"`
-	DeferredRandomizeLightPositions    *bool           `protobuf:"varint,69,req,name=DeferredRandomizeLightPositions" json:"DeferredRandomizeLightPositions,omitempty"`
-	LightingPassDrawMode               *int32          `protobuf:"varint,70,req,name=LightingPassDrawMode" json:"LightingPassDrawMode,omitempty"`
-	DeferredTestLightsNumber           *int32          `protobuf:"varint,71,req,name=DeferredTestLightsNumber" json:"DeferredTestLightsNumber,omitempty"`
-	DeferredAmbientStrength            *float32        `protobuf:"fixed32,72,req,name=DeferredAmbientStrength" json:"DeferredAmbientStrength,omitempty"`
-	DebugShadowTexture                 *bool           `protobuf:"varint,73,req,name=DebugShadowTexture" json:"DebugShadowTexture,omitempty"`
-	XXX_NoUnkeyedLiteral               struct{}        `json:"-"`
-	XXX_unrecognized                   []byte          `json:"-"`
-	XXX_sizecache                      int32           `json:"-"`
+	UIAmbientLightX                    *float32        `protobuf:"fixed32,50,opt,name=UIAmbientLightX" json:"UIAmbientLightX,omitempty"`
+	UIAmbientLightY                    *float32        `protobuf:"fixed32,51,opt,name=UIAmbientLightY" json:"UIAmbientLightY,omitempty"`
+	UIAmbientLightZ                    *float32        `protobuf:"fixed32,52,opt,name=UIAmbientLightZ" json:"UIAmbientLightZ,omitempty"`
+	SolidLightDirectionX               *float32        `protobuf:"fixed32,53,opt,name=SolidLightDirectionX" json:"SolidLightDirectionX,omitempty"`
+	SolidLightDirectionY               *float32        `protobuf:"fixed32,54,opt,name=SolidLightDirectionY" json:"SolidLightDirectionY,omitempty"`
+	SolidLightDirectionZ               *float32        `protobuf:"fixed32,55,opt,name=SolidLightDirectionZ" json:"SolidLightDirectionZ,omitempty"`
+	SolidLightMaterialColor            *Vec3           `protobuf:"bytes,56,opt,name=SolidLightMaterialColor" json:"SolidLightMaterialColor,omitempty"`
+	SolidLightAmbient                  *Vec3           `protobuf:"bytes,57,opt,name=SolidLightAmbient" json:"SolidLightAmbient,omitempty"`
+	SolidLightDiffuse                  *Vec3           `protobuf:"bytes,58,opt,name=SolidLightDiffuse" json:"SolidLightDiffuse,omitempty"`
+	SolidLightSpecular                 *Vec3           `protobuf:"bytes,59,opt,name=SolidLightSpecular" json:"SolidLightSpecular,omitempty"`
+	SolidLightAmbientStrength          *float32        `protobuf:"fixed32,60,opt,name=SolidLightAmbientStrength" json:"SolidLightAmbientStrength,omitempty"`
+	SolidLightDiffuseStrength          *float32        `protobuf:"fixed32,61,opt,name=SolidLightDiffuseStrength" json:"SolidLightDiffuseStrength,omitempty"`
+	SolidLightSpecularStrength         *float32        `protobuf:"fixed32,62,opt,name=SolidLightSpecularStrength" json:"SolidLightSpecularStrength,omitempty"`
+	SolidLightMaterialColorColorPicker *bool           `protobuf:"varint,63,opt,name=SolidLightMaterialColorColorPicker" json:"SolidLightMaterialColorColorPicker,omitempty"`
+	SolidLightAmbientColorPicker       *bool           `protobuf:"varint,64,opt,name=SolidLightAmbientColorPicker" json:"SolidLightAmbientColorPicker,omitempty"`
+	SolidLightDiffuseColorPicker       *bool           `protobuf:"varint,65,opt,name=SolidLightDiffuseColorPicker" json:"SolidLightDiffuseColorPicker,omitempty"`
+	SolidLightSpecularColorPicker      *bool           `protobuf:"varint,66,opt,name=SolidLightSpecularColorPicker" json:"SolidLightSpecularColorPicker,omitempty"`
+	DeferredTestMode                   *bool           `protobuf:"varint,67,opt,name=DeferredTestMode" json:"DeferredTestMode,omitempty"`
+	DeferredTestLights                 *bool           `protobuf:"varint,68,opt,name=DeferredTestLights" json:"DeferredTestLights,omitempty"`
+	DeferredRandomizeLightPositions    *bool           `protobuf:"varint,69,opt,name=DeferredRandomizeLightPositions" json:"DeferredRandomizeLightPositions,omitempty"`
+	LightingPassDrawMode               *int32          `protobuf:"varint,70,opt,name=LightingPassDrawMode" json:"LightingPassDrawMode,omitempty"`
+	DeferredTestLightsNumber           *int32          `protobuf:"varint,71,opt,name=DeferredTestLightsNumber" json:"DeferredTestLightsNumber,omitempty"`
+	DeferredAmbientStrength            *float32        `protobuf:"fixed32,72,opt,name=DeferredAmbientStrength" json:"DeferredAmbientStrength,omitempty"`
+	DebugShadowTexture                 *bool           `protobuf:"varint,73,opt,name=DebugShadowTexture" json:"DebugShadowTexture,omitempty"`
+	// version 2
+	CrossSectionShowPlane *bool    `protobuf:"varint,74,opt,name=CrossSectionShowPlane" json:"CrossSectionShowPlane,omitempty"`
+	CrossSectionNormal    *Vec3    `protobuf:"bytes,75,opt,name=CrossSectionNormal" json:"CrossSectionNormal,omitempty"`
+	CrossSectionOffset    *float32 `protobuf:"fixed32,76,opt,name=CrossSectionOffset" json:"CrossSectionOffset,omitempty"`
+	CrossSectionCount     *int32   `protobuf:"varint,77,opt,name=CrossSectionCount" json:"CrossSectionCount,omitempty"`
+	CrossSectionSpacing   *float32 `protobuf:"fixed32,78,opt,name=CrossSectionSpacing" json:"CrossSectionSpacing,omitempty"`
+	CrossSectionPlaneSize *float32 `protobuf:"fixed32,79,opt,name=CrossSectionPlaneSize" json:"CrossSectionPlaneSize,omitempty"`
+	// version 4
+	ShadowCascadeCount       *int32   `protobuf:"varint,80,opt,name=ShadowCascadeCount" json:"ShadowCascadeCount,omitempty"`
+	ShadowCascadeSplitLambda *float32 `protobuf:"fixed32,81,opt,name=ShadowCascadeSplitLambda" json:"ShadowCascadeSplitLambda,omitempty"`
+	ShadowCascadeBlend       *float32 `protobuf:"fixed32,82,opt,name=ShadowCascadeBlend" json:"ShadowCascadeBlend,omitempty"`
+	ShadowDebugCascades      *bool    `protobuf:"varint,83,opt,name=ShadowDebugCascades" json:"ShadowDebugCascades,omitempty"`
+	// version 6
+	PostProcessingEnabled               *bool    `protobuf:"varint,84,opt,name=PostProcessingEnabled" json:"PostProcessingEnabled,omitempty"`
+	PostProcessingBloom                 *bool    `protobuf:"varint,85,opt,name=PostProcessingBloom" json:"PostProcessingBloom,omitempty"`
+	PostProcessingBloomStrength         *float32 `protobuf:"fixed32,86,opt,name=PostProcessingBloomStrength" json:"PostProcessingBloomStrength,omitempty"`
+	PostProcessingBloomFilterRadius     *float32 `protobuf:"fixed32,87,opt,name=PostProcessingBloomFilterRadius" json:"PostProcessingBloomFilterRadius,omitempty"`
+	PostProcessingToneMapping           *bool    `protobuf:"varint,88,opt,name=PostProcessingToneMapping" json:"PostProcessingToneMapping,omitempty"`
+	PostProcessingToneMappingOperator   *int32   `protobuf:"varint,89,opt,name=PostProcessingToneMappingOperator" json:"PostProcessingToneMappingOperator,omitempty"`
+	PostProcessingExposure              *float32 `protobuf:"fixed32,90,opt,name=PostProcessingExposure" json:"PostProcessingExposure,omitempty"`
+	PostProcessingColorGrading          *bool    `protobuf:"varint,91,opt,name=PostProcessingColorGrading" json:"PostProcessingColorGrading,omitempty"`
+	PostProcessingColorGradingLUT       *string  `protobuf:"bytes,92,opt,name=PostProcessingColorGradingLUT" json:"PostProcessingColorGradingLUT,omitempty"`
+	PostProcessingColorGradingIntensity *float32 `protobuf:"fixed32,93,opt,name=PostProcessingColorGradingIntensity" json:"PostProcessingColorGradingIntensity,omitempty"`
+	PostProcessingVignette              *bool    `protobuf:"varint,94,opt,name=PostProcessingVignette" json:"PostProcessingVignette,omitempty"`
+	PostProcessingVignetteIntensity     *float32 `protobuf:"fixed32,95,opt,name=PostProcessingVignetteIntensity" json:"PostProcessingVignetteIntensity,omitempty"`
+	PostProcessingVignetteSmoothness    *float32 `protobuf:"fixed32,96,opt,name=PostProcessingVignetteSmoothness" json:"PostProcessingVignetteSmoothness,omitempty"`
+	PostProcessingFXAA                  *bool    `protobuf:"varint,97,opt,name=PostProcessingFXAA" json:"PostProcessingFXAA,omitempty"`
+	XXX_NoUnkeyedLiteral                struct{} `json:"-"`
+	XXX_unrecognized                    []byte   `json:"-"`
+	XXX_sizecache                       int32    `json:"-"`
 }
 
 func (m *GUISettings) Reset()         { *m = GUISettings{} }
@@ -635,20 +662,188 @@ func (m *GUISettings) GetDebugShadowTexture() bool {
 	return false
 }
 
+func (m *GUISettings) GetCrossSectionShowPlane() bool {
+	if m != nil && m.CrossSectionShowPlane != nil {
+		return *m.CrossSectionShowPlane
+	}
+	return false
+}
+
+func (m *GUISettings) GetCrossSectionNormal() *Vec3 {
+	if m != nil {
+		return m.CrossSectionNormal
+	}
+	return nil
+}
+
+func (m *GUISettings) GetCrossSectionOffset() float32 {
+	if m != nil && m.CrossSectionOffset != nil {
+		return *m.CrossSectionOffset
+	}
+	return 0
+}
+
+func (m *GUISettings) GetCrossSectionCount() int32 {
+	if m != nil && m.CrossSectionCount != nil {
+		return *m.CrossSectionCount
+	}
+	return 0
+}
+
+func (m *GUISettings) GetCrossSectionSpacing() float32 {
+	if m != nil && m.CrossSectionSpacing != nil {
+		return *m.CrossSectionSpacing
+	}
+	return 0
+}
+
+func (m *GUISettings) GetCrossSectionPlaneSize() float32 {
+	if m != nil && m.CrossSectionPlaneSize != nil {
+		return *m.CrossSectionPlaneSize
+	}
+	return 0
+}
+
+func (m *GUISettings) GetShadowCascadeCount() int32 {
+	if m != nil && m.ShadowCascadeCount != nil {
+		return *m.ShadowCascadeCount
+	}
+	return 0
+}
+
+func (m *GUISettings) GetShadowCascadeSplitLambda() float32 {
+	if m != nil && m.ShadowCascadeSplitLambda != nil {
+		return *m.ShadowCascadeSplitLambda
+	}
+	return 0
+}
+
+func (m *GUISettings) GetShadowCascadeBlend() float32 {
+	if m != nil && m.ShadowCascadeBlend != nil {
+		return *m.ShadowCascadeBlend
+	}
+	return 0
+}
+
+func (m *GUISettings) GetShadowDebugCascades() bool {
+	if m != nil && m.ShadowDebugCascades != nil {
+		return *m.ShadowDebugCascades
+	}
+	return false
+}
+
+func (m *GUISettings) GetPostProcessingEnabled() bool {
+	if m != nil && m.PostProcessingEnabled != nil {
+		return *m.PostProcessingEnabled
+	}
+	return false
+}
+
+func (m *GUISettings) GetPostProcessingBloom() bool {
+	if m != nil && m.PostProcessingBloom != nil {
+		return *m.PostProcessingBloom
+	}
+	return false
+}
+
+func (m *GUISettings) GetPostProcessingBloomStrength() float32 {
+	if m != nil && m.PostProcessingBloomStrength != nil {
+		return *m.PostProcessingBloomStrength
+	}
+	return 0
+}
+
+func (m *GUISettings) GetPostProcessingBloomFilterRadius() float32 {
+	if m != nil && m.PostProcessingBloomFilterRadius != nil {
+		return *m.PostProcessingBloomFilterRadius
+	}
+	return 0
+}
+
+func (m *GUISettings) GetPostProcessingToneMapping() bool {
+	if m != nil && m.PostProcessingToneMapping != nil {
+		return *m.PostProcessingToneMapping
+	}
+	return false
+}
+
+func (m *GUISettings) GetPostProcessingToneMappingOperator() int32 {
+	if m != nil && m.PostProcessingToneMappingOperator != nil {
+		return *m.PostProcessingToneMappingOperator
+	}
+	return 0
+}
+
+func (m *GUISettings) GetPostProcessingExposure() float32 {
+	if m != nil && m.PostProcessingExposure != nil {
+		return *m.PostProcessingExposure
+	}
+	return 0
+}
+
+func (m *GUISettings) GetPostProcessingColorGrading() bool {
+	if m != nil && m.PostProcessingColorGrading != nil {
+		return *m.PostProcessingColorGrading
+	}
+	return false
+}
+
+func (m *GUISettings) GetPostProcessingColorGradingLUT() string {
+	if m != nil && m.PostProcessingColorGradingLUT != nil {
+		return *m.PostProcessingColorGradingLUT
+	}
+	return ""
+}
+
+func (m *GUISettings) GetPostProcessingColorGradingIntensity() float32 {
+	if m != nil && m.PostProcessingColorGradingIntensity != nil {
+		return *m.PostProcessingColorGradingIntensity
+	}
+	return 0
+}
+
+func (m *GUISettings) GetPostProcessingVignette() bool {
+	if m != nil && m.PostProcessingVignette != nil {
+		return *m.PostProcessingVignette
+	}
+	return false
+}
+
+func (m *GUISettings) GetPostProcessingVignetteIntensity() float32 {
+	if m != nil && m.PostProcessingVignetteIntensity != nil {
+		return *m.PostProcessingVignetteIntensity
+	}
+	return 0
+}
+
+func (m *GUISettings) GetPostProcessingVignetteSmoothness() float32 {
+	if m != nil && m.PostProcessingVignetteSmoothness != nil {
+		return *m.PostProcessingVignetteSmoothness
+	}
+	return 0
+}
+
+func (m *GUISettings) GetPostProcessingFXAA() bool {
+	if m != nil && m.PostProcessingFXAA != nil {
+		return *m.PostProcessingFXAA
+	}
+	return false
+}
+
 type CameraSettings struct {
-	CameraPosition       *Vec3             `protobuf:"bytes,1,req,name=cameraPosition" json:"cameraPosition,omitempty"`
-	View_Eye             *Vec3             `protobuf:"bytes,2,req,name=View_Eye,json=ViewEye" json:"View_Eye,omitempty"`
-	View_Center          *Vec3             `protobuf:"bytes,3,req,name=View_Center,json=ViewCenter" json:"View_Center,omitempty"`
-	View_Up              *Vec3             `protobuf:"bytes,4,req,name=View_Up,json=ViewUp" json:"View_Up,omitempty"`
-	PositionX            *ObjectCoordinate `protobuf:"bytes,5,req,name=positionX" json:"positionX,omitempty"`
-	PositionY            *ObjectCoordinate `protobuf:"bytes,6,req,name=positionY" json:"positionY,omitempty"`
-	PositionZ            *ObjectCoordinate `protobuf:"bytes,7,req,name=positionZ" json:"positionZ,omitempty"`
-	RotateX              *ObjectCoordinate `protobuf:"bytes,8,req,name=rotateX" json:"rotateX,omitempty"`
-	RotateY              *ObjectCoordinate `protobuf:"bytes,9,req,name=rotateY" json:"rotateY,omitempty"`
-	RotateZ              *ObjectCoordinate `protobuf:"bytes,10,req,name=rotateZ" json:"rotateZ,omitempty"`
-	RotateCenterX        *ObjectCoordinate `protobuf:"bytes,11,req,name=rotateCenterX" json:"rotateCenterX,omitempty"`
-	RotateCenterY        *ObjectCoordinate `protobuf:"bytes,12,req,name=rotateCenterY" json:"rotateCenterY,omitempty"`
-	RotateCenterZ        *ObjectCoordinate `protobuf:"bytes,13,req,name=rotateCenterZ" json:"rotateCenterZ,omitempty"`
+	CameraPosition       *Vec3             `protobuf:"bytes,1,opt,name=cameraPosition" json:"cameraPosition,omitempty"`
+	View_Eye             *Vec3             `protobuf:"bytes,2,opt,name=View_Eye,json=ViewEye" json:"View_Eye,omitempty"`
+	View_Center          *Vec3             `protobuf:"bytes,3,opt,name=View_Center,json=ViewCenter" json:"View_Center,omitempty"`
+	View_Up              *Vec3             `protobuf:"bytes,4,opt,name=View_Up,json=ViewUp" json:"View_Up,omitempty"`
+	PositionX            *ObjectCoordinate `protobuf:"bytes,5,opt,name=positionX" json:"positionX,omitempty"`
+	PositionY            *ObjectCoordinate `protobuf:"bytes,6,opt,name=positionY" json:"positionY,omitempty"`
+	PositionZ            *ObjectCoordinate `protobuf:"bytes,7,opt,name=positionZ" json:"positionZ,omitempty"`
+	RotateX              *ObjectCoordinate `protobuf:"bytes,8,opt,name=rotateX" json:"rotateX,omitempty"`
+	RotateY              *ObjectCoordinate `protobuf:"bytes,9,opt,name=rotateY" json:"rotateY,omitempty"`
+	RotateZ              *ObjectCoordinate `protobuf:"bytes,10,opt,name=rotateZ" json:"rotateZ,omitempty"`
+	RotateCenterX        *ObjectCoordinate `protobuf:"bytes,11,opt,name=rotateCenterX" json:"rotateCenterX,omitempty"`
+	RotateCenterY        *ObjectCoordinate `protobuf:"bytes,12,opt,name=rotateCenterY" json:"rotateCenterY,omitempty"`
+	RotateCenterZ        *ObjectCoordinate `protobuf:"bytes,13,opt,name=rotateCenterZ" json:"rotateCenterZ,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -771,18 +966,18 @@ func (m *CameraSettings) GetRotateCenterZ() *ObjectCoordinate {
 }
 
 type GridSettings struct {
-	ActAsMirror          *bool             `protobuf:"varint,1,req,name=actAsMirror" json:"actAsMirror,omitempty"`
-	GridSize             *int32            `protobuf:"varint,2,req,name=gridSize" json:"gridSize,omitempty"`
-	PositionX            *ObjectCoordinate `protobuf:"bytes,3,req,name=positionX" json:"positionX,omitempty"`
-	PositionY            *ObjectCoordinate `protobuf:"bytes,4,req,name=positionY" json:"positionY,omitempty"`
-	PositionZ            *ObjectCoordinate `protobuf:"bytes,5,req,name=positionZ" json:"positionZ,omitempty"`
-	RotateX              *ObjectCoordinate `protobuf:"bytes,6,req,name=rotateX" json:"rotateX,omitempty"`
-	RotateY              *ObjectCoordinate `protobuf:"bytes,7,req,name=rotateY" json:"rotateY,omitempty"`
-	RotateZ              *ObjectCoordinate `protobuf:"bytes,8,req,name=rotateZ" json:"rotateZ,omitempty"`
-	ScaleX               *ObjectCoordinate `protobuf:"bytes,9,req,name=scaleX" json:"scaleX,omitempty"`
-	ScaleY               *ObjectCoordinate `protobuf:"bytes,10,req,name=scaleY" json:"scaleY,omitempty"`
-	ScaleZ               *ObjectCoordinate `protobuf:"bytes,11,req,name=scaleZ" json:"scaleZ,omitempty"`
-	Transparency         *float32          `protobuf:"fixed32,12,req,name=transparency" json:"transparency,omitempty"`
+	ActAsMirror          *bool             `protobuf:"varint,1,opt,name=actAsMirror" json:"actAsMirror,omitempty"`
+	GridSize             *int32            `protobuf:"varint,2,opt,name=gridSize" json:"gridSize,omitempty"`
+	PositionX            *ObjectCoordinate `protobuf:"bytes,3,opt,name=positionX" json:"positionX,omitempty"`
+	PositionY            *ObjectCoordinate `protobuf:"bytes,4,opt,name=positionY" json:"positionY,omitempty"`
+	PositionZ            *ObjectCoordinate `protobuf:"bytes,5,opt,name=positionZ" json:"positionZ,omitempty"`
+	RotateX              *ObjectCoordinate `protobuf:"bytes,6,opt,name=rotateX" json:"rotateX,omitempty"`
+	RotateY              *ObjectCoordinate `protobuf:"bytes,7,opt,name=rotateY" json:"rotateY,omitempty"`
+	RotateZ              *ObjectCoordinate `protobuf:"bytes,8,opt,name=rotateZ" json:"rotateZ,omitempty"`
+	ScaleX               *ObjectCoordinate `protobuf:"bytes,9,opt,name=scaleX" json:"scaleX,omitempty"`
+	ScaleY               *ObjectCoordinate `protobuf:"bytes,10,opt,name=scaleY" json:"scaleY,omitempty"`
+	ScaleZ               *ObjectCoordinate `protobuf:"bytes,11,opt,name=scaleZ" json:"scaleZ,omitempty"`
+	Transparency         *float32          `protobuf:"fixed32,12,opt,name=transparency" json:"transparency,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -898,35 +1093,36 @@ func (m *GridSettings) GetTransparency() float32 {
 }
 
 type LightObject struct {
-	Title                *string           `protobuf:"bytes,1,req,name=title" json:"title,omitempty"`
-	Description          *string           `protobuf:"bytes,2,req,name=description" json:"description,omitempty"`
-	Type                 *int32            `protobuf:"varint,3,req,name=type" json:"type,omitempty"`
-	ShowLampObject       *bool             `protobuf:"varint,4,req,name=showLampObject" json:"showLampObject,omitempty"`
-	ShowLampDirection    *bool             `protobuf:"varint,5,req,name=showLampDirection" json:"showLampDirection,omitempty"`
-	ShowInWire           *bool             `protobuf:"varint,6,req,name=showInWire" json:"showInWire,omitempty"`
-	PositionX            *ObjectCoordinate `protobuf:"bytes,7,req,name=positionX" json:"positionX,omitempty"`
-	PositionY            *ObjectCoordinate `protobuf:"bytes,8,req,name=positionY" json:"positionY,omitempty"`
-	PositionZ            *ObjectCoordinate `protobuf:"bytes,9,req,name=positionZ" json:"positionZ,omitempty"`
-	DirectionX           *ObjectCoordinate `protobuf:"bytes,10,req,name=directionX" json:"directionX,omitempty"`
-	DirectionY           *ObjectCoordinate `protobuf:"bytes,11,req,name=directionY" json:"directionY,omitempty"`
-	DirectionZ           *ObjectCoordinate `protobuf:"bytes,12,req,name=directionZ" json:"directionZ,omitempty"`
-	ScaleX               *ObjectCoordinate `protobuf:"bytes,13,req,name=scaleX" json:"scaleX,omitempty"`
-	ScaleY               *ObjectCoordinate `protobuf:"bytes,14,req,name=scaleY" json:"scaleY,omitempty"`
-	ScaleZ               *ObjectCoordinate `protobuf:"bytes,15,req,name=scaleZ" json:"scaleZ,omitempty"`
-	RotateX              *ObjectCoordinate `protobuf:"bytes,16,req,name=rotateX" json:"rotateX,omitempty"`
-	RotateY              *ObjectCoordinate `protobuf:"bytes,17,req,name=rotateY" json:"rotateY,omitempty"`
-	RotateZ              *ObjectCoordinate `protobuf:"bytes,18,req,name=rotateZ" json:"rotateZ,omitempty"`
-	RotateCenterX        *ObjectCoordinate `protobuf:"bytes,19,req,name=rotateCenterX" json:"rotateCenterX,omitempty"`
-	RotateCenterY        *ObjectCoordinate `protobuf:"bytes,20,req,name=rotateCenterY" json:"rotateCenterY,omitempty"`
-	RotateCenterZ        *ObjectCoordinate `protobuf:"bytes,21,req,name=rotateCenterZ" json:"rotateCenterZ,omitempty"`
-	Ambient              *MaterialColor    `protobuf:"bytes,22,req,name=ambient" json:"ambient,omitempty"`
-	Diffuse              *MaterialColor    `protobuf:"bytes,23,req,name=diffuse" json:"diffuse,omitempty"`
-	Specular             *MaterialColor    `protobuf:"bytes,24,req,name=specular" json:"specular,omitempty"`
-	LCutOff              *ObjectCoordinate `protobuf:"bytes,25,req,name=lCutOff" json:"lCutOff,omitempty"`
-	LOuterCutOff         *ObjectCoordinate `protobuf:"bytes,26,req,name=lOuterCutOff" json:"lOuterCutOff,omitempty"`
-	LConstant            *ObjectCoordinate `protobuf:"bytes,27,req,name=lConstant" json:"lConstant,omitempty"`
-	LLinear              *ObjectCoordinate `protobuf:"bytes,28,req,name=lLinear" json:"lLinear,omitempty"`
-	LQuadratic           *ObjectCoordinate `protobuf:"bytes,29,req,name=lQuadratic" json:"lQuadratic,omitempty"`
+	Title                *string           `protobuf:"bytes,1,opt,name=title" json:"title,omitempty"`
+	Description          *string           `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	Type                 *int32            `protobuf:"varint,3,opt,name=type" json:"type,omitempty"`
+	ShowLampObject       *bool             `protobuf:"varint,4,opt,name=showLampObject" json:"showLampObject,omitempty"`
+	ShowLampDirection    *bool             `protobuf:"varint,5,opt,name=showLampDirection" json:"showLampDirection,omitempty"`
+	ShowInWire           *bool             `protobuf:"varint,6,opt,name=showInWire" json:"showInWire,omitempty"`
+	PositionX            *ObjectCoordinate `protobuf:"bytes,7,opt,name=positionX" json:"positionX,omitempty"`
+	PositionY            *ObjectCoordinate `protobuf:"bytes,8,opt,name=positionY" json:"positionY,omitempty"`
+	PositionZ            *ObjectCoordinate `protobuf:"bytes,9,opt,name=positionZ" json:"positionZ,omitempty"`
+	DirectionX           *ObjectCoordinate `protobuf:"bytes,10,opt,name=directionX" json:"directionX,omitempty"`
+	DirectionY           *ObjectCoordinate `protobuf:"bytes,11,opt,name=directionY" json:"directionY,omitempty"`
+	DirectionZ           *ObjectCoordinate `protobuf:"bytes,12,opt,name=directionZ" json:"directionZ,omitempty"`
+	ScaleX               *ObjectCoordinate `protobuf:"bytes,13,opt,name=scaleX" json:"scaleX,omitempty"`
+	ScaleY               *ObjectCoordinate `protobuf:"bytes,14,opt,name=scaleY" json:"scaleY,omitempty"`
+	ScaleZ               *ObjectCoordinate `protobuf:"bytes,15,opt,name=scaleZ" json:"scaleZ,omitempty"`
+	RotateX              *ObjectCoordinate `protobuf:"bytes,16,opt,name=rotateX" json:"rotateX,omitempty"`
+	RotateY              *ObjectCoordinate `protobuf:"bytes,17,opt,name=rotateY" json:"rotateY,omitempty"`
+	RotateZ              *ObjectCoordinate `protobuf:"bytes,18,opt,name=rotateZ" json:"rotateZ,omitempty"`
+	RotateCenterX        *ObjectCoordinate `protobuf:"bytes,19,opt,name=rotateCenterX" json:"rotateCenterX,omitempty"`
+	RotateCenterY        *ObjectCoordinate `protobuf:"bytes,20,opt,name=rotateCenterY" json:"rotateCenterY,omitempty"`
+	RotateCenterZ        *ObjectCoordinate `protobuf:"bytes,21,opt,name=rotateCenterZ" json:"rotateCenterZ,omitempty"`
+	Ambient              *MaterialColor    `protobuf:"bytes,22,opt,name=ambient" json:"ambient,omitempty"`
+	Diffuse              *MaterialColor    `protobuf:"bytes,23,opt,name=diffuse" json:"diffuse,omitempty"`
+	Specular             *MaterialColor    `protobuf:"bytes,24,opt,name=specular" json:"specular,omitempty"`
+	LCutOff              *ObjectCoordinate `protobuf:"bytes,25,opt,name=lCutOff" json:"lCutOff,omitempty"`
+	LOuterCutOff         *ObjectCoordinate `protobuf:"bytes,26,opt,name=lOuterCutOff" json:"lOuterCutOff,omitempty"`
+	LConstant            *ObjectCoordinate `protobuf:"bytes,27,opt,name=lConstant" json:"lConstant,omitempty"`
+	LLinear              *ObjectCoordinate `protobuf:"bytes,28,opt,name=lLinear" json:"lLinear,omitempty"`
+	LQuadratic           *ObjectCoordinate `protobuf:"bytes,29,opt,name=lQuadratic" json:"lQuadratic,omitempty"`
+	CastShadows          *bool             `protobuf:"varint,30,opt,name=castShadows" json:"castShadows,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1160,6 +1356,13 @@ func (m *LightObject) GetLQuadratic() *ObjectCoordinate {
 	return nil
 }
 
+func (m *LightObject) GetCastShadows() bool {
+	if m != nil && m.CastShadows != nil {
+		return *m.CastShadows
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*GUISettings)(nil), "saveopen.GUISettings")
 	proto.RegisterType((*CameraSettings)(nil), "saveopen.CameraSettings")
@@ -1170,116 +1373,139 @@ func init() {
 func init() { proto.RegisterFile("KuplungAppSettings.proto", fileDescriptor_8d0f8268449b23b7) }
 
 var fileDescriptor_8d0f8268449b23b7 = []byte{
-	// 1772 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xe9, 0x76, 0xdb, 0xc6,
-	0x15, 0x3e, 0xa1, 0xb5, 0x50, 0x43, 0x49, 0xb6, 0xc7, 0x8b, 0x26, 0xb2, 0xe3, 0xb0, 0x6a, 0x9a,
-	0x30, 0x6e, 0xa2, 0x38, 0xb2, 0xeb, 0xba, 0xae, 0xeb, 0x9a, 0xa6, 0xbc, 0xe8, 0xd4, 0x8e, 0x54,
-	0xc0, 0x96, 0x05, 0xfc, 0xc9, 0x19, 0x01, 0x97, 0xe4, 0xd4, 0x20, 0xc0, 0x0e, 0x06, 0x91, 0x94,
-	0xa7, 0xe8, 0x03, 0xf6, 0x5f, 0xdf, 0xa0, 0x4f, 0x90, 0x33, 0x17, 0x00, 0x89, 0x8d, 0x22, 0x45,
-	0xff, 0x12, 0xe7, 0x5b, 0xae, 0x66, 0xb9, 0xb3, 0x5c, 0x10, 0xf6, 0x8f, 0x68, 0xe8, 0x45, 0x7e,
-	0xaf, 0x3d, 0x1c, 0x9a, 0xa0, 0x94, 0xf0, 0x7b, 0xe1, 0xf6, 0x50, 0x06, 0x2a, 0xa0, 0xf5, 0x90,
-	0xff, 0x02, 0xc1, 0x10, 0xfc, 0xcd, 0x54, 0xb3, 0x0b, 0x5d, 0xe1, 0x0b, 0x25, 0x02, 0x3f, 0xd1,
-	0x6c, 0xfd, 0xe7, 0x16, 0x69, 0xbc, 0x7a, 0xbf, 0x97, 0x3a, 0xe9, 0x26, 0xa9, 0x9b, 0xfd, 0xe0,
-	0xa4, 0x13, 0x1d, 0x03, 0xfb, 0xac, 0x59, 0x6b, 0xd5, 0x8d, 0x51, 0x9b, 0x5e, 0x21, 0x97, 0x5e,
-	0x06, 0xbf, 0xb0, 0x5a, 0xb3, 0xd6, 0xaa, 0x19, 0xfa, 0x27, 0xbd, 0x43, 0x88, 0xc1, 0x95, 0x08,
-	0x3e, 0x08, 0x57, 0xf5, 0xd9, 0x25, 0x24, 0x32, 0x08, 0x6d, 0x92, 0x06, 0xb6, 0x5e, 0x83, 0xe8,
-	0xf5, 0x15, 0x5b, 0x40, 0x41, 0x16, 0xd2, 0x11, 0x0e, 0x3c, 0xee, 0x43, 0xc7, 0x0b, 0x42, 0x60,
-	0x8b, 0x71, 0x84, 0x31, 0xa2, 0xfb, 0x83, 0xad, 0x97, 0x5c, 0xb2, 0x25, 0x64, 0x47, 0x6d, 0xda,
-	0x22, 0x97, 0x5f, 0xf1, 0xc1, 0x80, 0x77, 0x02, 0xe8, 0x0a, 0x47, 0x80, 0xaf, 0xd8, 0x32, 0x4a,
-	0x8a, 0x30, 0xdd, 0x22, 0xab, 0x7a, 0x14, 0x07, 0xc2, 0xf9, 0x68, 0xf0, 0xb3, 0x90, 0xd5, 0x71,
-	0x64, 0x39, 0x8c, 0x6e, 0x13, 0x9a, 0x6d, 0x9b, 0xc2, 0xef, 0x79, 0xc0, 0x56, 0x50, 0x59, 0xc1,
-	0xc4, 0x63, 0x3f, 0x6b, 0xfb, 0x62, 0xc0, 0x15, 0x30, 0x82, 0xba, 0x0c, 0x92, 0xf0, 0xfb, 0x52,
-	0xf4, 0x84, 0x7f, 0xc4, 0x1a, 0xe9, 0xdc, 0xa4, 0x48, 0x8e, 0xb7, 0xd8, 0x6a, 0x81, 0xb7, 0x72,
-	0xbc, 0xcd, 0xd6, 0x0a, 0xbc, 0x1d, 0xcf, 0x6d, 0x1a, 0xcd, 0x64, 0xeb, 0xcd, 0x5a, 0x6b, 0xc5,
-	0xc8, 0x42, 0x39, 0x85, 0x65, 0xb2, 0xcb, 0x05, 0x85, 0x95, 0x57, 0xd8, 0x26, 0xbb, 0x52, 0x50,
-	0xd8, 0x26, 0x65, 0x64, 0xd9, 0xe0, 0x67, 0xbb, 0x92, 0x9f, 0xb0, 0xab, 0x38, 0xc4, 0xb4, 0x49,
-	0xbf, 0x22, 0x6b, 0xfa, 0xa7, 0x90, 0xe0, 0xe8, 0x84, 0x3a, 0x62, 0x14, 0xbb, 0x98, 0x07, 0x8b,
-	0x2a, 0x8b, 0x5d, 0x2b, 0xab, 0xac, 0xa2, 0xca, 0x66, 0xd7, 0xcb, 0x2a, 0x9b, 0x7e, 0x4d, 0xd6,
-	0x73, 0xc1, 0x4d, 0x76, 0x03, 0x3b, 0x5c, 0x40, 0x8b, 0x3a, 0xcb, 0x64, 0x37, 0xcb, 0x3a, 0xab,
-	0xa4, 0xb3, 0x4d, 0xb6, 0x51, 0xd6, 0xd9, 0x26, 0xbd, 0x4b, 0xae, 0xec, 0x3b, 0x8e, 0x17, 0x85,
-	0x22, 0xf0, 0x3b, 0x91, 0xe7, 0x09, 0xbf, 0xc7, 0x18, 0x4e, 0x46, 0x09, 0xc7, 0x98, 0xe0, 0xbb,
-	0x20, 0x85, 0xde, 0x6d, 0x43, 0xd5, 0x67, 0x9f, 0xa3, 0xb2, 0x80, 0xd2, 0x07, 0xe4, 0x86, 0x09,
-	0x1e, 0x38, 0x0a, 0xdc, 0x43, 0x01, 0x27, 0x6f, 0x03, 0x17, 0x3c, 0xf3, 0xa3, 0xf0, 0xd9, 0x66,
-	0xb3, 0xd6, 0x5a, 0x33, 0xaa, 0x49, 0x9d, 0xf1, 0x3a, 0x13, 0x9f, 0x07, 0x91, 0xef, 0x0a, 0xbf,
-	0xf7, 0x3c, 0x38, 0x65, 0xb7, 0x30, 0x7c, 0x11, 0xd6, 0xd9, 0x9c, 0x69, 0x1a, 0xd0, 0x95, 0x10,
-	0xf6, 0xd9, 0xed, 0x38, 0x9b, 0xcb, 0x4c, 0x41, 0x7f, 0xc0, 0x5d, 0xfd, 0x8b, 0x7d, 0x81, 0xcb,
-	0x50, 0xc1, 0xd0, 0x1d, 0xb2, 0xba, 0x1f, 0x29, 0x4f, 0xf8, 0xd0, 0x09, 0xbc, 0x40, 0xb2, 0x3b,
-	0xcd, 0x5a, 0xab, 0xb1, 0xb3, 0xbe, 0x9d, 0x1e, 0x39, 0xdb, 0x87, 0xe0, 0x3c, 0x30, 0x72, 0x1a,
-	0xfa, 0x90, 0xdc, 0xcc, 0xb6, 0xf5, 0x7e, 0x02, 0xb9, 0x3f, 0x04, 0x9f, 0x7d, 0x89, 0xfd, 0x9a,
-	0xc0, 0xe2, 0xfc, 0xc7, 0xcc, 0xbb, 0xbe, 0x70, 0x3e, 0xfa, 0x10, 0x86, 0xac, 0x89, 0x3d, 0x2b,
-	0xe1, 0xf4, 0x1e, 0xb9, 0x76, 0x08, 0x52, 0xc1, 0xa9, 0x39, 0xec, 0x83, 0x84, 0x43, 0x11, 0x8a,
-	0x63, 0x0f, 0xd8, 0xef, 0xf0, 0x1f, 0x54, 0x51, 0xf4, 0x19, 0xb9, 0x95, 0x85, 0x8b, 0x5d, 0xdb,
-	0x42, 0xe7, 0x79, 0x12, 0xba, 0x43, 0xae, 0x67, 0xe9, 0xbd, 0x30, 0xfe, 0xcb, 0x7e, 0x8f, 0xd6,
-	0x4a, 0x8e, 0x3e, 0x25, 0x9b, 0x59, 0x5c, 0x2f, 0xdf, 0x07, 0x21, 0xa1, 0x2b, 0xf9, 0x00, 0x42,
-	0xf6, 0x15, 0x3a, 0xcf, 0x51, 0xe8, 0xf5, 0xca, 0xb2, 0x06, 0x77, 0x45, 0x14, 0xb2, 0x3f, 0xc4,
-	0xeb, 0x55, 0x66, 0x8a, 0x7d, 0x34, 0xa1, 0x37, 0x00, 0x5f, 0x85, 0xec, 0xeb, 0x66, 0xad, 0xb5,
-	0x68, 0x54, 0x72, 0xf4, 0x09, 0xb9, 0x5a, 0x1a, 0x36, 0xfb, 0xa6, 0x72, 0xa1, 0xcb, 0x42, 0xbd,
-	0xda, 0xba, 0xcf, 0x6d, 0xcf, 0x3b, 0x14, 0x61, 0xc4, 0xbd, 0xb6, 0x54, 0xd0, 0xe5, 0x8e, 0x0a,
-	0x59, 0x2b, 0x5e, 0xed, 0x6a, 0x96, 0xde, 0x26, 0x2b, 0x9a, 0xb1, 0xdb, 0xa7, 0x22, 0x64, 0xdf,
-	0xa2, 0x74, 0x0c, 0xe8, 0x71, 0x7c, 0x08, 0xa4, 0xe7, 0xbe, 0x92, 0xc2, 0x35, 0xc5, 0xaf, 0x60,
-	0xfe, 0x3b, 0xe2, 0x12, 0x42, 0x76, 0x37, 0x1e, 0x47, 0x15, 0x47, 0x1f, 0x91, 0x8d, 0x11, 0xfe,
-	0x52, 0x9c, 0x82, 0xfb, 0x41, 0xa8, 0x3e, 0x22, 0xec, 0x8f, 0x18, 0x7f, 0x12, 0x9d, 0xde, 0x86,
-	0x9a, 0x61, 0xdf, 0x8d, 0x6f, 0x43, 0xdd, 0xd6, 0x67, 0x67, 0xdb, 0x51, 0xed, 0xf0, 0xad, 0x90,
-	0x32, 0x90, 0xec, 0x7b, 0xa4, 0xb3, 0x10, 0xde, 0x28, 0x1f, 0xcf, 0x8e, 0x83, 0xd3, 0x74, 0x33,
-	0xef, 0x29, 0x18, 0xb0, 0x6d, 0xec, 0x69, 0x05, 0x43, 0xef, 0x91, 0x25, 0x87, 0x0f, 0x40, 0x72,
-	0xf6, 0x03, 0x4e, 0x32, 0x1b, 0x4f, 0x72, 0x07, 0xf1, 0xf4, 0x96, 0x36, 0x12, 0x1d, 0xbd, 0x4b,
-	0x16, 0x7a, 0xba, 0x6f, 0xf7, 0x9a, 0x9f, 0xb5, 0x1a, 0x3b, 0x37, 0xc7, 0x7a, 0x9c, 0x82, 0x54,
-	0x8d, 0x1a, 0xfa, 0x3d, 0x59, 0xf2, 0xf4, 0x95, 0x1b, 0xb2, 0x1f, 0x9b, 0x97, 0x5a, 0x8d, 0x9d,
-	0x1b, 0x63, 0xf5, 0x1b, 0x8d, 0xef, 0x1f, 0xff, 0x0b, 0x1c, 0x65, 0x24, 0x22, 0x7d, 0xd4, 0xbc,
-	0xdf, 0x6b, 0x0f, 0x8e, 0xf5, 0xfd, 0x89, 0xfc, 0x11, 0xdb, 0x89, 0x2f, 0xd7, 0x02, 0x5c, 0x56,
-	0x5a, 0xec, 0x7e, 0x95, 0xd2, 0x2a, 0x2b, 0x6d, 0xf6, 0xa0, 0x4a, 0x69, 0xeb, 0x65, 0x36, 0x03,
-	0x4f, 0xb8, 0xd8, 0xcc, 0xdc, 0x31, 0x7f, 0x42, 0x79, 0x25, 0x37, 0xc1, 0x63, 0xb1, 0x87, 0x13,
-	0x3d, 0xd6, 0x04, 0x8f, 0xcd, 0xfe, 0x3c, 0xd1, 0x63, 0xd3, 0xd7, 0x64, 0x63, 0x8c, 0xbf, 0xe5,
-	0x0a, 0xa4, 0xe0, 0x5e, 0xbc, 0x39, 0x1e, 0x55, 0x6c, 0x8e, 0xfb, 0xc6, 0x24, 0xb9, 0xde, 0x60,
-	0x63, 0x2a, 0x99, 0x00, 0xf6, 0x97, 0xca, 0x18, 0x65, 0x61, 0xde, 0xbd, 0x2b, 0xba, 0xdd, 0x28,
-	0x04, 0xf6, 0x78, 0x9a, 0x3b, 0x11, 0xd2, 0xa7, 0x84, 0x8e, 0x41, 0x73, 0x08, 0x4e, 0xe4, 0x71,
-	0xc9, 0xfe, 0x5a, 0x69, 0xaf, 0x50, 0xd2, 0x27, 0xe4, 0xf3, 0x52, 0x97, 0x4c, 0x25, 0xc1, 0xef,
-	0xa9, 0x3e, 0x7b, 0x82, 0xd3, 0x37, 0x59, 0x90, 0x77, 0x27, 0x5d, 0x1a, 0xb9, 0xff, 0x56, 0x74,
-	0x17, 0x04, 0xfa, 0xf0, 0x2c, 0xf7, 0x68, 0x64, 0x7f, 0x8a, 0xf6, 0x73, 0x14, 0xf4, 0x27, 0xb2,
-	0x35, 0x61, 0x49, 0x32, 0x47, 0x3b, 0xfb, 0x3b, 0xee, 0xe8, 0x19, 0x94, 0xf4, 0x39, 0xb9, 0x5d,
-	0x1a, 0x6a, 0x36, 0xd2, 0x33, 0x8c, 0x74, 0xae, 0x26, 0x1f, 0x23, 0x19, 0x70, 0x36, 0x46, 0xbb,
-	0x18, 0xa3, 0xac, 0xa1, 0xbb, 0xe4, 0x8b, 0xf2, 0xa8, 0xb3, 0x41, 0x9e, 0x63, 0x90, 0xf3, 0x45,
-	0xfa, 0xba, 0xdd, 0x85, 0x2e, 0x48, 0x09, 0xee, 0x3b, 0x08, 0x95, 0x7e, 0x7d, 0xb0, 0x4e, 0xfc,
-	0xdc, 0x29, 0xe2, 0xfa, 0x88, 0xcb, 0x62, 0x6f, 0xe2, 0x03, 0x66, 0x37, 0x7e, 0x66, 0x94, 0x19,
-	0xfa, 0x9a, 0x7c, 0x99, 0xa2, 0x06, 0xf7, 0xdd, 0x60, 0x20, 0x7e, 0x05, 0xa4, 0x0e, 0x82, 0x30,
-	0xae, 0x4b, 0xd8, 0x0b, 0x34, 0x4f, 0x93, 0xe9, 0x9d, 0x8b, 0x88, 0xf0, 0x7b, 0x07, 0x3c, 0x0c,
-	0xf5, 0x93, 0x14, 0x7b, 0xfa, 0x32, 0xbe, 0x08, 0xaa, 0x38, 0xfa, 0x98, 0xb0, 0x72, 0x9f, 0x7e,
-	0x8a, 0x06, 0xc7, 0x20, 0xd9, 0x2b, 0xf4, 0x4d, 0xe4, 0xf5, 0x25, 0x92, 0x72, 0xc5, 0x6c, 0x7f,
-	0x8d, 0x09, 0x37, 0x89, 0x8e, 0xe7, 0xe8, 0x38, 0xea, 0x99, 0x7d, 0xee, 0x06, 0x27, 0xef, 0xe0,
-	0x54, 0x45, 0x12, 0xd8, 0x5e, 0x3a, 0x47, 0x45, 0x66, 0xeb, 0x7f, 0x8b, 0x64, 0x3d, 0x7f, 0xde,
-	0xd3, 0x87, 0x64, 0x3d, 0x3e, 0xf1, 0xd3, 0xf1, 0x63, 0x6d, 0x56, 0xde, 0xa8, 0x05, 0x15, 0xfd,
-	0x96, 0xd4, 0xf5, 0x03, 0xf2, 0xe7, 0x17, 0x67, 0x80, 0x65, 0x5b, 0xd9, 0xb1, 0xac, 0xf9, 0x17,
-	0x67, 0x40, 0x7f, 0x20, 0x0d, 0x94, 0x76, 0xc0, 0x57, 0x20, 0xb1, 0x96, 0x2b, 0xab, 0x89, 0x96,
-	0xc4, 0x0a, 0xfa, 0x0d, 0x41, 0xef, 0xcf, 0xef, 0x87, 0x58, 0xd7, 0x95, 0xc5, 0x4b, 0x9a, 0x7e,
-	0x3f, 0xa4, 0x8f, 0xc8, 0xca, 0x30, 0xe9, 0xd0, 0x11, 0x56, 0x78, 0x8d, 0x9d, 0xcd, 0xb1, 0x34,
-	0xbe, 0x76, 0x3a, 0x41, 0x20, 0x5d, 0xe1, 0x73, 0x05, 0xc6, 0x58, 0x9c, 0x75, 0x5a, 0x58, 0xfd,
-	0xcd, 0xe8, 0xb4, 0xb2, 0x4e, 0x1b, 0x8b, 0xc2, 0x19, 0x9d, 0x36, 0x7d, 0x40, 0x96, 0x65, 0xa0,
-	0xb8, 0x82, 0x23, 0xac, 0x12, 0xcf, 0xf7, 0xa5, 0xd2, 0xb1, 0xcb, 0xc2, 0x8a, 0x71, 0x26, 0x97,
-	0x35, 0x76, 0xd9, 0x58, 0x3f, 0xce, 0xe4, 0xb2, 0xe9, 0x33, 0xb2, 0x16, 0xff, 0x8c, 0x17, 0x22,
-	0xae, 0x2d, 0xcf, 0xf7, 0xe6, 0x0d, 0xc5, 0x08, 0x71, 0xf5, 0x79, 0x81, 0x08, 0x56, 0x31, 0x42,
-	0x5c, 0x9f, 0x5e, 0x20, 0x82, 0xbd, 0xf5, 0xdf, 0x05, 0xb2, 0x9a, 0x7d, 0xa5, 0xe8, 0xf7, 0x14,
-	0xcf, 0xbc, 0xa7, 0xe2, 0x8f, 0x0f, 0x59, 0x48, 0xbf, 0xc6, 0x7a, 0xc9, 0xd3, 0x0e, 0xb3, 0x79,
-	0xd1, 0x18, 0xb5, 0xf3, 0x49, 0x76, 0x69, 0xee, 0x24, 0x5b, 0x98, 0x3b, 0xc9, 0x16, 0xe7, 0x4c,
-	0xb2, 0xa5, 0xb9, 0x92, 0x6c, 0x79, 0xae, 0x24, 0xab, 0xcf, 0x9e, 0x64, 0x3b, 0x64, 0x29, 0x74,
-	0xb8, 0x07, 0x47, 0x33, 0xe4, 0x73, 0xa2, 0x1c, 0x79, 0xac, 0x19, 0xb2, 0x39, 0x51, 0x8e, 0x3c,
-	0xf6, 0x0c, 0x59, 0x9c, 0x28, 0xe9, 0x16, 0x59, 0x55, 0x92, 0xfb, 0xe1, 0x90, 0x4b, 0xf0, 0x9d,
-	0xb3, 0xe4, 0xdb, 0x49, 0x0e, 0xdb, 0xfa, 0x7f, 0x83, 0x34, 0x32, 0xcf, 0x5a, 0x7a, 0x9d, 0x2c,
-	0x2a, 0xa1, 0xbc, 0xf8, 0xa3, 0xd6, 0x8a, 0x11, 0x37, 0x74, 0xce, 0xb9, 0x10, 0x3a, 0x52, 0x0c,
-	0xf1, 0x50, 0xad, 0xc5, 0xdf, 0x3f, 0x32, 0x10, 0xa5, 0x64, 0x41, 0x9d, 0x0d, 0x01, 0x53, 0x6a,
-	0xd1, 0xc0, 0xdf, 0xba, 0xc6, 0x0f, 0xfb, 0xc1, 0xc9, 0x1b, 0x3e, 0x18, 0xc6, 0xd1, 0x31, 0x6d,
-	0xea, 0x46, 0x01, 0xa5, 0xdf, 0x91, 0xab, 0x29, 0x32, 0x7a, 0x3e, 0x62, 0x9e, 0xd4, 0x8d, 0x32,
-	0x41, 0xef, 0x10, 0xa2, 0xc1, 0x3d, 0x5f, 0x57, 0x79, 0x98, 0x16, 0x75, 0x23, 0x83, 0xe4, 0x33,
-	0x7c, 0x79, 0xee, 0x0c, 0xaf, 0xcf, 0x9d, 0xe1, 0x2b, 0x17, 0xc9, 0xf0, 0xc7, 0x84, 0xb8, 0xe3,
-	0x67, 0xfb, 0xf4, 0x7c, 0xc8, 0xa8, 0x73, 0x5e, 0x6b, 0x86, 0xbc, 0xc8, 0xa8, 0x73, 0x5e, 0x7b,
-	0x86, 0x73, 0x2d, 0xa3, 0xce, 0xe4, 0xfc, 0xda, 0x1c, 0x39, 0xbf, 0x3e, 0x47, 0xce, 0x5f, 0x9e,
-	0x39, 0xe7, 0x33, 0x27, 0xc6, 0x95, 0xb9, 0x4e, 0x8c, 0xab, 0x73, 0x9d, 0x18, 0xf4, 0x13, 0xae,
-	0xa5, 0x6b, 0x9f, 0x7c, 0x2d, 0x5d, 0xff, 0xe4, 0x6b, 0xe9, 0xc6, 0x05, 0xaf, 0x25, 0xfa, 0x23,
-	0x59, 0xe6, 0x49, 0x21, 0x76, 0x13, 0xbd, 0x1b, 0x63, 0x6f, 0xee, 0xfd, 0x6f, 0xa4, 0x3a, 0x6d,
-	0x71, 0x93, 0xea, 0x6b, 0x63, 0x8a, 0x25, 0xd1, 0xd1, 0xfb, 0xa4, 0x1e, 0xa6, 0x25, 0x17, 0x3b,
-	0xdf, 0x33, 0x12, 0xea, 0x65, 0xf1, 0x3a, 0x91, 0xda, 0xef, 0x76, 0xf1, 0x9b, 0xe2, 0x94, 0x65,
-	0x49, 0xa4, 0xf4, 0x29, 0x59, 0xf5, 0xf6, 0x23, 0x05, 0x32, 0xb1, 0x6e, 0x4e, 0xb5, 0xe6, 0xf4,
-	0xfa, 0x08, 0xf0, 0x3a, 0x81, 0x1f, 0x2a, 0xee, 0x2b, 0xfc, 0xd8, 0x38, 0xe5, 0x08, 0x18, 0x89,
-	0xb1, 0xbf, 0x6f, 0x84, 0x0f, 0x5c, 0xe2, 0x77, 0xc7, 0x69, 0xfd, 0x8d, 0xa5, 0x7a, 0x03, 0x7b,
-	0xff, 0x8c, 0xb8, 0x2b, 0xb9, 0x12, 0x0e, 0x7e, 0x80, 0x9c, 0xb2, 0x81, 0xc7, 0xea, 0xdf, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x61, 0x44, 0x43, 0xbf, 0x0b, 0x19, 0x00, 0x00,
+	// 2131 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x59, 0xeb, 0x7e, 0x13, 0xc7,
+	0x15, 0xff, 0x19, 0x7c, 0x91, 0xc7, 0xc6, 0x81, 0x09, 0x97, 0x83, 0x81, 0xc4, 0x71, 0x52, 0xe2,
+	0xd0, 0x84, 0x10, 0x43, 0x29, 0xa5, 0x94, 0x22, 0xcb, 0x18, 0x9c, 0x18, 0xec, 0xec, 0xda, 0xc6,
+	0xda, 0x5e, 0xe8, 0x78, 0x77, 0x24, 0x4d, 0x59, 0xed, 0x6e, 0x67, 0x46, 0xc1, 0xce, 0x73, 0xf4,
+	0x21, 0xfa, 0x50, 0xfd, 0xd6, 0x17, 0xe9, 0x6f, 0xce, 0xee, 0x4a, 0x7b, 0x93, 0x25, 0xc4, 0x27,
+	0x6b, 0xfe, 0x97, 0xb3, 0x73, 0x39, 0x73, 0x35, 0x81, 0x9f, 0x7a, 0x91, 0xdf, 0x0b, 0xda, 0xf5,
+	0x28, 0xb2, 0xb9, 0xd6, 0x22, 0x68, 0xab, 0xbb, 0x91, 0x0c, 0x75, 0x48, 0x6b, 0x8a, 0xfd, 0xc2,
+	0xc3, 0x88, 0x07, 0xcb, 0xa9, 0x66, 0x93, 0xb7, 0x44, 0x20, 0xb4, 0x08, 0x83, 0x44, 0xb3, 0xfa,
+	0x9f, 0xdb, 0x64, 0xe1, 0xc5, 0xc1, 0x76, 0xea, 0xa4, 0xcb, 0xa4, 0x66, 0x77, 0xc2, 0xf7, 0x8d,
+	0xde, 0x31, 0x87, 0xa9, 0x95, 0xa9, 0xb5, 0x9a, 0xd5, 0x2f, 0xd3, 0x8b, 0xe4, 0xfc, 0x56, 0xf8,
+	0x0b, 0x9c, 0x5b, 0x99, 0x5a, 0x3b, 0x67, 0x99, 0x9f, 0xf4, 0x33, 0x42, 0x2c, 0xa6, 0x45, 0xf8,
+	0x46, 0x78, 0xba, 0x03, 0xe7, 0x91, 0xc8, 0x20, 0x74, 0x85, 0x2c, 0x60, 0xe9, 0x25, 0x17, 0xed,
+	0x8e, 0x86, 0x69, 0x14, 0x64, 0x21, 0x13, 0x61, 0xcf, 0x67, 0x01, 0x6f, 0xf8, 0xa1, 0xe2, 0x30,
+	0x13, 0x47, 0x18, 0x20, 0xa6, 0x3e, 0x58, 0xda, 0x62, 0x12, 0x66, 0x91, 0xed, 0x97, 0xe9, 0x1a,
+	0xf9, 0xe4, 0x05, 0xeb, 0x76, 0x59, 0x23, 0xe4, 0x2d, 0xe1, 0x0a, 0x1e, 0x68, 0x98, 0x43, 0x49,
+	0x11, 0xa6, 0xab, 0x64, 0xd1, 0xb4, 0x62, 0x4f, 0xb8, 0xef, 0x2c, 0x76, 0xaa, 0xa0, 0x86, 0x2d,
+	0xcb, 0x61, 0xf4, 0x2e, 0xa1, 0xd9, 0xb2, 0x2d, 0x82, 0xb6, 0xcf, 0x61, 0x1e, 0x95, 0x15, 0x4c,
+	0xdc, 0xf6, 0xd3, 0x7a, 0x20, 0xba, 0x4c, 0x73, 0x20, 0xa8, 0xcb, 0x20, 0x09, 0xbf, 0x2b, 0x45,
+	0x5b, 0x04, 0x47, 0xb0, 0x90, 0xf6, 0x4d, 0x8a, 0xe4, 0xf8, 0x26, 0x2c, 0x16, 0xf8, 0x66, 0x8e,
+	0x77, 0xe0, 0x42, 0x81, 0x77, 0xe2, 0xbe, 0x4d, 0xa3, 0xd9, 0xb0, 0xb4, 0x32, 0xb5, 0x36, 0x6f,
+	0x65, 0xa1, 0x9c, 0xa2, 0x69, 0xc3, 0x27, 0x05, 0x45, 0x33, 0xaf, 0x70, 0x6c, 0xb8, 0x58, 0x50,
+	0x38, 0x36, 0x05, 0x32, 0x67, 0xb1, 0xd3, 0x4d, 0xc9, 0xde, 0xc3, 0x25, 0x6c, 0x62, 0x5a, 0xa4,
+	0x5f, 0x91, 0x0b, 0xe6, 0xa7, 0x90, 0xdc, 0x35, 0x09, 0x75, 0x04, 0x14, 0xab, 0x98, 0x07, 0x8b,
+	0xaa, 0x26, 0x7c, 0x5a, 0x56, 0x35, 0x8b, 0x2a, 0x07, 0x2e, 0x97, 0x55, 0x0e, 0xbd, 0x4d, 0x96,
+	0x72, 0xc1, 0x6d, 0xb8, 0x82, 0x15, 0x2e, 0xa0, 0x45, 0x5d, 0xd3, 0x86, 0xab, 0x65, 0x5d, 0xb3,
+	0xa4, 0x73, 0x6c, 0xb8, 0x56, 0xd6, 0x39, 0x36, 0xbd, 0x43, 0x2e, 0xee, 0xba, 0xae, 0xdf, 0x53,
+	0x22, 0x0c, 0x1a, 0x3d, 0xdf, 0x17, 0x41, 0x1b, 0x00, 0x3b, 0xa3, 0x84, 0x63, 0x4c, 0x1e, 0x78,
+	0x5c, 0x0a, 0x33, 0xdb, 0x22, 0xdd, 0x81, 0xeb, 0xa8, 0x2c, 0xa0, 0xf4, 0x01, 0xb9, 0x62, 0x73,
+	0x9f, 0xbb, 0x9a, 0x7b, 0x87, 0x82, 0xbf, 0x7f, 0x15, 0x7a, 0xdc, 0xb7, 0xdf, 0x89, 0x00, 0x96,
+	0x57, 0xa6, 0xd6, 0x2e, 0x58, 0xd5, 0xa4, 0xc9, 0x78, 0x93, 0x89, 0x1b, 0x61, 0x2f, 0xf0, 0x44,
+	0xd0, 0xde, 0x08, 0x4f, 0xe0, 0x06, 0x86, 0x2f, 0xc2, 0x26, 0x9b, 0x33, 0x45, 0x8b, 0xb7, 0x24,
+	0x57, 0x1d, 0xb8, 0x19, 0x67, 0x73, 0x99, 0x29, 0xe8, 0xf7, 0x98, 0x67, 0x7e, 0xc1, 0x2d, 0x1c,
+	0x86, 0x0a, 0x86, 0xae, 0x93, 0xc5, 0xdd, 0x9e, 0xf6, 0x45, 0xc0, 0x1b, 0xa1, 0x1f, 0x4a, 0xf8,
+	0x6c, 0x65, 0x6a, 0x6d, 0x61, 0x7d, 0xe9, 0x6e, 0xba, 0xe4, 0xdc, 0x3d, 0xe4, 0xee, 0x03, 0x2b,
+	0xa7, 0xa1, 0x0f, 0xc9, 0xd5, 0x6c, 0xd9, 0xcc, 0x27, 0x2e, 0x77, 0x23, 0x1e, 0xc0, 0xe7, 0x58,
+	0xaf, 0x21, 0x2c, 0xf6, 0x7f, 0xcc, 0xec, 0x77, 0x84, 0xfb, 0x2e, 0xe0, 0x4a, 0xc1, 0x0a, 0xd6,
+	0xac, 0x84, 0xd3, 0x7b, 0xe4, 0xd3, 0x43, 0x2e, 0x35, 0x3f, 0xb1, 0xa3, 0x0e, 0x97, 0xfc, 0x50,
+	0x28, 0x71, 0xec, 0x73, 0xf8, 0x02, 0x3f, 0x50, 0x45, 0xd1, 0x67, 0xe4, 0x46, 0x16, 0x2e, 0x56,
+	0x6d, 0x15, 0x9d, 0x67, 0x49, 0xe8, 0x3a, 0xb9, 0x9c, 0xa5, 0xb7, 0x55, 0xfc, 0x17, 0xbe, 0x44,
+	0x6b, 0x25, 0x47, 0x9f, 0x92, 0xe5, 0x2c, 0x6e, 0x86, 0xef, 0x8d, 0x90, 0xbc, 0x25, 0x59, 0x97,
+	0x2b, 0xf8, 0x0a, 0x9d, 0x67, 0x28, 0xcc, 0x78, 0x65, 0x59, 0x8b, 0x79, 0xa2, 0xa7, 0xe0, 0x37,
+	0xf1, 0x78, 0x95, 0x99, 0x62, 0x1d, 0x6d, 0xde, 0xee, 0xf2, 0x40, 0x2b, 0xb8, 0xbd, 0x32, 0xb5,
+	0x36, 0x63, 0x55, 0x72, 0xf4, 0x09, 0xb9, 0x54, 0x6a, 0x36, 0x7c, 0x5d, 0x39, 0xd0, 0x65, 0xa1,
+	0x19, 0x6d, 0x53, 0xe7, 0xba, 0xef, 0x1f, 0x0a, 0xd5, 0x63, 0x7e, 0x5d, 0x6a, 0xde, 0x62, 0xae,
+	0x56, 0xb0, 0x16, 0x8f, 0x76, 0x35, 0x4b, 0x6f, 0x92, 0x79, 0xc3, 0x38, 0xf5, 0x13, 0xa1, 0xe0,
+	0x1b, 0x94, 0x0e, 0x00, 0xd3, 0x8e, 0x37, 0xa1, 0xf4, 0xbd, 0x17, 0x52, 0x78, 0xb6, 0xf8, 0x95,
+	0xdb, 0xff, 0xea, 0x31, 0xc9, 0x15, 0xdc, 0x89, 0xdb, 0x51, 0xc5, 0xd1, 0x47, 0xe4, 0x5a, 0x1f,
+	0xdf, 0x12, 0x27, 0xdc, 0x7b, 0x23, 0x74, 0x07, 0x11, 0xf8, 0x2d, 0xc6, 0x1f, 0x46, 0xa7, 0xbb,
+	0xa1, 0x61, 0xe0, 0xdb, 0xc1, 0x6e, 0x68, 0xca, 0x66, 0xed, 0xac, 0xbb, 0xba, 0xae, 0x5e, 0x09,
+	0x29, 0x43, 0x09, 0xdf, 0x21, 0x9d, 0x85, 0x70, 0x47, 0x79, 0x77, 0x7a, 0x1c, 0x9e, 0xa4, 0x93,
+	0x79, 0x5b, 0xf3, 0x2e, 0xdc, 0xc5, 0x9a, 0x56, 0x30, 0xf4, 0x1e, 0x99, 0x75, 0x59, 0x97, 0x4b,
+	0x06, 0xdf, 0x63, 0x27, 0xc3, 0xa0, 0x93, 0x1b, 0x88, 0xa7, 0xbb, 0xb4, 0x95, 0xe8, 0xe8, 0x1d,
+	0x32, 0xdd, 0x36, 0x75, 0xbb, 0x87, 0xfa, 0xab, 0x03, 0x3d, 0x76, 0x41, 0xaa, 0x46, 0x0d, 0xfd,
+	0x8e, 0xcc, 0xfa, 0x66, 0xcb, 0x55, 0xf0, 0xc3, 0xca, 0xf9, 0xb5, 0x85, 0xf5, 0x2b, 0x03, 0xf5,
+	0x8e, 0xc1, 0x77, 0x8f, 0xff, 0xc9, 0x5d, 0x6d, 0x25, 0x22, 0xb3, 0xd4, 0x1c, 0x6c, 0xd7, 0xbb,
+	0xc7, 0x82, 0x07, 0x1a, 0xf9, 0x23, 0x58, 0x8f, 0x37, 0xd7, 0x02, 0x5c, 0x56, 0x36, 0xe1, 0x7e,
+	0x95, 0xb2, 0x59, 0x56, 0x3a, 0xf0, 0xa0, 0x4a, 0xe9, 0x98, 0x61, 0xb6, 0x43, 0x5f, 0x78, 0x58,
+	0xcc, 0xec, 0x31, 0xbf, 0x43, 0x79, 0x25, 0x37, 0xc4, 0xd3, 0x84, 0x87, 0x43, 0x3d, 0xcd, 0x21,
+	0x1e, 0x07, 0x7e, 0x3f, 0xd4, 0xe3, 0xd0, 0x97, 0xe4, 0xda, 0x00, 0x7f, 0xc5, 0x34, 0x97, 0x82,
+	0xf9, 0xf1, 0xe4, 0x78, 0x54, 0x31, 0x39, 0xee, 0x5b, 0xc3, 0xe4, 0x66, 0x82, 0x0d, 0xa8, 0xa4,
+	0x03, 0xe0, 0x0f, 0x95, 0x31, 0xca, 0xc2, 0xbc, 0x7b, 0x53, 0xb4, 0x5a, 0x3d, 0xc5, 0xe1, 0xf1,
+	0x28, 0x77, 0x22, 0xa4, 0x4f, 0x09, 0x1d, 0x80, 0x76, 0xc4, 0xdd, 0x9e, 0xcf, 0x24, 0xfc, 0xb1,
+	0xd2, 0x5e, 0xa1, 0xa4, 0x4f, 0xc8, 0xf5, 0x52, 0x95, 0x6c, 0x2d, 0x79, 0xd0, 0xd6, 0x1d, 0x78,
+	0x82, 0xdd, 0x37, 0x5c, 0x90, 0x77, 0x27, 0x55, 0xea, 0xbb, 0xff, 0x54, 0x74, 0x17, 0x04, 0x66,
+	0xf1, 0x2c, 0xd7, 0xa8, 0x6f, 0x7f, 0x8a, 0xf6, 0x33, 0x14, 0xf4, 0x35, 0x59, 0x1d, 0x32, 0x24,
+	0x99, 0xa5, 0x1d, 0xfe, 0x8c, 0x33, 0x7a, 0x0c, 0x25, 0xdd, 0x20, 0x37, 0x4b, 0x4d, 0xcd, 0x46,
+	0x7a, 0x86, 0x91, 0xce, 0xd4, 0xe4, 0x63, 0x24, 0x0d, 0xce, 0xc6, 0xa8, 0x17, 0x63, 0x94, 0x35,
+	0x74, 0x93, 0xdc, 0x2a, 0xb7, 0x3a, 0x1b, 0x64, 0x03, 0x83, 0x9c, 0x2d, 0x32, 0xdb, 0xed, 0x26,
+	0x6f, 0x71, 0x29, 0xb9, 0xb7, 0xcf, 0x95, 0x36, 0xa7, 0x0f, 0x68, 0xc4, 0xc7, 0x9d, 0x22, 0x6e,
+	0x96, 0xb8, 0x2c, 0xb6, 0x13, 0x2f, 0x30, 0x9b, 0xa8, 0xae, 0x60, 0xe8, 0x4b, 0xf2, 0x79, 0x8a,
+	0x5a, 0x2c, 0xf0, 0xc2, 0xae, 0xf8, 0x95, 0x23, 0xb5, 0x17, 0xaa, 0xf8, 0x5e, 0x02, 0xcf, 0xd1,
+	0x3c, 0x4a, 0x66, 0x66, 0x2e, 0x22, 0x22, 0x68, 0xef, 0x31, 0xa5, 0xcc, 0x91, 0x14, 0x6b, 0xba,
+	0x15, 0x6f, 0x04, 0x55, 0x1c, 0x7d, 0x4c, 0xa0, 0x5c, 0xa7, 0xd7, 0xbd, 0xee, 0x31, 0x97, 0xf0,
+	0x02, 0x7d, 0x43, 0x79, 0xb3, 0x89, 0xa4, 0x5c, 0x31, 0xdb, 0x5f, 0x62, 0xc2, 0x0d, 0xa3, 0xe3,
+	0x3e, 0x3a, 0xee, 0xb5, 0xed, 0x0e, 0xf3, 0xc2, 0xf7, 0xfb, 0xfc, 0x44, 0xf7, 0x24, 0x87, 0xed,
+	0xb4, 0x8f, 0x8a, 0x8c, 0x39, 0x1a, 0x36, 0x64, 0xa8, 0x94, 0x1d, 0x2f, 0x38, 0x78, 0xf5, 0x30,
+	0x57, 0x1e, 0xf8, 0x11, 0x2d, 0xd5, 0xa4, 0x99, 0xcf, 0x59, 0xe2, 0x75, 0x28, 0xbb, 0xcc, 0x87,
+	0x9f, 0xaa, 0xe7, 0x73, 0x59, 0x69, 0x6a, 0x99, 0x45, 0x77, 0x5b, 0x2d, 0xc5, 0x35, 0xec, 0xc4,
+	0x07, 0x8a, 0x32, 0x43, 0xbf, 0x25, 0x97, 0xb2, 0x68, 0x23, 0xec, 0x05, 0x1a, 0x5e, 0x61, 0x27,
+	0x96, 0x09, 0x73, 0x2c, 0xcb, 0x55, 0x3b, 0x62, 0xae, 0x39, 0x5f, 0xbe, 0xc6, 0xf0, 0x55, 0x54,
+	0xb1, 0x17, 0xb0, 0x91, 0x66, 0x53, 0x87, 0x5d, 0xf4, 0x54, 0x93, 0xf1, 0x25, 0xce, 0x74, 0x66,
+	0x83, 0x29, 0x97, 0x79, 0x3c, 0xae, 0xd6, 0x5e, 0xb2, 0xe5, 0x96, 0x18, 0x93, 0x11, 0x39, 0xd4,
+	0x8e, 0x7c, 0xa1, 0x77, 0x58, 0xf7, 0xd8, 0x63, 0xf0, 0x33, 0x7e, 0x68, 0x28, 0x5f, 0xfa, 0xd6,
+	0x86, 0xcf, 0x03, 0x0f, 0xac, 0xb8, 0xc7, 0xca, 0x8c, 0xe9, 0x83, 0x18, 0xc5, 0x31, 0x4f, 0x28,
+	0x05, 0x76, 0x7c, 0x34, 0xad, 0xa0, 0x4c, 0x1f, 0xec, 0x85, 0x4a, 0xef, 0xc9, 0xd0, 0xe5, 0x4a,
+	0x89, 0xa0, 0xfd, 0x3c, 0x60, 0xc7, 0x3e, 0xf7, 0x60, 0x3f, 0xce, 0x84, 0x4a, 0xd2, 0x7c, 0x27,
+	0x4f, 0x6c, 0xf8, 0x61, 0xd8, 0x85, 0x83, 0xf8, 0x3b, 0x15, 0x94, 0x39, 0x02, 0x57, 0xc0, 0xfd,
+	0xfc, 0x3e, 0xc4, 0x26, 0x9d, 0x25, 0x31, 0xf3, 0xba, 0x82, 0xde, 0x12, 0xbe, 0xe6, 0x32, 0x39,
+	0x9b, 0xbe, 0xc1, 0x28, 0xa3, 0x64, 0x66, 0x67, 0xc8, 0x4b, 0xf6, 0xc3, 0x80, 0xbf, 0x62, 0x51,
+	0x64, 0xf2, 0xe5, 0x08, 0xdb, 0x30, 0x5c, 0x40, 0x77, 0xc8, 0x17, 0x43, 0xc9, 0xdd, 0x88, 0x4b,
+	0xa6, 0x43, 0x09, 0x4d, 0x4c, 0x87, 0xd1, 0x42, 0x73, 0x84, 0x2d, 0x74, 0xf1, 0x49, 0x14, 0x2a,
+	0x33, 0x7b, 0x1d, 0x6c, 0xcc, 0x10, 0xd6, 0xec, 0x4f, 0x79, 0x06, 0x97, 0xd7, 0x17, 0x92, 0xe1,
+	0xa5, 0xea, 0x2f, 0xf1, 0xe1, 0x7e, 0xb8, 0xc2, 0xac, 0xe3, 0xc3, 0xd9, 0x9d, 0x83, 0x7d, 0xf8,
+	0x2b, 0xde, 0x53, 0xcf, 0x16, 0xd1, 0x3d, 0xf2, 0xe5, 0x70, 0xc1, 0x76, 0xa0, 0x79, 0xa0, 0x84,
+	0x3e, 0x85, 0xbf, 0x61, 0x53, 0xc6, 0x91, 0x96, 0xfb, 0xe3, 0x50, 0xb4, 0x03, 0xae, 0x35, 0x87,
+	0xbf, 0xc7, 0x47, 0xfa, 0x6a, 0xb6, 0x9c, 0x1d, 0x29, 0x33, 0xa8, 0xc5, 0xdb, 0xaa, 0xec, 0x28,
+	0xc9, 0xe8, 0x8f, 0x64, 0xa5, 0x5a, 0x62, 0x77, 0xc3, 0x50, 0x77, 0xf0, 0x6a, 0xf8, 0x0f, 0x0c,
+	0x35, 0x52, 0x67, 0xe6, 0x6f, 0x5e, 0xb3, 0x75, 0x54, 0xaf, 0x03, 0x8b, 0xd7, 0xe5, 0x32, 0xb3,
+	0xfa, 0xbf, 0x19, 0xb2, 0x94, 0x3f, 0x87, 0xd3, 0x87, 0x64, 0x29, 0x3e, 0x89, 0xa7, 0xfb, 0x12,
+	0x4c, 0x55, 0x2e, 0xb8, 0x05, 0x15, 0xfd, 0x86, 0xd4, 0xcc, 0xc5, 0xfe, 0xed, 0xf3, 0x53, 0x0e,
+	0xe7, 0x2a, 0x1d, 0x73, 0x86, 0x7f, 0x7e, 0xca, 0xe9, 0xf7, 0x64, 0x01, 0xa5, 0x0d, 0x1e, 0x68,
+	0x2e, 0xe1, 0x7c, 0xa5, 0x9a, 0x18, 0x49, 0xac, 0xa0, 0x5f, 0x13, 0xf4, 0xbe, 0x3d, 0x88, 0x60,
+	0xba, 0x52, 0x3c, 0x6b, 0xe8, 0x83, 0x88, 0x3e, 0x22, 0xf3, 0x51, 0x52, 0xa1, 0x23, 0x7c, 0x79,
+	0x5b, 0x58, 0x5f, 0x1e, 0x48, 0xe3, 0xeb, 0x40, 0x23, 0x0c, 0xa5, 0x27, 0x02, 0xa6, 0xb9, 0x35,
+	0x10, 0x67, 0x9d, 0x4d, 0x98, 0x1d, 0xdf, 0xd9, 0xcc, 0x3a, 0x1d, 0x98, 0x1b, 0xdf, 0xe9, 0xd0,
+	0x07, 0x64, 0x4e, 0x86, 0x9a, 0x69, 0x7e, 0x04, 0xb5, 0x91, 0xbe, 0x54, 0x3a, 0x70, 0x35, 0x61,
+	0x7e, 0x5c, 0x57, 0x73, 0xe0, 0x72, 0x80, 0x8c, 0xeb, 0x72, 0xe8, 0x33, 0x72, 0x21, 0xfe, 0x19,
+	0x0f, 0x44, 0xfc, 0xe6, 0x77, 0xb6, 0x37, 0x6f, 0x28, 0x46, 0x88, 0x5f, 0x05, 0x3f, 0x20, 0x42,
+	0xb3, 0x18, 0x21, 0x7e, 0x37, 0xfc, 0x80, 0x08, 0xce, 0xea, 0x7f, 0xa7, 0xc9, 0x62, 0xf6, 0xf6,
+	0x68, 0xee, 0xb9, 0x2c, 0x73, 0xcf, 0x8d, 0x1f, 0x85, 0xb3, 0x90, 0xb9, 0x25, 0xb7, 0x93, 0x2b,
+	0x37, 0x66, 0xf3, 0x8c, 0xd5, 0x2f, 0xe7, 0x93, 0xec, 0xfc, 0xc4, 0x49, 0x36, 0x3d, 0x71, 0x92,
+	0xcd, 0x4c, 0x98, 0x64, 0xb3, 0x13, 0x25, 0xd9, 0xdc, 0x44, 0x49, 0x56, 0x1b, 0x3f, 0xc9, 0xd6,
+	0xc9, 0xac, 0x72, 0x99, 0xcf, 0x8f, 0xc6, 0xc8, 0xe7, 0x44, 0xd9, 0xf7, 0x34, 0xc7, 0xc8, 0xe6,
+	0x44, 0xd9, 0xf7, 0x38, 0x63, 0x64, 0x71, 0xa2, 0x34, 0xaf, 0xec, 0x5a, 0xb2, 0x40, 0x45, 0x4c,
+	0xf2, 0xc0, 0x3d, 0x4d, 0xde, 0xb4, 0x73, 0xd8, 0xea, 0xbf, 0x17, 0xc9, 0x42, 0xe6, 0xb9, 0x81,
+	0x5e, 0x26, 0x33, 0x5a, 0x68, 0x3f, 0xfe, 0x67, 0xc3, 0xbc, 0x15, 0x17, 0x4c, 0xce, 0x79, 0x5c,
+	0xb9, 0x52, 0x44, 0xb8, 0xa8, 0x9e, 0x43, 0x2e, 0x0b, 0x51, 0x4a, 0xa6, 0xf5, 0x69, 0xc4, 0x31,
+	0xa5, 0x66, 0x2c, 0xfc, 0x6d, 0xde, 0x5e, 0x55, 0x27, 0x7c, 0xbf, 0xc3, 0xba, 0x51, 0x1c, 0x1d,
+	0xd3, 0xa6, 0x66, 0x15, 0x50, 0x73, 0x74, 0x4d, 0x91, 0xfe, 0xb5, 0x1e, 0xf3, 0xa4, 0x66, 0x95,
+	0x09, 0xf3, 0x0e, 0x6f, 0xc0, 0xed, 0xc0, 0xbc, 0xbe, 0x61, 0x5a, 0xd4, 0xac, 0x0c, 0x92, 0xcf,
+	0xf0, 0xb9, 0x89, 0x33, 0xbc, 0x36, 0x71, 0x86, 0xcf, 0x7f, 0x48, 0x86, 0x3f, 0x26, 0xc4, 0x1b,
+	0x3c, 0xa7, 0x8c, 0xce, 0x87, 0x8c, 0x3a, 0xe7, 0x6d, 0x8e, 0x91, 0x17, 0x19, 0x75, 0xce, 0xeb,
+	0x8c, 0xb1, 0xae, 0x65, 0xd4, 0x99, 0x9c, 0xbf, 0x30, 0x41, 0xce, 0x2f, 0x4d, 0x90, 0xf3, 0x9f,
+	0x8c, 0x9d, 0xf3, 0x99, 0x15, 0xe3, 0xe2, 0x44, 0x2b, 0xc6, 0xa5, 0x89, 0x56, 0x0c, 0xfa, 0x11,
+	0xdb, 0xd2, 0xa7, 0x1f, 0xbd, 0x2d, 0x5d, 0xfe, 0xe8, 0x6d, 0xe9, 0xca, 0x07, 0x6e, 0x4b, 0xf4,
+	0x07, 0x32, 0xc7, 0x92, 0x07, 0xb2, 0xab, 0xe8, 0xbd, 0x36, 0xf0, 0xe6, 0xde, 0x65, 0xac, 0x54,
+	0x67, 0x2c, 0x5e, 0xf2, 0x2a, 0x76, 0x6d, 0x84, 0x25, 0xd1, 0xd1, 0xfb, 0xa4, 0xa6, 0xd2, 0xa7,
+	0x30, 0x38, 0xdb, 0xd3, 0x17, 0x9a, 0x61, 0xf1, 0x1b, 0x3d, 0xbd, 0xdb, 0x6a, 0xc1, 0xf5, 0x91,
+	0xcd, 0x4a, 0xa5, 0xf4, 0x29, 0x59, 0xf4, 0x77, 0x7b, 0x9a, 0xcb, 0xc4, 0xba, 0x3c, 0xd2, 0x9a,
+	0xd3, 0x9b, 0x25, 0xc0, 0x6f, 0x84, 0x81, 0xd2, 0x2c, 0xd0, 0x70, 0x63, 0xa4, 0x79, 0x20, 0xc6,
+	0xfa, 0xee, 0x88, 0x80, 0x33, 0x09, 0x37, 0x47, 0xfa, 0x52, 0xa9, 0x99, 0xc0, 0xfe, 0xcf, 0x3d,
+	0xe6, 0x49, 0xa6, 0x85, 0x0b, 0xb7, 0x46, 0x1a, 0x33, 0x6a, 0xb3, 0x9c, 0xbb, 0x4c, 0xe9, 0xf8,
+	0x8a, 0xab, 0xf0, 0x7f, 0x45, 0x35, 0x2b, 0x0b, 0xfd, 0x7f, 0x00, 0xb5, 0xbd, 0xde, 0xfd, 0xc5,
+	0x1e, 0x00, 0x00,
 }
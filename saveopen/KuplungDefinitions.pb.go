@@ -21,8 +21,8 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type Vec2 struct {
-	X                    *float32 `protobuf:"fixed32,1,req,name=x" json:"x,omitempty"`
-	Y                    *float32 `protobuf:"fixed32,2,req,name=y" json:"y,omitempty"`
+	X                    *float32 `protobuf:"fixed32,1,opt,name=x" json:"x,omitempty"`
+	Y                    *float32 `protobuf:"fixed32,2,opt,name=y" json:"y,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -68,9 +68,9 @@ func (m *Vec2) GetY() float32 {
 }
 
 type Vec3 struct {
-	X                    *float32 `protobuf:"fixed32,1,req,name=x" json:"x,omitempty"`
-	Y                    *float32 `protobuf:"fixed32,2,req,name=y" json:"y,omitempty"`
-	Z                    *float32 `protobuf:"fixed32,3,req,name=z" json:"z,omitempty"`
+	X                    *float32 `protobuf:"fixed32,1,opt,name=x" json:"x,omitempty"`
+	Y                    *float32 `protobuf:"fixed32,2,opt,name=y" json:"y,omitempty"`
+	Z                    *float32 `protobuf:"fixed32,3,opt,name=z" json:"z,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -123,10 +123,10 @@ func (m *Vec3) GetZ() float32 {
 }
 
 type Vec4 struct {
-	X                    *float32 `protobuf:"fixed32,1,req,name=x" json:"x,omitempty"`
-	Y                    *float32 `protobuf:"fixed32,2,req,name=y" json:"y,omitempty"`
-	Z                    *float32 `protobuf:"fixed32,3,req,name=z" json:"z,omitempty"`
-	W                    *float32 `protobuf:"fixed32,4,req,name=w" json:"w,omitempty"`
+	X                    *float32 `protobuf:"fixed32,1,opt,name=x" json:"x,omitempty"`
+	Y                    *float32 `protobuf:"fixed32,2,opt,name=y" json:"y,omitempty"`
+	Z                    *float32 `protobuf:"fixed32,3,opt,name=z" json:"z,omitempty"`
+	W                    *float32 `protobuf:"fixed32,4,opt,name=w" json:"w,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -186,8 +186,8 @@ func (m *Vec4) GetW() float32 {
 }
 
 type ObjectCoordinate struct {
-	Animate              *bool    `protobuf:"varint,1,req,name=animate" json:"animate,omitempty"`
-	Point                *float32 `protobuf:"fixed32,2,req,name=point" json:"point,omitempty"`
+	Animate              *bool    `protobuf:"varint,1,opt,name=animate" json:"animate,omitempty"`
+	Point                *float32 `protobuf:"fixed32,2,opt,name=point" json:"point,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -233,10 +233,10 @@ func (m *ObjectCoordinate) GetPoint() float32 {
 }
 
 type MaterialColor struct {
-	ColorPickerOpen      *bool    `protobuf:"varint,1,req,name=colorPickerOpen" json:"colorPickerOpen,omitempty"`
-	Animate              *bool    `protobuf:"varint,2,req,name=animate" json:"animate,omitempty"`
-	Strength             *float32 `protobuf:"fixed32,3,req,name=strength" json:"strength,omitempty"`
-	Color                *Vec3    `protobuf:"bytes,4,req,name=color" json:"color,omitempty"`
+	ColorPickerOpen      *bool    `protobuf:"varint,1,opt,name=colorPickerOpen" json:"colorPickerOpen,omitempty"`
+	Animate              *bool    `protobuf:"varint,2,opt,name=animate" json:"animate,omitempty"`
+	Strength             *float32 `protobuf:"fixed32,3,opt,name=strength" json:"strength,omitempty"`
+	Color                *Vec3    `protobuf:"bytes,4,opt,name=color" json:"color,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -296,11 +296,11 @@ func (m *MaterialColor) GetColor() *Vec3 {
 }
 
 type MeshMaterialTextureImage struct {
-	Filename             *string  `protobuf:"bytes,1,req,name=Filename" json:"Filename,omitempty"`
-	Image                *string  `protobuf:"bytes,2,req,name=Image" json:"Image,omitempty"`
-	Width                *int32   `protobuf:"varint,3,req,name=Width" json:"Width,omitempty"`
-	Height               *int32   `protobuf:"varint,4,req,name=Height" json:"Height,omitempty"`
-	UseTexture           *bool    `protobuf:"varint,5,req,name=UseTexture" json:"UseTexture,omitempty"`
+	Filename             *string  `protobuf:"bytes,1,opt,name=Filename" json:"Filename,omitempty"`
+	Image                *string  `protobuf:"bytes,2,opt,name=Image" json:"Image,omitempty"`
+	Width                *int32   `protobuf:"varint,3,opt,name=Width" json:"Width,omitempty"`
+	Height               *int32   `protobuf:"varint,4,opt,name=Height" json:"Height,omitempty"`
+	UseTexture           *bool    `protobuf:"varint,5,opt,name=UseTexture" json:"UseTexture,omitempty"`
 	Commands             []string `protobuf:"bytes,6,rep,name=Commands" json:"Commands,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -375,23 +375,23 @@ func (m *MeshMaterialTextureImage) GetCommands() []string {
 }
 
 type MeshModelMaterial struct {
-	MaterialID           *int32                    `protobuf:"varint,1,req,name=MaterialID" json:"MaterialID,omitempty"`
-	MaterialTitle        *string                   `protobuf:"bytes,2,req,name=MaterialTitle" json:"MaterialTitle,omitempty"`
-	AmbientColor         *Vec3                     `protobuf:"bytes,3,req,name=AmbientColor" json:"AmbientColor,omitempty"`
-	DiffuseColor         *Vec3                     `protobuf:"bytes,4,req,name=DiffuseColor" json:"DiffuseColor,omitempty"`
-	SpecularColor        *Vec3                     `protobuf:"bytes,5,req,name=SpecularColor" json:"SpecularColor,omitempty"`
-	EmissionColor        *Vec3                     `protobuf:"bytes,6,req,name=EmissionColor" json:"EmissionColor,omitempty"`
-	SpecularExp          *float32                  `protobuf:"fixed32,7,req,name=SpecularExp" json:"SpecularExp,omitempty"`
-	Transparency         *float32                  `protobuf:"fixed32,8,req,name=Transparency" json:"Transparency,omitempty"`
-	IlluminationMode     *uint32                   `protobuf:"fixed32,9,req,name=IlluminationMode" json:"IlluminationMode,omitempty"`
-	OpticalDensity       *float32                  `protobuf:"fixed32,10,req,name=OpticalDensity" json:"OpticalDensity,omitempty"`
-	TextureAmbient       *MeshMaterialTextureImage `protobuf:"bytes,11,req,name=TextureAmbient" json:"TextureAmbient,omitempty"`
-	TextureDiffuse       *MeshMaterialTextureImage `protobuf:"bytes,12,req,name=TextureDiffuse" json:"TextureDiffuse,omitempty"`
-	TextureSpecular      *MeshMaterialTextureImage `protobuf:"bytes,13,req,name=TextureSpecular" json:"TextureSpecular,omitempty"`
-	TextureSpecularExp   *MeshMaterialTextureImage `protobuf:"bytes,14,req,name=TextureSpecularExp" json:"TextureSpecularExp,omitempty"`
-	TextureDissolve      *MeshMaterialTextureImage `protobuf:"bytes,15,req,name=TextureDissolve" json:"TextureDissolve,omitempty"`
-	TextureBump          *MeshMaterialTextureImage `protobuf:"bytes,16,req,name=TextureBump" json:"TextureBump,omitempty"`
-	TextureDisplacement  *MeshMaterialTextureImage `protobuf:"bytes,17,req,name=TextureDisplacement" json:"TextureDisplacement,omitempty"`
+	MaterialID           *int32                    `protobuf:"varint,1,opt,name=MaterialID" json:"MaterialID,omitempty"`
+	MaterialTitle        *string                   `protobuf:"bytes,2,opt,name=MaterialTitle" json:"MaterialTitle,omitempty"`
+	AmbientColor         *Vec3                     `protobuf:"bytes,3,opt,name=AmbientColor" json:"AmbientColor,omitempty"`
+	DiffuseColor         *Vec3                     `protobuf:"bytes,4,opt,name=DiffuseColor" json:"DiffuseColor,omitempty"`
+	SpecularColor        *Vec3                     `protobuf:"bytes,5,opt,name=SpecularColor" json:"SpecularColor,omitempty"`
+	EmissionColor        *Vec3                     `protobuf:"bytes,6,opt,name=EmissionColor" json:"EmissionColor,omitempty"`
+	SpecularExp          *float32                  `protobuf:"fixed32,7,opt,name=SpecularExp" json:"SpecularExp,omitempty"`
+	Transparency         *float32                  `protobuf:"fixed32,8,opt,name=Transparency" json:"Transparency,omitempty"`
+	IlluminationMode     *uint32                   `protobuf:"fixed32,9,opt,name=IlluminationMode" json:"IlluminationMode,omitempty"`
+	OpticalDensity       *float32                  `protobuf:"fixed32,10,opt,name=OpticalDensity" json:"OpticalDensity,omitempty"`
+	TextureAmbient       *MeshMaterialTextureImage `protobuf:"bytes,11,opt,name=TextureAmbient" json:"TextureAmbient,omitempty"`
+	TextureDiffuse       *MeshMaterialTextureImage `protobuf:"bytes,12,opt,name=TextureDiffuse" json:"TextureDiffuse,omitempty"`
+	TextureSpecular      *MeshMaterialTextureImage `protobuf:"bytes,13,opt,name=TextureSpecular" json:"TextureSpecular,omitempty"`
+	TextureSpecularExp   *MeshMaterialTextureImage `protobuf:"bytes,14,opt,name=TextureSpecularExp" json:"TextureSpecularExp,omitempty"`
+	TextureDissolve      *MeshMaterialTextureImage `protobuf:"bytes,15,opt,name=TextureDissolve" json:"TextureDissolve,omitempty"`
+	TextureBump          *MeshMaterialTextureImage `protobuf:"bytes,16,opt,name=TextureBump" json:"TextureBump,omitempty"`
+	TextureDisplacement  *MeshMaterialTextureImage `protobuf:"bytes,17,opt,name=TextureDisplacement" json:"TextureDisplacement,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
 	XXX_sizecache        int32                     `json:"-"`
@@ -542,16 +542,16 @@ func (m *MeshModelMaterial) GetTextureDisplacement() *MeshMaterialTextureImage {
 }
 
 type Mesh struct {
-	ID                      *int32             `protobuf:"varint,1,req,name=ID" json:"ID,omitempty"`
-	File                    *string            `protobuf:"bytes,2,req,name=File" json:"File,omitempty"`
-	FilePath                *string            `protobuf:"bytes,3,req,name=FilePath" json:"FilePath,omitempty"`
-	ModelTitle              *string            `protobuf:"bytes,4,req,name=ModelTitle" json:"ModelTitle,omitempty"`
-	MaterialTitle           *string            `protobuf:"bytes,5,req,name=MaterialTitle" json:"MaterialTitle,omitempty"`
-	CountVertices           *int32             `protobuf:"varint,6,req,name=countVertices" json:"countVertices,omitempty"`
-	CountTextureCoordinates *int32             `protobuf:"varint,7,req,name=countTextureCoordinates" json:"countTextureCoordinates,omitempty"`
-	CountNormals            *int32             `protobuf:"varint,8,req,name=countNormals" json:"countNormals,omitempty"`
-	CountIndices            *int32             `protobuf:"varint,9,req,name=countIndices" json:"countIndices,omitempty"`
-	ModelMaterial           *MeshModelMaterial `protobuf:"bytes,10,req,name=ModelMaterial" json:"ModelMaterial,omitempty"`
+	ID                      *int32             `protobuf:"varint,1,opt,name=ID" json:"ID,omitempty"`
+	File                    *string            `protobuf:"bytes,2,opt,name=File" json:"File,omitempty"`
+	FilePath                *string            `protobuf:"bytes,3,opt,name=FilePath" json:"FilePath,omitempty"`
+	ModelTitle              *string            `protobuf:"bytes,4,opt,name=ModelTitle" json:"ModelTitle,omitempty"`
+	MaterialTitle           *string            `protobuf:"bytes,5,opt,name=MaterialTitle" json:"MaterialTitle,omitempty"`
+	CountVertices           *int32             `protobuf:"varint,6,opt,name=countVertices" json:"countVertices,omitempty"`
+	CountTextureCoordinates *int32             `protobuf:"varint,7,opt,name=countTextureCoordinates" json:"countTextureCoordinates,omitempty"`
+	CountNormals            *int32             `protobuf:"varint,8,opt,name=countNormals" json:"countNormals,omitempty"`
+	CountIndices            *int32             `protobuf:"varint,9,opt,name=countIndices" json:"countIndices,omitempty"`
+	ModelMaterial           *MeshModelMaterial `protobuf:"bytes,10,opt,name=ModelMaterial" json:"ModelMaterial,omitempty"`
 	Vertices                []*Vec3            `protobuf:"bytes,11,rep,name=vertices" json:"vertices,omitempty"`
 	TextureCoordinates      []*Vec2            `protobuf:"bytes,12,rep,name=texture_coordinates,json=textureCoordinates" json:"texture_coordinates,omitempty"`
 	Normals                 []*Vec3            `protobuf:"bytes,13,rep,name=normals" json:"normals,omitempty"`
@@ -698,55 +698,55 @@ func init() {
 func init() { proto.RegisterFile("KuplungDefinitions.proto", fileDescriptor_caea9955171986a8) }
 
 var fileDescriptor_caea9955171986a8 = []byte{
-	// 790 bytes of a gzipped FileDescriptorProto
+	// 785 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x6e, 0x2b, 0x35,
 	0x10, 0x56, 0x7e, 0xb6, 0x49, 0x26, 0x3f, 0xed, 0xf1, 0x41, 0x60, 0x81, 0x84, 0xa2, 0x55, 0x85,
-	0xa2, 0x73, 0x51, 0xa1, 0x70, 0x2e, 0xb8, 0x02, 0x9d, 0x26, 0x45, 0x04, 0x28, 0xad, 0x4c, 0x28,
-	0x97, 0xc8, 0xdd, 0xb8, 0xa9, 0x61, 0xd7, 0x5e, 0xad, 0xbd, 0x6d, 0xd2, 0xf7, 0xe0, 0x1d, 0x78,
-	0x02, 0x5e, 0x8a, 0x97, 0x38, 0xb2, 0xd7, 0x9b, 0xec, 0x26, 0xdb, 0x2a, 0xbd, 0xdb, 0xef, 0xf3,
-	0x7c, 0x9f, 0x67, 0xc6, 0x99, 0x09, 0xe0, 0x9f, 0xd3, 0x38, 0x4c, 0xc5, 0x72, 0xca, 0xee, 0xb8,
-	0xe0, 0x9a, 0x4b, 0xa1, 0xce, 0xe2, 0x44, 0x6a, 0x89, 0xda, 0x8a, 0x3e, 0x30, 0x19, 0x33, 0xe1,
-	0xfb, 0xd0, 0xbc, 0x61, 0xc1, 0x18, 0xf5, 0xa0, 0xb6, 0xc2, 0xb5, 0x61, 0x7d, 0x54, 0x27, 0xb5,
-	0x95, 0x41, 0x6b, 0x5c, 0xcf, 0xd0, 0xda, 0xff, 0xda, 0xc6, 0x7c, 0xf3, 0x52, 0x8c, 0x41, 0x4f,
-	0xb8, 0x91, 0xa1, 0x27, 0xff, 0x3b, 0xab, 0x78, 0x7f, 0xb8, 0xc2, 0xa0, 0x47, 0xdc, 0xcc, 0xd0,
-	0xa3, 0x7f, 0x0e, 0x27, 0x57, 0xb7, 0x7f, 0xb1, 0x40, 0x4f, 0xa4, 0x4c, 0x16, 0x5c, 0x50, 0xcd,
-	0x10, 0x86, 0x16, 0x15, 0x3c, 0xa2, 0x9a, 0x59, 0xc7, 0x36, 0xc9, 0x21, 0xfa, 0x04, 0xbc, 0x58,
-	0x72, 0xa1, 0x9d, 0x77, 0x06, 0xfc, 0x7f, 0x6a, 0xd0, 0xbf, 0xa4, 0x9a, 0x25, 0x9c, 0x86, 0x13,
-	0x19, 0xca, 0x04, 0x8d, 0xe0, 0x38, 0x30, 0x1f, 0xd7, 0x3c, 0xf8, 0x9b, 0x25, 0x57, 0x31, 0x13,
-	0xce, 0x69, 0x97, 0x2e, 0xde, 0x55, 0x2f, 0xdf, 0xf5, 0x39, 0xb4, 0x95, 0x4e, 0x98, 0x58, 0xea,
-	0x7b, 0x97, 0xfc, 0x06, 0xa3, 0x53, 0xf0, 0xac, 0x91, 0xad, 0xa3, 0x3b, 0x1e, 0x9c, 0xe5, 0x5d,
-	0x3e, 0x33, 0xed, 0x23, 0xd9, 0xa1, 0xff, 0x5f, 0x0d, 0xf0, 0x25, 0x53, 0xf7, 0x79, 0x6e, 0x73,
-	0xb6, 0xd2, 0x69, 0xc2, 0x66, 0x11, 0x5d, 0x5a, 0xfb, 0x1f, 0x78, 0xc8, 0x04, 0x8d, 0xb2, 0x2a,
-	0x3b, 0x64, 0x83, 0x4d, 0x99, 0x36, 0xc8, 0xa6, 0xd4, 0x21, 0x19, 0x30, 0xec, 0x1f, 0x7c, 0xe1,
-	0xb2, 0xf1, 0x48, 0x06, 0xd0, 0xa7, 0x70, 0xf4, 0x23, 0xe3, 0xcb, 0x7b, 0x6d, 0x73, 0xf1, 0x88,
-	0x43, 0xe8, 0x4b, 0x80, 0xdf, 0x15, 0x73, 0x57, 0x62, 0xcf, 0xd6, 0x56, 0x60, 0xcc, 0xfd, 0x13,
-	0x19, 0x45, 0x54, 0x2c, 0x14, 0x3e, 0x1a, 0x36, 0xcc, 0xfd, 0x39, 0xf6, 0xff, 0x6f, 0xc1, 0x1b,
-	0x9b, 0xb8, 0x5c, 0xb0, 0x30, 0xcf, 0xde, 0x38, 0xe6, 0xdf, 0xb3, 0xa9, 0xcd, 0xd9, 0x23, 0x05,
-	0x06, 0x9d, 0x6e, 0x5f, 0x61, 0xce, 0x75, 0x98, 0x67, 0x5f, 0x26, 0xd1, 0x18, 0x7a, 0x1f, 0xa2,
-	0x5b, 0xce, 0x84, 0xb6, 0x4f, 0x65, 0x8b, 0xd9, 0xef, 0x60, 0x29, 0xc6, 0x68, 0xa6, 0xfc, 0xee,
-	0x2e, 0x55, 0x6c, 0xf2, 0x42, 0xd7, 0x4b, 0x31, 0xe8, 0x3d, 0xf4, 0x7f, 0x8b, 0x59, 0x90, 0x86,
-	0x34, 0xc9, 0x44, 0x5e, 0xa5, 0xa8, 0x1c, 0x64, 0x54, 0x17, 0x11, 0x57, 0x8a, 0x4b, 0x91, 0xa9,
-	0x8e, 0xaa, 0x55, 0xa5, 0x20, 0x34, 0x84, 0x6e, 0x6e, 0x73, 0xb1, 0x8a, 0x71, 0xcb, 0xfe, 0x5a,
-	0x8a, 0x14, 0xf2, 0xa1, 0x37, 0x4f, 0xa8, 0x50, 0x31, 0x4d, 0x98, 0x08, 0xd6, 0xb8, 0x6d, 0x43,
-	0x4a, 0x1c, 0x7a, 0x07, 0x27, 0xb3, 0x30, 0x4c, 0x23, 0x33, 0x04, 0x5c, 0x0a, 0xd3, 0x7c, 0xdc,
-	0x19, 0xd6, 0x47, 0x2d, 0xb2, 0xc7, 0xa3, 0xaf, 0x60, 0x70, 0x15, 0x6b, 0x1e, 0xd0, 0x70, 0xca,
-	0x84, 0xe2, 0x7a, 0x8d, 0xc1, 0x3a, 0xee, 0xb0, 0xe8, 0x27, 0x18, 0xb8, 0x07, 0x77, 0x0d, 0xc5,
-	0x5d, 0x5b, 0x90, 0xbf, 0x2d, 0xe8, 0xb9, 0x5f, 0x28, 0xd9, 0x51, 0x16, 0xbc, 0x5c, 0xa3, 0x71,
-	0xef, 0xd5, 0x5e, 0x4e, 0x89, 0x7e, 0x81, 0x63, 0xc7, 0xe4, 0x5d, 0xc2, 0xfd, 0x83, 0xcd, 0x76,
-	0xa5, 0x88, 0x00, 0xda, 0xa1, 0xcc, 0x33, 0x0c, 0x0e, 0x36, 0xac, 0x50, 0x17, 0x32, 0x9c, 0x72,
-	0xa5, 0x64, 0xf8, 0xc0, 0xf0, 0xf1, 0xab, 0x33, 0xcc, 0xa5, 0x68, 0x0a, 0x5d, 0x47, 0x9d, 0xa7,
-	0x51, 0x8c, 0x4f, 0x0e, 0x76, 0x2a, 0xca, 0xd0, 0x1c, 0xde, 0x6e, 0x8d, 0xe3, 0x90, 0x06, 0x2c,
-	0x32, 0x4f, 0xfa, 0xe6, 0x60, 0xb7, 0x2a, 0xb9, 0xff, 0x6f, 0x13, 0x9a, 0x46, 0x81, 0x06, 0x50,
-	0xdf, 0x0c, 0x76, 0x7d, 0x36, 0x45, 0x08, 0x9a, 0x66, 0x25, 0xb9, 0x39, 0xb6, 0xdf, 0xf9, 0xda,
-	0xba, 0xa6, 0x6e, 0x0f, 0xb9, 0xb5, 0x65, 0xb0, 0x5d, 0x10, 0x66, 0x63, 0x64, 0xd3, 0xdf, 0xb4,
-	0xa7, 0x05, 0x66, 0x7f, 0x41, 0x78, 0x55, 0x0b, 0xe2, 0x14, 0xfa, 0x81, 0x4c, 0x85, 0xbe, 0x61,
-	0x89, 0xe6, 0x01, 0x53, 0x76, 0x04, 0x3d, 0x52, 0x26, 0xd1, 0xb7, 0xf0, 0x99, 0x25, 0x5c, 0x41,
-	0xdb, 0x7f, 0x0f, 0x65, 0xc7, 0xcf, 0x23, 0xcf, 0x1d, 0x9b, 0x51, 0xb4, 0x47, 0xbf, 0xca, 0x24,
-	0xa2, 0xa1, 0xb2, 0xa3, 0xe8, 0x91, 0x12, 0xb7, 0x89, 0x99, 0x89, 0x85, 0x4d, 0xa1, 0x53, 0x88,
-	0x71, 0x1c, 0xfa, 0x00, 0xfd, 0xd2, 0x7e, 0xb4, 0x13, 0xd8, 0x1d, 0x7f, 0xb1, 0xf3, 0x0c, 0xc5,
-	0x10, 0x52, 0x56, 0xa0, 0x77, 0xd0, 0x7e, 0xc8, 0xab, 0xec, 0x0e, 0x1b, 0x15, 0x8b, 0x66, 0x73,
-	0x8e, 0xbe, 0x87, 0xb7, 0x3a, 0x2b, 0xe6, 0xcf, 0xa0, 0x50, 0x6c, 0xaf, 0x42, 0x36, 0x26, 0x48,
-	0xef, 0xd7, 0x3d, 0x82, 0x96, 0x70, 0x25, 0xf7, 0x2b, 0xef, 0xca, 0x8f, 0xcd, 0x7f, 0x22, 0x77,
-	0x85, 0x0f, 0x86, 0x8d, 0x51, 0x8b, 0xe4, 0xf0, 0x63, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4b, 0xa7,
-	0x73, 0xac, 0x68, 0x08, 0x00, 0x00,
+	0xa2, 0x73, 0x51, 0xa1, 0x70, 0x2e, 0xb8, 0x02, 0xb5, 0x49, 0x2b, 0x02, 0x94, 0x56, 0x26, 0x94,
+	0x4b, 0xe4, 0x6e, 0xdc, 0xd4, 0xb0, 0x6b, 0xaf, 0xd6, 0xde, 0x36, 0xe9, 0x7b, 0xf0, 0x0e, 0x3c,
+	0x01, 0x2f, 0xc5, 0x4b, 0x20, 0x7b, 0xbd, 0xc9, 0x6e, 0xba, 0xad, 0xd2, 0xbb, 0xfd, 0x3e, 0xcf,
+	0xf7, 0x79, 0x66, 0x9c, 0x99, 0x00, 0xfe, 0x29, 0x8d, 0xc3, 0x54, 0x2c, 0xa7, 0xec, 0x8e, 0x0b,
+	0xae, 0xb9, 0x14, 0xea, 0x24, 0x4e, 0xa4, 0x96, 0xa8, 0xad, 0xe8, 0x03, 0x93, 0x31, 0x13, 0xbe,
+	0x0f, 0xcd, 0x1b, 0x16, 0x8c, 0x51, 0x0f, 0x6a, 0x2b, 0x5c, 0x1b, 0xd6, 0x46, 0x75, 0x52, 0x5b,
+	0x19, 0xb4, 0xc6, 0xf5, 0x0c, 0xad, 0xfd, 0xaf, 0x6d, 0xcc, 0x37, 0xaf, 0xc5, 0x18, 0xf4, 0x84,
+	0x1b, 0x19, 0x7a, 0xf2, 0xbf, 0xb3, 0x8a, 0x8f, 0xfb, 0x2b, 0x0c, 0x7a, 0xc4, 0xcd, 0x0c, 0x3d,
+	0xfa, 0x67, 0x70, 0x74, 0x75, 0xfb, 0x27, 0x0b, 0xf4, 0x44, 0xca, 0x64, 0xc1, 0x05, 0xd5, 0x0c,
+	0x61, 0x68, 0x51, 0xc1, 0x23, 0xaa, 0x99, 0x75, 0x6c, 0x93, 0x1c, 0xa2, 0x4f, 0xc0, 0x8b, 0x25,
+	0x17, 0xda, 0x79, 0x67, 0xc0, 0xff, 0xbb, 0x06, 0xfd, 0x4b, 0xaa, 0x59, 0xc2, 0x69, 0x38, 0x91,
+	0xa1, 0x4c, 0xd0, 0x08, 0x0e, 0x03, 0xf3, 0x71, 0xcd, 0x83, 0xbf, 0x58, 0x72, 0x15, 0x33, 0xe1,
+	0x9c, 0x76, 0xe9, 0xe2, 0x5d, 0xf5, 0xf2, 0x5d, 0x9f, 0x43, 0x5b, 0xe9, 0x84, 0x89, 0xa5, 0xbe,
+	0x77, 0xc9, 0x6f, 0x30, 0x3a, 0x06, 0xcf, 0x1a, 0xd9, 0x3a, 0xba, 0xe3, 0xc1, 0x49, 0xde, 0xe5,
+	0x13, 0xd3, 0x3e, 0x92, 0x1d, 0xfa, 0xff, 0xd6, 0x00, 0x5f, 0x32, 0x75, 0x9f, 0xe7, 0x36, 0x67,
+	0x2b, 0x9d, 0x26, 0x6c, 0x16, 0xd1, 0xa5, 0xb5, 0xbf, 0xe0, 0x21, 0x13, 0x34, 0xca, 0xaa, 0xec,
+	0x90, 0x0d, 0x36, 0x65, 0xda, 0x20, 0x9b, 0x52, 0x87, 0x64, 0xc0, 0xb0, 0xbf, 0xf3, 0x85, 0xcb,
+	0xc6, 0x23, 0x19, 0x40, 0x9f, 0xc2, 0xc1, 0x0f, 0x8c, 0x2f, 0xef, 0xb5, 0xcd, 0xc5, 0x23, 0x0e,
+	0xa1, 0x2f, 0x01, 0x7e, 0x53, 0xcc, 0x5d, 0x89, 0x3d, 0x5b, 0x5b, 0x81, 0x31, 0xf7, 0x4f, 0x64,
+	0x14, 0x51, 0xb1, 0x50, 0xf8, 0x60, 0xd8, 0x30, 0xf7, 0xe7, 0xd8, 0xff, 0xaf, 0x05, 0xef, 0x6c,
+	0xe2, 0x72, 0xc1, 0xc2, 0x3c, 0x7b, 0xe3, 0x98, 0x7f, 0xcf, 0xa6, 0x36, 0x67, 0x8f, 0x14, 0x18,
+	0x74, 0xbc, 0x7d, 0x85, 0x39, 0xd7, 0x61, 0x9e, 0x7d, 0x99, 0x44, 0x63, 0xe8, 0x9d, 0x46, 0xb7,
+	0x9c, 0x09, 0x6d, 0x9f, 0x0a, 0x37, 0x2a, 0x3b, 0x58, 0x8a, 0x31, 0x9a, 0x29, 0xbf, 0xbb, 0x4b,
+	0x15, 0x9b, 0xbc, 0xd2, 0xf5, 0x52, 0x0c, 0xfa, 0x08, 0xfd, 0x5f, 0x63, 0x16, 0xa4, 0x21, 0x4d,
+	0x32, 0x91, 0x57, 0x29, 0x2a, 0x07, 0x19, 0xd5, 0x79, 0xc4, 0x95, 0xe2, 0x52, 0x64, 0xaa, 0x83,
+	0x6a, 0x55, 0x29, 0x08, 0x0d, 0xa1, 0x9b, 0xdb, 0x9c, 0xaf, 0x62, 0xdc, 0xb2, 0xbf, 0x96, 0x22,
+	0x85, 0x7c, 0xe8, 0xcd, 0x13, 0x2a, 0x54, 0x4c, 0x13, 0x26, 0x82, 0x35, 0x6e, 0xdb, 0x90, 0x12,
+	0x87, 0x3e, 0xc0, 0xd1, 0x2c, 0x0c, 0xd3, 0xc8, 0x0c, 0x01, 0x97, 0xc2, 0x34, 0x1f, 0x77, 0x86,
+	0xb5, 0x51, 0x8b, 0x3c, 0xe3, 0xd1, 0x57, 0x30, 0xb8, 0x8a, 0x35, 0x0f, 0x68, 0x38, 0x65, 0x42,
+	0x71, 0xbd, 0xc6, 0x60, 0x1d, 0x77, 0x58, 0xf4, 0x23, 0x0c, 0xdc, 0x83, 0xbb, 0x86, 0xe2, 0xae,
+	0x2d, 0xc8, 0xdf, 0x16, 0xf4, 0xd2, 0x2f, 0x94, 0xec, 0x28, 0x0b, 0x5e, 0xae, 0xd1, 0xb8, 0xf7,
+	0x66, 0x2f, 0xa7, 0x44, 0x3f, 0xc3, 0xa1, 0x63, 0xf2, 0x2e, 0xe1, 0xfe, 0xde, 0x66, 0xbb, 0x52,
+	0x44, 0x00, 0xed, 0x50, 0xe6, 0x19, 0x06, 0x7b, 0x1b, 0x56, 0xa8, 0x0b, 0x19, 0x4e, 0xb9, 0x52,
+	0x32, 0x7c, 0x60, 0xf8, 0xf0, 0xcd, 0x19, 0xe6, 0x52, 0x34, 0x85, 0xae, 0xa3, 0xce, 0xd2, 0x28,
+	0xc6, 0x47, 0x7b, 0x3b, 0x15, 0x65, 0x68, 0x0e, 0xef, 0xb7, 0xc6, 0x71, 0x48, 0x03, 0x16, 0x99,
+	0x27, 0x7d, 0xb7, 0xb7, 0x5b, 0x95, 0xdc, 0xff, 0xa7, 0x09, 0x4d, 0xa3, 0x40, 0x03, 0xa8, 0x6f,
+	0x06, 0xbb, 0x3e, 0x9b, 0x22, 0x04, 0xcd, 0x0b, 0xbe, 0x99, 0x63, 0xfb, 0x9d, 0xaf, 0xad, 0x6b,
+	0xea, 0xf6, 0x50, 0x87, 0x6c, 0xb0, 0x5d, 0x10, 0x66, 0x63, 0x64, 0xd3, 0xdf, 0xb4, 0xa7, 0x05,
+	0xe6, 0xf9, 0x82, 0xf0, 0xaa, 0x16, 0xc4, 0x31, 0xf4, 0x03, 0x99, 0x0a, 0x7d, 0xc3, 0x12, 0xcd,
+	0x03, 0xa6, 0xec, 0x08, 0x7a, 0xa4, 0x4c, 0xa2, 0x6f, 0xe1, 0x33, 0x4b, 0xb8, 0x82, 0xb6, 0xff,
+	0x1e, 0xca, 0x8e, 0x9f, 0x47, 0x5e, 0x3a, 0x36, 0xa3, 0x68, 0x8f, 0x7e, 0x91, 0x49, 0x44, 0x43,
+	0x65, 0x47, 0xd1, 0x23, 0x25, 0x6e, 0x13, 0x33, 0x13, 0x0b, 0x9b, 0x42, 0xa7, 0x10, 0xe3, 0x38,
+	0x74, 0x0a, 0xfd, 0xd2, 0x7e, 0xb4, 0x13, 0xd8, 0x1d, 0x7f, 0xb1, 0xf3, 0x0c, 0xc5, 0x10, 0x52,
+	0x56, 0xa0, 0x0f, 0xd0, 0x7e, 0xc8, 0xab, 0xec, 0x0e, 0x1b, 0x15, 0x8b, 0x66, 0x73, 0x8e, 0xbe,
+	0x87, 0xf7, 0x3a, 0x2b, 0xe6, 0x8f, 0xa0, 0x50, 0x6c, 0xaf, 0x42, 0x36, 0x26, 0x48, 0x3f, 0xaf,
+	0x7b, 0x04, 0x2d, 0xe1, 0x4a, 0xee, 0x57, 0xde, 0x95, 0x1f, 0x9b, 0xff, 0x44, 0xee, 0x0a, 0x1f,
+	0x0c, 0x1b, 0xa3, 0x16, 0xc9, 0xe1, 0xff, 0x03, 0x00, 0x0a, 0x2b, 0xa9, 0x2c, 0x68, 0x08, 0x00,
+	0x00,
 }
@@ -0,0 +1,93 @@
+package saveopen
+
+import (
+	"fmt"
+
+	"github.com/supudo/Kuplung-Go/engine"
+	"github.com/supudo/Kuplung-Go/interfaces"
+	"github.com/supudo/Kuplung-Go/meshes"
+	"github.com/supudo/Kuplung-Go/objects"
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+// AppendOptions selects what is taken over from the appended scene besides its models and lights
+type AppendOptions struct {
+	Camera         bool
+	Grid           bool
+	RenderSettings bool
+}
+
+// Append adds the models and lights of the scene file to the current scene.
+// The appended models are numbered after the current ones and clashing names get a suffix.
+func (som *SOManager) Append(file *types.FBEntity, window interfaces.Window, systemModels map[string]types.MeshModel, faces *[]*meshes.ModelFace, lights *[]*objects.Light, rprops *types.RenderProperties, cam *objects.Camera, grid *objects.WorldGrid, opts AppendOptions) ([]string, error) {
+	var archive *SceneArchive
+	var err error
+	if isJSONScene(file.Path) {
+		archive, err = ReadSceneJSON(file.Path)
+	} else {
+		archive, err = ReadScene(file.Path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	pm := som.soProtobufs
+	if opts.RenderSettings {
+		pm.openGeneralSettings(archive.Settings, rprops)
+	}
+	if opts.Camera {
+		readCamera(archive.Settings, cam)
+	}
+	if opts.Grid {
+		pm.openGrid(archive.Settings, grid)
+	}
+
+	// the textures are loaded with the models
+	engine.AddPackedResources(archive.Resources)
+
+	var appendedFaces []*meshes.ModelFace
+	warnings := pm.readObjects(archive.Scene, window, &appendedFaces)
+	*faces = appendModels(*faces, appendedFaces)
+	*lights = appendLights(*lights, pm.readLights(archive.Settings, window, systemModels))
+	return warnings, nil
+}
+
+func appendModels(faces, appended []*meshes.ModelFace) []*meshes.ModelFace {
+	maxID := int32(0)
+	titles := make(map[string]bool, len(faces)+len(appended))
+	for _, m := range faces {
+		if m.ModelID > maxID {
+			maxID = m.ModelID
+		}
+		titles[m.MeshModel.ModelTitle] = true
+	}
+	for _, m := range appended {
+		maxID++
+		m.ModelID = maxID
+		m.MeshModel.ModelTitle = uniqueTitle(m.MeshModel.ModelTitle, titles)
+		faces = append(faces, m)
+	}
+	return faces
+}
+
+func appendLights(lights, appended []*objects.Light) []*objects.Light {
+	titles := make(map[string]bool, len(lights)+len(appended))
+	for _, l := range lights {
+		titles[l.Title] = true
+	}
+	for _, l := range appended {
+		l.Title = uniqueTitle(l.Title, titles)
+		lights = append(lights, l)
+	}
+	return lights
+}
+
+// uniqueTitle returns the title, or the title with the first free number, and marks it as used
+func uniqueTitle(title string, used map[string]bool) string {
+	unique := title
+	for i := 2; used[unique]; i++ {
+		unique = fmt.Sprintf("%v (%v)", title, i)
+	}
+	used[unique] = true
+	return unique
+}
@@ -0,0 +1,84 @@
+package saveopen
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// archiveEntry is a named file inside the .kuplung zip archive
+type archiveEntry struct {
+	name string
+	data []byte
+}
+
+// writeArchive zips the entries into the target file
+func writeArchive(filename string, entries []archiveEntry) error {
+	return writeFileAtomic(filename, func(w io.Writer) error {
+		zw := zip.NewWriter(w)
+		for _, entry := range entries {
+			ew, err := zw.CreateHeader(&zip.FileHeader{Name: entry.name, Method: zip.Deflate})
+			if err != nil {
+				return err
+			}
+			if _, err := ew.Write(entry.data); err != nil {
+				return err
+			}
+		}
+		return zw.Close()
+	})
+}
+
+// writeFileAtomic writes into a temporary file in the target folder, syncs it and renames it over the target,
+// so that a failed save never leaves a truncated file behind
+func writeFileAtomic(filename string, write func(w io.Writer) error) (err error) {
+	tmp, err := ioutil.TempFile(filepath.Dir(filename), "."+filepath.Base(filename)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	if err = write(tmp); err != nil {
+		return err
+	}
+	if err = tmp.Chmod(0644); err != nil {
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filename)
+}
+
+// readArchive returns the contents of every file in the zip archive, by entry name
+func readArchive(filename string) (map[string][]byte, error) {
+	zr, err := zip.OpenReader(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	entries := make(map[string][]byte)
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		data, err := readZipFile(f)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", f.Name, err)
+		}
+		entries[f.Name] = data
+	}
+	return entries, nil
+}
@@ -0,0 +1,136 @@
+package saveopen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/supudo/Kuplung-Go/meshes"
+	"github.com/supudo/Kuplung-Go/objects"
+	"github.com/supudo/Kuplung-Go/settings"
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+const (
+	autosavePrefix    = "autosave_"
+	autosaveSuffix    = ".kuplung"
+	autosaveTimestamp = "20060102_150405"
+	sessionLockFile   = "session.lock"
+)
+
+// RecoveryFolder is where the autosave snapshots and the session lock are kept
+func RecoveryFolder() string {
+	return settings.GetSettings().App.AppFolder + "recovery/"
+}
+
+// BeginSession marks the session as running and reports whether the previous one didn't shut down cleanly
+func (som *SOManager) BeginSession() bool {
+	folder := RecoveryFolder()
+	if err := os.MkdirAll(folder, 0755); err != nil {
+		settings.LogWarn("[Autosave] Can't create the recovery folder %v : %v", folder, err)
+		return false
+	}
+	lock := filepath.Join(folder, sessionLockFile)
+	_, err := os.Stat(lock)
+	crashed := err == nil
+	if err := ioutil.WriteFile(lock, []byte(time.Now().Format(time.RFC3339)), 0644); err != nil {
+		settings.LogWarn("[Autosave] Can't create the session lock %v : %v", lock, err)
+	}
+	som.autosaveLast = time.Now()
+	return crashed
+}
+
+// EndSession marks a clean shutdown
+func (som *SOManager) EndSession() {
+	lock := filepath.Join(RecoveryFolder(), sessionLockFile)
+	if err := os.Remove(lock); err != nil && !os.IsNotExist(err) {
+		settings.LogWarn("[Autosave] Can't remove the session lock %v : %v", lock, err)
+	}
+}
+
+// Autosave snapshots the scene when the autosave interval has passed.
+// The protocol buffers and the metadata are built on the calling thread, the scene is encoded and written in the background
+// without reading the settings.
+func (som *SOManager) Autosave(meshModelFaces []*meshes.ModelFace, lights []*objects.Light, rprops types.RenderProperties, cam *objects.Camera, grid *objects.WorldGrid) {
+	sett := settings.GetSettings()
+	if !sett.Autosave.Enabled || sett.Autosave.Interval <= 0 {
+		return
+	}
+	if time.Since(som.autosaveLast) < time.Duration(sett.Autosave.Interval)*time.Second {
+		return
+	}
+	if len(meshModelFaces) == 0 && len(lights) == 0 {
+		return
+	}
+	if !atomic.CompareAndSwapInt32(&som.autosaveWriting, 0, 1) {
+		return
+	}
+	som.autosaveLast = time.Now()
+
+	folder := RecoveryFolder()
+	fileName := filepath.Join(folder, autosavePrefix+som.autosaveLast.Format(autosaveTimestamp)+autosaveSuffix)
+	snapshot := som.soProtobufs.snapshot(fileName, meshModelFaces, lights, rprops, cam, grid)
+
+	keep := int(sett.Autosave.KeepVersions)
+	go func() {
+		defer atomic.StoreInt32(&som.autosaveWriting, 0)
+		entries, err := snapshot.encode(fileName, nil)
+		if err != nil {
+			settings.LogWarn("[Autosave] Can't encode the scene : %v", err)
+			return
+		}
+		if err := writeArchive(fileName, entries); err != nil {
+			settings.LogWarn("[Autosave] Can't save %v : %v", fileName, err)
+			return
+		}
+		pruneSnapshots(folder, keep)
+	}()
+}
+
+// RecoverySnapshots returns the autosave snapshots, newest first
+func RecoverySnapshots() []*types.FBEntity {
+	return recoverySnapshots(RecoveryFolder())
+}
+
+func recoverySnapshots(folder string) []*types.FBEntity {
+	files, err := ioutil.ReadDir(folder)
+	if err != nil {
+		return nil
+	}
+	var snapshots []*types.FBEntity
+	for _, f := range files {
+		if f.IsDir() || !strings.HasPrefix(f.Name(), autosavePrefix) || !strings.HasSuffix(f.Name(), autosaveSuffix) {
+			continue
+		}
+		snapshots = append(snapshots, &types.FBEntity{
+			IsFile:       true,
+			Path:         filepath.Join(folder, f.Name()),
+			Title:        f.Name(),
+			Extension:    autosaveSuffix,
+			ModifiedDate: f.ModTime().Format("02-Jan-2006 15:04:05"),
+			Size:         settings.ConvertSize(f.Size()),
+		})
+	}
+	// the timestamp in the name sorts chronologically
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Title > snapshots[j].Title
+	})
+	return snapshots
+}
+
+// pruneSnapshots removes all but the newest keep snapshots in the folder
+func pruneSnapshots(folder string, keep int) {
+	if keep <= 0 {
+		return
+	}
+	snapshots := recoverySnapshots(folder)
+	for i := keep; i < len(snapshots); i++ {
+		if err := os.Remove(snapshots[i].Path); err != nil {
+			settings.LogWarn("[Autosave] Can't remove %v : %v", snapshots[i].Path, err)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package saveopen
+
+import (
+	"fmt"
+	"math"
+	"os"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/supudo/Kuplung-Go/meshes"
+	"github.com/supudo/Kuplung-Go/objects"
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+// Models returns the scene models with their transformations, without creating any OpenGL objects,
+// so that the scene can be inspected and exported without a window.
+// Linked models are re-imported from their source, the warnings list those that couldn't be.
+func (sa *SceneArchive) Models(doProgress func(float32)) ([]*meshes.ModelFace, []string) {
+	links := newRelinker(doProgress)
+	var faces []*meshes.ModelFace
+	for _, gm := range sa.Scene.GetModels() {
+		mm, ok := readMeshModel(gm, links)
+		if !ok {
+			continue
+		}
+		face := &meshes.ModelFace{MeshModel: mm}
+		face.InitProperties()
+		face.ModelID = gm.GetModelID()
+		readModelTransform(gm, face)
+		faces = append(faces, face)
+	}
+	return faces, links.warnings
+}
+
+// Lights returns the scene light sources as interchange scene lights, without creating any OpenGL objects
+func (sa *SceneArchive) Lights() []types.SceneLight {
+	var lights []types.SceneLight
+	for _, l := range sa.Settings.GetLights() {
+		lights = append(lights, types.SceneLight{
+			Title:            l.GetTitle(),
+			LightType:        types.LightSourceType(l.GetType()),
+			Position:         mgl32.Vec3{l.GetPositionX().GetPoint(), l.GetPositionY().GetPoint(), l.GetPositionZ().GetPoint()},
+			Direction:        mgl32.Vec3{l.GetDirectionX().GetPoint(), l.GetDirectionY().GetPoint(), l.GetDirectionZ().GetPoint()},
+			Rotate:           mgl32.Vec3{l.GetRotateX().GetPoint(), l.GetRotateY().GetPoint(), l.GetRotateZ().GetPoint()},
+			Scale:            mgl32.Vec3{l.GetScaleX().GetPoint(), l.GetScaleY().GetPoint(), l.GetScaleZ().GetPoint()},
+			Ambient:          mgl32.Vec3{l.GetAmbient().GetColor().GetX(), l.GetAmbient().GetColor().GetY(), l.GetAmbient().GetColor().GetZ()},
+			Diffuse:          mgl32.Vec3{l.GetDiffuse().GetColor().GetX(), l.GetDiffuse().GetColor().GetY(), l.GetDiffuse().GetColor().GetZ()},
+			Specular:         mgl32.Vec3{l.GetSpecular().GetColor().GetX(), l.GetSpecular().GetColor().GetY(), l.GetSpecular().GetColor().GetZ()},
+			StrengthAmbient:  l.GetAmbient().GetStrength(),
+			StrengthDiffuse:  l.GetDiffuse().GetStrength(),
+			StrengthSpecular: l.GetSpecular().GetStrength(),
+			CutOff:           l.GetLCutOff().GetPoint(),
+			OuterCutOff:      l.GetLOuterCutOff().GetPoint(),
+			Constant:         l.GetLConstant().GetPoint(),
+			Linear:           l.GetLLinear().GetPoint(),
+			Quadratic:        l.GetLQuadratic().GetPoint(),
+		})
+	}
+	return lights
+}
+
+// Camera returns the scene camera with its view matrix and the vertical field of view, without creating any OpenGL objects
+func (sa *SceneArchive) Camera() (*objects.Camera, float32) {
+	cam := objects.InitCamera(nil)
+	readCamera(sa.Settings, cam)
+	cam.Render()
+	fov := sa.Settings.GetFov()
+	if fov <= 0 {
+		fov = 45.0
+	}
+	return cam, fov
+}
+
+// Validate checks the integrity of the scene and returns the problems found
+func (sa *SceneArchive) Validate() []string {
+	var problems []string
+	report := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	ids := make(map[int32]int)
+	for i, gm := range sa.Scene.GetModels() {
+		mesh := gm.GetMeshObject()
+		name := fmt.Sprintf("model %v (%v)", i, mesh.GetModelTitle())
+		if mesh == nil {
+			report("%v : has no mesh", name)
+			continue
+		}
+		if other, ok := ids[gm.GetModelID()]; ok {
+			report("%v : has the same ID %v as model %v", name, gm.GetModelID(), other)
+		}
+		ids[gm.GetModelID()] = i
+
+		material := readMaterial(mesh.GetModelMaterial())
+		for _, texture := range material.Textures() {
+			if texture.Image == "" {
+				continue
+			}
+			if _, ok := sa.Resources[texture.Image]; ok {
+				continue
+			}
+			if _, err := os.Stat(texture.Image); err != nil {
+				report("%v : the texture %v is not packed and can't be read", name, texture.Image)
+			}
+		}
+
+		if source := gm.GetSource(); source.GetLinked() {
+			ms := readModelSource(source)
+			if err := StatModelSource(&ms); err != nil {
+				report("%v : the linked file %v can't be read : %v", name, ms.Path, err)
+			}
+			continue
+		}
+
+		vertices := len(mesh.GetVertices())
+		if vertices == 0 {
+			report("%v : has no vertices", name)
+		}
+		if len(mesh.GetNormals()) != vertices {
+			report("%v : has %v normals for %v vertices", name, len(mesh.GetNormals()), vertices)
+		}
+		if n := len(mesh.GetTextureCoordinates()); n != 0 && n != vertices {
+			report("%v : has %v texture coordinates for %v vertices", name, n, vertices)
+		}
+		if int(mesh.GetCountIndices()) != len(mesh.GetIndices()) {
+			report("%v : has %v indices, the mesh says %v", name, len(mesh.GetIndices()), mesh.GetCountIndices())
+		}
+		if len(mesh.GetIndices())%3 != 0 {
+			report("%v : the %v indices are not whole triangles", name, len(mesh.GetIndices()))
+		}
+		for _, idx := range mesh.GetIndices() {
+			if int(idx) >= vertices {
+				report("%v : index %v is outside of the %v vertices", name, idx, vertices)
+				break
+			}
+		}
+		for j, v := range mesh.GetVertices() {
+			if !isFinite(v.GetX()) || !isFinite(v.GetY()) || !isFinite(v.GetZ()) {
+				report("%v : vertex %v is not a finite number", name, j)
+				break
+			}
+		}
+
+	}
+	return problems
+}
+
+func isFinite(f float32) bool {
+	return !math.IsNaN(float64(f)) && !math.IsInf(float64(f), 0)
+}
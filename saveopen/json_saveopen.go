@@ -0,0 +1,263 @@
+package saveopen
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	proto "github.com/golang/protobuf/proto"
+	"github.com/supudo/Kuplung-Go/engine"
+	"github.com/supudo/Kuplung-Go/interfaces"
+	"github.com/supudo/Kuplung-Go/meshes"
+	"github.com/supudo/Kuplung-Go/objects"
+	"github.com/supudo/Kuplung-Go/settings"
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+const jsonSceneFormat = "kuplung-scene"
+
+// jsonSceneDocument is the JSON scene, the settings and the scene are the GUISettings and Scene messages
+// without the vertex data, which is in the external buffer
+type jsonSceneDocument struct {
+	Format       string            `json:"format"`
+	Version      uint32            `json:"version"`
+	Application  string            `json:"application"`
+	Buffer       string            `json:"buffer"`
+	BufferLength int               `json:"bufferLength"`
+	Meshes       []jsonMeshBuffers `json:"meshes"`
+	Settings     json.RawMessage   `json:"settings"`
+	Scene        json.RawMessage   `json:"scene"`
+}
+
+// jsonMeshBuffers locates the vertex data of the scene model with the same index
+type jsonMeshBuffers struct {
+	Vertices           jsonBufferView `json:"vertices"`
+	TextureCoordinates jsonBufferView `json:"textureCoordinates"`
+	Normals            jsonBufferView `json:"normals"`
+	Indices            jsonBufferView `json:"indices"`
+}
+
+// jsonBufferView is a range of little-endian float32 or uint32 values
+type jsonBufferView struct {
+	ByteOffset int `json:"byteOffset"`
+	Count      int `json:"count"`
+}
+
+// JSONSaveOpen saves and opens scenes as JSON documents with the vertex data in an external binary buffer
+type JSONSaveOpen struct {
+	doProgress func(float32)
+	pb         *ProtoBufsSaveOpen
+}
+
+// NewJSONSaveOpen ...
+func NewJSONSaveOpen(doProgress func(float32), pb *ProtoBufsSaveOpen) *JSONSaveOpen {
+	return &JSONSaveOpen{doProgress: doProgress, pb: pb}
+}
+
+// Save returns the path of the written scene
+func (js *JSONSaveOpen) Save(file *types.FBEntity, meshModelFaces []*meshes.ModelFace, lights []*objects.Light, rprops types.RenderProperties, cam *objects.Camera, grid *objects.WorldGrid) (string, error) {
+	fileName := file.Path
+	if !strings.HasSuffix(fileName, ".json") {
+		fileName += ".json"
+	}
+	return fileName, WriteSceneJSON(fileName, js.pb.storeRenderingSettings(lights, rprops, cam, grid), js.pb.storeObjects(meshModelFaces))
+}
+
+// Open ...
+func (js *JSONSaveOpen) Open(file *types.FBEntity, window interfaces.Window, systemModels map[string]types.MeshModel, faces *[]*meshes.ModelFace, lights *[]*objects.Light, rprops *types.RenderProperties, cam *objects.Camera, grid *objects.WorldGrid) ([]string, error) {
+	archive, err := ReadSceneJSON(file.Path)
+	if err != nil {
+		return nil, err
+	}
+	if archive.Version < KuplungFormatVersion {
+		settings.LogInfo("[SaveOpen-JSON] [Open] Upgraded %v from format version %v to %v", file.Path, archive.Version, KuplungFormatVersion)
+	}
+	engine.ClearPackedResources()
+	js.pb.openRenderingSettings(archive.Settings, window, systemModels, lights, rprops, cam, grid)
+	return js.pb.readObjects(archive.Scene, window, faces), nil
+}
+
+// WriteSceneJSON writes the JSON document and its .bin buffer next to it
+func WriteSceneJSON(filename string, gs *GUISettings, scene *Scene) error {
+	scene = proto.Clone(scene).(*Scene)
+	bufferName := strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename)) + ".bin"
+
+	var buffer bytes.Buffer
+	meshBuffers := make([]jsonMeshBuffers, len(scene.Models))
+	for i, model := range scene.Models {
+		mesh := model.GetMeshObject()
+		if mesh == nil {
+			continue
+		}
+		mb := &meshBuffers[i]
+		mb.Vertices = writeVec3s(&buffer, mesh.Vertices)
+		mb.TextureCoordinates = writeVec2s(&buffer, mesh.TextureCoordinates)
+		mb.Normals = writeVec3s(&buffer, mesh.Normals)
+		mb.Indices = jsonBufferView{ByteOffset: buffer.Len(), Count: len(mesh.Indices)}
+		_ = binary.Write(&buffer, binary.LittleEndian, mesh.Indices)
+		mesh.Vertices, mesh.TextureCoordinates, mesh.Normals, mesh.Indices = nil, nil, nil, nil
+	}
+
+	marshaler := jsonpb.Marshaler{OrigName: true, Indent: "  "}
+	dataSettings, err := marshaler.MarshalToString(gs)
+	if err != nil {
+		return fmt.Errorf("can't encode the settings: %v", err)
+	}
+	dataScene, err := marshaler.MarshalToString(scene)
+	if err != nil {
+		return fmt.Errorf("can't encode the scene: %v", err)
+	}
+	document, err := json.MarshalIndent(jsonSceneDocument{
+		Format:       jsonSceneFormat,
+		Version:      KuplungFormatVersion,
+		Application:  "Kuplung",
+		Buffer:       bufferName,
+		BufferLength: buffer.Len(),
+		Meshes:       meshBuffers,
+		Settings:     json.RawMessage(dataSettings),
+		Scene:        json.RawMessage(dataScene),
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("can't encode the document: %v", err)
+	}
+
+	err = writeFileAtomic(filepath.Join(filepath.Dir(filename), bufferName), func(w io.Writer) error {
+		_, err := w.Write(buffer.Bytes())
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(filename, func(w io.Writer) error {
+		_, err := w.Write(append(document, '\n'))
+		return err
+	})
+}
+
+// ReadSceneJSON decodes a JSON scene and its buffer and upgrades it to the current format version
+func ReadSceneJSON(filename string) (*SceneArchive, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var document jsonSceneDocument
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("invalid document: %v", err)
+	}
+	if document.Format != jsonSceneFormat {
+		return nil, fmt.Errorf("unknown document format %q", document.Format)
+	}
+	if document.Version == 0 || document.Version > KuplungFormatVersion {
+		return nil, fmt.Errorf("unsupported format version %v, this build reads up to %v", document.Version, KuplungFormatVersion)
+	}
+
+	gs := &GUISettings{}
+	if err := jsonpb.UnmarshalString(string(document.Settings), gs); err != nil {
+		return nil, fmt.Errorf("can't decode the settings: %v", err)
+	}
+	scene := &Scene{}
+	if err := jsonpb.UnmarshalString(string(document.Scene), scene); err != nil {
+		return nil, fmt.Errorf("can't decode the scene: %v", err)
+	}
+	if len(document.Meshes) != len(scene.Models) {
+		return nil, fmt.Errorf("the document has %v mesh buffers for %v models", len(document.Meshes), len(scene.Models))
+	}
+
+	buffer, err := ioutil.ReadFile(filepath.Join(filepath.Dir(filename), document.Buffer))
+	if err != nil {
+		return nil, fmt.Errorf("can't read the buffer: %v", err)
+	}
+	if len(buffer) != document.BufferLength {
+		return nil, fmt.Errorf("the buffer %v is %v bytes, expected %v", document.Buffer, len(buffer), document.BufferLength)
+	}
+	for i, model := range scene.Models {
+		mb := document.Meshes[i]
+		if model.MeshObject == nil {
+			model.MeshObject = &Mesh{}
+		}
+		mesh := model.MeshObject
+		values, err := readFloats(buffer, mb.Vertices, 3)
+		if err != nil {
+			return nil, fmt.Errorf("model %v vertices: %v", i, err)
+		}
+		for j := 0; j+2 < len(values); j += 3 {
+			mesh.Vertices = append(mesh.Vertices, &Vec3{X: proto.Float32(values[j]), Y: proto.Float32(values[j+1]), Z: proto.Float32(values[j+2])})
+		}
+		if values, err = readFloats(buffer, mb.TextureCoordinates, 2); err != nil {
+			return nil, fmt.Errorf("model %v texture coordinates: %v", i, err)
+		}
+		for j := 0; j+1 < len(values); j += 2 {
+			mesh.TextureCoordinates = append(mesh.TextureCoordinates, &Vec2{X: proto.Float32(values[j]), Y: proto.Float32(values[j+1])})
+		}
+		if values, err = readFloats(buffer, mb.Normals, 3); err != nil {
+			return nil, fmt.Errorf("model %v normals: %v", i, err)
+		}
+		for j := 0; j+2 < len(values); j += 3 {
+			mesh.Normals = append(mesh.Normals, &Vec3{X: proto.Float32(values[j]), Y: proto.Float32(values[j+1]), Z: proto.Float32(values[j+2])})
+		}
+		if mesh.Indices, err = readUints(buffer, mb.Indices); err != nil {
+			return nil, fmt.Errorf("model %v indices: %v", i, err)
+		}
+	}
+
+	for v := document.Version; v < KuplungFormatVersion; v++ {
+		sceneMigrations[v-1](gs, scene)
+	}
+	return &SceneArchive{Settings: gs, Scene: scene, Version: document.Version}, nil
+}
+
+func writeVec3s(buffer *bytes.Buffer, values []*Vec3) jsonBufferView {
+	view := jsonBufferView{ByteOffset: buffer.Len(), Count: len(values)}
+	for _, v := range values {
+		_ = binary.Write(buffer, binary.LittleEndian, [3]float32{v.GetX(), v.GetY(), v.GetZ()})
+	}
+	return view
+}
+
+func writeVec2s(buffer *bytes.Buffer, values []*Vec2) jsonBufferView {
+	view := jsonBufferView{ByteOffset: buffer.Len(), Count: len(values)}
+	for _, v := range values {
+		_ = binary.Write(buffer, binary.LittleEndian, [2]float32{v.GetX(), v.GetY()})
+	}
+	return view
+}
+
+// bufferRange returns the bytes of the view, count elements of the given byte size
+func bufferRange(buffer []byte, view jsonBufferView, size int) ([]byte, error) {
+	end := view.ByteOffset + view.Count*size
+	if view.ByteOffset < 0 || view.Count < 0 || end > len(buffer) {
+		return nil, fmt.Errorf("range %v+%v is outside of the buffer", view.ByteOffset, view.Count*size)
+	}
+	return buffer[view.ByteOffset:end], nil
+}
+
+func readFloats(buffer []byte, view jsonBufferView, components int) ([]float32, error) {
+	data, err := bufferRange(buffer, view, 4*components)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]float32, len(data)/4)
+	for i := range values {
+		values[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[i*4:]))
+	}
+	return values, nil
+}
+
+func readUints(buffer []byte, view jsonBufferView) ([]uint32, error) {
+	data, err := bufferRange(buffer, view, 4)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]uint32, len(data)/4)
+	for i := range values {
+		values[i] = binary.LittleEndian.Uint32(data[i*4:])
+	}
+	return values, nil
+}
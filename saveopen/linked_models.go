@@ -0,0 +1,121 @@
+package saveopen
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	proto "github.com/golang/protobuf/proto"
+	"github.com/supudo/Kuplung-Go/engine/parsers"
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+// storeModelSource returns the source of an imported model, the built-in shapes have none
+func storeModelSource(source types.MeshModelSource, linked bool) *ModelSource {
+	if source.Path == "" {
+		return nil
+	}
+	return &ModelSource{
+		SourcePath:     proto.String(source.Path),
+		ImportFormat:   proto.Int32(int32(source.Format)),
+		ImportSettings: source.Settings,
+		SourceIndex:    proto.Int32(source.Index),
+		SourceTitle:    proto.String(source.Title),
+		SourceSize:     proto.Int64(source.Size),
+		SourceModTime:  proto.Int64(source.ModTime),
+		Linked:         proto.Bool(linked),
+	}
+}
+
+func readModelSource(ms *ModelSource) types.MeshModelSource {
+	return types.MeshModelSource{
+		Path:     ms.GetSourcePath(),
+		Format:   types.ImportExportFormat(ms.GetImportFormat()),
+		Settings: ms.GetImportSettings(),
+		Index:    ms.GetSourceIndex(),
+		Title:    ms.GetSourceTitle(),
+		Size:     ms.GetSourceSize(),
+		ModTime:  ms.GetSourceModTime(),
+	}
+}
+
+// StatModelSource fills in the size and modification time of the source file
+func StatModelSource(source *types.MeshModelSource) error {
+	fi, err := os.Stat(source.Path)
+	if err != nil {
+		return err
+	}
+	source.Size = fi.Size()
+	source.ModTime = fi.ModTime().UnixNano()
+	return nil
+}
+
+// relinker re-imports the sources of linked models, every file is parsed once
+type relinker struct {
+	doProgress func(float32)
+	parser     *parsers.ParserManager
+	parsed     map[string][]types.MeshModel
+	warnings   []string
+}
+
+func newRelinker(doProgress func(float32)) *relinker {
+	return &relinker{
+		doProgress: doProgress,
+		parsed:     make(map[string][]types.MeshModel),
+	}
+}
+
+// model returns the geometry of the linked model, or false and a warning when it can't be re-imported
+func (rl *relinker) model(ms *ModelSource, title string) (types.MeshModel, bool) {
+	source := readModelSource(ms)
+	current := source
+	if err := StatModelSource(&current); err != nil {
+		if os.IsNotExist(err) {
+			rl.warnings = append(rl.warnings, fmt.Sprintf("%v : the linked file %v is missing", title, source.Path))
+		} else {
+			rl.warnings = append(rl.warnings, fmt.Sprintf("%v : can't read the linked file %v : %v", title, source.Path, err))
+		}
+		return types.MeshModel{}, false
+	}
+
+	key := fmt.Sprintf("%v|%v|%v", source.Format, source.Path, strings.Join(source.Settings, "|"))
+	models, ok := rl.parsed[key]
+	if !ok {
+		if rl.parser == nil {
+			rl.parser = parsers.NewParserManager(rl.doProgress)
+		}
+		models = rl.parser.Parse(source.Path, source.Settings, source.Format)
+		rl.parsed[key] = models
+		if current.Size != source.Size || current.ModTime != source.ModTime {
+			rl.warnings = append(rl.warnings, fmt.Sprintf("%v was changed since the scene was saved", source.Path))
+		}
+	}
+
+	// the model can be renamed in the scene, older scenes only have the scene name
+	sourceTitle := source.Title
+	if sourceTitle == "" {
+		sourceTitle = title
+	}
+
+	// the index is only trusted while the file still has the same model there
+	index := -1
+	if source.Index >= 0 && int(source.Index) < len(models) && models[source.Index].ModelTitle == sourceTitle {
+		index = int(source.Index)
+	} else {
+		for i := range models {
+			if models[i].ModelTitle == sourceTitle {
+				index = i
+				break
+			}
+		}
+	}
+	if index < 0 {
+		rl.warnings = append(rl.warnings, fmt.Sprintf("%v : the linked file %v no longer has this model", title, source.Path))
+		return types.MeshModel{}, false
+	}
+
+	mm := models[index]
+	current.Index, current.Title = int32(index), sourceTitle
+	mm.Source = current
+	return mm, true
+}
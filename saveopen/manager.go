@@ -1,6 +1,10 @@
 package saveopen
 
 import (
+	"path/filepath"
+	"strings"
+	"time"
+
 	"github.com/supudo/Kuplung-Go/interfaces"
 	"github.com/supudo/Kuplung-Go/meshes"
 	"github.com/supudo/Kuplung-Go/objects"
@@ -10,8 +14,12 @@ import (
 // SOManager ...
 type SOManager struct {
 	soProtobufs *ProtoBufsSaveOpen
+	soJSON      *JSONSaveOpen
 
 	doProgress func(float32)
+
+	autosaveLast    time.Time
+	autosaveWriting int32
 }
 
 // NewSaveOpenManager ...
@@ -19,19 +27,40 @@ func NewSaveOpenManager(doProgress func(float32)) *SOManager {
 	som := &SOManager{}
 	som.doProgress = doProgress
 	som.initProtobufs()
+	som.initJSON()
 	return som
 }
 
-// Save ...
-func (som *SOManager) Save(file *types.FBEntity, meshes []*meshes.ModelFace, lights []*objects.Light, rprops types.RenderProperties, cam *objects.Camera, grid *objects.WorldGrid) {
-	som.soProtobufs.Save(file, meshes, lights, rprops, cam, grid)
+// Save writes a JSON scene for .json files and a .kuplung archive otherwise, only the archive keeps the PNG thumbnail.
+// It returns the path of the written file, with the extension added when the file name had none.
+func (som *SOManager) Save(file *types.FBEntity, meshes []*meshes.ModelFace, lights []*objects.Light, rprops types.RenderProperties, cam *objects.Camera, grid *objects.WorldGrid, thumbnail []byte) (string, error) {
+	if isJSONScene(file.Path) {
+		return som.soJSON.Save(file, meshes, lights, rprops, cam, grid)
+	}
+	return som.soProtobufs.Save(file, meshes, lights, rprops, cam, grid, thumbnail)
+}
+
+// Open returns the warnings about linked models that changed since the scene was saved or couldn't be re-imported
+func (som *SOManager) Open(file *types.FBEntity, window interfaces.Window, systemModels map[string]types.MeshModel, faces *[]*meshes.ModelFace, lights *[]*objects.Light, rprops *types.RenderProperties, cam *objects.Camera, grid *objects.WorldGrid) ([]string, error) {
+	if isJSONScene(file.Path) {
+		return som.soJSON.Open(file, window, systemModels, faces, lights, rprops, cam, grid)
+	}
+	return som.soProtobufs.Open(file, window, systemModels, faces, lights, rprops, cam, grid)
 }
 
-// Open ...
-func (som *SOManager) Open(file *types.FBEntity, window interfaces.Window, systemModels map[string]types.MeshModel, faces *[]*meshes.ModelFace, lights *[]*objects.Light, rprops *types.RenderProperties, cam *objects.Camera, grid *objects.WorldGrid) {
-	som.soProtobufs.Open(file, window, systemModels, faces, lights, rprops, cam, grid)
+// UnpackResources writes the resources packed in the opened scene to the folder
+func (som *SOManager) UnpackResources(folder string, meshModelFaces []*meshes.ModelFace) (int, error) {
+	return UnpackResources(folder, meshModelFaces)
 }
 
 func (som *SOManager) initProtobufs() {
 	som.soProtobufs = NewProtoBufsSaveOpen(som.doProgress)
 }
+
+func (som *SOManager) initJSON() {
+	som.soJSON = NewJSONSaveOpen(som.doProgress, som.soProtobufs)
+}
+
+func isJSONScene(fileName string) bool {
+	return strings.EqualFold(filepath.Ext(fileName), ".json")
+}
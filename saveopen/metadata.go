@@ -0,0 +1,117 @@
+package saveopen
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os/user"
+	"strings"
+	"time"
+
+	"github.com/supudo/Kuplung-Go/meshes"
+	"github.com/supudo/Kuplung-Go/objects"
+	"github.com/supudo/Kuplung-Go/settings"
+)
+
+const thumbnailSuffix = ".thumbnail.png"
+
+// SceneMetadata describes a saved scene, it's kept in the archive manifest
+type SceneMetadata struct {
+	Author      string    `json:"author,omitempty"`
+	Created     time.Time `json:"created"`
+	Modified    time.Time `json:"modified"`
+	Application string    `json:"application"`
+	Objects     int       `json:"objects"`
+	Lights      int       `json:"lights"`
+	Vertices    int       `json:"vertices"`
+	Triangles   int       `json:"triangles"`
+}
+
+// ScenePreview is what the open dialog shows for a scene, without decoding the scene itself
+type ScenePreview struct {
+	Version  uint32
+	Metadata *SceneMetadata
+	// Thumbnail is a PNG of the viewport when the scene was saved
+	Thumbnail []byte
+}
+
+// sceneCounts counts the objects, the lights, the vertices and the triangles of the scene
+func sceneCounts(meshModelFaces []*meshes.ModelFace, lights []*objects.Light) SceneMetadata {
+	counts := SceneMetadata{Objects: len(meshModelFaces), Lights: len(lights)}
+	for _, m := range meshModelFaces {
+		counts.Vertices += len(m.MeshModel.Vertices)
+		counts.Triangles += len(m.MeshModel.Indices) / 3
+	}
+	return counts
+}
+
+// newSceneMetadata describes the scene saved to fileName, the creation time is kept when the file is overwritten
+func newSceneMetadata(fileName string, counts SceneMetadata) *SceneMetadata {
+	sett := settings.GetSettings()
+	now := time.Now()
+	metadata := &counts
+	metadata.Author = sett.App.Author
+	metadata.Created = now
+	metadata.Modified = now
+	metadata.Application = strings.TrimSpace("Kuplung " + sett.App.ApplicationVersion)
+	if metadata.Author == "" {
+		if u, err := user.Current(); err == nil {
+			metadata.Author = u.Username
+		}
+	}
+	if previous, err := ReadScenePreview(fileName); err == nil && previous.Metadata != nil && !previous.Metadata.Created.IsZero() {
+		metadata.Created = previous.Metadata.Created
+	}
+	return metadata
+}
+
+// ReadScenePreview reads the manifest and the thumbnail of a .kuplung archive.
+// Scenes saved before the metadata was added have no metadata and no thumbnail.
+func ReadScenePreview(filename string) (*ScenePreview, error) {
+	zr, err := zip.OpenReader(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	var manifest *kuplungManifest
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+		if manifest == nil && strings.HasSuffix(f.Name, manifestSuffix) {
+			data, err := readZipFile(f)
+			if err != nil {
+				return nil, err
+			}
+			manifest = &kuplungManifest{}
+			if err := json.Unmarshal(data, manifest); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if manifest == nil {
+		// version 1 archives have no manifest
+		return &ScenePreview{Version: 1}, nil
+	}
+	if manifest.Format != manifestFormat {
+		return nil, errors.New("not a Kuplung scene")
+	}
+
+	preview := &ScenePreview{Version: manifest.Version, Metadata: manifest.Metadata}
+	if f, ok := files[manifest.Thumbnail]; ok && manifest.Thumbnail != "" {
+		if preview.Thumbnail, err = readZipFile(f); err != nil {
+			return nil, err
+		}
+	}
+	return preview, nil
+}
+
+func readZipFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return ioutil.ReadAll(rc)
+}
@@ -2,27 +2,22 @@ package saveopen
 
 import (
 	fmt "fmt"
-	"io/ioutil"
-	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/go-gl/mathgl/mgl32"
 	proto "github.com/golang/protobuf/proto"
+	"github.com/supudo/Kuplung-Go/engine"
 	"github.com/supudo/Kuplung-Go/interfaces"
 	"github.com/supudo/Kuplung-Go/meshes"
 	"github.com/supudo/Kuplung-Go/objects"
 	"github.com/supudo/Kuplung-Go/settings"
 	"github.com/supudo/Kuplung-Go/types"
-	"github.com/supudo/Kuplung-Go/utilities"
 )
 
 // ProtoBufsSaveOpen ...
 type ProtoBufsSaveOpen struct {
 	doProgress func(float32)
-
-	fileNameSettings string
-	fileNameScene    string
 }
 
 // NewProtoBufsSaveOpen ...
@@ -32,184 +27,271 @@ func NewProtoBufsSaveOpen(doProgress func(float32)) *ProtoBufsSaveOpen {
 	return pm
 }
 
-// Save ...
-func (pm *ProtoBufsSaveOpen) Save(file *types.FBEntity, meshModelFaces []*meshes.ModelFace, lights []*objects.Light, rprops types.RenderProperties, cam *objects.Camera, grid *objects.WorldGrid) {
+// Save writes the scene to a temporary file next to the target and renames it over the target once it's complete,
+// it returns the path of the archive
+func (pm *ProtoBufsSaveOpen) Save(file *types.FBEntity, meshModelFaces []*meshes.ModelFace, lights []*objects.Light, rprops types.RenderProperties, cam *objects.Camera, grid *objects.WorldGrid, thumbnail []byte) (string, error) {
 	fileName := file.Path
 	if !strings.HasSuffix(fileName, ".kuplung") {
 		fileName += ".kuplung"
 	}
-	pm.fileNameSettings = fileName + ".settings"
-	pm.fileNameScene = fileName + ".scene"
-
-	pm.storeRenderingSettings(lights, rprops, cam, grid)
-	pm.storeObjects(meshModelFaces)
+	entries, err := pm.snapshot(fileName, meshModelFaces, lights, rprops, cam, grid).encode(fileName, thumbnail)
+	if err != nil {
+		return fileName, err
+	}
+	return fileName, writeArchive(fileName, entries)
+}
 
-	zfiles := []string{pm.fileNameSettings, pm.fileNameScene}
-	utilities.ZipFiles(fileName, zfiles)
+// sceneSnapshot is the scene as it was when it was saved, it doesn't share anything with the rendered scene
+type sceneSnapshot struct {
+	settings  *GUISettings
+	scene     *Scene
+	resources []string
+	metadata  *SceneMetadata
+}
 
-	if err := os.Remove(pm.fileNameSettings); err != nil {
-		settings.LogWarn("[SaveOpen-ProtoBufs] [Save] Can't delete temp file : %v!", pm.fileNameSettings)
+// snapshot copies the scene into its protocol buffers and describes it for the archive saved to fileName,
+// the resources are only collected when they are packed
+func (pm *ProtoBufsSaveOpen) snapshot(fileName string, meshModelFaces []*meshes.ModelFace, lights []*objects.Light, rprops types.RenderProperties, cam *objects.Camera, grid *objects.WorldGrid) *sceneSnapshot {
+	snapshot := &sceneSnapshot{
+		settings: pm.storeRenderingSettings(lights, rprops, cam, grid),
+		scene:    pm.storeObjects(meshModelFaces),
+		metadata: newSceneMetadata(fileName, sceneCounts(meshModelFaces, lights)),
 	}
-	if err := os.Remove(pm.fileNameScene); err != nil {
-		settings.LogWarn("[SaveOpen-ProtoBufs] [Save] Can't delete temp file : %v!", pm.fileNameScene)
+	if settings.GetSettings().App.PackResources {
+		snapshot.resources = resourcePaths(meshModelFaces)
 	}
+	return snapshot
 }
 
-// Open ...
-func (pm *ProtoBufsSaveOpen) Open(file *types.FBEntity, window interfaces.Window, systemModels map[string]types.MeshModel, faces *[]*meshes.ModelFace, lights *[]*objects.Light, rprops *types.RenderProperties, cam *objects.Camera, grid *objects.WorldGrid) {
-	pfiles := utilities.UnzipFiles(file.Path, filepath.Dir(file.Path))
-	if len(pfiles) == 2 {
-		pm.openRenderingSettings(pfiles[0], window, systemModels, lights, rprops, cam, grid)
-		pm.readObjects(pfiles[1], window, faces)
-		if err := os.Remove(pfiles[0]); err != nil {
-			settings.LogWarn("[SaveOpen-ProtoBufs] [Open] Can't delete temp file : %v!", pfiles[0])
-		}
-		if err := os.Remove(pfiles[1]); err != nil {
-			settings.LogWarn("[SaveOpen-ProtoBufs] [Open] Can't delete temp file : %v!", pfiles[1])
-		}
+// encode returns the archive entries of the scene, the thumbnail is optional
+func (snapshot *sceneSnapshot) encode(fileName string, thumbnail []byte) ([]archiveEntry, error) {
+	entrySettings := filepath.Base(fileName) + ".settings"
+	entryScene := filepath.Base(fileName) + ".scene"
+
+	dataSettings, err := proto.Marshal(snapshot.settings)
+	if err != nil {
+		return nil, fmt.Errorf("can't encode the settings: %v", err)
 	}
+	dataScene, err := proto.Marshal(snapshot.scene)
+	if err != nil {
+		return nil, fmt.Errorf("can't encode the scene: %v", err)
+	}
+
+	var resources []kuplungResource
+	var resourceEntries []archiveEntry
+	if len(snapshot.resources) > 0 {
+		resources, resourceEntries = packResources(snapshot.resources)
+	}
+
+	manifest := kuplungManifest{
+		Settings:  entrySettings,
+		Scene:     entryScene,
+		Resources: resources,
+		Metadata:  snapshot.metadata,
+	}
+	if len(thumbnail) > 0 {
+		manifest.Thumbnail = filepath.Base(fileName) + thumbnailSuffix
+		resourceEntries = append(resourceEntries, archiveEntry{name: manifest.Thumbnail, data: thumbnail})
+	}
+	dataManifest, err := encodeManifest(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("can't encode the manifest: %v", err)
+	}
+
+	return append([]archiveEntry{
+		{name: filepath.Base(fileName) + manifestSuffix, data: dataManifest},
+		{name: entrySettings, data: dataSettings},
+		{name: entryScene, data: dataScene},
+	}, resourceEntries...), nil
 }
 
-func (pm *ProtoBufsSaveOpen) openRenderingSettings(filename string, window interfaces.Window, systemModels map[string]types.MeshModel, lights *[]*objects.Light, rprops *types.RenderProperties, cam *objects.Camera, grid *objects.WorldGrid) {
-	fSettingsHandle, err := ioutil.ReadFile(filename)
+// Open ...
+func (pm *ProtoBufsSaveOpen) Open(file *types.FBEntity, window interfaces.Window, systemModels map[string]types.MeshModel, faces *[]*meshes.ModelFace, lights *[]*objects.Light, rprops *types.RenderProperties, cam *objects.Camera, grid *objects.WorldGrid) ([]string, error) {
+	archive, err := ReadScene(file.Path)
 	if err != nil {
-		settings.LogWarn("[SaveOpen-ProtoBufs] [Open] Error reading file %v : %v", filename, err)
-		return
+		return nil, err
 	}
-	gs := &GUISettings{}
-	if err := proto.Unmarshal(fSettingsHandle, gs); err != nil {
-		settings.LogWarn("[SaveOpen-ProtoBufs] [Open] Can't decode general settings: %v", err)
-		return
+	if archive.Version < KuplungFormatVersion {
+		settings.LogInfo("[SaveOpen-ProtoBufs] [Open] Upgraded %v from format version %v to %v", file.Path, archive.Version, KuplungFormatVersion)
 	}
+	engine.SetPackedResources(archive.Resources)
+	pm.openRenderingSettings(archive.Settings, window, systemModels, lights, rprops, cam, grid)
+	return pm.readObjects(archive.Scene, window, faces), nil
+}
+
+func (pm *ProtoBufsSaveOpen) openRenderingSettings(gs *GUISettings, window interfaces.Window, systemModels map[string]types.MeshModel, lights *[]*objects.Light, rprops *types.RenderProperties, cam *objects.Camera, grid *objects.WorldGrid) {
+	pm.openGeneralSettings(gs, rprops)
+	readCamera(gs, cam)
+	pm.openGrid(gs, grid)
+	*lights = pm.readLights(gs, window, systemModels)
+}
 
+func (pm *ProtoBufsSaveOpen) openGeneralSettings(gs *GUISettings, rprops *types.RenderProperties) {
 	rsett := settings.GetRenderingSettings()
 
 	// Render Settings
-	rsett.General.ShowCube = *gs.ShowCube
-	rsett.General.Fov = *gs.Fov
-	rsett.General.RatioWidth = *gs.RatioWidth
-	rsett.General.RatioHeight = *gs.RatioHeight
-	rsett.General.PlaneClose = *gs.PlaneClose
-	rsett.General.PlaneFar = *gs.PlaneFar
-	rsett.General.GammaCoeficient = *gs.GammaCoeficient
-
-	rsett.General.ShowPickRays = *gs.ShowPickRays
-	rsett.General.ShowPickRaysSingle = *gs.ShowPickRaysSingle
-	rsett.General.RayAnimate = *gs.RayAnimate
-	rsett.General.RayOriginX = *gs.RayOriginX
-	rsett.General.RayOriginX = *gs.RayOriginY
-	rsett.General.RayOriginZ = *gs.RayOriginZ
-	rsett.General.RayOriginXS = *gs.RayOriginXS
-	rsett.General.RayOriginYS = *gs.RayOriginYS
-	rsett.General.RayOriginZS = *gs.RayOriginZS
-	rsett.General.RayDraw = *gs.RayDraw
-	rsett.General.RayDirectionX = *gs.RayDirectionX
-	rsett.General.RayDirectionY = *gs.RayDirectionY
-	rsett.General.RayDirectionZ = *gs.RayDirectionZ
-	rsett.General.RayDirectionXS = *gs.RayDirectionXS
-	rsett.General.RayDirectionYS = *gs.RayDirectionYS
-	rsett.General.RayDirectionZS = *gs.RayDirectionZS
-
-	rsett.General.OcclusionCulling = *gs.OcclusionCulling
-	rsett.General.RenderingDepth = *gs.RenderingDepth
-	rsett.General.SelectedViewModelSkin = types.ViewModelSkin(*gs.SelectedViewModelSkin)
-	rsett.General.ShowBoundingBox = *gs.ShowBoundingBox
-	rsett.General.BoundingBoxRefresh = *gs.BoundingBoxRefresh
-	rsett.General.BoundingBoxPadding = *gs.BoundingBoxPadding
-	rsett.General.OutlineColor = mgl32.Vec4{*gs.OutlineColor.X, *gs.OutlineColor.Y, *gs.OutlineColor.Z, 1}
-	rsett.General.OutlineColorPickerOpen = *gs.OutlineColorPickerOpen
-	rsett.General.OutlineThickness = *gs.OutlineThickness
-
-	rsett.General.VertexSphereVisible = *gs.VertexSphereVisible
-	rsett.General.VertexSphereColorPickerOpen = *gs.VertexSphereColorPickerOpen
-	rsett.General.VertexSphereIsSphere = *gs.VertexSphereIsSphere
-	rsett.General.VertexSphereShowWireframes = *gs.VertexSphereShowWireframes
-	rsett.General.VertexSphereRadius = *gs.VertexSphereRadius
-	rsett.General.VertexSphereSegments = *gs.VertexSphereSegments
-	rsett.General.VertexSphereColor = mgl32.Vec4{*gs.VertexSphereColor.X, *gs.VertexSphereColor.Y, *gs.VertexSphereColor.Z, 1}
-
-	rsett.General.ShowAllVisualArtefacts = *gs.ShowAllVisualArtefacts
-
-	rsett.Axis.ShowZAxis = *gs.ShowZAxis
-
-	rsett.Grid.WorldGridSizeSquares = *gs.WorldGridSizeSquares
-	rsett.Grid.WorldGridFixedWithWorld = *gs.WorldGridFixedWithWorld
-	rsett.Grid.ShowGrid = *gs.ShowGrid
-	rsett.Grid.ActAsMirror = *gs.ActAsMirror
-
-	rsett.SkyBox.SkyboxSelectedItem = *gs.SkyboxSelectedItem
-
-	rsett.Defered.DeferredTestMode = *gs.DeferredTestMode
-	rsett.Defered.DeferredTestLights = *gs.DeferredTestLights
-	rsett.Defered.DeferredRandomizeLightPositions = *gs.DeferredRandomizeLightPositions
-	rsett.Defered.LightingPassDrawMode = *gs.LightingPassDrawMode
-	rsett.Defered.DeferredTestLightsNumber = *gs.DeferredTestLightsNumber
-	rsett.Defered.DeferredAmbientStrength = *gs.DeferredAmbientStrength
-
-	rsett.General.DebugShadowTexture = *gs.DebugShadowTexture
+	rsett.General.ShowCube = gs.GetShowCube()
+	rsett.General.Fov = gs.GetFov()
+	rsett.General.RatioWidth = gs.GetRatioWidth()
+	rsett.General.RatioHeight = gs.GetRatioHeight()
+	rsett.General.PlaneClose = gs.GetPlaneClose()
+	rsett.General.PlaneFar = gs.GetPlaneFar()
+	rsett.General.GammaCoeficient = gs.GetGammaCoeficient()
+
+	rsett.General.ShowPickRays = gs.GetShowPickRays()
+	rsett.General.ShowPickRaysSingle = gs.GetShowPickRaysSingle()
+	rsett.General.RayAnimate = gs.GetRayAnimate()
+	rsett.General.RayOriginX = gs.GetRayOriginX()
+	rsett.General.RayOriginY = gs.GetRayOriginY()
+	rsett.General.RayOriginZ = gs.GetRayOriginZ()
+	rsett.General.RayOriginXS = gs.GetRayOriginXS()
+	rsett.General.RayOriginYS = gs.GetRayOriginYS()
+	rsett.General.RayOriginZS = gs.GetRayOriginZS()
+	rsett.General.RayDraw = gs.GetRayDraw()
+	rsett.General.RayDirectionX = gs.GetRayDirectionX()
+	rsett.General.RayDirectionY = gs.GetRayDirectionY()
+	rsett.General.RayDirectionZ = gs.GetRayDirectionZ()
+	rsett.General.RayDirectionXS = gs.GetRayDirectionXS()
+	rsett.General.RayDirectionYS = gs.GetRayDirectionYS()
+	rsett.General.RayDirectionZS = gs.GetRayDirectionZS()
+
+	rsett.General.OcclusionCulling = gs.GetOcclusionCulling()
+	rsett.General.RenderingDepth = gs.GetRenderingDepth()
+	rsett.General.SelectedViewModelSkin = types.ViewModelSkin(gs.GetSelectedViewModelSkin())
+	rsett.General.ShowBoundingBox = gs.GetShowBoundingBox()
+	rsett.General.BoundingBoxRefresh = gs.GetBoundingBoxRefresh()
+	rsett.General.BoundingBoxPadding = gs.GetBoundingBoxPadding()
+	rsett.General.OutlineColor = mgl32.Vec4{gs.GetOutlineColor().GetX(), gs.GetOutlineColor().GetY(), gs.GetOutlineColor().GetZ(), 1}
+	rsett.General.OutlineColorPickerOpen = gs.GetOutlineColorPickerOpen()
+	rsett.General.OutlineThickness = gs.GetOutlineThickness()
+
+	rsett.General.VertexSphereVisible = gs.GetVertexSphereVisible()
+	rsett.General.VertexSphereColorPickerOpen = gs.GetVertexSphereColorPickerOpen()
+	rsett.General.VertexSphereIsSphere = gs.GetVertexSphereIsSphere()
+	rsett.General.VertexSphereShowWireframes = gs.GetVertexSphereShowWireframes()
+	rsett.General.VertexSphereRadius = gs.GetVertexSphereRadius()
+	rsett.General.VertexSphereSegments = gs.GetVertexSphereSegments()
+	rsett.General.VertexSphereColor = mgl32.Vec4{gs.GetVertexSphereColor().GetX(), gs.GetVertexSphereColor().GetY(), gs.GetVertexSphereColor().GetZ(), 1}
+
+	rsett.General.ShowAllVisualArtefacts = gs.GetShowAllVisualArtefacts()
+
+	rsett.Axis.ShowZAxis = gs.GetShowZAxis()
+
+	rsett.Grid.WorldGridSizeSquares = gs.GetWorldGridSizeSquares()
+	rsett.Grid.WorldGridFixedWithWorld = gs.GetWorldGridFixedWithWorld()
+	rsett.Grid.ShowGrid = gs.GetShowGrid()
+	rsett.Grid.ActAsMirror = gs.GetActAsMirror()
+
+	rsett.SkyBox.SkyboxSelectedItem = gs.GetSkyboxSelectedItem()
+
+	rsett.Defered.DeferredTestMode = gs.GetDeferredTestMode()
+	rsett.Defered.DeferredTestLights = gs.GetDeferredTestLights()
+	rsett.Defered.DeferredRandomizeLightPositions = gs.GetDeferredRandomizeLightPositions()
+	rsett.Defered.LightingPassDrawMode = gs.GetLightingPassDrawMode()
+	rsett.Defered.DeferredTestLightsNumber = gs.GetDeferredTestLightsNumber()
+	rsett.Defered.DeferredAmbientStrength = gs.GetDeferredAmbientStrength()
+
+	rsett.General.DebugShadowTexture = gs.GetDebugShadowTexture()
+
+	rsett.CrossSection.ShowPlane = gs.GetCrossSectionShowPlane()
+	rsett.CrossSection.NormalX = gs.GetCrossSectionNormal().GetX()
+	rsett.CrossSection.NormalY = gs.GetCrossSectionNormal().GetY()
+	rsett.CrossSection.NormalZ = gs.GetCrossSectionNormal().GetZ()
+	rsett.CrossSection.Offset = gs.GetCrossSectionOffset()
+	rsett.CrossSection.Count = gs.GetCrossSectionCount()
+	if rsett.CrossSection.Count < 1 {
+		rsett.CrossSection.Count = 1
+	}
+	rsett.CrossSection.Spacing = gs.GetCrossSectionSpacing()
+	rsett.CrossSection.PlaneSize = gs.GetCrossSectionPlaneSize()
+
+	rsett.Shadows.CascadeCount = gs.GetShadowCascadeCount()
+	rsett.Shadows.CascadeSplitLambda = gs.GetShadowCascadeSplitLambda()
+	rsett.Shadows.CascadeBlend = gs.GetShadowCascadeBlend()
+	rsett.Shadows.DebugCascades = gs.GetShadowDebugCascades()
+
+	rsett.PostProcessing.Enabled = gs.GetPostProcessingEnabled()
+	rsett.PostProcessing.Bloom = gs.GetPostProcessingBloom()
+	rsett.PostProcessing.BloomStrength = gs.GetPostProcessingBloomStrength()
+	rsett.PostProcessing.BloomFilterRadius = gs.GetPostProcessingBloomFilterRadius()
+	rsett.PostProcessing.ToneMapping = gs.GetPostProcessingToneMapping()
+	rsett.PostProcessing.ToneMappingOperator = gs.GetPostProcessingToneMappingOperator()
+	rsett.PostProcessing.Exposure = gs.GetPostProcessingExposure()
+	rsett.PostProcessing.ColorGrading = gs.GetPostProcessingColorGrading()
+	rsett.PostProcessing.ColorGradingLUT = gs.GetPostProcessingColorGradingLUT()
+	rsett.PostProcessing.ColorGradingIntensity = gs.GetPostProcessingColorGradingIntensity()
+	rsett.PostProcessing.Vignette = gs.GetPostProcessingVignette()
+	rsett.PostProcessing.VignetteIntensity = gs.GetPostProcessingVignetteIntensity()
+	rsett.PostProcessing.VignetteSmoothness = gs.GetPostProcessingVignetteSmoothness()
+	rsett.PostProcessing.FXAA = gs.GetPostProcessingFXAA()
 
 	// Render Properties
-	rprops.UIAmbientLightX = *gs.UIAmbientLightX
-	rprops.UIAmbientLightY = *gs.UIAmbientLightY
-	rprops.UIAmbientLightZ = *gs.UIAmbientLightZ
-
-	rprops.SolidLightDirectionX = *gs.SolidLightDirectionX
-	rprops.SolidLightDirectionY = *gs.SolidLightDirectionY
-	rprops.SolidLightDirectionZ = *gs.SolidLightDirectionZ
-
-	rprops.SolidLightMaterialColor = mgl32.Vec3{*gs.SolidLightMaterialColor.X, *gs.SolidLightMaterialColor.Y, *gs.SolidLightMaterialColor.Z}
-	rprops.SolidLightAmbient = mgl32.Vec3{*gs.SolidLightAmbient.X, *gs.SolidLightAmbient.Y, *gs.SolidLightAmbient.Z}
-	rprops.SolidLightDiffuse = mgl32.Vec3{*gs.SolidLightDiffuse.X, *gs.SolidLightDiffuse.Y, *gs.SolidLightDiffuse.Z}
-	rprops.SolidLightSpecular = mgl32.Vec3{*gs.SolidLightSpecular.X, *gs.SolidLightSpecular.Y, *gs.SolidLightSpecular.Z}
-
-	rprops.SolidLightAmbientStrength = *gs.SolidLightAmbientStrength
-	rprops.SolidLightDiffuseStrength = *gs.SolidLightDiffuseStrength
-	rprops.SolidLightSpecularStrength = *gs.SolidLightSpecularStrength
-
-	rprops.SolidLightMaterialColorColorPicker = *gs.SolidLightMaterialColorColorPicker
-	rprops.SolidLightAmbientColorPicker = *gs.SolidLightAmbientColorPicker
-	rprops.SolidLightDiffuseColorPicker = *gs.SolidLightDiffuseColorPicker
-	rprops.SolidLightSpecularColorPicker = *gs.SolidLightSpecularColorPicker
+	rprops.UIAmbientLightX = gs.GetUIAmbientLightX()
+	rprops.UIAmbientLightY = gs.GetUIAmbientLightY()
+	rprops.UIAmbientLightZ = gs.GetUIAmbientLightZ()
+
+	rprops.SolidLightDirectionX = gs.GetSolidLightDirectionX()
+	rprops.SolidLightDirectionY = gs.GetSolidLightDirectionY()
+	rprops.SolidLightDirectionZ = gs.GetSolidLightDirectionZ()
+
+	rprops.SolidLightMaterialColor = mgl32.Vec3{gs.GetSolidLightMaterialColor().GetX(), gs.GetSolidLightMaterialColor().GetY(), gs.GetSolidLightMaterialColor().GetZ()}
+	rprops.SolidLightAmbient = mgl32.Vec3{gs.GetSolidLightAmbient().GetX(), gs.GetSolidLightAmbient().GetY(), gs.GetSolidLightAmbient().GetZ()}
+	rprops.SolidLightDiffuse = mgl32.Vec3{gs.GetSolidLightDiffuse().GetX(), gs.GetSolidLightDiffuse().GetY(), gs.GetSolidLightDiffuse().GetZ()}
+	rprops.SolidLightSpecular = mgl32.Vec3{gs.GetSolidLightSpecular().GetX(), gs.GetSolidLightSpecular().GetY(), gs.GetSolidLightSpecular().GetZ()}
+
+	rprops.SolidLightAmbientStrength = gs.GetSolidLightAmbientStrength()
+	rprops.SolidLightDiffuseStrength = gs.GetSolidLightDiffuseStrength()
+	rprops.SolidLightSpecularStrength = gs.GetSolidLightSpecularStrength()
+
+	rprops.SolidLightMaterialColorColorPicker = gs.GetSolidLightMaterialColorColorPicker()
+	rprops.SolidLightAmbientColorPicker = gs.GetSolidLightAmbientColorPicker()
+	rprops.SolidLightDiffuseColorPicker = gs.GetSolidLightDiffuseColorPicker()
+	rprops.SolidLightSpecularColorPicker = gs.GetSolidLightSpecularColorPicker()
+}
 
-	// Camera
-	c := *gs.Camera
-	cam.CameraPosition = mgl32.Vec3{*c.CameraPosition.X, *c.CameraPosition.Y, *c.CameraPosition.Z}
-	cam.EyeSettings.ViewEye = mgl32.Vec3{*c.View_Eye.X, *c.View_Eye.Y, *c.View_Eye.Z}
-	cam.EyeSettings.ViewCenter = mgl32.Vec3{*c.View_Center.X, *c.View_Center.Y, *c.View_Center.Z}
-	cam.EyeSettings.ViewUp = mgl32.Vec3{*c.View_Up.X, *c.View_Up.Y, *c.View_Up.Z}
-	cam.PositionX = types.ObjectCoordinate{Animate: *c.PositionX.Animate, Point: *c.PositionX.Point}
-	cam.PositionY = types.ObjectCoordinate{Animate: *c.PositionY.Animate, Point: *c.PositionY.Point}
-	cam.PositionZ = types.ObjectCoordinate{Animate: *c.PositionZ.Animate, Point: *c.PositionZ.Point}
-	cam.RotateX = types.ObjectCoordinate{Animate: *c.RotateX.Animate, Point: *c.RotateX.Point}
-	cam.RotateY = types.ObjectCoordinate{Animate: *c.RotateY.Animate, Point: *c.RotateY.Point}
-	cam.RotateZ = types.ObjectCoordinate{Animate: *c.RotateZ.Animate, Point: *c.RotateZ.Point}
-	cam.RotateCenterX = types.ObjectCoordinate{Animate: *c.RotateCenterX.Animate, Point: *c.RotateCenterX.Point}
-	cam.RotateCenterY = types.ObjectCoordinate{Animate: *c.RotateCenterY.Animate, Point: *c.RotateCenterY.Point}
-	cam.RotateCenterZ = types.ObjectCoordinate{Animate: *c.RotateCenterZ.Animate, Point: *c.RotateCenterZ.Point}
+// readCamera sets the camera from the saved settings
+func readCamera(gs *GUISettings, cam *objects.Camera) {
+	c := gs.GetCamera()
+	cam.CameraPosition = mgl32.Vec3{c.GetCameraPosition().GetX(), c.GetCameraPosition().GetY(), c.GetCameraPosition().GetZ()}
+	cam.EyeSettings.ViewEye = mgl32.Vec3{c.GetView_Eye().GetX(), c.GetView_Eye().GetY(), c.GetView_Eye().GetZ()}
+	cam.EyeSettings.ViewCenter = mgl32.Vec3{c.GetView_Center().GetX(), c.GetView_Center().GetY(), c.GetView_Center().GetZ()}
+	cam.EyeSettings.ViewUp = mgl32.Vec3{c.GetView_Up().GetX(), c.GetView_Up().GetY(), c.GetView_Up().GetZ()}
+	cam.PositionX = types.ObjectCoordinate{Animate: c.GetPositionX().GetAnimate(), Point: c.GetPositionX().GetPoint()}
+	cam.PositionY = types.ObjectCoordinate{Animate: c.GetPositionY().GetAnimate(), Point: c.GetPositionY().GetPoint()}
+	cam.PositionZ = types.ObjectCoordinate{Animate: c.GetPositionZ().GetAnimate(), Point: c.GetPositionZ().GetPoint()}
+	cam.RotateX = types.ObjectCoordinate{Animate: c.GetRotateX().GetAnimate(), Point: c.GetRotateX().GetPoint()}
+	cam.RotateY = types.ObjectCoordinate{Animate: c.GetRotateY().GetAnimate(), Point: c.GetRotateY().GetPoint()}
+	cam.RotateZ = types.ObjectCoordinate{Animate: c.GetRotateZ().GetAnimate(), Point: c.GetRotateZ().GetPoint()}
+	cam.RotateCenterX = types.ObjectCoordinate{Animate: c.GetRotateCenterX().GetAnimate(), Point: c.GetRotateCenterX().GetPoint()}
+	cam.RotateCenterY = types.ObjectCoordinate{Animate: c.GetRotateCenterY().GetAnimate(), Point: c.GetRotateCenterY().GetPoint()}
+	cam.RotateCenterZ = types.ObjectCoordinate{Animate: c.GetRotateCenterZ().GetAnimate(), Point: c.GetRotateCenterZ().GetPoint()}
+}
 
-	// Grid
-	g := *gs.Grid
-	grid.ActAsMirror = *g.ActAsMirror
-	grid.GridSize = *g.GridSize
-	grid.Transparency = *g.Transparency
-	grid.PositionX = types.ObjectCoordinate{Animate: *g.PositionX.Animate, Point: *g.PositionX.Point}
-	grid.PositionY = types.ObjectCoordinate{Animate: *g.PositionY.Animate, Point: *g.PositionY.Point}
-	grid.PositionZ = types.ObjectCoordinate{Animate: *g.PositionZ.Animate, Point: *g.PositionZ.Point}
-	grid.RotateX = types.ObjectCoordinate{Animate: *g.RotateX.Animate, Point: *g.RotateX.Point}
-	grid.RotateY = types.ObjectCoordinate{Animate: *g.RotateY.Animate, Point: *g.RotateY.Point}
-	grid.RotateZ = types.ObjectCoordinate{Animate: *g.RotateZ.Animate, Point: *g.RotateZ.Point}
-	grid.ScaleX = types.ObjectCoordinate{Animate: *g.ScaleX.Animate, Point: *g.ScaleX.Point}
-	grid.ScaleY = types.ObjectCoordinate{Animate: *g.ScaleY.Animate, Point: *g.ScaleY.Point}
-	grid.ScaleZ = types.ObjectCoordinate{Animate: *g.ScaleZ.Animate, Point: *g.ScaleZ.Point}
+func (pm *ProtoBufsSaveOpen) openGrid(gs *GUISettings, grid *objects.WorldGrid) {
+	g := gs.GetGrid()
+	grid.ActAsMirror = g.GetActAsMirror()
+	grid.GridSize = g.GetGridSize()
+	grid.Transparency = g.GetTransparency()
+	grid.PositionX = types.ObjectCoordinate{Animate: g.GetPositionX().GetAnimate(), Point: g.GetPositionX().GetPoint()}
+	grid.PositionY = types.ObjectCoordinate{Animate: g.GetPositionY().GetAnimate(), Point: g.GetPositionY().GetPoint()}
+	grid.PositionZ = types.ObjectCoordinate{Animate: g.GetPositionZ().GetAnimate(), Point: g.GetPositionZ().GetPoint()}
+	grid.RotateX = types.ObjectCoordinate{Animate: g.GetRotateX().GetAnimate(), Point: g.GetRotateX().GetPoint()}
+	grid.RotateY = types.ObjectCoordinate{Animate: g.GetRotateY().GetAnimate(), Point: g.GetRotateY().GetPoint()}
+	grid.RotateZ = types.ObjectCoordinate{Animate: g.GetRotateZ().GetAnimate(), Point: g.GetRotateZ().GetPoint()}
+	grid.ScaleX = types.ObjectCoordinate{Animate: g.GetScaleX().GetAnimate(), Point: g.GetScaleX().GetPoint()}
+	grid.ScaleY = types.ObjectCoordinate{Animate: g.GetScaleY().GetAnimate(), Point: g.GetScaleY().GetPoint()}
+	grid.ScaleZ = types.ObjectCoordinate{Animate: g.GetScaleZ().GetAnimate(), Point: g.GetScaleZ().GetPoint()}
+}
 
-	// Lights
-	*lights = []*objects.Light{}
+// readLights creates the light sources of the scene
+func (pm *ProtoBufsSaveOpen) readLights(gs *GUISettings, window interfaces.Window, systemModels map[string]types.MeshModel) []*objects.Light {
+	lights := []*objects.Light{}
 	for i := 0; i < len(gs.Lights); i++ {
 		l := gs.Lights[i]
 
 		var lShape types.LightSourceType
 		var lTitle, lDescription, lModel string
-		switch *l.Type {
+		switch l.GetType() {
 		case 0:
 			lShape = types.LightSourceTypeDirectional
 			lTitle = fmt.Sprintf("Directional %v", i)
@@ -230,231 +312,158 @@ func (pm *ProtoBufsSaveOpen) openRenderingSettings(filename string, window inter
 		ll.InitProperties(lShape)
 		ll.Title = lTitle
 		ll.Description = lDescription
+		if l.GetTitle() != "" {
+			ll.Title = l.GetTitle()
+		}
+		if l.GetDescription() != "" {
+			ll.Description = l.GetDescription()
+		}
+		ll.ShowLampObject = l.GetShowLampObject()
+		ll.ShowLampDirection = l.GetShowLampDirection()
+		ll.ShowInWire = l.GetShowInWire()
+		ll.CastShadows = l.GetCastShadows()
+
+		ll.PositionX = types.ObjectCoordinate{Animate: l.GetPositionX().GetAnimate(), Point: l.GetPositionX().GetPoint()}
+		ll.PositionY = types.ObjectCoordinate{Animate: l.GetPositionY().GetAnimate(), Point: l.GetPositionY().GetPoint()}
+		ll.PositionZ = types.ObjectCoordinate{Animate: l.GetPositionZ().GetAnimate(), Point: l.GetPositionZ().GetPoint()}
+		ll.DirectionX = types.ObjectCoordinate{Animate: l.GetDirectionX().GetAnimate(), Point: l.GetDirectionX().GetPoint()}
+		ll.DirectionY = types.ObjectCoordinate{Animate: l.GetDirectionY().GetAnimate(), Point: l.GetDirectionY().GetPoint()}
+		ll.DirectionZ = types.ObjectCoordinate{Animate: l.GetDirectionZ().GetAnimate(), Point: l.GetDirectionZ().GetPoint()}
+		ll.ScaleX = types.ObjectCoordinate{Animate: l.GetScaleX().GetAnimate(), Point: l.GetScaleX().GetPoint()}
+		ll.ScaleY = types.ObjectCoordinate{Animate: l.GetScaleY().GetAnimate(), Point: l.GetScaleY().GetPoint()}
+		ll.ScaleZ = types.ObjectCoordinate{Animate: l.GetScaleZ().GetAnimate(), Point: l.GetScaleZ().GetPoint()}
+		ll.RotateX = types.ObjectCoordinate{Animate: l.GetRotateX().GetAnimate(), Point: l.GetRotateX().GetPoint()}
+		ll.RotateY = types.ObjectCoordinate{Animate: l.GetRotateY().GetAnimate(), Point: l.GetRotateY().GetPoint()}
+		ll.RotateZ = types.ObjectCoordinate{Animate: l.GetRotateZ().GetAnimate(), Point: l.GetRotateZ().GetPoint()}
+		ll.RotateCenterX = types.ObjectCoordinate{Animate: l.GetRotateCenterX().GetAnimate(), Point: l.GetRotateCenterX().GetPoint()}
+		ll.RotateCenterY = types.ObjectCoordinate{Animate: l.GetRotateCenterY().GetAnimate(), Point: l.GetRotateCenterY().GetPoint()}
+		ll.RotateCenterZ = types.ObjectCoordinate{Animate: l.GetRotateCenterZ().GetAnimate(), Point: l.GetRotateCenterZ().GetPoint()}
+
+		ll.Ambient = readLightColor(l.GetAmbient())
+		ll.Diffuse = readLightColor(l.GetDiffuse())
+		ll.Specular = readLightColor(l.GetSpecular())
+
+		ll.LCutOff = types.ObjectCoordinate{Animate: l.GetLCutOff().GetAnimate(), Point: l.GetLCutOff().GetPoint()}
+		ll.LOuterCutOff = types.ObjectCoordinate{Animate: l.GetLOuterCutOff().GetAnimate(), Point: l.GetLOuterCutOff().GetPoint()}
+		ll.LConstant = types.ObjectCoordinate{Animate: l.GetLConstant().GetAnimate(), Point: l.GetLConstant().GetPoint()}
+		ll.LLinear = types.ObjectCoordinate{Animate: l.GetLLinear().GetAnimate(), Point: l.GetLLinear().GetPoint()}
+		ll.LQuadratic = types.ObjectCoordinate{Animate: l.GetLQuadratic().GetAnimate(), Point: l.GetLQuadratic().GetPoint()}
+
 		ll.SetModel(systemModels[lModel])
 		ll.InitBuffers()
 
-		*lights = append(*lights, ll)
+		lights = append(lights, ll)
 	}
+	return lights
 }
 
-func (pm *ProtoBufsSaveOpen) readObjects(filename string, window interfaces.Window, faces *[]*meshes.ModelFace) {
-	fModelsHandle, err := ioutil.ReadFile(filename)
-	if err != nil {
-		settings.LogError("[SaveOpen-ProtoBufs] [readObjects] Error reading file %v : %v", filename, err)
-		return
-	}
-	gs := &Scene{}
-	if err := proto.Unmarshal(fModelsHandle, gs); err != nil {
-		settings.LogError("[SaveOpen-ProtoBufs] [readObjects] Can't decode general settings: %v", err)
-		return
-	}
+func readLightColor(mc *MaterialColor) types.MaterialColor {
+	return types.MaterialColor{
+		ColorPickerOpen: mc.GetColorPickerOpen(),
+		Animate:         mc.GetAnimate(),
+		Strength:        mc.GetStrength(),
+		Color:           mgl32.Vec3{mc.GetColor().GetX(), mc.GetColor().GetY(), mc.GetColor().GetZ()}}
+}
 
+// readObjects creates the scene models and returns the warnings about linked models that changed or couldn't be re-imported
+func (pm *ProtoBufsSaveOpen) readObjects(gs *Scene, window interfaces.Window, faces *[]*meshes.ModelFace) []string {
 	sett := settings.GetSettings()
 	*faces = []*meshes.ModelFace{}
+	links := newRelinker(pm.doProgress)
 	var i int32
 	for i = 0; i < int32(len(gs.Models)); i++ {
 		gm := gs.Models[i]
-		gmo := gm.MeshObject
-		gmom := gm.MeshObject.ModelMaterial
+		mm, ok := readMeshModel(gm, links)
 
-		// MeshModel
-		mm := types.MeshModel{}
-		mm.ID = uint32(*gmo.ID)
-		mm.File = *gmo.File
-		mm.FilePath = *gmo.FilePath
+		mesh := meshes.NewModelFace(window, mm)
+		mesh.InitProperties()
+		mesh.InitBuffers()
+		mesh.SourceMissing = !ok
 
-		mm.ModelTitle = *gmo.ModelTitle
-		mm.MaterialTitle = *gmo.MaterialTitle
+		mesh.ModelID = gm.GetModelID()
+		mesh.ModelViewSkin = types.ViewModelSkin(gm.GetSetting_ModelViewSkin())
 
-		mm.CountVertices = *gmo.CountVertices
-		mm.CountTextureCoordinates = *gmo.CountTextureCoordinates
-		mm.CountNormals = *gmo.CountNormals
-		mm.CountIndices = *gmo.CountIndices
+		mesh.DeferredRender = gm.GetSettings_DeferredRender()
+		mesh.CelShading = gm.GetSetting_CelShading()
+		mesh.Wireframe = gm.GetSetting_Wireframe()
+		mesh.UseTessellation = gm.GetSetting_UseTessellation()
+		mesh.UseCullFace = gm.GetSetting_UseCullFace()
+		mesh.ShowMaterialEditor = gm.GetShowMaterialEditor()
 
-		for j := 0; j < len(gmo.Vertices); j++ {
-			mm.Vertices = append(mm.Vertices, mgl32.Vec3{*gmo.Vertices[j].X, *gmo.Vertices[j].Y, *gmo.Vertices[j].Z})
-		}
-		for j := 0; j < len(gmo.TextureCoordinates); j++ {
-			mm.TextureCoordinates = append(mm.TextureCoordinates, mgl32.Vec2{*gmo.TextureCoordinates[j].X, *gmo.TextureCoordinates[j].Y})
-		}
-		for j := 0; j < len(gmo.Normals); j++ {
-			mm.Normals = append(mm.Normals, mgl32.Vec3{*gmo.Normals[j].X, *gmo.Normals[j].Y, *gmo.Normals[j].Z})
-		}
-		mm.Indices = gmo.Indices
-
-		// MeshModelMaterial
-		mmm := types.MeshModelMaterial{}
-		mmm.MaterialID = uint32(*gmom.MaterialID)
-		mmm.MaterialTitle = *gmom.MaterialTitle
-
-		mmm.SpecularExp = *gmom.SpecularExp
-
-		mmm.AmbientColor = mgl32.Vec3{*gmom.AmbientColor.X, *gmom.AmbientColor.Y, *gmom.AmbientColor.Z}
-		mmm.DiffuseColor = mgl32.Vec3{*gmom.DiffuseColor.X, *gmom.DiffuseColor.Y, *gmom.DiffuseColor.Z}
-		mmm.SpecularColor = mgl32.Vec3{*gmom.SpecularColor.X, *gmom.SpecularColor.Y, *gmom.SpecularColor.Z}
-		mmm.EmissionColor = mgl32.Vec3{*gmom.EmissionColor.X, *gmom.EmissionColor.Y, *gmom.EmissionColor.Z}
-
-		mmm.Transparency = *gmom.Transparency
-		mmm.IlluminationMode = *gmom.IlluminationMode
-		mmm.OpticalDensity = *gmom.OpticalDensity
-
-		mmmtia := types.MeshMaterialTextureImage{
-			Filename:   *gmom.TextureAmbient.Filename,
-			Image:      *gmom.TextureAmbient.Image,
-			Width:      *gmom.TextureAmbient.Width,
-			Height:     *gmom.TextureAmbient.Height,
-			UseTexture: *gmom.TextureAmbient.UseTexture,
-			Commands:   gmom.TextureAmbient.Commands}
-		mmm.TextureAmbient = mmmtia
-		mmmtid := types.MeshMaterialTextureImage{
-			Filename:   *gmom.TextureDiffuse.Filename,
-			Image:      *gmom.TextureDiffuse.Image,
-			Width:      *gmom.TextureDiffuse.Width,
-			Height:     *gmom.TextureDiffuse.Height,
-			UseTexture: *gmom.TextureDiffuse.UseTexture,
-			Commands:   gmom.TextureDiffuse.Commands}
-		mmm.TextureDiffuse = mmmtid
-		mmmtis := types.MeshMaterialTextureImage{
-			Filename:   *gmom.TextureSpecular.Filename,
-			Image:      *gmom.TextureSpecular.Image,
-			Width:      *gmom.TextureSpecular.Width,
-			Height:     *gmom.TextureSpecular.Height,
-			UseTexture: *gmom.TextureSpecular.UseTexture,
-			Commands:   gmom.TextureSpecular.Commands}
-		mmm.TextureSpecular = mmmtis
-		mmmtise := types.MeshMaterialTextureImage{
-			Filename:   *gmom.TextureSpecularExp.Filename,
-			Image:      *gmom.TextureSpecularExp.Image,
-			Width:      *gmom.TextureSpecularExp.Width,
-			Height:     *gmom.TextureSpecularExp.Height,
-			UseTexture: *gmom.TextureSpecularExp.UseTexture,
-			Commands:   gmom.TextureSpecularExp.Commands}
-		mmm.TextureSpecularExp = mmmtise
-		mmmtidi := types.MeshMaterialTextureImage{
-			Filename:   *gmom.TextureDissolve.Filename,
-			Image:      *gmom.TextureDissolve.Image,
-			Width:      *gmom.TextureDissolve.Width,
-			Height:     *gmom.TextureDissolve.Height,
-			UseTexture: *gmom.TextureDissolve.UseTexture,
-			Commands:   gmom.TextureDissolve.Commands}
-		mmm.TextureDissolve = mmmtidi
-		mmmtib := types.MeshMaterialTextureImage{
-			Filename:   *gmom.TextureBump.Filename,
-			Image:      *gmom.TextureBump.Image,
-			Width:      *gmom.TextureBump.Width,
-			Height:     *gmom.TextureBump.Height,
-			UseTexture: *gmom.TextureBump.UseTexture,
-			Commands:   gmom.TextureBump.Commands}
-		mmm.TextureBump = mmmtib
-		mmmtids := types.MeshMaterialTextureImage{
-			Filename:   *gmom.TextureDisplacement.Filename,
-			Image:      *gmom.TextureDisplacement.Image,
-			Width:      *gmom.TextureDisplacement.Width,
-			Height:     *gmom.TextureDisplacement.Height,
-			UseTexture: *gmom.TextureDisplacement.UseTexture,
-			Commands:   gmom.TextureDisplacement.Commands}
-		mmm.TextureDisplacement = mmmtids
-		mm.ModelMaterial = mmm
+		mesh.Alpha = gm.GetSetting_Alpha()
+		mesh.TessellationSubdivision = gm.GetSetting_TessellationSubdivision()
+		readModelTransform(gm, mesh)
 
-		mesh := meshes.NewModelFace(window, mm)
-		mesh.InitProperties()
-		mesh.InitBuffers()
+		mesh.MaterialRefraction = types.ObjectCoordinate{Animate: gm.GetSetting_MaterialRefraction().GetAnimate(), Point: gm.GetSetting_MaterialRefraction().GetPoint()}
+		mesh.MaterialSpecularExp = types.ObjectCoordinate{Animate: gm.GetSetting_MaterialSpecularExp().GetAnimate(), Point: gm.GetSetting_MaterialSpecularExp().GetPoint()}
+
+		mesh.LightPosition = mgl32.Vec3{gm.GetSetting_LightPosition().GetX(), gm.GetSetting_LightPosition().GetY(), gm.GetSetting_LightPosition().GetZ()}
+		mesh.LightDirection = mgl32.Vec3{gm.GetSetting_LightDirection().GetX(), gm.GetSetting_LightDirection().GetY(), gm.GetSetting_LightDirection().GetZ()}
+		mesh.LightAmbient = mgl32.Vec3{gm.GetSetting_LightAmbient().GetX(), gm.GetSetting_LightAmbient().GetY(), gm.GetSetting_LightAmbient().GetZ()}
+		mesh.LightDiffuse = mgl32.Vec3{gm.GetSetting_LightDiffuse().GetX(), gm.GetSetting_LightDiffuse().GetY(), gm.GetSetting_LightDiffuse().GetZ()}
+		mesh.LightSpecular = mgl32.Vec3{gm.GetSetting_LightSpecular().GetX(), gm.GetSetting_LightSpecular().GetY(), gm.GetSetting_LightSpecular().GetZ()}
 
-		mesh.ModelID = *gm.ModelID
-		mesh.ModelViewSkin = types.ViewModelSkin(*gm.Setting_ModelViewSkin)
-
-		mesh.DeferredRender = *gm.Settings_DeferredRender
-		mesh.CelShading = *gm.Setting_CelShading
-		mesh.Wireframe = *gm.Setting_Wireframe
-		mesh.UseTessellation = *gm.Setting_UseTessellation
-		mesh.UseCullFace = *gm.Setting_UseCullFace
-		mesh.ShowMaterialEditor = *gm.ShowMaterialEditor
-
-		mesh.Alpha = *gm.Setting_Alpha
-		mesh.TessellationSubdivision = *gm.Setting_TessellationSubdivision
-		mesh.PositionX = types.ObjectCoordinate{Animate: *gm.PositionX.Animate, Point: *gm.PositionX.Point}
-		mesh.PositionY = types.ObjectCoordinate{Animate: *gm.PositionY.Animate, Point: *gm.PositionY.Point}
-		mesh.PositionZ = types.ObjectCoordinate{Animate: *gm.PositionZ.Animate, Point: *gm.PositionZ.Point}
-		mesh.ScaleX = types.ObjectCoordinate{Animate: *gm.ScaleX.Animate, Point: *gm.ScaleX.Point}
-		mesh.ScaleY = types.ObjectCoordinate{Animate: *gm.ScaleY.Animate, Point: *gm.ScaleY.Point}
-		mesh.ScaleZ = types.ObjectCoordinate{Animate: *gm.ScaleZ.Animate, Point: *gm.ScaleZ.Point}
-		mesh.RotateX = types.ObjectCoordinate{Animate: *gm.RotateX.Animate, Point: *gm.RotateX.Point}
-		mesh.RotateY = types.ObjectCoordinate{Animate: *gm.RotateY.Animate, Point: *gm.RotateY.Point}
-		mesh.RotateZ = types.ObjectCoordinate{Animate: *gm.RotateZ.Animate, Point: *gm.RotateZ.Point}
-		mesh.DisplaceX = types.ObjectCoordinate{Animate: *gm.DisplaceX.Animate, Point: *gm.DisplaceX.Point}
-		mesh.DisplaceY = types.ObjectCoordinate{Animate: *gm.DisplaceY.Animate, Point: *gm.DisplaceY.Point}
-		mesh.DisplaceZ = types.ObjectCoordinate{Animate: *gm.DisplaceZ.Animate, Point: *gm.DisplaceZ.Point}
-
-		mesh.MaterialRefraction = types.ObjectCoordinate{Animate: *gm.Setting_MaterialRefraction.Animate, Point: *gm.Setting_MaterialRefraction.Point}
-		mesh.MaterialSpecularExp = types.ObjectCoordinate{Animate: *gm.Setting_MaterialSpecularExp.Animate, Point: *gm.Setting_MaterialSpecularExp.Point}
-
-		mesh.LightPosition = mgl32.Vec3{*gm.Setting_LightPosition.X, *gm.Setting_LightPosition.Y, *gm.Setting_LightPosition.Z}
-		mesh.LightDirection = mgl32.Vec3{*gm.Setting_LightDirection.X, *gm.Setting_LightDirection.Y, *gm.Setting_LightDirection.Z}
-		mesh.LightAmbient = mgl32.Vec3{*gm.Setting_LightAmbient.X, *gm.Setting_LightAmbient.Y, *gm.Setting_LightAmbient.Z}
-		mesh.LightDiffuse = mgl32.Vec3{*gm.Setting_LightDiffuse.X, *gm.Setting_LightDiffuse.Y, *gm.Setting_LightDiffuse.Z}
-		mesh.LightSpecular = mgl32.Vec3{*gm.Setting_LightSpecular.X, *gm.Setting_LightSpecular.Y, *gm.Setting_LightSpecular.Z}
-
-		mesh.LightStrengthAmbient = *gm.Setting_LightStrengthAmbient
-		mesh.LightStrengthDiffuse = *gm.Setting_LightStrengthDiffuse
-		mesh.LightStrengthSpecular = *gm.Setting_LightStrengthSpecular
-		mesh.LightingPassDrawMode = uint32(*gm.Setting_LightingPass_DrawMode)
-
-		mesh.MaterialIlluminationModel = uint32(*gm.MaterialIlluminationModel)
-		mesh.ParallaxMapping = *gm.Setting_ParallaxMapping
+		mesh.LightStrengthAmbient = gm.GetSetting_LightStrengthAmbient()
+		mesh.LightStrengthDiffuse = gm.GetSetting_LightStrengthDiffuse()
+		mesh.LightStrengthSpecular = gm.GetSetting_LightStrengthSpecular()
+		mesh.LightingPassDrawMode = uint32(gm.GetSetting_LightingPass_DrawMode())
+
+		mesh.MaterialIlluminationModel = uint32(gm.GetMaterialIlluminationModel())
+		mesh.ParallaxMapping = gm.GetSetting_ParallaxMapping()
 
 		mesh.MaterialAmbient = types.MaterialColor{
-			ColorPickerOpen: *gm.MaterialAmbient.ColorPickerOpen,
-			Animate:         *gm.MaterialAmbient.Animate,
-			Strength:        *gm.MaterialAmbient.Strength,
-			Color:           mgl32.Vec3{*gm.MaterialAmbient.Color.X, *gm.MaterialAmbient.Color.Y, *gm.MaterialAmbient.Color.Z}}
+			ColorPickerOpen: gm.GetMaterialAmbient().GetColorPickerOpen(),
+			Animate:         gm.GetMaterialAmbient().GetAnimate(),
+			Strength:        gm.GetMaterialAmbient().GetStrength(),
+			Color:           mgl32.Vec3{gm.GetMaterialAmbient().GetColor().GetX(), gm.GetMaterialAmbient().GetColor().GetY(), gm.GetMaterialAmbient().GetColor().GetZ()}}
 		mesh.MaterialDiffuse = types.MaterialColor{
-			ColorPickerOpen: *gm.MaterialDiffuse.ColorPickerOpen,
-			Animate:         *gm.MaterialDiffuse.Animate,
-			Strength:        *gm.MaterialDiffuse.Strength,
-			Color:           mgl32.Vec3{*gm.MaterialDiffuse.Color.X, *gm.MaterialDiffuse.Color.Y, *gm.MaterialDiffuse.Color.Z}}
+			ColorPickerOpen: gm.GetMaterialDiffuse().GetColorPickerOpen(),
+			Animate:         gm.GetMaterialDiffuse().GetAnimate(),
+			Strength:        gm.GetMaterialDiffuse().GetStrength(),
+			Color:           mgl32.Vec3{gm.GetMaterialDiffuse().GetColor().GetX(), gm.GetMaterialDiffuse().GetColor().GetY(), gm.GetMaterialDiffuse().GetColor().GetZ()}}
 		mesh.MaterialSpecular = types.MaterialColor{
-			ColorPickerOpen: *gm.MaterialSpecular.ColorPickerOpen,
-			Animate:         *gm.MaterialSpecular.Animate,
-			Strength:        *gm.MaterialSpecular.Strength,
-			Color:           mgl32.Vec3{*gm.MaterialSpecular.Color.X, *gm.MaterialSpecular.Color.Y, *gm.MaterialSpecular.Color.Z}}
+			ColorPickerOpen: gm.GetMaterialSpecular().GetColorPickerOpen(),
+			Animate:         gm.GetMaterialSpecular().GetAnimate(),
+			Strength:        gm.GetMaterialSpecular().GetStrength(),
+			Color:           mgl32.Vec3{gm.GetMaterialSpecular().GetColor().GetX(), gm.GetMaterialSpecular().GetColor().GetY(), gm.GetMaterialSpecular().GetColor().GetZ()}}
 		mesh.MaterialEmission = types.MaterialColor{
-			ColorPickerOpen: *gm.MaterialEmission.ColorPickerOpen,
-			Animate:         *gm.MaterialEmission.Animate,
-			Strength:        *gm.MaterialEmission.Strength,
-			Color:           mgl32.Vec3{*gm.MaterialEmission.Color.X, *gm.MaterialEmission.Color.Y, *gm.MaterialEmission.Color.Z}}
+			ColorPickerOpen: gm.GetMaterialEmission().GetColorPickerOpen(),
+			Animate:         gm.GetMaterialEmission().GetAnimate(),
+			Strength:        gm.GetMaterialEmission().GetStrength(),
+			Color:           mgl32.Vec3{gm.GetMaterialEmission().GetColor().GetX(), gm.GetMaterialEmission().GetColor().GetY(), gm.GetMaterialEmission().GetColor().GetZ()}}
 
-		mesh.DisplacementHeightScale = types.ObjectCoordinate{Animate: *gm.DisplacementHeightScale.Animate, Point: *gm.DisplacementHeightScale.Point}
+		mesh.DisplacementHeightScale = types.ObjectCoordinate{Animate: gm.GetDisplacementHeightScale().GetAnimate(), Point: gm.GetDisplacementHeightScale().GetPoint()}
 
-		mesh.EffectGBlurMode = *gm.Effect_GBlur_Mode
-		mesh.EffectGBlurRadius = types.ObjectCoordinate{Animate: *gm.Effect_GBlur_Radius.Animate, Point: *gm.Effect_GBlur_Radius.Point}
-		mesh.EffectGBlurWidth = types.ObjectCoordinate{Animate: *gm.Effect_GBlur_Width.Animate, Point: *gm.Effect_GBlur_Width.Point}
+		mesh.EffectGBlurMode = gm.GetEffect_GBlur_Mode()
+		mesh.EffectGBlurRadius = types.ObjectCoordinate{Animate: gm.GetEffect_GBlur_Radius().GetAnimate(), Point: gm.GetEffect_GBlur_Radius().GetPoint()}
+		mesh.EffectGBlurWidth = types.ObjectCoordinate{Animate: gm.GetEffect_GBlur_Width().GetAnimate(), Point: gm.GetEffect_GBlur_Width().GetPoint()}
 
-		mesh.EffectBloomDoBloom = *gm.Effect_Bloom_DoBloom
-		mesh.EffectBloomWeightA = *gm.Effect_Bloom_WeightA
-		mesh.EffectBloomWeightB = *gm.Effect_Bloom_WeightB
-		mesh.EffectBloomWeightC = *gm.Effect_Bloom_WeightC
-		mesh.EffectBloomWeightD = *gm.Effect_Bloom_WeightD
-		mesh.EffectBloomVignette = *gm.Effect_Bloom_Vignette
-		mesh.EffectBloomVignetteAtt = *gm.Effect_Bloom_VignetteAtt
+		mesh.EffectBloomDoBloom = gm.GetEffect_Bloom_DoBloom()
+		mesh.EffectBloomWeightA = gm.GetEffect_Bloom_WeightA()
+		mesh.EffectBloomWeightB = gm.GetEffect_Bloom_WeightB()
+		mesh.EffectBloomWeightC = gm.GetEffect_Bloom_WeightC()
+		mesh.EffectBloomWeightD = gm.GetEffect_Bloom_WeightD()
+		mesh.EffectBloomVignette = gm.GetEffect_Bloom_Vignette()
+		mesh.EffectBloomVignetteAtt = gm.GetEffect_Bloom_VignetteAtt()
 
-		mesh.EffectToneMappingACESFilmRec2020 = *gm.EffectToneMappingACESFilmRec2020
-		mesh.EffectHDRTonemapping = *gm.EffectHDRTonemapping
+		mesh.EffectToneMappingACESFilmRec2020 = gm.GetEffectToneMappingACESFilmRec2020()
+		mesh.EffectHDRTonemapping = gm.GetEffectHDRTonemapping()
 
-		mesh.ShowShadows = *gm.ShowShadows
+		mesh.ShowShadows = gm.GetShowShadows()
 
-		mesh.RenderingPBR = *gm.RenderingPBR
-		mesh.RenderingPBRMetallic = *gm.RenderingPBRMetallic
-		mesh.RenderingPBRRoughness = *gm.RenderingPBRRoughness
-		mesh.RenderingPBRAO = *gm.RenderingPBRAO
+		mesh.RenderingPBR = gm.GetRenderingPBR()
+		mesh.RenderingPBRMetallic = gm.GetRenderingPBRMetallic()
+		mesh.RenderingPBRRoughness = gm.GetRenderingPBRRoughness()
+		mesh.RenderingPBRAO = gm.GetRenderingPBRAO()
 
-		mesh.SolidLightSkinMaterialColor = mgl32.Vec3{*gm.SolidLightSkin_MaterialColor.X, *gm.SolidLightSkin_MaterialColor.Y, *gm.SolidLightSkin_MaterialColor.Z}
-		mesh.SolidLightSkinAmbient = mgl32.Vec3{*gm.SolidLightSkin_Ambient.X, *gm.SolidLightSkin_Ambient.Y, *gm.SolidLightSkin_Ambient.Z}
-		mesh.SolidLightSkinDiffuse = mgl32.Vec3{*gm.SolidLightSkin_Diffuse.X, *gm.SolidLightSkin_Diffuse.Y, *gm.SolidLightSkin_Diffuse.Z}
-		mesh.SolidLightSkinSpecular = mgl32.Vec3{*gm.SolidLightSkin_Specular.X, *gm.SolidLightSkin_Specular.Y, *gm.SolidLightSkin_Specular.Z}
+		mesh.SolidLightSkinMaterialColor = mgl32.Vec3{gm.GetSolidLightSkin_MaterialColor().GetX(), gm.GetSolidLightSkin_MaterialColor().GetY(), gm.GetSolidLightSkin_MaterialColor().GetZ()}
+		mesh.SolidLightSkinAmbient = mgl32.Vec3{gm.GetSolidLightSkin_Ambient().GetX(), gm.GetSolidLightSkin_Ambient().GetY(), gm.GetSolidLightSkin_Ambient().GetZ()}
+		mesh.SolidLightSkinDiffuse = mgl32.Vec3{gm.GetSolidLightSkin_Diffuse().GetX(), gm.GetSolidLightSkin_Diffuse().GetY(), gm.GetSolidLightSkin_Diffuse().GetZ()}
+		mesh.SolidLightSkinSpecular = mgl32.Vec3{gm.GetSolidLightSkin_Specular().GetX(), gm.GetSolidLightSkin_Specular().GetY(), gm.GetSolidLightSkin_Specular().GetZ()}
 
-		mesh.SolidLightSkinAmbientStrength = *gm.SolidLightSkin_Ambient_Strength
-		mesh.SolidLightSkinDiffuseStrength = *gm.SolidLightSkin_Diffuse_Strength
-		mesh.SlidLightSkinSpecularStrength = *gm.SolidLightSkin_Specular_Strength
+		mesh.SolidLightSkinAmbientStrength = gm.GetSolidLightSkin_Ambient_Strength()
+		mesh.SolidLightSkinDiffuseStrength = gm.GetSolidLightSkin_Diffuse_Strength()
+		mesh.SlidLightSkinSpecularStrength = gm.GetSolidLightSkin_Specular_Strength()
 
 		*faces = append(*faces, mesh)
 
@@ -464,9 +473,151 @@ func (pm *ProtoBufsSaveOpen) readObjects(filename string, window interfaces.Wind
 		sett.MemSettings.TotalFaces += mesh.MeshModel.CountVertices / 6
 		sett.MemSettings.TotalObjects++
 	}
+
+	return links.warnings
+}
+
+func readMaterial(gmom *MeshModelMaterial) types.MeshModelMaterial {
+	mmm := types.MeshModelMaterial{}
+	mmm.MaterialID = uint32(gmom.GetMaterialID())
+	mmm.MaterialTitle = gmom.GetMaterialTitle()
+
+	mmm.SpecularExp = gmom.GetSpecularExp()
+
+	mmm.AmbientColor = mgl32.Vec3{gmom.GetAmbientColor().GetX(), gmom.GetAmbientColor().GetY(), gmom.GetAmbientColor().GetZ()}
+	mmm.DiffuseColor = mgl32.Vec3{gmom.GetDiffuseColor().GetX(), gmom.GetDiffuseColor().GetY(), gmom.GetDiffuseColor().GetZ()}
+	mmm.SpecularColor = mgl32.Vec3{gmom.GetSpecularColor().GetX(), gmom.GetSpecularColor().GetY(), gmom.GetSpecularColor().GetZ()}
+	mmm.EmissionColor = mgl32.Vec3{gmom.GetEmissionColor().GetX(), gmom.GetEmissionColor().GetY(), gmom.GetEmissionColor().GetZ()}
+
+	mmm.Transparency = gmom.GetTransparency()
+	mmm.IlluminationMode = gmom.GetIlluminationMode()
+	mmm.OpticalDensity = gmom.GetOpticalDensity()
+
+	mmmtia := types.MeshMaterialTextureImage{
+		Filename:   gmom.GetTextureAmbient().GetFilename(),
+		Image:      gmom.GetTextureAmbient().GetImage(),
+		Width:      gmom.GetTextureAmbient().GetWidth(),
+		Height:     gmom.GetTextureAmbient().GetHeight(),
+		UseTexture: gmom.GetTextureAmbient().GetUseTexture(),
+		Commands:   gmom.GetTextureAmbient().GetCommands()}
+	mmm.TextureAmbient = mmmtia
+	mmmtid := types.MeshMaterialTextureImage{
+		Filename:   gmom.GetTextureDiffuse().GetFilename(),
+		Image:      gmom.GetTextureDiffuse().GetImage(),
+		Width:      gmom.GetTextureDiffuse().GetWidth(),
+		Height:     gmom.GetTextureDiffuse().GetHeight(),
+		UseTexture: gmom.GetTextureDiffuse().GetUseTexture(),
+		Commands:   gmom.GetTextureDiffuse().GetCommands()}
+	mmm.TextureDiffuse = mmmtid
+	mmmtis := types.MeshMaterialTextureImage{
+		Filename:   gmom.GetTextureSpecular().GetFilename(),
+		Image:      gmom.GetTextureSpecular().GetImage(),
+		Width:      gmom.GetTextureSpecular().GetWidth(),
+		Height:     gmom.GetTextureSpecular().GetHeight(),
+		UseTexture: gmom.GetTextureSpecular().GetUseTexture(),
+		Commands:   gmom.GetTextureSpecular().GetCommands()}
+	mmm.TextureSpecular = mmmtis
+	mmmtise := types.MeshMaterialTextureImage{
+		Filename:   gmom.GetTextureSpecularExp().GetFilename(),
+		Image:      gmom.GetTextureSpecularExp().GetImage(),
+		Width:      gmom.GetTextureSpecularExp().GetWidth(),
+		Height:     gmom.GetTextureSpecularExp().GetHeight(),
+		UseTexture: gmom.GetTextureSpecularExp().GetUseTexture(),
+		Commands:   gmom.GetTextureSpecularExp().GetCommands()}
+	mmm.TextureSpecularExp = mmmtise
+	mmmtidi := types.MeshMaterialTextureImage{
+		Filename:   gmom.GetTextureDissolve().GetFilename(),
+		Image:      gmom.GetTextureDissolve().GetImage(),
+		Width:      gmom.GetTextureDissolve().GetWidth(),
+		Height:     gmom.GetTextureDissolve().GetHeight(),
+		UseTexture: gmom.GetTextureDissolve().GetUseTexture(),
+		Commands:   gmom.GetTextureDissolve().GetCommands()}
+	mmm.TextureDissolve = mmmtidi
+	mmmtib := types.MeshMaterialTextureImage{
+		Filename:   gmom.GetTextureBump().GetFilename(),
+		Image:      gmom.GetTextureBump().GetImage(),
+		Width:      gmom.GetTextureBump().GetWidth(),
+		Height:     gmom.GetTextureBump().GetHeight(),
+		UseTexture: gmom.GetTextureBump().GetUseTexture(),
+		Commands:   gmom.GetTextureBump().GetCommands()}
+	mmm.TextureBump = mmmtib
+	mmmtids := types.MeshMaterialTextureImage{
+		Filename:   gmom.GetTextureDisplacement().GetFilename(),
+		Image:      gmom.GetTextureDisplacement().GetImage(),
+		Width:      gmom.GetTextureDisplacement().GetWidth(),
+		Height:     gmom.GetTextureDisplacement().GetHeight(),
+		UseTexture: gmom.GetTextureDisplacement().GetUseTexture(),
+		Commands:   gmom.GetTextureDisplacement().GetCommands()}
+	mmm.TextureDisplacement = mmmtids
+	return mmm
+}
+
+// readModelTransform sets the position, scale, rotation and displacement of the scene model
+func readModelTransform(gm *MeshModel, mesh *meshes.ModelFace) {
+	mesh.PositionX = types.ObjectCoordinate{Animate: gm.GetPositionX().GetAnimate(), Point: gm.GetPositionX().GetPoint()}
+	mesh.PositionY = types.ObjectCoordinate{Animate: gm.GetPositionY().GetAnimate(), Point: gm.GetPositionY().GetPoint()}
+	mesh.PositionZ = types.ObjectCoordinate{Animate: gm.GetPositionZ().GetAnimate(), Point: gm.GetPositionZ().GetPoint()}
+	mesh.ScaleX = types.ObjectCoordinate{Animate: gm.GetScaleX().GetAnimate(), Point: gm.GetScaleX().GetPoint()}
+	mesh.ScaleY = types.ObjectCoordinate{Animate: gm.GetScaleY().GetAnimate(), Point: gm.GetScaleY().GetPoint()}
+	mesh.ScaleZ = types.ObjectCoordinate{Animate: gm.GetScaleZ().GetAnimate(), Point: gm.GetScaleZ().GetPoint()}
+	mesh.RotateX = types.ObjectCoordinate{Animate: gm.GetRotateX().GetAnimate(), Point: gm.GetRotateX().GetPoint()}
+	mesh.RotateY = types.ObjectCoordinate{Animate: gm.GetRotateY().GetAnimate(), Point: gm.GetRotateY().GetPoint()}
+	mesh.RotateZ = types.ObjectCoordinate{Animate: gm.GetRotateZ().GetAnimate(), Point: gm.GetRotateZ().GetPoint()}
+	mesh.DisplaceX = types.ObjectCoordinate{Animate: gm.GetDisplaceX().GetAnimate(), Point: gm.GetDisplaceX().GetPoint()}
+	mesh.DisplaceY = types.ObjectCoordinate{Animate: gm.GetDisplaceY().GetAnimate(), Point: gm.GetDisplaceY().GetPoint()}
+	mesh.DisplaceZ = types.ObjectCoordinate{Animate: gm.GetDisplaceZ().GetAnimate(), Point: gm.GetDisplaceZ().GetPoint()}
 }
 
-func (pm *ProtoBufsSaveOpen) storeRenderingSettings(lights []*objects.Light, rprops types.RenderProperties, cam *objects.Camera, grid *objects.WorldGrid) {
+// readMeshModel returns the geometry and the material of the scene model, linked models are re-imported from their source.
+// When the source can't be re-imported, it returns false and the model without geometry.
+func readMeshModel(gm *MeshModel, links *relinker) (types.MeshModel, bool) {
+	gmo := gm.GetMeshObject()
+
+	// MeshModel
+	mm := types.MeshModel{}
+	mm.ID = uint32(gmo.GetID())
+	mm.File = gmo.GetFile()
+	mm.FilePath = gmo.GetFilePath()
+
+	mm.ModelTitle = gmo.GetModelTitle()
+	mm.MaterialTitle = gmo.GetMaterialTitle()
+
+	mm.CountVertices = gmo.GetCountVertices()
+	mm.CountTextureCoordinates = gmo.GetCountTextureCoordinates()
+	mm.CountNormals = gmo.GetCountNormals()
+	mm.CountIndices = gmo.GetCountIndices()
+
+	for j := 0; j < len(gmo.GetVertices()); j++ {
+		mm.Vertices = append(mm.Vertices, mgl32.Vec3{gmo.GetVertices()[j].GetX(), gmo.GetVertices()[j].GetY(), gmo.GetVertices()[j].GetZ()})
+	}
+	for j := 0; j < len(gmo.GetTextureCoordinates()); j++ {
+		mm.TextureCoordinates = append(mm.TextureCoordinates, mgl32.Vec2{gmo.GetTextureCoordinates()[j].GetX(), gmo.GetTextureCoordinates()[j].GetY()})
+	}
+	for j := 0; j < len(gmo.GetNormals()); j++ {
+		mm.Normals = append(mm.Normals, mgl32.Vec3{gmo.GetNormals()[j].GetX(), gmo.GetNormals()[j].GetY(), gmo.GetNormals()[j].GetZ()})
+	}
+	mm.Indices = gmo.GetIndices()
+
+	mm.ModelMaterial = readMaterial(gmo.GetModelMaterial())
+
+	if gms := gm.GetSource(); gms.GetLinked() {
+		linked, ok := links.model(gms, mm.ModelTitle)
+		if !ok {
+			mm.CountVertices, mm.CountTextureCoordinates, mm.CountNormals, mm.CountIndices = 0, 0, 0, 0
+			mm.Vertices, mm.TextureCoordinates, mm.Normals, mm.Indices = nil, nil, nil, nil
+			mm.Source = readModelSource(gms)
+			return mm, false
+		}
+		mm.CountVertices, mm.CountTextureCoordinates, mm.CountNormals, mm.CountIndices = linked.CountVertices, linked.CountTextureCoordinates, linked.CountNormals, linked.CountIndices
+		mm.Vertices, mm.TextureCoordinates, mm.Normals, mm.Indices = linked.Vertices, linked.TextureCoordinates, linked.Normals, linked.Indices
+		mm.Source = linked.Source
+	} else if gms != nil {
+		mm.Source = readModelSource(gms)
+	}
+	return mm, true
+}
+
+func (pm *ProtoBufsSaveOpen) storeRenderingSettings(lights []*objects.Light, rprops types.RenderProperties, cam *objects.Camera, grid *objects.WorldGrid) *GUISettings {
 	gs := &GUISettings{}
 
 	// Render Settings
@@ -544,6 +695,37 @@ func (pm *ProtoBufsSaveOpen) storeRenderingSettings(lights []*objects.Light, rpr
 
 	gs.DebugShadowTexture = proto.Bool(rsett.General.DebugShadowTexture)
 
+	gs.CrossSectionShowPlane = proto.Bool(rsett.CrossSection.ShowPlane)
+	gs.CrossSectionNormal = &Vec3{
+		X: proto.Float32(rsett.CrossSection.NormalX),
+		Y: proto.Float32(rsett.CrossSection.NormalY),
+		Z: proto.Float32(rsett.CrossSection.NormalZ),
+	}
+	gs.CrossSectionOffset = proto.Float32(rsett.CrossSection.Offset)
+	gs.CrossSectionCount = proto.Int32(rsett.CrossSection.Count)
+	gs.CrossSectionSpacing = proto.Float32(rsett.CrossSection.Spacing)
+	gs.CrossSectionPlaneSize = proto.Float32(rsett.CrossSection.PlaneSize)
+
+	gs.ShadowCascadeCount = proto.Int32(rsett.Shadows.CascadeCount)
+	gs.ShadowCascadeSplitLambda = proto.Float32(rsett.Shadows.CascadeSplitLambda)
+	gs.ShadowCascadeBlend = proto.Float32(rsett.Shadows.CascadeBlend)
+	gs.ShadowDebugCascades = proto.Bool(rsett.Shadows.DebugCascades)
+
+	gs.PostProcessingEnabled = proto.Bool(rsett.PostProcessing.Enabled)
+	gs.PostProcessingBloom = proto.Bool(rsett.PostProcessing.Bloom)
+	gs.PostProcessingBloomStrength = proto.Float32(rsett.PostProcessing.BloomStrength)
+	gs.PostProcessingBloomFilterRadius = proto.Float32(rsett.PostProcessing.BloomFilterRadius)
+	gs.PostProcessingToneMapping = proto.Bool(rsett.PostProcessing.ToneMapping)
+	gs.PostProcessingToneMappingOperator = proto.Int32(rsett.PostProcessing.ToneMappingOperator)
+	gs.PostProcessingExposure = proto.Float32(rsett.PostProcessing.Exposure)
+	gs.PostProcessingColorGrading = proto.Bool(rsett.PostProcessing.ColorGrading)
+	gs.PostProcessingColorGradingLUT = proto.String(rsett.PostProcessing.ColorGradingLUT)
+	gs.PostProcessingColorGradingIntensity = proto.Float32(rsett.PostProcessing.ColorGradingIntensity)
+	gs.PostProcessingVignette = proto.Bool(rsett.PostProcessing.Vignette)
+	gs.PostProcessingVignetteIntensity = proto.Float32(rsett.PostProcessing.VignetteIntensity)
+	gs.PostProcessingVignetteSmoothness = proto.Float32(rsett.PostProcessing.VignetteSmoothness)
+	gs.PostProcessingFXAA = proto.Bool(rsett.PostProcessing.FXAA)
+
 	// Render Properties
 	gs.UIAmbientLightX = proto.Float32(rprops.UIAmbientLightX)
 	gs.UIAmbientLightY = proto.Float32(rprops.UIAmbientLightY)
@@ -626,6 +808,7 @@ func (pm *ProtoBufsSaveOpen) storeRenderingSettings(lights []*objects.Light, rpr
 		l.ShowLampObject = proto.Bool(lo.ShowLampObject)
 		l.ShowLampDirection = proto.Bool(lo.ShowLampDirection)
 		l.ShowInWire = proto.Bool(lo.ShowInWire)
+		l.CastShadows = proto.Bool(lo.CastShadows)
 
 		l.PositionX = &ObjectCoordinate{Animate: proto.Bool(lo.PositionX.Animate), Point: proto.Float32(lo.PositionX.Point)}
 		l.PositionY = &ObjectCoordinate{Animate: proto.Bool(lo.PositionY.Animate), Point: proto.Float32(lo.PositionY.Point)}
@@ -663,21 +846,12 @@ func (pm *ProtoBufsSaveOpen) storeRenderingSettings(lights []*objects.Light, rpr
 		gs.Lights = append(gs.Lights, l)
 	}
 
-	// Save
-	data, err := proto.Marshal(gs)
-	if err != nil {
-		settings.LogWarn("[SaveOpen-ProtoBufs] [storeRenderingSettings] Marshalling error: %v", err)
-		return
-	}
-	err = ioutil.WriteFile(pm.fileNameSettings, data, 0644)
-	if err != nil {
-		settings.LogWarn("[SaveOpen-ProtoBufs] [storeRenderingSettings] Can't save byte data error: %v", err)
-		return
-	}
+	return gs
 }
 
-func (pm *ProtoBufsSaveOpen) storeObjects(meshModelFaces []*meshes.ModelFace) {
+func (pm *ProtoBufsSaveOpen) storeObjects(meshModelFaces []*meshes.ModelFace) *Scene {
 	gs := &Scene{}
+	linkModels := settings.GetSettings().App.LinkModels
 
 	for i := 0; i < len(meshModelFaces); i++ {
 		m := meshModelFaces[i]
@@ -777,17 +951,23 @@ func (pm *ProtoBufsSaveOpen) storeObjects(meshModelFaces []*meshes.ModelFace) {
 		mo.CountNormals = proto.Int32(m.MeshModel.CountNormals)
 		mo.CountIndices = proto.Int32(m.MeshModel.CountIndices)
 
-		for i := 0; i < len(m.MeshModel.Vertices); i++ {
-			mo.Vertices = append(mo.Vertices, &Vec3{X: proto.Float32(m.MeshModel.Vertices[i].X()), Y: proto.Float32(m.MeshModel.Vertices[i].Y()), Z: proto.Float32(m.MeshModel.Vertices[i].Z())})
-		}
-		for i := 0; i < len(m.MeshModel.TextureCoordinates); i++ {
-			mo.TextureCoordinates = append(mo.TextureCoordinates, &Vec2{X: proto.Float32(m.MeshModel.TextureCoordinates[i].X()), Y: proto.Float32(m.MeshModel.TextureCoordinates[i].Y())})
-		}
-		for i := 0; i < len(m.MeshModel.Normals); i++ {
-			mo.Normals = append(mo.Normals, &Vec3{X: proto.Float32(m.MeshModel.Normals[i].X()), Y: proto.Float32(m.MeshModel.Normals[i].Y()), Z: proto.Float32(m.MeshModel.Normals[i].Z())})
-		}
-		for i := 0; i < len(m.MeshModel.Indices); i++ {
-			mo.Indices = append(mo.Indices, m.MeshModel.Indices[i])
+		// linked models are re-imported on open, only their source is stored,
+		// models with a missing source stay linked to it
+		linked := linkModels || m.SourceMissing
+		mm.Source = storeModelSource(m.MeshModel.Source, linked)
+		if mm.Source == nil || !linked {
+			for i := 0; i < len(m.MeshModel.Vertices); i++ {
+				mo.Vertices = append(mo.Vertices, &Vec3{X: proto.Float32(m.MeshModel.Vertices[i].X()), Y: proto.Float32(m.MeshModel.Vertices[i].Y()), Z: proto.Float32(m.MeshModel.Vertices[i].Z())})
+			}
+			for i := 0; i < len(m.MeshModel.TextureCoordinates); i++ {
+				mo.TextureCoordinates = append(mo.TextureCoordinates, &Vec2{X: proto.Float32(m.MeshModel.TextureCoordinates[i].X()), Y: proto.Float32(m.MeshModel.TextureCoordinates[i].Y())})
+			}
+			for i := 0; i < len(m.MeshModel.Normals); i++ {
+				mo.Normals = append(mo.Normals, &Vec3{X: proto.Float32(m.MeshModel.Normals[i].X()), Y: proto.Float32(m.MeshModel.Normals[i].Y()), Z: proto.Float32(m.MeshModel.Normals[i].Z())})
+			}
+			for i := 0; i < len(m.MeshModel.Indices); i++ {
+				mo.Indices = append(mo.Indices, m.MeshModel.Indices[i])
+			}
 		}
 
 		mo.File = proto.String(m.MeshModel.File)
@@ -869,15 +1049,5 @@ func (pm *ProtoBufsSaveOpen) storeObjects(meshModelFaces []*meshes.ModelFace) {
 		gs.Models = append(gs.Models, mm)
 	}
 
-	// Save
-	data, err := proto.Marshal(gs)
-	if err != nil {
-		settings.LogWarn("[SaveOpen-ProtoBufs] [storeObjects] Marshalling error: %v", err)
-		return
-	}
-	err = ioutil.WriteFile(pm.fileNameScene, data, 0644)
-	if err != nil {
-		settings.LogWarn("[SaveOpen-ProtoBufs] [storeObjects] Can't save byte data error: %v", err)
-		return
-	}
+	return gs
 }
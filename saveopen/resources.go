@@ -0,0 +1,94 @@
+package saveopen
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/supudo/Kuplung-Go/engine"
+	"github.com/supudo/Kuplung-Go/meshes"
+	"github.com/supudo/Kuplung-Go/settings"
+)
+
+const resourcesFolder = "resources/"
+
+// resourcePaths returns the textures referenced by the faces and the color grading lookup table
+func resourcePaths(meshModelFaces []*meshes.ModelFace) []string {
+	var paths []string
+	for _, m := range meshModelFaces {
+		for _, texture := range m.MeshModel.ModelMaterial.Textures() {
+			paths = append(paths, texture.Image)
+		}
+	}
+	return append(paths, settings.GetRenderingSettings().PostProcessing.ColorGradingLUT)
+}
+
+// packResources reads every resource, already packed resources are taken from the opened scene
+func packResources(paths []string) ([]kuplungResource, []archiveEntry) {
+	var resources []kuplungResource
+	var entries []archiveEntry
+	packed := make(map[string]bool)
+	for _, path := range paths {
+		if path == "" || packed[path] {
+			continue
+		}
+		packed[path] = true
+
+		data, ok := engine.PackedResource(path)
+		if !ok {
+			var err error
+			if data, err = ioutil.ReadFile(path); err != nil {
+				settings.LogWarn("[SaveOpen] Can't pack the resource %v : %v", path, err)
+				continue
+			}
+		}
+		entry := fmt.Sprintf("%s%03d_%s", resourcesFolder, len(resources)+1, filepath.Base(path))
+		resources = append(resources, kuplungResource{Path: path, Entry: entry})
+		entries = append(entries, archiveEntry{name: entry, data: data})
+	}
+	return resources, entries
+}
+
+// UnpackResources writes the packed resources of the opened scene to the folder and points the textures and the lookup table to the written files
+func UnpackResources(folder string, meshModelFaces []*meshes.ModelFace) (int, error) {
+	paths := engine.PackedResourcePaths()
+	if len(paths) == 0 {
+		return 0, nil
+	}
+	if err := os.MkdirAll(folder, 0755); err != nil {
+		return 0, err
+	}
+
+	unpacked := make(map[string]string, len(paths))
+	names := make(map[string]bool, len(paths))
+	for i, path := range paths {
+		name := filepath.Base(path)
+		if names[name] {
+			name = fmt.Sprintf("%03d_%s", i+1, name)
+		}
+		names[name] = true
+
+		data, _ := engine.PackedResource(path)
+		target := filepath.Join(folder, name)
+		if err := ioutil.WriteFile(target, data, 0644); err != nil {
+			return 0, err
+		}
+		unpacked[path] = target
+	}
+
+	for _, m := range meshModelFaces {
+		for _, texture := range m.MeshModel.ModelMaterial.Textures() {
+			if target, ok := unpacked[texture.Image]; ok {
+				texture.Image = target
+				texture.Filename = filepath.Base(target)
+			}
+		}
+	}
+	rsett := settings.GetRenderingSettings()
+	if target, ok := unpacked[rsett.PostProcessing.ColorGradingLUT]; ok {
+		rsett.PostProcessing.ColorGradingLUT = target
+	}
+	engine.ClearPackedResources()
+	return len(unpacked), nil
+}
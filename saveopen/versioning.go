@@ -0,0 +1,225 @@
+package saveopen
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// KuplungFormatVersion is the version of the .kuplung archives written by this build.
+// Version 1 archives have no manifest and were written with required proto2 fields,
+// version 2 adds the manifest, optional fields and the cross-section settings,
+// version 3 adds the model sources and linked models, which have no geometry in the scene,
+// version 4 adds the cascaded shadow maps settings,
+// version 5 adds the shadow toggle of the lights,
+// version 6 adds the post-processing settings.
+const KuplungFormatVersion uint32 = 6
+
+const (
+	manifestSuffix = ".manifest"
+	manifestFormat = "kuplung"
+)
+
+// kuplungManifest is the JSON entry describing the archive contents
+type kuplungManifest struct {
+	Format      string `json:"format"`
+	Version     uint32 `json:"version"`
+	Application string `json:"application"`
+	Settings    string `json:"settings"`
+	Scene       string `json:"scene"`
+
+	Resources []kuplungResource `json:"resources,omitempty"`
+
+	Thumbnail string         `json:"thumbnail,omitempty"`
+	Metadata  *SceneMetadata `json:"metadata,omitempty"`
+}
+
+// kuplungResource maps a packed entry to the path the scene references it with
+type kuplungResource struct {
+	Path  string `json:"path"`
+	Entry string `json:"entry"`
+}
+
+// SceneArchive is a decoded .kuplung archive
+type SceneArchive struct {
+	Settings *GUISettings
+	Scene    *Scene
+	// Resources are the packed files, keyed by the path the scene references them with
+	Resources map[string][]byte
+	// Version is the format version the archive was saved with
+	Version uint32
+	// Metadata and Thumbnail are empty for scenes saved before they were added
+	Metadata  *SceneMetadata
+	Thumbnail []byte
+}
+
+// sceneMigration upgrades the decoded settings and scene by one format version
+type sceneMigration func(gs *GUISettings, scene *Scene)
+
+// sceneMigrations[i] upgrades a scene from version i+1 to version i+2
+var sceneMigrations = []sceneMigration{
+	migrateV1ToV2,
+	migrateV2ToV3,
+	migrateV3ToV4,
+	migrateV4ToV5,
+	migrateV5ToV6,
+}
+
+// encodeManifest fills in the format and the version of the manifest
+func encodeManifest(manifest kuplungManifest) ([]byte, error) {
+	manifest.Format = manifestFormat
+	manifest.Version = KuplungFormatVersion
+	manifest.Application = "Kuplung"
+	return json.MarshalIndent(manifest, "", "  ")
+}
+
+// ReadScene decodes a .kuplung archive and upgrades it to the current format version
+func ReadScene(filename string) (*SceneArchive, error) {
+	entries, err := readArchive(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	// version 1 archives have no manifest and are identified by their entries suffixes
+	version := uint32(1)
+	var settingsEntry, sceneEntry string
+	var resources []kuplungResource
+	var thumbnailEntry string
+	var metadata *SceneMetadata
+	for name := range entries {
+		switch {
+		case strings.HasSuffix(name, manifestSuffix):
+			var manifest kuplungManifest
+			if err := json.Unmarshal(entries[name], &manifest); err != nil {
+				return nil, fmt.Errorf("invalid manifest: %v", err)
+			}
+			if manifest.Format != manifestFormat {
+				return nil, fmt.Errorf("unknown archive format %q", manifest.Format)
+			}
+			version = manifest.Version
+			settingsEntry, sceneEntry = manifest.Settings, manifest.Scene
+			resources = manifest.Resources
+			thumbnailEntry, metadata = manifest.Thumbnail, manifest.Metadata
+		case strings.HasSuffix(name, ".settings") && settingsEntry == "":
+			settingsEntry = name
+		case strings.HasSuffix(name, ".scene") && sceneEntry == "":
+			sceneEntry = name
+		}
+	}
+	if version == 0 || version > KuplungFormatVersion {
+		return nil, fmt.Errorf("unsupported format version %v, this build reads up to %v", version, KuplungFormatVersion)
+	}
+	dataSettings, ok := entries[settingsEntry]
+	if !ok {
+		return nil, errors.New("the archive has no settings entry")
+	}
+	dataScene, ok := entries[sceneEntry]
+	if !ok {
+		return nil, errors.New("the archive has no scene entry")
+	}
+
+	gs := &GUISettings{}
+	if err := proto.Unmarshal(dataSettings, gs); err != nil {
+		return nil, fmt.Errorf("can't decode the settings: %v", err)
+	}
+	scene := &Scene{}
+	if err := proto.Unmarshal(dataScene, scene); err != nil {
+		return nil, fmt.Errorf("can't decode the scene: %v", err)
+	}
+
+	packed := make(map[string][]byte, len(resources))
+	for _, r := range resources {
+		data, ok := entries[r.Entry]
+		if !ok {
+			return nil, fmt.Errorf("the archive has no entry %v for the packed resource %v", r.Entry, r.Path)
+		}
+		packed[r.Path] = data
+	}
+
+	for v := version; v < KuplungFormatVersion; v++ {
+		sceneMigrations[v-1](gs, scene)
+	}
+	return &SceneArchive{Settings: gs, Scene: scene, Resources: packed, Version: version, Metadata: metadata, Thumbnail: entries[thumbnailEntry]}, nil
+}
+
+// migrateV1ToV2 adds the cross-section settings with their defaults
+func migrateV1ToV2(gs *GUISettings, scene *Scene) {
+	if gs.CrossSectionNormal == nil {
+		gs.CrossSectionNormal = &Vec3{X: proto.Float32(0.0), Y: proto.Float32(1.0), Z: proto.Float32(0.0)}
+	}
+	if gs.CrossSectionCount == nil {
+		gs.CrossSectionCount = proto.Int32(1)
+	}
+	if gs.CrossSectionSpacing == nil {
+		gs.CrossSectionSpacing = proto.Float32(0.5)
+	}
+	if gs.CrossSectionPlaneSize == nil {
+		gs.CrossSectionPlaneSize = proto.Float32(10.0)
+	}
+}
+
+// migrateV2ToV3 has nothing to fill, older models have no source and stay embedded
+func migrateV2ToV3(gs *GUISettings, scene *Scene) {
+}
+
+// migrateV3ToV4 adds the cascaded shadow maps settings with their defaults
+func migrateV3ToV4(gs *GUISettings, scene *Scene) {
+	if gs.ShadowCascadeCount == nil {
+		gs.ShadowCascadeCount = proto.Int32(4)
+	}
+	if gs.ShadowCascadeSplitLambda == nil {
+		gs.ShadowCascadeSplitLambda = proto.Float32(0.75)
+	}
+	if gs.ShadowCascadeBlend == nil {
+		gs.ShadowCascadeBlend = proto.Float32(0.1)
+	}
+}
+
+// migrateV4ToV5 turns the shadows on for the lights, as they were before the toggle
+func migrateV4ToV5(gs *GUISettings, scene *Scene) {
+	for _, l := range gs.Lights {
+		if l.CastShadows == nil {
+			l.CastShadows = proto.Bool(true)
+		}
+	}
+}
+
+// migrateV5ToV6 adds the post-processing settings with their defaults, the chain is off as it wasn't there
+func migrateV5ToV6(gs *GUISettings, scene *Scene) {
+	if gs.PostProcessingEnabled == nil {
+		gs.PostProcessingEnabled = proto.Bool(false)
+	}
+	if gs.PostProcessingBloom == nil {
+		gs.PostProcessingBloom = proto.Bool(true)
+	}
+	if gs.PostProcessingBloomStrength == nil {
+		gs.PostProcessingBloomStrength = proto.Float32(0.04)
+	}
+	if gs.PostProcessingBloomFilterRadius == nil {
+		gs.PostProcessingBloomFilterRadius = proto.Float32(0.005)
+	}
+	if gs.PostProcessingToneMapping == nil {
+		gs.PostProcessingToneMapping = proto.Bool(true)
+	}
+	if gs.PostProcessingToneMappingOperator == nil {
+		gs.PostProcessingToneMappingOperator = proto.Int32(1)
+	}
+	if gs.PostProcessingExposure == nil {
+		gs.PostProcessingExposure = proto.Float32(1.0)
+	}
+	if gs.PostProcessingColorGradingIntensity == nil {
+		gs.PostProcessingColorGradingIntensity = proto.Float32(1.0)
+	}
+	if gs.PostProcessingVignetteIntensity == nil {
+		gs.PostProcessingVignetteIntensity = proto.Float32(0.35)
+	}
+	if gs.PostProcessingVignetteSmoothness == nil {
+		gs.PostProcessingVignetteSmoothness = proto.Float32(0.45)
+	}
+	if gs.PostProcessingFXAA == nil {
+		gs.PostProcessingFXAA = proto.Bool(true)
+	}
+}
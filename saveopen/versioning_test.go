@@ -0,0 +1,128 @@
+package saveopen
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+)
+
+// TestReadSceneGoldenFiles opens every golden archive in testdata, the scene is described in testdata/README.md
+func TestReadSceneGoldenFiles(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "scene_v*.kuplung"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != int(KuplungFormatVersion) {
+		t.Errorf("found %v golden files, expected one for each of the %v format versions", len(files), KuplungFormatVersion)
+	}
+
+	for _, file := range files {
+		file := file
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			var version uint32
+			if _, err := fmt.Sscanf(filepath.Base(file), "scene_v%d.kuplung", &version); err != nil {
+				t.Fatalf("can't read the version from the file name: %v", err)
+			}
+
+			archive, err := ReadScene(file)
+			if err != nil {
+				t.Fatalf("ReadScene: %v", err)
+			}
+			if archive.Version != version {
+				t.Errorf("version = %v, expected %v", archive.Version, version)
+			}
+			checkGoldenModel(t, archive, version)
+			checkGoldenSettings(t, archive, version)
+		})
+	}
+}
+
+// checkGoldenModel checks the cube at (1, 2, 3), rotated 45 degrees around Y, with the red-ish diffuse material
+func checkGoldenModel(t *testing.T, archive *SceneArchive, version uint32) {
+	models := archive.Scene.GetModels()
+	if len(models) != 1 {
+		t.Fatalf("models = %v, expected 1", len(models))
+	}
+	gm := models[0]
+	mesh := gm.GetMeshObject()
+	if mesh.GetModelTitle() != "Cube" {
+		t.Errorf("model title = %q, expected \"Cube\"", mesh.GetModelTitle())
+	}
+	position := [3]float32{gm.GetPositionX().GetPoint(), gm.GetPositionY().GetPoint(), gm.GetPositionZ().GetPoint()}
+	if position != [3]float32{1, 2, 3} {
+		t.Errorf("position = %v, expected [1 2 3]", position)
+	}
+	if gm.GetRotateY().GetPoint() != 45 {
+		t.Errorf("rotation around Y = %v, expected 45", gm.GetRotateY().GetPoint())
+	}
+	diffuse := mesh.GetModelMaterial().GetDiffuseColor()
+	if color := [3]float32{diffuse.GetX(), diffuse.GetY(), diffuse.GetZ()}; color != [3]float32{0.8, 0.2, 0.1} {
+		t.Errorf("diffuse color = %v, expected [0.8 0.2 0.1]", color)
+	}
+
+	// the cube of the version 3 scene is linked and has no geometry
+	source := gm.GetSource()
+	if version == 3 {
+		if !source.GetLinked() || source.GetSourcePath() != "shapes/cube.obj" || source.GetSourceIndex() != 0 || source.GetSourceTitle() != "Cube" {
+			t.Errorf("source = %v, expected the cube linked to shapes/cube.obj", source)
+		}
+		if len(mesh.GetVertices()) != 0 {
+			t.Errorf("vertices = %v, a linked model has none", len(mesh.GetVertices()))
+		}
+	} else {
+		if source.GetLinked() {
+			t.Errorf("source = %v, expected an embedded model", source)
+		}
+		if len(mesh.GetVertices()) != 8 || len(mesh.GetIndices()) != 36 {
+			t.Errorf("vertices = %v, indices = %v, expected 8 and 36", len(mesh.GetVertices()), len(mesh.GetIndices()))
+		}
+	}
+}
+
+// checkGoldenSettings checks the point light and the settings that were added by the migrations
+func checkGoldenSettings(t *testing.T, archive *SceneArchive, version uint32) {
+	gs := archive.Settings
+	if len(gs.GetLights()) != 1 {
+		t.Fatalf("lights = %v, expected 1", len(gs.GetLights()))
+	}
+	light := gs.GetLights()[0]
+	if light.GetType() != 1 {
+		t.Errorf("light type = %v, expected a point light", light.GetType())
+	}
+	if castShadows := version < 5; light.GetCastShadows() != castShadows {
+		t.Errorf("light casts shadows = %v, expected %v", light.GetCastShadows(), castShadows)
+	}
+
+	if gs.GetCrossSectionCount() != 1 || gs.GetCrossSectionNormal().GetY() != 1 {
+		t.Errorf("cross-section count = %v, normal = %v, expected 1 plane along Y", gs.GetCrossSectionCount(), gs.GetCrossSectionNormal())
+	}
+
+	cascades := [3]float32{4, 0.75, 0.1}
+	if version >= 4 {
+		cascades = [3]float32{3, 0.5, 0.2}
+	}
+	if got := [3]float32{float32(gs.GetShadowCascadeCount()), gs.GetShadowCascadeSplitLambda(), gs.GetShadowCascadeBlend()}; got != cascades {
+		t.Errorf("cascades, split lambda and blend = %v, expected %v", got, cascades)
+	}
+
+	type postProcessing struct {
+		enabled, vignette, fxaa bool
+		operator                int32
+		bloomStrength, exposure float32
+	}
+	expected := postProcessing{enabled: false, vignette: false, fxaa: true, operator: 1, bloomStrength: 0.04, exposure: 1.0}
+	if version >= 6 {
+		expected = postProcessing{enabled: true, vignette: true, fxaa: false, operator: 2, bloomStrength: 0.08, exposure: 1.5}
+	}
+	got := postProcessing{
+		enabled:       gs.GetPostProcessingEnabled(),
+		vignette:      gs.GetPostProcessingVignette(),
+		fxaa:          gs.GetPostProcessingFXAA(),
+		operator:      gs.GetPostProcessingToneMappingOperator(),
+		bloomStrength: gs.GetPostProcessingBloomStrength(),
+		exposure:      gs.GetPostProcessingExposure(),
+	}
+	if got != expected {
+		t.Errorf("post-processing = %+v, expected %+v", got, expected)
+	}
+}
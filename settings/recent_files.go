@@ -0,0 +1,151 @@
+package settings
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+// Recent files lists, kept in the user config folder
+const (
+	RecentScenesFile  = "recent_scenes.json"
+	RecentImportsFile = "recent_imports.json"
+)
+
+const (
+	recentFilesVersion = 1
+	recentFilesMax     = 10
+)
+
+// RecentFile is an entry in a recent files list, imports also keep the format and the settings they were imported with
+type RecentFile struct {
+	Title    string                   `json:"title"`
+	Path     string                   `json:"path"`
+	Format   types.ImportExportFormat `json:"format,omitempty"`
+	Settings []string                 `json:"settings,omitempty"`
+	Opened   time.Time                `json:"opened"`
+}
+
+// RecentFiles is a most-recently-used files list, the newest file is first
+type RecentFiles struct {
+	Version int           `json:"version"`
+	Files   []*RecentFile `json:"files"`
+
+	fileName string
+}
+
+// RecentFilesFolder returns the Kuplung folder in the user config folder, or the application folder if there is none
+func RecentFilesFolder() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return GetSettings().App.AppFolder
+	}
+	return filepath.Join(dir, "Kuplung")
+}
+
+// LoadRecentFiles reads the list from the user config folder and drops the files that no longer exist
+func LoadRecentFiles(name string) *RecentFiles {
+	rf := &RecentFiles{fileName: filepath.Join(RecentFilesFolder(), name)}
+	data, err := ioutil.ReadFile(rf.fileName)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			LogWarn("[RecentFiles] Can't read %v : %v", rf.fileName, err)
+		}
+		return rf
+	}
+	if err := json.Unmarshal(data, rf); err != nil {
+		LogWarn("[RecentFiles] Can't parse %v : %v", rf.fileName, err)
+		rf.Files = nil
+	}
+
+	// tolerate hand-edited lists
+	files := rf.Files
+	rf.Files = nil
+	seen := make(map[string]bool, len(files))
+	for _, f := range files {
+		if f == nil || f.Path == "" || seen[f.Path] {
+			continue
+		}
+		seen[f.Path] = true
+		rf.Files = append(rf.Files, f)
+	}
+	if len(rf.Files) > recentFilesMax {
+		rf.Files = rf.Files[:recentFilesMax]
+	}
+	if rf.Prune() || len(rf.Files) != len(files) {
+		rf.save()
+	}
+	return rf
+}
+
+// Add puts the file on top of the list, replacing an older entry with the same path
+func (rf *RecentFiles) Add(file RecentFile) {
+	if abs, err := filepath.Abs(file.Path); err == nil {
+		file.Path = abs
+	}
+	if file.Title == "" {
+		file.Title = filepath.Base(file.Path)
+	}
+	file.Opened = time.Now()
+
+	files := []*RecentFile{&file}
+	for _, f := range rf.Files {
+		if f.Path != file.Path && len(files) < recentFilesMax {
+			files = append(files, f)
+		}
+	}
+	rf.Files = files
+	rf.save()
+}
+
+// Prune drops the files that no longer exist and reports whether any were dropped, it doesn't save the list
+func (rf *RecentFiles) Prune() bool {
+	var files []*RecentFile
+	for _, f := range rf.Files {
+		if _, err := os.Stat(f.Path); err == nil {
+			files = append(files, f)
+		}
+	}
+	pruned := len(files) != len(rf.Files)
+	rf.Files = files
+	return pruned
+}
+
+// PruneAndSave drops the files that no longer exist and saves the list if it changed
+func (rf *RecentFiles) PruneAndSave() {
+	if rf.Prune() {
+		rf.save()
+	}
+}
+
+// Clear empties the list
+func (rf *RecentFiles) Clear() {
+	rf.Files = nil
+	rf.save()
+}
+
+func (rf *RecentFiles) save() {
+	rf.Version = recentFilesVersion
+	data, err := json.MarshalIndent(rf, "", "  ")
+	if err != nil {
+		LogWarn("[RecentFiles] Can't encode %v : %v", rf.fileName, err)
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(rf.fileName), 0755); err != nil {
+		LogWarn("[RecentFiles] Can't create %v : %v", filepath.Dir(rf.fileName), err)
+		return
+	}
+	tmp := rf.fileName + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		LogWarn("[RecentFiles] Can't save %v : %v", rf.fileName, err)
+		return
+	}
+	if err := os.Rename(tmp, rf.fileName); err != nil {
+		LogWarn("[RecentFiles] Can't save %v : %v", rf.fileName, err)
+		_ = os.Remove(tmp)
+	}
+}
@@ -0,0 +1,157 @@
+package settings
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/supudo/Kuplung-Go/types"
+)
+
+// withConfigFolder points the user config folder to a temporary folder, the returned function restores it
+func withConfigFolder(t *testing.T) (string, func()) {
+	folder, err := ioutil.TempDir("", "kuplung-recent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	env := map[string]string{}
+	for _, name := range []string{"XDG_CONFIG_HOME", "HOME", "AppData"} {
+		env[name] = os.Getenv(name)
+		_ = os.Setenv(name, folder)
+	}
+	return folder, func() {
+		for name, value := range env {
+			_ = os.Setenv(name, value)
+		}
+		os.RemoveAll(folder)
+	}
+}
+
+// createFiles creates empty files in the folder and returns their paths
+func createFiles(t *testing.T, folder string, count int) []string {
+	var paths []string
+	for i := 0; i < count; i++ {
+		path := filepath.Join(folder, fmt.Sprintf("scene_%02d.kuplung", i))
+		if err := ioutil.WriteFile(path, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, path)
+	}
+	return paths
+}
+
+func recentPaths(rf *RecentFiles) []string {
+	var paths []string
+	for _, f := range rf.Files {
+		paths = append(paths, f.Path)
+	}
+	return paths
+}
+
+func TestRecentFilesAddMovesToTop(t *testing.T) {
+	folder, restore := withConfigFolder(t)
+	defer restore()
+	paths := createFiles(t, folder, 3)
+
+	rf := LoadRecentFiles(RecentScenesFile)
+	for _, i := range []int{0, 1, 2, 0} {
+		rf.Add(RecentFile{Path: paths[i]})
+	}
+	if expected := []string{paths[0], paths[2], paths[1]}; !reflect.DeepEqual(recentPaths(rf), expected) {
+		t.Errorf("files = %v, expected %v", recentPaths(rf), expected)
+	}
+	if rf.Files[0].Title != filepath.Base(paths[0]) {
+		t.Errorf("title = %q, expected the file name", rf.Files[0].Title)
+	}
+}
+
+func TestRecentFilesSizeCap(t *testing.T) {
+	folder, restore := withConfigFolder(t)
+	defer restore()
+	paths := createFiles(t, folder, recentFilesMax+5)
+
+	rf := LoadRecentFiles(RecentScenesFile)
+	for _, path := range paths {
+		rf.Add(RecentFile{Path: path})
+	}
+	if len(rf.Files) != recentFilesMax {
+		t.Fatalf("files = %v, expected %v", len(rf.Files), recentFilesMax)
+	}
+	if rf.Files[0].Path != paths[len(paths)-1] || rf.Files[recentFilesMax-1].Path != paths[5] {
+		t.Errorf("files = %v, expected the newest %v, newest first", recentPaths(rf), recentFilesMax)
+	}
+}
+
+func TestRecentFilesPruneMissing(t *testing.T) {
+	folder, restore := withConfigFolder(t)
+	defer restore()
+	paths := createFiles(t, folder, 3)
+
+	rf := LoadRecentFiles(RecentScenesFile)
+	for _, path := range paths {
+		rf.Add(RecentFile{Path: path})
+	}
+	if err := os.Remove(paths[1]); err != nil {
+		t.Fatal(err)
+	}
+
+	// loading prunes and saves the list
+	loaded := LoadRecentFiles(RecentScenesFile)
+	if expected := []string{paths[2], paths[0]}; !reflect.DeepEqual(recentPaths(loaded), expected) {
+		t.Errorf("loaded files = %v, expected %v", recentPaths(loaded), expected)
+	}
+	if !rf.Prune() {
+		t.Error("Prune didn't report the removed file")
+	}
+	if rf.Prune() {
+		t.Error("Prune reported a change on a pruned list")
+	}
+	data, err := ioutil.ReadFile(loaded.fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var saved RecentFiles
+	if err := json.Unmarshal(data, &saved); err != nil {
+		t.Fatal(err)
+	}
+	if len(saved.Files) != 2 {
+		t.Errorf("saved files = %v, expected the 2 that exist", len(saved.Files))
+	}
+}
+
+func TestRecentFilesPersistence(t *testing.T) {
+	folder, restore := withConfigFolder(t)
+	defer restore()
+	paths := createFiles(t, folder, 2)
+
+	rf := LoadRecentFiles(RecentImportsFile)
+	rf.Add(RecentFile{Path: paths[0], Title: "Cube", Format: types.ImportExportFormatOBJ, Settings: []string{"1", "0"}})
+	rf.Add(RecentFile{Path: paths[1], Format: types.ImportExportFormatSTL})
+
+	data, err := ioutil.ReadFile(filepath.Join(RecentFilesFolder(), RecentImportsFile))
+	if err != nil {
+		t.Fatalf("the list wasn't saved: %v", err)
+	}
+	var saved RecentFiles
+	if err := json.Unmarshal(data, &saved); err != nil {
+		t.Fatalf("the list isn't JSON: %v", err)
+	}
+	if saved.Version != recentFilesVersion {
+		t.Errorf("version = %v, expected %v", saved.Version, recentFilesVersion)
+	}
+
+	loaded := LoadRecentFiles(RecentImportsFile)
+	if len(loaded.Files) != len(rf.Files) {
+		t.Fatalf("loaded files = %v, expected %v", len(loaded.Files), len(rf.Files))
+	}
+	for i, f := range loaded.Files {
+		expected := rf.Files[i]
+		if f.Path != expected.Path || f.Title != expected.Title || f.Format != expected.Format || !reflect.DeepEqual(f.Settings, expected.Settings) || !f.Opened.Equal(expected.Opened) {
+			t.Errorf("file %v = %+v, expected %+v", i, f, expected)
+		}
+	}
+}
@@ -64,6 +64,7 @@ type RenderingSettings struct {
 		ShowAllVisualArtefacts bool
 
 		DebugShadowTexture bool
+		DebugLightClusters bool
 	} `yaml:"General"`
 
 	Axis struct {
@@ -80,6 +81,8 @@ type RenderingSettings struct {
 
 	SkyBox struct {
 		SkyboxSelectedItem int32
+		SkyboxRotation     float32
+		SkyboxExposure     float32
 	}
 
 	Defered struct {
@@ -89,6 +92,58 @@ type RenderingSettings struct {
 		LightingPassDrawMode            int32
 		DeferredTestLightsNumber        int32
 		DeferredAmbientStrength         float32
+		SSAOEnabled                     bool
+		SSAORadius                      float32
+		SSAOBias                        float32
+		SSAOSamples                     int32
+		SSAOStrength                    float32
+	}
+
+	Shadows struct {
+		AtlasSize    int32   `yaml:"AtlasSize"`
+		PCFRadius    int32   `yaml:"PCFRadius"`
+		BiasConstant float32 `yaml:"BiasConstant"`
+		BiasSlope    float32 `yaml:"BiasSlope"`
+		MaxLights    int32   `yaml:"MaxLights"`
+		// cascaded shadow maps for the directional lights
+		CascadeCount       int32   `yaml:"CascadeCount"`
+		CascadeSplitLambda float32 `yaml:"CascadeSplitLambda"`
+		CascadeBlend       float32 `yaml:"CascadeBlend"`
+		DebugCascades      bool    `yaml:"DebugCascades"`
+		// cube map shadows for the point lights
+		PointMapSize  int32   `yaml:"PointMapSize"`
+		PointBias     float32 `yaml:"PointBias"`
+		PointSoftness float32 `yaml:"PointSoftness"`
+	} `yaml:"Shadows"`
+
+	// PostProcessing is the scene-level chain that runs over the rendered frame, in the order of the fields
+	PostProcessing struct {
+		Enabled bool
+		// physically based bloom over a chain of downsampled mips
+		Bloom             bool
+		BloomStrength     float32
+		BloomFilterRadius float32
+		// 0 - Reinhard, 1 - ACES filmic, 2 - Uncharted 2
+		ToneMapping         bool
+		ToneMappingOperator int32
+		Exposure            float32
+		// 3D lookup table from a .cube file
+		ColorGrading          bool
+		ColorGradingLUT       string
+		ColorGradingIntensity float32
+		Vignette              bool
+		VignetteIntensity     float32
+		VignetteSmoothness    float32
+		FXAA                  bool
+	}
+
+	// PathTracing is the CPU path tracer of the Rendered view skin
+	PathTracing struct {
+		Samples  int32
+		Bounces  int32
+		Exposure float32
+		// ResolutionScale is the size of the path traced image relative to the viewport
+		ResolutionScale float32
 	}
 
 	Rays struct {
@@ -102,6 +157,17 @@ type RenderingSettings struct {
 		DirectionZ float32
 	}
 
+	CrossSection struct {
+		ShowPlane bool
+		NormalX   float32
+		NormalY   float32
+		NormalZ   float32
+		Offset    float32
+		Count     int32
+		Spacing   float32
+		PlaneSize float32
+	}
+
 	Controls struct {
 		MouseX int32
 		MouseY int32
@@ -123,6 +189,39 @@ func GetRenderingSettings() *RenderingSettings {
 // InitRenderingSettings will initialize application settings
 func InitRenderingSettings() RenderingSettings {
 	var rSettings RenderingSettings
+	rSettings.Shadows.AtlasSize = 4096
+	rSettings.Shadows.PCFRadius = 1
+	rSettings.Shadows.BiasConstant = 0.0005
+	rSettings.Shadows.BiasSlope = 0.005
+	rSettings.Shadows.MaxLights = 4
+	rSettings.Shadows.CascadeCount = 4
+	rSettings.Shadows.CascadeSplitLambda = 0.75
+	rSettings.Shadows.CascadeBlend = 0.1
+	rSettings.Shadows.DebugCascades = false
+	rSettings.Shadows.PointMapSize = 1024
+	rSettings.Shadows.PointBias = 0.05
+	rSettings.Shadows.PointSoftness = 0.04
+	rSettings.SkyBox.SkyboxRotation = 0.0
+	rSettings.SkyBox.SkyboxExposure = 1.0
+	rSettings.PostProcessing.Enabled = false
+	rSettings.PostProcessing.Bloom = true
+	rSettings.PostProcessing.BloomStrength = 0.04
+	rSettings.PostProcessing.BloomFilterRadius = 0.005
+	rSettings.PostProcessing.ToneMapping = true
+	rSettings.PostProcessing.ToneMappingOperator = 1
+	rSettings.PostProcessing.Exposure = 1.0
+	rSettings.PostProcessing.ColorGrading = false
+	rSettings.PostProcessing.ColorGradingLUT = ""
+	rSettings.PostProcessing.ColorGradingIntensity = 1.0
+	rSettings.PostProcessing.Vignette = false
+	rSettings.PostProcessing.VignetteIntensity = 0.35
+	rSettings.PostProcessing.VignetteSmoothness = 0.45
+	rSettings.PostProcessing.FXAA = true
+
+	rSettings.PathTracing.Samples = 256
+	rSettings.PathTracing.Bounces = 6
+	rSettings.PathTracing.Exposure = 1.0
+	rSettings.PathTracing.ResolutionScale = 0.5
 
 	dir, err := os.Getwd()
 	if err != nil {
@@ -179,8 +278,14 @@ func InitRenderingSettings() RenderingSettings {
 	rSettings.Defered.LightingPassDrawMode = 0
 	rSettings.Defered.DeferredAmbientStrength = 0.1
 	rSettings.Defered.DeferredTestLightsNumber = 32
+	rSettings.Defered.SSAOEnabled = true
+	rSettings.Defered.SSAORadius = 0.5
+	rSettings.Defered.SSAOBias = 0.025
+	rSettings.Defered.SSAOSamples = 32
+	rSettings.Defered.SSAOStrength = 1.0
 
 	rSettings.General.DebugShadowTexture = false
+	rSettings.General.DebugLightClusters = false
 
 	rSettings.Rays.Draw = false
 	rSettings.Rays.Animate = false
@@ -191,6 +296,15 @@ func InitRenderingSettings() RenderingSettings {
 	rSettings.Rays.DirectionY = 0.0
 	rSettings.Rays.DirectionZ = 0.0
 
+	rSettings.CrossSection.ShowPlane = false
+	rSettings.CrossSection.NormalX = 0.0
+	rSettings.CrossSection.NormalY = 1.0
+	rSettings.CrossSection.NormalZ = 0.0
+	rSettings.CrossSection.Offset = 0.0
+	rSettings.CrossSection.Count = 1
+	rSettings.CrossSection.Spacing = 0.5
+	rSettings.CrossSection.PlaneSize = 10.0
+
 	return rSettings
 }
 
@@ -255,8 +369,49 @@ func ResetRenderSettings() {
 	rSettings.Defered.LightingPassDrawMode = 0
 	rSettings.Defered.DeferredAmbientStrength = 0.1
 	rSettings.Defered.DeferredTestLightsNumber = 32
+	rSettings.Defered.SSAOEnabled = true
+	rSettings.Defered.SSAORadius = 0.5
+	rSettings.Defered.SSAOBias = 0.025
+	rSettings.Defered.SSAOSamples = 32
+	rSettings.Defered.SSAOStrength = 1.0
 
 	rSettings.General.DebugShadowTexture = false
+	rSettings.General.DebugLightClusters = false
+
+	rSettings.Shadows.AtlasSize = 4096
+	rSettings.Shadows.PCFRadius = 1
+	rSettings.Shadows.BiasConstant = 0.0005
+	rSettings.Shadows.BiasSlope = 0.005
+	rSettings.Shadows.MaxLights = 4
+	rSettings.Shadows.CascadeCount = 4
+	rSettings.Shadows.CascadeSplitLambda = 0.75
+	rSettings.Shadows.CascadeBlend = 0.1
+	rSettings.Shadows.DebugCascades = false
+	rSettings.Shadows.PointMapSize = 1024
+	rSettings.Shadows.PointBias = 0.05
+	rSettings.Shadows.PointSoftness = 0.04
+
+	rSettings.SkyBox.SkyboxRotation = 0.0
+	rSettings.SkyBox.SkyboxExposure = 1.0
+	rSettings.PostProcessing.Enabled = false
+	rSettings.PostProcessing.Bloom = true
+	rSettings.PostProcessing.BloomStrength = 0.04
+	rSettings.PostProcessing.BloomFilterRadius = 0.005
+	rSettings.PostProcessing.ToneMapping = true
+	rSettings.PostProcessing.ToneMappingOperator = 1
+	rSettings.PostProcessing.Exposure = 1.0
+	rSettings.PostProcessing.ColorGrading = false
+	rSettings.PostProcessing.ColorGradingLUT = ""
+	rSettings.PostProcessing.ColorGradingIntensity = 1.0
+	rSettings.PostProcessing.Vignette = false
+	rSettings.PostProcessing.VignetteIntensity = 0.35
+	rSettings.PostProcessing.VignetteSmoothness = 0.45
+	rSettings.PostProcessing.FXAA = true
+
+	rSettings.PathTracing.Samples = 256
+	rSettings.PathTracing.Bounces = 6
+	rSettings.PathTracing.Exposure = 1.0
+	rSettings.PathTracing.ResolutionScale = 0.5
 
 	rSettings.Rays.Draw = false
 	rSettings.Rays.Animate = false
@@ -266,6 +421,15 @@ func ResetRenderSettings() {
 	rSettings.Rays.DirectionX = 0.0
 	rSettings.Rays.DirectionY = 0.0
 	rSettings.Rays.DirectionZ = 0.0
+
+	rSettings.CrossSection.ShowPlane = false
+	rSettings.CrossSection.NormalX = 0.0
+	rSettings.CrossSection.NormalY = 1.0
+	rSettings.CrossSection.NormalZ = 0.0
+	rSettings.CrossSection.Offset = 0.0
+	rSettings.CrossSection.Count = 1
+	rSettings.CrossSection.Spacing = 0.5
+	rSettings.CrossSection.PlaneSize = 10.0
 }
 
 // SaveRenderingSettings will save the settings back to yaml file
@@ -274,7 +438,8 @@ func SaveRenderingSettings() {
 
 	dir, err := os.Getwd()
 	if err != nil {
-		LogError("Rendering Settings error: %v", err)
+		LogWarn("Rendering Settings error: %v", err)
+		return
 	}
 
 	if runtime.GOOS == "darwin" {
@@ -287,12 +452,13 @@ func SaveRenderingSettings() {
 
 	data, err := yaml.Marshal(&rsett)
 	if err != nil {
-		LogError("Rendering Settings save error: %v", err)
+		LogWarn("Rendering Settings save error: %v", err)
+		return
 	}
 
 	err = ioutil.WriteFile(dir+"Kuplung_RenderingSettings.yaml", data, 0644)
 	if err != nil {
-		LogError("Rendering Settings save error: %v", err)
+		LogWarn("Rendering Settings save error: %v", err)
 	}
 }
 
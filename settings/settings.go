@@ -18,6 +18,12 @@ type ApplicationSettings struct {
 		CurrentFolder      string `yaml:"currentFolder"`
 		RendererType       uint32 `yaml:"RendererType"`
 		ShowLog            bool   `yaml:"showLog"`
+		Author             string `yaml:"author"`
+		PackResources      bool   `yaml:"packResources"`
+		LinkModels         bool   `yaml:"linkModels"`
+		AppendCamera       bool   `yaml:"appendCamera"`
+		AppendGrid         bool   `yaml:"appendGrid"`
+		AppendRendering    bool   `yaml:"appendRendering"`
 	} `yaml:"App"`
 	AppWindow struct {
 		SDLWindowWidth    float32 `yaml:"SDL_Window_Width"`
@@ -34,6 +40,19 @@ type ApplicationSettings struct {
 	AppGui struct {
 		GUIClearColor []float32 `yaml:"guiClearColor"`
 	} `yaml:"AppGui"`
+	RenderImage struct {
+		Width         int32  `yaml:"Width"`
+		Height        int32  `yaml:"Height"`
+		Format        uint32 `yaml:"Format"`
+		Supersampling int32  `yaml:"Supersampling"`
+		Transparent   bool   `yaml:"Transparent"`
+		NamePattern   string `yaml:"NamePattern"`
+	} `yaml:"RenderImage"`
+	Autosave struct {
+		Enabled      bool  `yaml:"Enabled"`
+		Interval     int64 `yaml:"Interval"`
+		KeepVersions int32 `yaml:"KeepVersions"`
+	} `yaml:"Autosave"`
 	Consumption struct {
 		ConsumptionIntervalCPU    int64 `yaml:"Consumption_Interval_CPU"`
 		ConsumptionTimerCPU       int64
@@ -145,6 +164,24 @@ func InitSettings() ApplicationSettings {
 		appSettings.Rendering.FramesPerSecond = 30.0
 	}
 
+	if appSettings.RenderImage.Width <= 0 || appSettings.RenderImage.Height <= 0 {
+		appSettings.RenderImage.Width = 1920
+		appSettings.RenderImage.Height = 1080
+	}
+	if appSettings.RenderImage.Supersampling <= 0 {
+		appSettings.RenderImage.Supersampling = 1
+	}
+	if len(appSettings.RenderImage.NamePattern) == 0 {
+		appSettings.RenderImage.NamePattern = "{name}_{width}x{height}"
+	}
+
+	if appSettings.Autosave.Interval <= 0 {
+		appSettings.Autosave.Interval = 300
+	}
+	if appSettings.Autosave.KeepVersions <= 0 {
+		appSettings.Autosave.KeepVersions = 5
+	}
+
 	appSettings.Consumption.ConsumptionCPU = ""
 	appSettings.Consumption.ConsumptionCounterCPU = 0
 	appSettings.Consumption.ConsumptionTimerCPU = 0
@@ -158,9 +195,12 @@ func InitSettings() ApplicationSettings {
 	appSettings.Components.ShaderSourceTES = ReadFile(appSettings.App.AppFolder+"shaders/model_face.tes", true)
 	appSettings.Components.ShaderSourceGeometry = ReadFile(appSettings.App.AppFolder+"shaders/model_face.geom", true)
 	appSettings.Components.ShaderSourceFragment = ReadFile(appSettings.App.AppFolder+"shaders/model_face_vars.frag", false)
+	appSettings.Components.ShaderSourceFragment += ReadFile(appSettings.App.AppFolder+"shaders/light_clusters.frag", false)
 	appSettings.Components.ShaderSourceFragment += ReadFile(appSettings.App.AppFolder+"shaders/model_face_effects.frag", false)
 	appSettings.Components.ShaderSourceFragment += ReadFile(appSettings.App.AppFolder+"shaders/model_face_lights.frag", false)
 	appSettings.Components.ShaderSourceFragment += ReadFile(appSettings.App.AppFolder+"shaders/model_face_mapping.frag", false)
+	appSettings.Components.ShaderSourceFragment += ReadFile(appSettings.App.AppFolder+"shaders/shadow_atlas.frag", false)
+	appSettings.Components.ShaderSourceFragment += ReadFile(appSettings.App.AppFolder+"shaders/shadow_cubemaps.frag", false)
 	appSettings.Components.ShaderSourceFragment += ReadFile(appSettings.App.AppFolder+"shaders/model_face_shadow_mapping.frag", false)
 	appSettings.Components.ShaderSourceFragment += ReadFile(appSettings.App.AppFolder+"shaders/model_face_misc.frag", false)
 	appSettings.Components.ShaderSourceFragment += ReadFile(appSettings.App.AppFolder+"shaders/model_face_pbr.frag", false)
@@ -179,11 +219,12 @@ func SaveSettings() {
 
 	data, err := yaml.Marshal(&sett)
 	if err != nil {
-		log.Fatalf("Settings save error: %v", err)
+		LogWarn("Settings save error: %v", err)
+		return
 	}
 
 	err = ioutil.WriteFile(sett.App.AppFolder+"Kuplung_Settings.yaml", data, 0644)
 	if err != nil {
-		log.Fatalf("Settings save error: %v", err)
+		LogWarn("Settings save error: %v", err)
 	}
 }
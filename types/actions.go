@@ -16,9 +16,19 @@ const (
 	ActionFileSaverSaveScene = "Action_FileSaver_SaveScene"
 	ActionFileSaverOpenScene = "Action_FileSaver_OpenScene"
 	ActionFileSaverRenderer  = "Action_FileSaver_Renderer"
+	ActionFileSaverUnpack    = "Action_FileSaver_Unpack"
+	ActionFileSaverAppend    = "Action_FileSaver_Append"
+
+	ActionFileSaverEnvironmentMap  = "Action_FileSaver_EnvironmentMap"
+	ActionFileSaverColorGradingLUT = "Action_FileSaver_ColorGradingLUT"
+
+	ActionFileSaverAddToRecentFiles = "Action_FileSaver_AddToRecentFiles"
 
 	ActionLog = "Action_Log"
 
+	ActionGuiShowError    = "Gui_Show_Error"
+	ActionGuiShowRecovery = "Gui_Show_Recovery"
+
 	ActionLoadingShow = "Loading_Show"
 	ActionLoadingHide = "Loading_Hide"
 	ActionParsingShow = "Parsing_Show"
@@ -8,4 +8,8 @@ const (
 	FileSaverOperationSaveScene FileSaverOperation = 0 + iota
 	FileSaverOperationOpenScene
 	FileSaverOperationRenderer
+	FileSaverOperationUnpackResources
+	FileSaverOperationAppendScene
+	FileSaverOperationOpenEnvironmentMap
+	FileSaverOperationOpenColorGradingLUT
 )
@@ -10,4 +10,10 @@ const (
 	ImportExportFormatGLTF
 	ImportExportFormatSTL
 	ImportExportFormatPLY
+	ImportExportFormatUSD
+	ImportExportFormatHTML
+	ImportExportFormatSVG
+	ImportExportFormatPDF
+	ImportExportFormatSectionSVG
+	ImportExportFormatSectionDXF
 )
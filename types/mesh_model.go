@@ -24,4 +24,7 @@ type MeshModel struct {
 	Indices            []uint32
 
 	ModelMaterial MeshModelMaterial
+
+	// Source is empty for the built-in shapes
+	Source MeshModelSource
 }
@@ -26,3 +26,16 @@ type MeshModelMaterial struct {
 	TextureBump         MeshMaterialTextureImage
 	TextureDisplacement MeshMaterialTextureImage
 }
+
+// Textures returns the texture slots of the material
+func (material *MeshModelMaterial) Textures() []*MeshMaterialTextureImage {
+	return []*MeshMaterialTextureImage{
+		&material.TextureAmbient,
+		&material.TextureDiffuse,
+		&material.TextureSpecular,
+		&material.TextureSpecularExp,
+		&material.TextureDissolve,
+		&material.TextureBump,
+		&material.TextureDisplacement,
+	}
+}
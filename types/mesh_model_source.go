@@ -0,0 +1,13 @@
+package types
+
+// MeshModelSource is the file a model was imported from, so that a scene can link to it instead of storing the geometry
+type MeshModelSource struct {
+	Path     string
+	Format   ImportExportFormat
+	Settings []string
+	// Index and Title are the position and the name of the model in the parsed file
+	Index   int32
+	Title   string
+	Size    int64
+	ModTime int64
+}
@@ -0,0 +1,11 @@
+package types
+
+// RenderImageFormat ...
+type RenderImageFormat uint32
+
+// Render Image formats
+const (
+	RenderImageFormatPNG RenderImageFormat = iota
+	RenderImageFormatJPEG
+	RenderImageFormatPNG16
+)
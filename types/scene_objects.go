@@ -0,0 +1,25 @@
+package types
+
+import "github.com/go-gl/mathgl/mgl32"
+
+// SceneLight describes a light source read from, or written to, an interchange scene file
+type SceneLight struct {
+	Title     string
+	LightType LightSourceType
+
+	Position, Direction, Rotate, Scale mgl32.Vec3
+
+	Ambient, Diffuse, Specular                         mgl32.Vec3
+	StrengthAmbient, StrengthDiffuse, StrengthSpecular float32
+
+	CutOff, OuterCutOff         float32
+	Constant, Linear, Quadratic float32
+}
+
+// SceneCamera describes a camera read from, or written to, an interchange scene file
+type SceneCamera struct {
+	Title            string
+	Position, Rotate mgl32.Vec3
+	FocalLength, Fov float32
+	View             mgl32.Mat4
+}
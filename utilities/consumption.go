@@ -69,12 +69,12 @@ func getCPU(formatted bool) string {
 	cmd.Stdout = &out
 	err := cmd.Run()
 	if err != nil {
-		settings.LogWarn("[Consumption] Can't get process information: ", err)
+		settings.LogWarn("[Consumption] Can't get process information: %v", err)
 	}
 	_, _ = out.ReadString('\n')
 	line, err := out.ReadString('\n')
 	if err != nil {
-		settings.LogWarn("[Consumption] Can't read process information: ", err)
+		settings.LogWarn("[Consumption] Can't read process information: %v", err)
 	}
 	if formatted {
 		return fmt.Sprintf("CPU: %v%%", strings.TrimSpace(line))
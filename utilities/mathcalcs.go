@@ -57,3 +57,14 @@ func ComputeTangentBasis(uvs []mgl32.Vec2, vertices, normals []mgl32.Vec3) (t, b
 	// }
 	return tangents, bitangents
 }
+
+// PlaneBasis returns two unit vectors perpendicular to the normal and to each other
+func PlaneBasis(normal mgl32.Vec3) (u, v mgl32.Vec3) {
+	helper := mgl32.Vec3{0, 1, 0}
+	if normal.Y()*normal.Y() > 0.81 {
+		helper = mgl32.Vec3{0, 0, -1}
+	}
+	u = helper.Cross(normal).Normalize()
+	v = normal.Cross(u)
+	return u, v
+}